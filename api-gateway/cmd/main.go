@@ -6,16 +6,21 @@ import (
 	"github.com/joho/godotenv"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"gitlab.com/final_project1240930/api_gateway/internal/document"
 	bookingHandler "gitlab.com/final_project1240930/api_gateway/internal/handlers/booking_handler"
 	dashboardHandler "gitlab.com/final_project1240930/api_gateway/internal/handlers/dashboard_handler"
+	inventoryHandler "gitlab.com/final_project1240930/api_gateway/internal/handlers/inventory_handler"
 	menuHandler "gitlab.com/final_project1240930/api_gateway/internal/handlers/menu_handler"
+	promotionHandler "gitlab.com/final_project1240930/api_gateway/internal/handlers/promotion_handler"
 	tableHandler "gitlab.com/final_project1240930/api_gateway/internal/handlers/table_handler"
 	userHandler "gitlab.com/final_project1240930/api_gateway/internal/handlers/user_handler"
 	"gitlab.com/final_project1240930/api_gateway/internal/logs"
 	internalMiddleware "gitlab.com/final_project1240930/api_gateway/internal/middleware"
 	bookingService "gitlab.com/final_project1240930/api_gateway/internal/services/booking"
 	dashboardService "gitlab.com/final_project1240930/api_gateway/internal/services/dashboard"
+	inventoryService "gitlab.com/final_project1240930/api_gateway/internal/services/inventory"
 	menuService "gitlab.com/final_project1240930/api_gateway/internal/services/menu"
+	promotionService "gitlab.com/final_project1240930/api_gateway/internal/services/promotion"
 	tableService "gitlab.com/final_project1240930/api_gateway/internal/services/table"
 	userService "gitlab.com/final_project1240930/api_gateway/internal/services/user_service"
 	"go.uber.org/zap"
@@ -68,6 +73,11 @@ func main() {
 		}
 	})
 
+	// รูปเมนูที่ restaurant-service เก็บไว้ในเครื่อง (IMAGE_STORE=local) ต้อง mount โฟลเดอร์เดียวกัน
+	if imageDir := os.Getenv("IMAGE_LOCAL_DIR"); imageDir != "" {
+		e.Static("/images", imageDir)
+	}
+
 	servicePort_1 := os.Getenv("SERVICE_1_PORT")
 	if servicePort_1 == "" {
 		logs.Fatal("SERVICE_1_PORT is not set in .env file")
@@ -113,6 +123,14 @@ func main() {
 	}
 	defer dashboardCC.Close()
 
+	// gRPC Promotion
+	promotionCC, err := grpc.Dial(grpcBookingAddress, grpc.WithInsecure())
+	if err != nil {
+		logs.Fatal("Failed to connect to gRPC server", zap.Error(err))
+		return
+	}
+	defer promotionCC.Close()
+
 	// gRPC Menu
 	menuCC, err := grpc.Dial(grpcRestaurantAddress, grpc.WithInsecure())
 	if err != nil {
@@ -129,13 +147,22 @@ func main() {
 	}
 	defer tableCC.Close()
 
+	// gRPC Inventory
+	inventoryCC, err := grpc.Dial(grpcRestaurantAddress, grpc.WithInsecure())
+	if err != nil {
+		logs.Fatal("Failed to connect to gRPC server", zap.Error(err))
+		return
+	}
+	defer inventoryCC.Close()
+
 	userServiceClient := userService.NewUserServiceClient(userCC)
 	userService := userService.NewUserService(userServiceClient)
 	userHandler := userHandler.NewUserHandler(userService)
 
 	bookingServiceClient := bookingService.NewBookingServiceClient(bookingCC)
 	bookingService := bookingService.NewBookingService(bookingServiceClient)
-	bookingHandler := bookingHandler.NewBookingHandler(bookingService)
+	documents := document.NewRenderer(document.ConfigFromEnv())
+	bookingHandler := bookingHandler.NewBookingHandler(bookingService, documents)
 
 	menuServiceClient := menuService.NewMenuServiceClient(menuCC)
 	menuService := menuService.NewMenuService(menuServiceClient)
@@ -145,10 +172,18 @@ func main() {
 	tableService := tableService.NewTableService(tableServiceClient)
 	tableHandler := tableHandler.NewTableHandler(tableService)
 
+	inventoryServiceClient := inventoryService.NewInventoryServiceClient(inventoryCC)
+	inventoryService := inventoryService.NewInventoryService(inventoryServiceClient)
+	inventoryHandler := inventoryHandler.NewInventoryHandler(inventoryService, documents)
+
 	dashboardServiceClient := dashboardService.NewDashboardServiceClient(dashboardCC)
 	dashboardService := dashboardService.NewDashboardService(dashboardServiceClient)
 	dashboardHandler := dashboardHandler.NewDashboardHandler(dashboardService)
 
+	promotionServiceClient := promotionService.NewPromotionServiceClient(promotionCC)
+	promotionService := promotionService.NewPromotionService(promotionServiceClient)
+	promotionHandler := promotionHandler.NewPromotionHandler(promotionService)
+
 	// Routes Dashboard Service
 	dashboardGroup := e.Group("/dashboard")
 	{
@@ -157,6 +192,9 @@ func main() {
 		dashboardGroup.GET("/monthly-sales", dashboardHandler.GetMonthlySales)                            // ยอดขายรายเดือน
 		dashboardGroup.GET("/monthly-bookings-customers", dashboardHandler.GetMonthlyBookingAndCustomers) // การจองและลูกค้ารายเดือน
 		dashboardGroup.GET("/best-sellers", dashboardHandler.GetBestSellers)                              // สินค้าขายดี
+
+		// Secured routes
+		dashboardGroup.GET("/export", internalMiddleware.AuthMiddleware("manager", "admin")(dashboardHandler.ExportReport)) // ส่งออกรายงาน CSV / XLSX
 	}
 
 	// Routes User Service
@@ -180,11 +218,18 @@ func main() {
 	bookingGroup := e.Group("/booking")
 	{
 		bookingGroup.GET("", bookingHandler.GetBookings)
+		bookingGroup.GET("/export", internalMiddleware.AuthMiddleware("manager", "admin")(bookingHandler.ExportBookings)) // ส่งออกการจอง CSV / XLSX
+		bookingGroup.GET("/customers/:phone_number/dietary-restrictions", internalMiddleware.AuthMiddleware("user", "manager", "admin")(bookingHandler.GetCustomerDietaryRestrictions))
 		bookingGroup.GET("/:booking_id", bookingHandler.GetBookingById)
+		bookingGroup.GET("/prep-sheet.pdf", internalMiddleware.AuthMiddleware("user", "manager", "admin")(bookingHandler.GetPrepSheet))        // ใบเตรียมอาหารประจำวัน
+		bookingGroup.GET("/:booking_id/receipt.pdf", internalMiddleware.AuthMiddleware("user", "manager", "admin")(bookingHandler.GetReceipt)) // ใบเสร็จ
 
 		securedBookingGroup := bookingGroup.Group("")
 		{
 			securedBookingGroup.POST("/create", internalMiddleware.AuthMiddleware("user", "manager", "admin")(bookingHandler.CreateBooking))
+			securedBookingGroup.POST("/quote", internalMiddleware.AuthMiddleware("user", "manager", "admin")(bookingHandler.QuoteBooking))
+			securedBookingGroup.POST("/import", internalMiddleware.AuthMiddleware("manager", "admin")(bookingHandler.ImportBookings))
+			securedBookingGroup.PUT("/customers/:phone_number/dietary-restrictions", internalMiddleware.AuthMiddleware("user", "manager", "admin")(bookingHandler.SetCustomerDietaryRestrictions))
 			securedBookingGroup.PUT("/edit/:booking_id", internalMiddleware.AuthMiddleware("user", "manager", "admin")(bookingHandler.UpdateBooking))
 			securedBookingGroup.DELETE("/delete/:booking_id", internalMiddleware.AuthMiddleware("admin")(bookingHandler.DeleteBooking))
 		}
@@ -194,26 +239,58 @@ func main() {
 	menuGroup := e.Group("/menu")
 	{
 		menuGroup.GET("/items", menuHandler.GetMenuItems)
+		menuGroup.GET("/search", menuHandler.SearchMenu)
 		menuGroup.GET("/item/:id", menuHandler.GetMenuItemById)
 		menuGroup.GET("/sets", menuHandler.GetMenuSets)
 		menuGroup.GET("/set/:id", menuHandler.GetMenuSetById)
 		menuGroup.GET("/set-items", menuHandler.GetMenuSetItems)
 		menuGroup.GET("/set-item/:id", menuHandler.GetMenuSetItemById)
+		menuGroup.GET("/categories", menuHandler.GetMenuCategories)
+		menuGroup.GET("/category/:id", menuHandler.GetMenuCategoryById)
 
 		securedMenuGroup := menuGroup.Group("")
 		{
 			securedMenuGroup.POST("/item", internalMiddleware.AuthMiddleware("admin", "manager")(menuHandler.CreateMenuItem))
 			securedMenuGroup.PUT("/item/:id", internalMiddleware.AuthMiddleware("admin", "manager")(menuHandler.UpdateMenuItem))
 			securedMenuGroup.DELETE("/item/:id", internalMiddleware.AuthMiddleware("manager")(menuHandler.DeleteMenuItem))
+			securedMenuGroup.GET("/items/archived", internalMiddleware.AuthMiddleware("admin", "manager")(menuHandler.GetArchivedMenuItems))
+			securedMenuGroup.POST("/item/:id/archive", internalMiddleware.AuthMiddleware("admin", "manager")(menuHandler.ArchiveMenuItem))
+			securedMenuGroup.POST("/item/:id/restore", internalMiddleware.AuthMiddleware("admin", "manager")(menuHandler.RestoreMenuItem))
+			securedMenuGroup.PUT("/item/:id/availability", internalMiddleware.AuthMiddleware("admin", "manager")(menuHandler.SetMenuItemAvailability))
+			securedMenuGroup.PUT("/item/:id/schedules", internalMiddleware.AuthMiddleware("admin", "manager")(menuHandler.SetMenuItemSchedules))
+			securedMenuGroup.PUT("/item/:id/tags", internalMiddleware.AuthMiddleware("admin", "manager")(menuHandler.SetMenuItemTags))
+			securedMenuGroup.PUT("/item/:id/modifiers", internalMiddleware.AuthMiddleware("admin", "manager")(menuHandler.SetMenuItemModifiers))
+			securedMenuGroup.GET("/item/:id/history", internalMiddleware.AuthMiddleware("admin", "manager")(menuHandler.GetMenuItemHistory))
+			securedMenuGroup.POST("/item/:id/price-schedule", internalMiddleware.AuthMiddleware("admin", "manager")(menuHandler.ScheduleMenuItemPrice))
+
+			// แกลเลอรีรูปภาพของเมนู
+			securedMenuGroup.POST("/item/:id/images", internalMiddleware.AuthMiddleware("admin", "manager")(menuHandler.AddMenuItemImage))
+			securedMenuGroup.PUT("/item/:id/images/order", internalMiddleware.AuthMiddleware("admin", "manager")(menuHandler.ReorderMenuItemImages))
+			securedMenuGroup.DELETE("/item/:id/images/:imageId", internalMiddleware.AuthMiddleware("admin", "manager")(menuHandler.RemoveMenuItemImage))
 
 			securedMenuGroup.POST("/set", internalMiddleware.AuthMiddleware("admin", "manager")(menuHandler.CreateMenuSet))
 			securedMenuGroup.PUT("/set/:id", internalMiddleware.AuthMiddleware("admin", "manager")(menuHandler.UpdateMenuSet))
+			securedMenuGroup.PUT("/set/:id/schedules", internalMiddleware.AuthMiddleware("admin", "manager")(menuHandler.SetMenuSetSchedules))
+			securedMenuGroup.PUT("/set/:id/slots", internalMiddleware.AuthMiddleware("admin", "manager")(menuHandler.SetMenuSetSlots))
+			securedMenuGroup.GET("/set/:id/history", internalMiddleware.AuthMiddleware("admin", "manager")(menuHandler.GetMenuSetHistory))
+			securedMenuGroup.POST("/set/:id/price-schedule", internalMiddleware.AuthMiddleware("admin", "manager")(menuHandler.ScheduleMenuSetPrice))
 			securedMenuGroup.DELETE("/set/:id", internalMiddleware.AuthMiddleware("manager")(menuHandler.DeleteMenuSet))
+			securedMenuGroup.GET("/sets/archived", internalMiddleware.AuthMiddleware("admin", "manager")(menuHandler.GetArchivedMenuSets))
+			securedMenuGroup.POST("/set/:id/archive", internalMiddleware.AuthMiddleware("admin", "manager")(menuHandler.ArchiveMenuSet))
+			securedMenuGroup.POST("/set/:id/restore", internalMiddleware.AuthMiddleware("admin", "manager")(menuHandler.RestoreMenuSet))
 
 			securedMenuGroup.POST("/set-item", internalMiddleware.AuthMiddleware("admin", "manager")(menuHandler.CreateMenuSetItem))
 			securedMenuGroup.PUT("/set-item", internalMiddleware.AuthMiddleware("admin", "manager")(menuHandler.UpdateMenuSetItem))
 			securedMenuGroup.DELETE("/set-item/:id", internalMiddleware.AuthMiddleware("manager")(menuHandler.DeleteMenuSetItem))
 
+			securedMenuGroup.POST("/category", internalMiddleware.AuthMiddleware("admin", "manager")(menuHandler.CreateMenuCategory))
+			securedMenuGroup.PUT("/category/:id", internalMiddleware.AuthMiddleware("admin", "manager")(menuHandler.UpdateMenuCategory))
+			securedMenuGroup.DELETE("/category/:id", internalMiddleware.AuthMiddleware("manager")(menuHandler.DeleteMenuCategory))
+
+			// นำออก/นำเข้าเมนูทั้งร้าน (?format=json|csv|zip, ?dry_run=true)
+			securedMenuGroup.GET("/export", internalMiddleware.AuthMiddleware("admin", "manager")(menuHandler.ExportMenu))
+			securedMenuGroup.POST("/import", internalMiddleware.AuthMiddleware("admin", "manager")(menuHandler.ImportMenu))
+
 		}
 	}
 
@@ -221,10 +298,13 @@ func main() {
 	tableGroup := e.Group("/tables")
 	{
 		// Public routes
-		tableGroup.GET("/:number", tableHandler.GetTableByNumTable) // Get table by number
-		tableGroup.GET("", tableHandler.GetTables)                  // Get all tables
-		tableGroup.GET("/types", tableHandler.ListTableTypes)       // List all table types
-		tableGroup.GET("/available/:date", tableHandler.GetAvailableTables)
+		tableGroup.GET("/:number", tableHandler.GetTableByNumTable)         // Get table by number
+		tableGroup.GET("", tableHandler.GetTables)                          // Get all tables
+		tableGroup.GET("/types", tableHandler.ListTableTypes)               // List all table types
+		tableGroup.GET("/available/:date", tableHandler.GetAvailableTables) // ?zone_id= เฉพาะโซน
+		tableGroup.GET("/zones", tableHandler.ListTableZones)               // List all zones
+		tableGroup.GET("/floor-plan", tableHandler.GetFloorPlan)            // Zones with table positions
+		tableGroup.GET("/combinations", tableHandler.ListTableCombinations) // List combinable table sets
 
 		// Secured routes
 		securedTableGroup := tableGroup.Group("")
@@ -235,10 +315,70 @@ func main() {
 			securedTableGroup.DELETE("/:id", internalMiddleware.AuthMiddleware("admin")(tableHandler.DeleteTable))             // Delete a table
 
 			// Table type management
-			securedTableGroup.PUT("/type", internalMiddleware.AuthMiddleware("admin", "manager")(tableHandler.UpdateTableType)) // Update table type
+			securedTableGroup.POST("/types", internalMiddleware.AuthMiddleware("admin", "manager")(tableHandler.CreateTableType))
+			securedTableGroup.PUT("/types/:id", internalMiddleware.AuthMiddleware("admin", "manager")(tableHandler.UpdateTableType))
+			securedTableGroup.DELETE("/types/:id", internalMiddleware.AuthMiddleware("admin")(tableHandler.DeleteTableType))
+
+			// Floor plan management
+			securedTableGroup.POST("/zones", internalMiddleware.AuthMiddleware("admin", "manager")(tableHandler.CreateTableZone))
+			securedTableGroup.PUT("/zones/:id", internalMiddleware.AuthMiddleware("admin", "manager")(tableHandler.UpdateTableZone))
+			securedTableGroup.DELETE("/zones/:id", internalMiddleware.AuthMiddleware("admin")(tableHandler.DeleteTableZone))
+			securedTableGroup.PUT("/layout", internalMiddleware.AuthMiddleware("admin", "manager")(tableHandler.UpdateTableLayouts)) // Save table positions
+
+			// Table combination management
+			securedTableGroup.POST("/combinations", internalMiddleware.AuthMiddleware("admin", "manager")(tableHandler.CreateTableCombination))
+			securedTableGroup.PUT("/combinations/:id", internalMiddleware.AuthMiddleware("admin", "manager")(tableHandler.UpdateTableCombination))
+			securedTableGroup.DELETE("/combinations/:id", internalMiddleware.AuthMiddleware("admin")(tableHandler.DeleteTableCombination))
+
+			// Table blocks (โต๊ะชำรุด/กันไว้ให้พนักงาน)
+			securedTableGroup.GET("/blocks", internalMiddleware.AuthMiddleware("admin", "manager")(tableHandler.ListTableBlocks)) // ?date=YYYY-MM-DD
+			securedTableGroup.POST("/blocks", internalMiddleware.AuthMiddleware("admin", "manager")(tableHandler.CreateTableBlock))
+			securedTableGroup.DELETE("/blocks/:id", internalMiddleware.AuthMiddleware("admin", "manager")(tableHandler.DeleteTableBlock))
 		}
 	}
 
+	// Routes Promotion Service
+	promotionGroup := e.Group("/promotions")
+	{
+		promotionGroup.GET("", internalMiddleware.AuthMiddleware("user", "manager", "admin")(promotionHandler.GetPromotions))
+		promotionGroup.GET("/:id", internalMiddleware.AuthMiddleware("user", "manager", "admin")(promotionHandler.GetPromotionByID))
+		promotionGroup.GET("/:id/redemptions", internalMiddleware.AuthMiddleware("manager", "admin")(promotionHandler.GetPromotionRedemptions)) // การจองที่ใช้โค้ดนี้
+
+		promotionGroup.POST("", internalMiddleware.AuthMiddleware("manager", "admin")(promotionHandler.CreatePromotion))
+		promotionGroup.PUT("/:id", internalMiddleware.AuthMiddleware("manager", "admin")(promotionHandler.UpdatePromotion))
+		promotionGroup.DELETE("/:id", internalMiddleware.AuthMiddleware("manager", "admin")(promotionHandler.DeletePromotion)) // ลบได้เฉพาะโค้ดที่ยังไม่มีการใช้
+	}
+
+	// Routes Inventory Service (วัตถุดิบ สูตรเมนู และสต็อก)
+	inventoryGroup := e.Group("/inventory")
+	{
+		inventoryGroup.GET("/ingredients", internalMiddleware.AuthMiddleware("manager", "admin")(inventoryHandler.GetIngredients))
+		inventoryGroup.GET("/ingredients/:id", internalMiddleware.AuthMiddleware("manager", "admin")(inventoryHandler.GetIngredientByID))
+		inventoryGroup.POST("/ingredients", internalMiddleware.AuthMiddleware("manager", "admin")(inventoryHandler.CreateIngredient))
+		inventoryGroup.PUT("/ingredients/:id", internalMiddleware.AuthMiddleware("manager", "admin")(inventoryHandler.UpdateIngredient))
+		inventoryGroup.DELETE("/ingredients/:id", internalMiddleware.AuthMiddleware("manager", "admin")(inventoryHandler.DeleteIngredient))    // ลบได้เฉพาะวัตถุดิบที่ไม่อยู่ในสูตรแล้ว
+		inventoryGroup.POST("/ingredients/:id/movements", internalMiddleware.AuthMiddleware("manager", "admin")(inventoryHandler.AdjustStock)) // รับเข้า ใช้ไป ทิ้ง ตรวจนับ ปรับแก้
+
+		inventoryGroup.GET("/movements", internalMiddleware.AuthMiddleware("manager", "admin")(inventoryHandler.GetStockMovements))
+		inventoryGroup.GET("/projection", internalMiddleware.AuthMiddleware("manager", "admin")(inventoryHandler.GetConsumptionProjection)) // ปริมาณที่การจองที่ยืนยันแล้วต้องใช้
+		inventoryGroup.GET("/alerts", internalMiddleware.AuthMiddleware("manager", "admin")(inventoryHandler.GetLowStockAlerts))
+
+		inventoryGroup.GET("/recipes/:id", internalMiddleware.AuthMiddleware("manager", "admin")(inventoryHandler.GetMenuItemRecipe)) // id = ID ของเมนู
+		inventoryGroup.PUT("/recipes/:id", internalMiddleware.AuthMiddleware("manager", "admin")(inventoryHandler.SetMenuItemRecipe))
+
+		inventoryGroup.GET("/suppliers", internalMiddleware.AuthMiddleware("manager", "admin")(inventoryHandler.GetSuppliers))
+		inventoryGroup.POST("/suppliers", internalMiddleware.AuthMiddleware("manager", "admin")(inventoryHandler.CreateSupplier))
+		inventoryGroup.PUT("/suppliers/:id", internalMiddleware.AuthMiddleware("manager", "admin")(inventoryHandler.UpdateSupplier))
+		inventoryGroup.DELETE("/suppliers/:id", internalMiddleware.AuthMiddleware("manager", "admin")(inventoryHandler.DeleteSupplier)) // ลบได้เฉพาะผู้ขายที่ยังไม่มีใบสั่งซื้อ
+
+		inventoryGroup.POST("/purchase-orders/generate", internalMiddleware.AuthMiddleware("manager", "admin")(inventoryHandler.GeneratePurchaseOrders)) // สร้างร่างใหม่แทนร่างเดิม
+		inventoryGroup.GET("/purchase-orders", internalMiddleware.AuthMiddleware("manager", "admin")(inventoryHandler.GetPurchaseOrders))
+		inventoryGroup.GET("/purchase-orders/export", internalMiddleware.AuthMiddleware("manager", "admin")(inventoryHandler.ExportPurchaseOrders)) // ?status=DRAFT&format=csv|xlsx|pdf
+		inventoryGroup.GET("/purchase-orders/:id", internalMiddleware.AuthMiddleware("manager", "admin")(inventoryHandler.GetPurchaseOrderByID))
+		inventoryGroup.GET("/purchase-orders/:id/export", internalMiddleware.AuthMiddleware("manager", "admin")(inventoryHandler.ExportPurchaseOrder))
+		inventoryGroup.PUT("/purchase-orders/:id/status", internalMiddleware.AuthMiddleware("manager", "admin")(inventoryHandler.UpdatePurchaseOrderStatus)) // RECEIVED = รับของเข้าสต็อก
+	}
+
 	appPort := os.Getenv("APP_PORT")
 	if appPort == "" {
 		logs.Fatal("APP_PORT is not set in .env file")
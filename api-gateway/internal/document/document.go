@@ -0,0 +1,216 @@
+package document
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/go-pdf/fpdf"
+)
+
+// ไฟล์ฟอนต์ภาษาไทยเริ่มต้น (แพ็กเกจ font-noto-thai ของ Alpine)
+const (
+	defaultRegularFont = "/usr/share/fonts/noto/NotoSansThai-Regular.ttf"
+	defaultBoldFont    = "/usr/share/fonts/noto/NotoSansThai-Bold.ttf"
+)
+
+const fontFamily = "thai"
+
+// Config คือค่าตั้งค่าของเอกสาร PDF
+type Config struct {
+	RegularFontPath string
+	BoldFontPath    string
+}
+
+// ConfigFromEnv อ่านตำแหน่งไฟล์ฟอนต์จาก PDF_FONT_REGULAR และ PDF_FONT_BOLD
+func ConfigFromEnv() Config {
+	return Config{
+		RegularFontPath: envOrDefault("PDF_FONT_REGULAR", defaultRegularFont),
+		BoldFontPath:    envOrDefault("PDF_FONT_BOLD", defaultBoldFont),
+	}
+}
+
+func envOrDefault(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// Renderer สร้างเอกสาร PDF โดยโหลดไฟล์ฟอนต์ครั้งแรกที่ใช้งานแล้วเก็บไว้ใช้ซ้ำ
+type Renderer struct {
+	cfg Config
+
+	fontsOnce sync.Once
+	regular   []byte
+	bold      []byte
+	fontsErr  error
+}
+
+func NewRenderer(cfg Config) *Renderer {
+	return &Renderer{cfg: cfg}
+}
+
+func (r *Renderer) loadFonts() error {
+	r.fontsOnce.Do(func() {
+		if r.regular, r.fontsErr = os.ReadFile(r.cfg.RegularFontPath); r.fontsErr != nil {
+			r.fontsErr = fmt.Errorf("failed to load PDF font: %w", r.fontsErr)
+			return
+		}
+		if r.bold, r.fontsErr = os.ReadFile(r.cfg.BoldFontPath); r.fontsErr != nil {
+			r.fontsErr = fmt.Errorf("failed to load PDF bold font: %w", r.fontsErr)
+		}
+	})
+	return r.fontsErr
+}
+
+// newPDF สร้างเอกสาร A4 แนวตั้งที่ตั้งค่าฟอนต์ภาษาไทยและเลขหน้าไว้แล้ว
+func (r *Renderer) newPDF(title string) (*fpdf.Fpdf, error) {
+	if err := r.loadFonts(); err != nil {
+		return nil, err
+	}
+
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetTitle(title, true)
+	pdf.SetMargins(15, 15, 15)
+	pdf.SetAutoPageBreak(true, 15)
+	pdf.AddUTF8FontFromBytes(fontFamily, "", r.regular)
+	pdf.AddUTF8FontFromBytes(fontFamily, "B", r.bold)
+	pdf.AliasNbPages("")
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-12)
+		pdf.SetFont(fontFamily, "", 8)
+		pdf.CellFormat(0, 5, fmt.Sprintf("หน้า %d/{nb}", pdf.PageNo()), "", 0, "R", false, 0, "")
+	})
+	pdf.AddPage()
+
+	return pdf, pdf.Error()
+}
+
+// ---------------- Table ------------------------
+
+type column struct {
+	title string
+	width float64
+	align string
+}
+
+const lineHeight = 5.5
+
+// table วาดตารางที่ขึ้นบรรทัดใหม่ในเซลล์ได้ และวาดหัวตารางซ้ำเมื่อขึ้นหน้าใหม่
+type table struct {
+	pdf     *fpdf.Fpdf
+	columns []column
+}
+
+func newTable(pdf *fpdf.Fpdf, columns ...column) *table {
+	t := &table{pdf: pdf, columns: columns}
+	t.header()
+	return t
+}
+
+func (t *table) header() {
+	t.pdf.SetFont(fontFamily, "B", 9)
+	t.pdf.SetFillColor(235, 235, 235)
+	for _, col := range t.columns {
+		t.pdf.CellFormat(col.width, 7, col.title, "1", 0, "C", true, 0, "")
+	}
+	t.pdf.Ln(-1)
+}
+
+// row วาดหนึ่งแถว ความสูงของแถวเท่ากับเซลล์ที่มีจำนวนบรรทัดมากที่สุด
+func (t *table) row(style string, values ...string) {
+	t.pdf.SetFont(fontFamily, style, 9)
+
+	lines := make([][]string, len(t.columns))
+	maxLines := 1
+	for i, col := range t.columns {
+		lines[i] = wrapText(t.pdf, values[i], col.width)
+		if len(lines[i]) > maxLines {
+			maxLines = len(lines[i])
+		}
+	}
+	height := float64(maxLines) * lineHeight
+
+	_, pageHeight := t.pdf.GetPageSize()
+	_, _, _, bottom := t.pdf.GetMargins()
+	if t.pdf.GetY()+height > pageHeight-bottom {
+		t.pdf.AddPage()
+		t.header()
+		t.pdf.SetFont(fontFamily, style, 9)
+	}
+
+	left, _, _, _ := t.pdf.GetMargins()
+	x, y := left, t.pdf.GetY()
+	for i, col := range t.columns {
+		t.pdf.Rect(x, y, col.width, height, "D")
+		for j, line := range lines[i] {
+			t.pdf.SetXY(x, y+float64(j)*lineHeight)
+			t.pdf.CellFormat(col.width, lineHeight, line, "", 0, col.align, false, 0, "")
+		}
+		x += col.width
+	}
+	t.pdf.SetXY(left, y+height)
+}
+
+// wrapText ตัดข้อความให้พอดีกับความกว้าง ภาษาไทยไม่มีช่องว่างระหว่างคำจึงอาจตัดกลางคำได้
+// แต่จะไม่ให้สระบน/ล่างและวรรณยุกต์ไปขึ้นต้นบรรทัดใหม่
+func wrapText(pdf *fpdf.Fpdf, text string, width float64) []string {
+	lines := pdf.SplitText(text, width)
+	if len(lines) == 0 {
+		return []string{""}
+	}
+	for i := 1; i < len(lines); i++ {
+		runes := []rune(lines[i])
+		n := 0
+		for n < len(runes) && unicode.Is(unicode.Mn, runes[n]) {
+			n++
+		}
+		if n > 0 {
+			lines[i-1] += string(runes[:n])
+			lines[i] = string(runes[n:])
+		}
+	}
+	return lines
+}
+
+// ---------------- Formatting ------------------------
+
+// formatBaht แสดงจำนวนเงินเป็น "1,234.50"
+func formatBaht(satang int64) string {
+	sign := ""
+	if satang < 0 {
+		sign = "-"
+		satang = -satang
+	}
+
+	whole := strconv.FormatInt(satang/100, 10)
+	var grouped strings.Builder
+	for i, digit := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			grouped.WriteByte(',')
+		}
+		grouped.WriteRune(digit)
+	}
+	return fmt.Sprintf("%s%s.%02d", sign, grouped.String(), satang%100)
+}
+
+func formatPercent(percent float64) string {
+	return strconv.FormatFloat(percent, 'f', -1, 64) + "%"
+}
+
+// bangkokTime แปลงเวลา RFC3339 เป็นเวลากรุงเทพ ถ้าแปลงไม่ได้จะคืนค่าเดิม
+func bangkokTime(value, layout string) string {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return value
+	}
+	if bangkok, err := time.LoadLocation("Asia/Bangkok"); err == nil {
+		t = t.In(bangkok)
+	}
+	return t.Format(layout)
+}
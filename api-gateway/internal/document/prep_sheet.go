@@ -0,0 +1,87 @@
+package document
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	services "gitlab.com/final_project1240930/api_gateway/internal/services/booking"
+)
+
+// RenderPrepSheet เขียนใบเตรียมอาหารประจำวันของครัวเป็น PDF
+func (r *Renderer) RenderPrepSheet(w io.Writer, sheet *services.PrepSheet) error {
+	pdf, err := r.newPDF("Kitchen prep sheet " + sheet.Date)
+	if err != nil {
+		return err
+	}
+
+	pdf.SetFont(fontFamily, "B", 16)
+	pdf.CellFormat(0, 9, "ใบเตรียมอาหาร / Kitchen Prep Sheet", "", 1, "C", false, 0, "")
+	pdf.SetFont(fontFamily, "", 11)
+	pdf.CellFormat(0, 6, fmt.Sprintf("วันที่ / Date: %s", sheet.Date), "", 1, "C", false, 0, "")
+	pdf.CellFormat(0, 6, fmt.Sprintf("การจอง %d รายการ, ผู้ใหญ่ %d, เด็ก %d", len(sheet.Bookings), sheet.TotalAdults, sheet.TotalChildren), "", 1, "C", false, 0, "")
+	pdf.Ln(4)
+
+	section := func(title string) {
+		pdf.SetFont(fontFamily, "B", 12)
+		pdf.CellFormat(0, 8, title, "", 1, "L", false, 0, "")
+	}
+
+	section("จำนวนที่ต้องเตรียม / Dishes to prepare")
+	items := newTable(pdf,
+		column{title: "หมวดหมู่ / Category", width: 35, align: "L"},
+		column{title: "เมนู / Menu", width: 85, align: "L"},
+		column{title: "จากเซ็ต / Sets", width: 20, align: "R"},
+		column{title: "สั่งแยก / A la carte", width: 20, align: "R"},
+		column{title: "รวม / Total", width: 20, align: "R"},
+	)
+	for _, item := range sheet.Items {
+		name := item.NameTh
+		if item.NameEn != "" && item.NameEn != item.NameTh {
+			name += " / " + item.NameEn
+		}
+		// ตัวเลือกของจานที่สั่งแยกแสดงใต้ชื่อเมนูพร้อมจำนวนจาน
+		for _, modifier := range item.Modifiers {
+			name += fmt.Sprintf("\n- %s: %s x%d", modifier.GroupNameTh, modifier.NameTh, modifier.Quantity)
+		}
+		items.row("", item.Category, name, fmt.Sprint(item.FromSets), fmt.Sprint(item.ALaCarte), fmt.Sprint(item.Total))
+	}
+	pdf.Ln(5)
+
+	if len(sheet.MenuSets) > 0 {
+		section("เมนูเซ็ต / Menu sets")
+		menuSets := newTable(pdf,
+			column{title: "เมนูเซ็ต / Menu set", width: 60, align: "L"},
+			column{title: "จำนวน / Qty", width: 20, align: "R"},
+			column{title: "รายการในเซ็ต / Contents", width: 100, align: "L"},
+		)
+		for _, menuSet := range sheet.MenuSets {
+			menuSets.row("", menuSet.MenuSetName, fmt.Sprint(menuSet.Quantity), menuItemNames(menuSet.MenuItems))
+		}
+		pdf.Ln(5)
+	}
+
+	section("การจอง / Bookings")
+	bookings := newTable(pdf,
+		column{title: "เวลา / Time", width: 20, align: "C"},
+		column{title: "ลูกค้า / Customer", width: 80, align: "L"},
+		column{title: "ผู้ใหญ่ / Adults", width: 25, align: "R"},
+		column{title: "เด็ก / Children", width: 25, align: "R"},
+		column{title: "โต๊ะ / Tables", width: 30, align: "L"},
+	)
+	for _, booking := range sheet.Bookings {
+		customer := booking.CustomerName
+		if booking.CompanyName != "" {
+			customer += "\n" + booking.CompanyName
+		}
+		bookings.row("",
+			bangkokTime(booking.BookingDateTime, "15:04"),
+			customer,
+			fmt.Sprint(booking.NumAdults),
+			fmt.Sprint(booking.NumChildren),
+			strings.Join(booking.TableNumbers, ", "),
+		)
+	}
+
+	return pdf.Output(w)
+}
@@ -0,0 +1,81 @@
+package document
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+
+	services "gitlab.com/final_project1240930/api_gateway/internal/services/inventory"
+)
+
+// RenderPurchaseOrders เขียนใบสั่งซื้อเป็น PDF หนึ่งหน้าต่อผู้ขายหนึ่งราย
+func (r *Renderer) RenderPurchaseOrders(w io.Writer, orders []*services.PurchaseOrder) error {
+	pdf, err := r.newPDF("Purchase orders")
+	if err != nil {
+		return err
+	}
+
+	if len(orders) == 0 {
+		pdf.SetFont(fontFamily, "", 11)
+		pdf.CellFormat(0, 8, "ไม่มีใบสั่งซื้อ / No purchase orders", "", 1, "C", false, 0, "")
+		return pdf.Output(w)
+	}
+
+	for i, order := range orders {
+		if i > 0 {
+			pdf.AddPage()
+		}
+
+		pdf.SetFont(fontFamily, "B", 16)
+		pdf.CellFormat(0, 9, "ใบสั่งซื้อ / Purchase Order", "", 1, "C", false, 0, "")
+		pdf.SetFont(fontFamily, "", 10)
+		pdf.CellFormat(0, 5, fmt.Sprintf("เลขที่ / No.: %s (%s)", order.Id, order.Status), "", 1, "C", false, 0, "")
+		pdf.Ln(4)
+
+		supplier := order.Supplier
+		pdf.SetFont(fontFamily, "B", 11)
+		pdf.CellFormat(0, 6, "ผู้ขาย / Supplier: "+supplier.GetName(), "", 1, "L", false, 0, "")
+		pdf.SetFont(fontFamily, "", 10)
+		if supplier.GetContactName() != "" {
+			pdf.CellFormat(0, 5, "ผู้ติดต่อ / Contact: "+supplier.GetContactName(), "", 1, "L", false, 0, "")
+		}
+		if supplier.GetPhone() != "" || supplier.GetEmail() != "" {
+			pdf.CellFormat(0, 5, fmt.Sprintf("โทร / Phone: %s   อีเมล / Email: %s", supplier.GetPhone(), supplier.GetEmail()), "", 1, "L", false, 0, "")
+		}
+		pdf.CellFormat(0, 5, fmt.Sprintf("สำหรับการจองวันที่ / For bookings: %s - %s", order.FromDate, order.ToDate), "", 1, "L", false, 0, "")
+		pdf.CellFormat(0, 5, fmt.Sprintf("ควรสั่งภายใน / Order by: %s", order.OrderByDate), "", 1, "L", false, 0, "")
+		pdf.Ln(4)
+
+		lines := newTable(pdf,
+			column{title: "วัตถุดิบ / Ingredient", width: 58, align: "L"},
+			column{title: "ต้องใช้ / Required", width: 22, align: "R"},
+			column{title: "คงเหลือ / On hand", width: 22, align: "R"},
+			column{title: "สั่งแล้ว / On order", width: 22, align: "R"},
+			column{title: "สั่ง / Order", width: 22, align: "R"},
+			column{title: "จำนวนเงิน / Amount", width: 34, align: "R"},
+		)
+		for _, line := range order.Lines {
+			name := line.NameTh
+			if line.NameEn != "" && line.NameEn != line.NameTh {
+				name += " / " + line.NameEn
+			}
+			lines.row("",
+				name,
+				formatQuantity(line.Required, line.Unit),
+				formatQuantity(line.OnHand, line.Unit),
+				formatQuantity(line.OnOrder, line.Unit),
+				formatQuantity(line.Quantity, line.Unit),
+				formatBaht(int64(math.Round(line.Amount*100))),
+			)
+		}
+		lines.row("B", "รวม / Total", "", "", "", "", formatBaht(int64(math.Round(order.Total*100))))
+	}
+
+	return pdf.Output(w)
+}
+
+// formatQuantity แสดงปริมาณพร้อมหน่วย ตัดศูนย์ท้ายทศนิยมทิ้ง เช่น "1.5 kg"
+func formatQuantity(quantity float64, unit string) string {
+	return strconv.FormatFloat(quantity, 'f', -1, 64) + " " + unit
+}
@@ -0,0 +1,153 @@
+package document
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+
+	services "gitlab.com/final_project1240930/api_gateway/internal/services/booking"
+)
+
+// RenderReceipt เขียนใบเสร็จของการจองเป็น PDF
+func (r *Renderer) RenderReceipt(w io.Writer, booking *services.BookingDetail) error {
+	pdf, err := r.newPDF("Receipt " + booking.BookingId)
+	if err != nil {
+		return err
+	}
+
+	pdf.SetFont(fontFamily, "B", 16)
+	pdf.CellFormat(0, 9, "ใบเสร็จรับเงิน / Receipt", "", 1, "C", false, 0, "")
+	pdf.Ln(3)
+
+	tableNumbers := make([]string, 0, len(booking.Tables))
+	for _, table := range booking.Tables {
+		tableNumbers = append(tableNumbers, table.TableNumber)
+	}
+
+	info := [][2]string{
+		{"เลขที่การจอง / Booking ID", booking.BookingId},
+		{"วันที่จอง / Booking date", bangkokTime(booking.BookingDateTime, "02/01/2006 15:04")},
+		{"ลูกค้า / Customer", booking.CustomerName},
+		{"บริษัท / Company", booking.CompanyName},
+		{"โทรศัพท์ / Phone", booking.PhoneNumber},
+		{"จำนวนลูกค้า / Guests", fmt.Sprintf("ผู้ใหญ่ %d, เด็ก %d", booking.NumAdults, booking.NumChildren)},
+		{"โต๊ะ / Tables", strings.Join(tableNumbers, ", ")},
+		{"วันที่ออกใบเสร็จ / Issued", bangkokTime(time.Now().Format(time.RFC3339), "02/01/2006 15:04")},
+	}
+	for _, field := range info {
+		if field[1] == "" {
+			continue
+		}
+		pdf.SetFont(fontFamily, "B", 10)
+		pdf.CellFormat(55, 6, field[0], "", 0, "L", false, 0, "")
+		pdf.SetFont(fontFamily, "", 10)
+		pdf.CellFormat(0, 6, field[1], "", 1, "L", false, 0, "")
+	}
+	pdf.Ln(4)
+
+	lines := newTable(pdf,
+		column{title: "#", width: 10, align: "C"},
+		column{title: "รายการ / Item", width: 76, align: "L"},
+		column{title: "จำนวน / Qty", width: 20, align: "R"},
+		column{title: "ราคา / Price", width: 25, align: "R"},
+		column{title: "ส่วนลด / Discount", width: 24, align: "R"},
+		column{title: "รวม / Amount", width: 25, align: "R"},
+	)
+
+	pricing := booking.Pricing
+	if pricing == nil {
+		pricing = &services.PriceBreakdown{TotalSatang: int64(math.Round(booking.TotalPrice * 100))}
+	}
+
+	// ชื่อเมนูตายตัวในเซ็ตจะแสดงใต้ชื่อเซ็ต ส่วนเมนูที่เลือกมากับ line.Modifiers
+	setContents := make(map[string]string, len(booking.MenuSets))
+	for _, menuSet := range booking.MenuSets {
+		var fixed []*services.BookingMenuItem
+		for _, item := range menuSet.MenuItems {
+			if item.Quantity == 0 {
+				fixed = append(fixed, item)
+			}
+		}
+		setContents[menuSet.MenuSetId] = menuItemNames(fixed)
+	}
+	itemNames := make(map[string]string, len(booking.MenuItems))
+	for _, item := range booking.MenuItems {
+		itemNames[item.MenuItemId] = menuItemName(item)
+	}
+
+	for i, line := range pricing.Lines {
+		name := line.Name
+		if contents := setContents[line.RefId]; line.Kind == "MENU_SET" && contents != "" {
+			name += "\n(" + contents + ")"
+		}
+		if full, ok := itemNames[line.RefId]; line.Kind == "MENU_ITEM" && ok {
+			name = full
+		}
+		if len(line.Modifiers) > 0 {
+			name += "\n(" + strings.Join(line.Modifiers, ", ") + ")"
+		}
+		discount := ""
+		if line.DiscountSatang != 0 {
+			discount = formatBaht(-line.DiscountSatang)
+		}
+		lines.row("", fmt.Sprint(i+1), name, fmt.Sprint(line.Quantity), formatBaht(line.UnitPriceSatang), discount, formatBaht(line.NetSatang))
+	}
+	pdf.Ln(3)
+
+	totals := [][2]string{{"รวม / Subtotal", formatBaht(pricing.SubtotalSatang)}}
+	if pricing.LineDiscountSatang != 0 {
+		totals = append(totals, [2]string{"ส่วนลดรายการ / Item discounts", formatBaht(-pricing.LineDiscountSatang)})
+	}
+	if pricing.OrderDiscountSatang != 0 {
+		totals = append(totals, [2]string{"ส่วนลดท้ายบิล / Order discount", formatBaht(-pricing.OrderDiscountSatang)})
+	}
+	if pricing.PromotionDiscountSatang != 0 {
+		totals = append(totals, [2]string{"โปรโมชั่น / Promotion " + pricing.PromotionCode, formatBaht(-pricing.PromotionDiscountSatang)})
+	}
+	if pricing.ServiceChargeSatang != 0 {
+		totals = append(totals, [2]string{"ค่าบริการ / Service charge " + formatPercent(pricing.ServiceChargePercent), formatBaht(pricing.ServiceChargeSatang)})
+	}
+	if pricing.VatSatang != 0 {
+		label := "ภาษีมูลค่าเพิ่ม / VAT " + formatPercent(pricing.VatPercent)
+		if pricing.VatInclusive {
+			label += " (รวมในราคาแล้ว / included)"
+		}
+		totals = append(totals, [2]string{label, formatBaht(pricing.VatSatang)})
+	}
+	if pricing.RoundingSatang != 0 {
+		totals = append(totals, [2]string{"ปัดเศษ / Rounding", formatBaht(pricing.RoundingSatang)})
+	}
+	for _, total := range totals {
+		pdf.SetFont(fontFamily, "", 10)
+		pdf.CellFormat(155, 6, total[0], "", 0, "R", false, 0, "")
+		pdf.CellFormat(25, 6, total[1], "", 1, "R", false, 0, "")
+	}
+	pdf.SetFont(fontFamily, "B", 11)
+	pdf.CellFormat(155, 8, "ยอดรวมทั้งสิ้น / Grand total (THB)", "T", 0, "R", false, 0, "")
+	pdf.CellFormat(25, 8, formatBaht(pricing.TotalSatang), "T", 1, "R", false, 0, "")
+
+	return pdf.Output(w)
+}
+
+// menuItemName แสดงชื่อไทยและอังกฤษ (ถ้ามีทั้งสองภาษา)
+func menuItemName(item *services.BookingMenuItem) string {
+	if item.NameEn == "" || item.NameEn == item.NameTh {
+		return item.NameTh
+	}
+	return item.NameTh + " / " + item.NameEn
+}
+
+// menuItemNames เมนูในเซ็ตที่มี quantity (เมนูที่เลือก) แสดงจำนวนจานต่อท้าย
+func menuItemNames(items []*services.BookingMenuItem) string {
+	names := make([]string, 0, len(items))
+	for _, item := range items {
+		if item.Quantity > 0 {
+			names = append(names, fmt.Sprintf("%s x%d", item.NameTh, item.Quantity))
+			continue
+		}
+		names = append(names, item.NameTh)
+	}
+	return strings.Join(names, ", ")
+}
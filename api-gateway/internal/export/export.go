@@ -0,0 +1,319 @@
+package export
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/xuri/excelize/v2"
+)
+
+type Format string
+
+const (
+	CSV  Format = "csv"
+	XLSX Format = "xlsx"
+)
+
+// ParseFormat แปลงค่า query "format" (ค่าว่างจะใช้ CSV)
+func ParseFormat(format string) (Format, error) {
+	switch Format(format) {
+	case "", CSV:
+		return CSV, nil
+	case XLSX:
+		return XLSX, nil
+	default:
+		return "", fmt.Errorf("unsupported export format: %s", format)
+	}
+}
+
+func (f Format) ContentType() string {
+	if f == XLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+func (f Format) FileName(name string) string {
+	return name + "." + string(f)
+}
+
+// Header คือหัวคอลัมน์สองภาษา จะแสดงเป็น "ภาษาไทย (English)"
+type Header struct {
+	TH string
+	EN string
+}
+
+func (h Header) String() string {
+	return fmt.Sprintf("%s (%s)", h.TH, h.EN)
+}
+
+// Baht คือจำนวนเงินบาท จะถูกปัดเป็นสตางค์และแสดงทศนิยม 2 ตำแหน่งเสมอ
+type Baht float64
+
+// FromSatang แปลงจำนวนเงินสตางค์ (ยอดที่บันทึกไว้ของการจอง) เป็น Baht
+func FromSatang(satang int64) Baht {
+	return Baht(float64(satang) / 100)
+}
+
+// Satang ปัดจำนวนเงินเป็นสตางค์ (ปัดครึ่งขึ้น) เพื่อไม่ให้ค่าทศนิยมของ float หลุดไปในไฟล์
+func (b Baht) Satang() int64 {
+	return int64(math.Round(float64(b) * 100))
+}
+
+func (b Baht) String() string {
+	return strconv.FormatFloat(float64(b.Satang())/100, 'f', 2, 64)
+}
+
+// Writer เขียนข้อมูลทีละแถวลงใน io.Writer โดยไม่ต้องเก็บข้อมูลทั้งหมดไว้ก่อน
+type Writer interface {
+	WriteRow(values ...interface{}) error
+	Close() error
+}
+
+// NewWriter สร้าง Writer ตามรูปแบบไฟล์และเขียนแถวหัวคอลัมน์ให้ทันที
+func NewWriter(w io.Writer, format Format, sheet string, headers []Header) (Writer, error) {
+	titles := make([]string, len(headers))
+	for i, h := range headers {
+		titles[i] = h.String()
+	}
+
+	switch format {
+	case XLSX:
+		return newXLSXWriter(w, sheet, titles)
+	default:
+		return newCSVWriter(w, titles)
+	}
+}
+
+// ---------------- CSV ------------------------
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func newCSVWriter(w io.Writer, titles []string) (*csvWriter, error) {
+	// ใส่ UTF-8 BOM เพื่อให้ Excel อ่านภาษาไทยได้ถูกต้อง
+	if _, err := io.WriteString(w, "\ufeff"); err != nil {
+		return nil, err
+	}
+	cw := &csvWriter{w: csv.NewWriter(w)}
+	if err := cw.w.Write(titles); err != nil {
+		return nil, err
+	}
+	return cw, nil
+}
+
+func (cw *csvWriter) WriteRow(values ...interface{}) error {
+	record := make([]string, len(values))
+	for i, v := range values {
+		switch val := v.(type) {
+		case nil:
+			record[i] = ""
+		case string:
+			record[i] = val
+		case Baht:
+			record[i] = val.String()
+		default:
+			record[i] = fmt.Sprint(val)
+		}
+	}
+	if err := cw.w.Write(record); err != nil {
+		return err
+	}
+	// flush ทีละแถวเพื่อให้ข้อมูลถูกส่งออกไประหว่างที่ยังอ่านข้อมูลอยู่
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+func (cw *csvWriter) Close() error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+// ---------------- XLSX ------------------------
+
+type xlsxWriter struct {
+	out       io.Writer
+	file      *excelize.File
+	stream    *excelize.StreamWriter
+	row       int
+	bahtStyle int
+}
+
+func newXLSXWriter(w io.Writer, sheet string, titles []string) (*xlsxWriter, error) {
+	f := excelize.NewFile()
+	if err := f.SetSheetName("Sheet1", sheet); err != nil {
+		return nil, err
+	}
+
+	headerStyle, err := f.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+	if err != nil {
+		return nil, err
+	}
+	bahtFormat := "#,##0.00"
+	bahtStyle, err := f.NewStyle(&excelize.Style{CustomNumFmt: &bahtFormat})
+	if err != nil {
+		return nil, err
+	}
+
+	// StreamWriter จะพักข้อมูลลงไฟล์ชั่วคราวเมื่อมีขนาดใหญ่ แทนการเก็บทั้งหมดไว้ในหน่วยความจำ
+	sw, err := f.NewStreamWriter(sheet)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]interface{}, len(titles))
+	for i, t := range titles {
+		header[i] = excelize.Cell{StyleID: headerStyle, Value: t}
+	}
+	if err := sw.SetRow("A1", header); err != nil {
+		return nil, err
+	}
+
+	return &xlsxWriter{out: w, file: f, stream: sw, row: 1, bahtStyle: bahtStyle}, nil
+}
+
+func (xw *xlsxWriter) WriteRow(values ...interface{}) error {
+	xw.row++
+	cells := make([]interface{}, len(values))
+	for i, v := range values {
+		if baht, ok := v.(Baht); ok {
+			cells[i] = excelize.Cell{StyleID: xw.bahtStyle, Value: float64(baht.Satang()) / 100}
+			continue
+		}
+		cells[i] = v
+	}
+
+	cell, err := excelize.CoordinatesToCellName(1, xw.row)
+	if err != nil {
+		return err
+	}
+	return xw.stream.SetRow(cell, cells)
+}
+
+// finish ปิด sheet ก่อนเขียนไฟล์ ส่วนใหญ่ error ของ XLSX จะเกิดตรงนี้
+func (xw *xlsxWriter) finish() error {
+	return xw.stream.Flush()
+}
+
+// writeOut เขียนไฟล์ทั้งหมดลง out (เรียกหลัง finish)
+func (xw *xlsxWriter) writeOut() error {
+	defer xw.file.Close()
+	return xw.file.Write(xw.out)
+}
+
+func (xw *xlsxWriter) Close() error {
+	if err := xw.finish(); err != nil {
+		xw.file.Close()
+		return err
+	}
+	return xw.writeOut()
+}
+
+// ---------------- HTTP ------------------------
+
+// flush ข้อมูลออกไปยัง client ทุกๆ กี่แถว
+const flushEvery = 100
+
+// MaxXLSXRows จำนวนแถวสูงสุดของไฟล์ XLSX ที่ส่งออกทาง HTTP
+// ไฟล์ XLSX ถูกเก็บทั้งไฟล์ไว้ (หน่วยความจำและไฟล์ชั่วคราว) จนเขียนแถวสุดท้ายเสร็จ ข้อมูลที่มากกว่านี้ให้ใช้ CSV
+const MaxXLSXRows = 100000
+
+// ErrTooManyRows ข้อมูลเกิน MaxXLSXRows แถว
+var ErrTooManyRows = fmt.Errorf("too many rows for an xlsx export (max %d), narrow the date range or use format=csv", MaxXLSXRows)
+
+// HTTPWriter เขียนไฟล์ export ลง http response โดยจะยังไม่ส่ง header จนกว่าจำเป็น
+// เพื่อให้ handler ยังตอบ error เป็น JSON ได้ถ้าล้มเหลวก่อนส่งข้อมูล
+// CSV ส่งทีละแถวตั้งแต่แถวแรก ส่วน XLSX ต้องเก็บทั้งไฟล์ไว้ก่อนจึงส่ง header ตอน Close เท่านั้น
+type HTTPWriter struct {
+	w       http.ResponseWriter
+	format  Format
+	name    string
+	sheet   string
+	headers []Header
+	writer  Writer
+	sent    bool
+	rows    int
+}
+
+func NewHTTPWriter(w http.ResponseWriter, format Format, name, sheet string, headers []Header) *HTTPWriter {
+	return &HTTPWriter{w: w, format: format, name: name, sheet: sheet, headers: headers}
+}
+
+// Started บอกว่าได้ส่ง header ของ response ไปแล้วหรือยัง
+func (hw *HTTPWriter) Started() bool {
+	return hw.sent
+}
+
+func (hw *HTTPWriter) sendHeader() {
+	hw.w.Header().Set("Content-Type", hw.format.ContentType())
+	hw.w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, hw.format.FileName(hw.name)))
+	hw.w.WriteHeader(http.StatusOK)
+	hw.sent = true
+}
+
+func (hw *HTTPWriter) start() error {
+	// XLSX ไม่เขียนอะไรลง response จนกว่าจะ Close จึงยังไม่ต้องส่ง header
+	if hw.format != XLSX {
+		hw.sendHeader()
+	}
+	writer, err := NewWriter(hw.w, hw.format, hw.sheet, hw.headers)
+	if err != nil {
+		return err
+	}
+	hw.writer = writer
+	return nil
+}
+
+func (hw *HTTPWriter) WriteRow(values ...interface{}) error {
+	if hw.writer == nil {
+		if err := hw.start(); err != nil {
+			return err
+		}
+	}
+	if hw.format == XLSX && hw.rows >= MaxXLSXRows {
+		return ErrTooManyRows
+	}
+	if err := hw.writer.WriteRow(values...); err != nil {
+		return err
+	}
+
+	hw.rows++
+	if flusher, ok := hw.w.(http.Flusher); ok && hw.sent && hw.rows%flushEvery == 0 {
+		flusher.Flush()
+	}
+	return nil
+}
+
+// Close เขียนส่วนที่เหลือของไฟล์ (ถ้าไม่มีข้อมูลเลยจะได้ไฟล์ที่มีแต่หัวคอลัมน์)
+// XLSX จะส่ง header หลังปิด sheet สำเร็จแล้วเท่านั้น ถ้า Close ล้มเหลวและ Started เป็น false ยังตอบ error ได้
+func (hw *HTTPWriter) Close() error {
+	if hw.writer == nil {
+		if err := hw.start(); err != nil {
+			return err
+		}
+	}
+	xw, ok := hw.writer.(*xlsxWriter)
+	if !ok || hw.sent {
+		return hw.writer.Close()
+	}
+	if err := xw.finish(); err != nil {
+		xw.file.Close()
+		return err
+	}
+	hw.sendHeader()
+	return xw.writeOut()
+}
+
+// ErrorStatus คือ HTTP status ที่ควรตอบเมื่อ export ล้มเหลวก่อนส่ง header
+func ErrorStatus(err error) int {
+	if errors.Is(err, ErrTooManyRows) {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
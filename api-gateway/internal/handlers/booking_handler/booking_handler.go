@@ -1,23 +1,34 @@
 package handlers
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 	"io"
+	"math"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
+	"gitlab.com/final_project1240930/api_gateway/internal/document"
+	"gitlab.com/final_project1240930/api_gateway/internal/export"
 	"gitlab.com/final_project1240930/api_gateway/internal/logs"
 	services "gitlab.com/final_project1240930/api_gateway/internal/services/booking"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
 type bookingHandler struct {
 	bookingSrv services.BookingService
+	documents  *document.Renderer
 }
 
-func NewBookingHandler(bookingSrv services.BookingService) *bookingHandler {
-	return &bookingHandler{bookingSrv: bookingSrv}
+func NewBookingHandler(bookingSrv services.BookingService, documents *document.Renderer) *bookingHandler {
+	return &bookingHandler{bookingSrv: bookingSrv, documents: documents}
 }
 
 func createErrorResponse(err error) map[string]string {
@@ -35,6 +46,32 @@ func (h *bookingHandler) CreateBooking(c echo.Context) error {
 	resp, err := h.bookingSrv.CreateBooking(c.Request().Context(), &req)
 	if err != nil {
 		logs.Error("Failed to create booking", zap.Error(err))
+		if status.Code(err) == codes.FailedPrecondition {
+			// โค้ดโปรโมชั่นใช้กับการจองนี้ไม่ได้ หรือเมนูที่สั่งปิดขาย/หมด/เลิกขาย/ไม่อยู่ในเวลาที่สั่งได้/เลือกตัวเลือกหรือเมนูในเซ็ตไม่ถูกต้อง
+			return c.JSON(http.StatusUnprocessableEntity, createErrorResponse(errors.New(status.Convert(err).Message())))
+		}
+		return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// QuoteBooking คำนวณยอดเงิน (ส่วนลด ค่าบริการ VAT) ของการจองก่อนบันทึก
+func (h *bookingHandler) QuoteBooking(c echo.Context) error {
+	var req services.CreateBookingRequest
+
+	if err := c.Bind(&req); err != nil {
+		logs.Error("Invalid request format for QuoteBooking", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("invalid request format")))
+	}
+
+	resp, err := h.bookingSrv.QuoteBooking(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to quote booking", zap.Error(err))
+		if status.Code(err) == codes.FailedPrecondition {
+			// โค้ดโปรโมชั่นใช้กับการจองนี้ไม่ได้ หรือเลือกตัวเลือกของเมนูหรือเมนูในเซ็ตไม่ถูกต้อง
+			return c.JSON(http.StatusUnprocessableEntity, createErrorResponse(errors.New(status.Convert(err).Message())))
+		}
 		return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
 	}
 
@@ -76,6 +113,48 @@ func (h *bookingHandler) UpdateBooking(c echo.Context) error {
 	resp, err := h.bookingSrv.UpdateBooking(c.Request().Context(), &req)
 	if err != nil {
 		logs.Error("Failed to update booking", zap.Error(err))
+		if status.Code(err) == codes.FailedPrecondition {
+			// โค้ดโปรโมชั่นใช้กับการจองนี้ไม่ได้ หรือเมนูที่สั่งปิดขาย/หมด/เลิกขาย/ไม่อยู่ในเวลาที่สั่งได้/เลือกตัวเลือกหรือเมนูในเซ็ตไม่ถูกต้อง
+			return c.JSON(http.StatusUnprocessableEntity, createErrorResponse(errors.New(status.Convert(err).Message())))
+		}
+		return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetCustomerDietaryRestrictions ข้อจำกัดด้านอาหารที่บันทึกไว้ของลูกค้า (ระบุด้วยเบอร์โทร)
+func (h *bookingHandler) GetCustomerDietaryRestrictions(c echo.Context) error {
+	req := services.GetCustomerDietaryRestrictionsRequest{PhoneNumber: c.Param("phone_number")}
+
+	resp, err := h.bookingSrv.GetCustomerDietaryRestrictions(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to get dietary restrictions", zap.Error(err))
+		if status.Code(err) == codes.InvalidArgument {
+			return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New(status.Convert(err).Message())))
+		}
+		return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// SetCustomerDietaryRestrictions แทนที่ข้อจำกัดด้านอาหารของลูกค้า
+// body: {"avoid_allergens": ["SHELLFISH"], "required_dietary": ["HALAL"]}
+func (h *bookingHandler) SetCustomerDietaryRestrictions(c echo.Context) error {
+	var req services.CustomerDietaryRestrictions
+	if err := c.Bind(&req); err != nil {
+		logs.Error("Invalid request format for SetCustomerDietaryRestrictions", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("invalid request format")))
+	}
+	req.PhoneNumber = c.Param("phone_number")
+
+	resp, err := h.bookingSrv.SetCustomerDietaryRestrictions(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to set dietary restrictions", zap.Error(err))
+		if status.Code(err) == codes.InvalidArgument {
+			return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New(status.Convert(err).Message())))
+		}
 		return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
 	}
 
@@ -124,3 +203,260 @@ func (h *bookingHandler) GetBookings(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, resp)
 }
+
+var bookingExportHeaders = []export.Header{
+	{TH: "รหัสการจอง", EN: "Booking ID"},
+	{TH: "วันที่", EN: "Date"},
+	{TH: "เวลา", EN: "Time"},
+	{TH: "ชื่อลูกค้า", EN: "Customer Name"},
+	{TH: "ชื่อบริษัท", EN: "Company Name"},
+	{TH: "เบอร์โทรศัพท์", EN: "Phone Number"},
+	{TH: "จำนวนผู้ใหญ่", EN: "Adults"},
+	{TH: "จำนวนเด็ก", EN: "Children"},
+	{TH: "โต๊ะ", EN: "Tables"},
+	{TH: "สถานะ", EN: "Status"},
+	{TH: "ประเภทรายการ", EN: "Line Type"},
+	{TH: "ชื่อเมนูภาษาไทย", EN: "Menu Name TH"},
+	{TH: "ชื่อเมนูภาษาอังกฤษ", EN: "Menu Name EN"},
+	{TH: "ตัวเลือก", EN: "Options"},
+	{TH: "จำนวน", EN: "Quantity"},
+	{TH: "ราคาต่อหน่วย", EN: "Unit Price THB"},
+	{TH: "ส่วนลดรายการ", EN: "Line Discount THB"},
+	{TH: "ราคารวมรายการ", EN: "Line Total THB"},
+	{TH: "ส่วนลดท้ายบิล", EN: "Order Discount THB"},
+	{TH: "โค้ดโปรโมชั่น", EN: "Promotion Code"},
+	{TH: "ส่วนลดโปรโมชั่น", EN: "Promotion Discount THB"},
+	{TH: "ค่าบริการ", EN: "Service Charge THB"},
+	{TH: "ภาษีมูลค่าเพิ่ม", EN: "VAT THB"},
+	{TH: "ปัดเศษ", EN: "Rounding THB"},
+	{TH: "ยอดรวมการจอง", EN: "Booking Total THB"},
+}
+
+// ExportBookings ส่งออกการจองพร้อมโต๊ะและรายการอาหารเป็น CSV หรือ XLSX
+// GET /booking/export?start_date=2024-12-01&end_date=2024-12-31&format=csv|xlsx
+func (h *bookingHandler) ExportBookings(c echo.Context) error {
+	startDate := c.QueryParam("start_date")
+	endDate := c.QueryParam("end_date")
+	if startDate == "" || endDate == "" {
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("start_date and end_date are required")))
+	}
+
+	format, err := export.ParseFormat(c.QueryParam("format"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, createErrorResponse(err))
+	}
+
+	out := export.NewHTTPWriter(c.Response(), format, fmt.Sprintf("bookings_%s_%s", startDate, endDate), "Bookings", bookingExportHeaders)
+
+	req := &services.ExportBookingsRequest{StartDate: startDate, EndDate: endDate}
+	err = h.bookingSrv.ExportBookings(c.Request().Context(), req, func(booking *services.BookingDetail) error {
+		return writeBookingRows(out, booking)
+	})
+	if err != nil {
+		logs.Error("Failed to export bookings", zap.Error(err))
+		if !out.Started() {
+			if status.Code(err) == codes.InvalidArgument {
+				return c.JSON(http.StatusBadRequest, createErrorResponse(err))
+			}
+			return c.JSON(export.ErrorStatus(err), createErrorResponse(err))
+		}
+		return nil
+	}
+
+	if err := out.Close(); err != nil {
+		logs.Error("Failed to finish bookings export", zap.Error(err))
+		if !out.Started() {
+			return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
+		}
+	}
+	return nil
+}
+
+// writeBookingRows เขียนหนึ่งแถวต่อหนึ่งรายการในบิล โดยใช้ราคาที่บันทึกไว้ ณ เวลาที่จอง (ตรงกับใบเสร็จ)
+// ยอดระดับการจอง (ส่วนลดท้ายบิล โปรโมชั่น ค่าบริการ VAT ยอดรวม) จะอยู่แถวแรกของการจองเท่านั้น
+// เพื่อให้รวมคอลัมน์ใน Excel ได้โดยไม่นับซ้ำ
+func writeBookingRows(out export.Writer, booking *services.BookingDetail) error {
+	date, clock := booking.BookingDateTime, ""
+	if t, err := time.Parse(time.RFC3339, booking.BookingDateTime); err == nil {
+		date, clock = t.Format("2006-01-02"), t.Format("15:04")
+	}
+
+	tableNumbers := make([]string, 0, len(booking.Tables))
+	for _, table := range booking.Tables {
+		tableNumbers = append(tableNumbers, table.TableNumber)
+	}
+
+	pricing := booking.Pricing
+	if pricing == nil {
+		pricing = &services.PriceBreakdown{TotalSatang: int64(math.Round(booking.TotalPrice * 100))}
+	}
+
+	itemNames := make(map[string]*services.BookingMenuItem, len(booking.MenuItems))
+	for _, item := range booking.MenuItems {
+		itemNames[item.MenuItemId] = item
+	}
+
+	first := true
+	writeLine := func(line *services.PriceLine) error {
+		bookingColumns := make([]interface{}, 7)
+		if first {
+			bookingColumns = []interface{}{
+				export.FromSatang(pricing.OrderDiscountSatang),
+				pricing.PromotionCode,
+				export.FromSatang(pricing.PromotionDiscountSatang),
+				export.FromSatang(pricing.ServiceChargeSatang),
+				export.FromSatang(pricing.VatSatang),
+				export.FromSatang(pricing.RoundingSatang),
+				export.FromSatang(pricing.TotalSatang),
+			}
+			first = false
+		}
+
+		lineColumns := make([]interface{}, 8)
+		if line != nil {
+			lineType, nameTh, nameEn := "เมนูเซ็ต / Menu Set", line.Name, line.Name
+			if line.Kind == "MENU_ITEM" {
+				lineType = "อาหารจานเดี่ยว / A La Carte"
+				if item, ok := itemNames[line.RefId]; ok {
+					nameTh, nameEn = item.NameTh, item.NameEn
+				}
+			}
+			lineColumns = []interface{}{
+				lineType,
+				nameTh,
+				nameEn,
+				strings.Join(line.Modifiers, ", "),
+				line.Quantity,
+				export.FromSatang(line.UnitPriceSatang),
+				export.FromSatang(line.DiscountSatang),
+				export.FromSatang(line.NetSatang),
+			}
+		}
+
+		row := []interface{}{
+			booking.BookingId,
+			date,
+			clock,
+			booking.CustomerName,
+			booking.CompanyName,
+			booking.PhoneNumber,
+			booking.NumAdults,
+			booking.NumChildren,
+			strings.Join(tableNumbers, ", "),
+			booking.Status,
+		}
+		row = append(row, lineColumns...)
+		row = append(row, bookingColumns...)
+		return out.WriteRow(row...)
+	}
+
+	for _, line := range pricing.Lines {
+		if err := writeLine(line); err != nil {
+			return err
+		}
+	}
+
+	// การจองที่ไม่มีรายการอาหารก็ยังต้องมีหนึ่งแถว
+	if first {
+		return writeLine(nil)
+	}
+	return nil
+}
+
+// ขนาดไฟล์ CSV สูงสุดที่รับได้ (ต่ำกว่าขนาด message สูงสุดของ gRPC ที่ 4MB)
+const maxImportFileSize = 3 << 20
+
+// ImportBookings นำเข้าการจองจากไฟล์ CSV (multipart field "file")
+// POST /booking/import?dry_run=true
+func (h *bookingHandler) ImportBookings(c echo.Context) error {
+	dryRun := false
+	if value := c.QueryParam("dry_run"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("dry_run must be true or false")))
+		}
+		dryRun = parsed
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		logs.Error("Missing import file", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("file is required")))
+	}
+	if fileHeader.Size > maxImportFileSize {
+		return c.JSON(http.StatusBadRequest, createErrorResponse(fmt.Errorf("file must not be larger than %d MB", maxImportFileSize>>20)))
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		logs.Error("Error opening import file", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("could not read file")))
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		logs.Error("Error reading import file", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("could not read file")))
+	}
+
+	resp, err := h.bookingSrv.ImportBookings(c.Request().Context(), &services.ImportBookingsRequest{Csv: data, DryRun: dryRun})
+	if err != nil {
+		logs.Error("Failed to import bookings", zap.Error(err))
+		if status.Code(err) == codes.InvalidArgument {
+			return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New(status.Convert(err).Message())))
+		}
+		return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// renderPDF สร้าง PDF ลง buffer ก่อน เพื่อให้ยังตอบ error เป็น JSON ได้ถ้าสร้างไม่สำเร็จ
+func renderPDF(c echo.Context, fileName string, render func(w io.Writer) error) error {
+	var buf bytes.Buffer
+	if err := render(&buf); err != nil {
+		logs.Error("Failed to render PDF", zap.String("file", fileName), zap.Error(err))
+		return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`inline; filename="%s"`, fileName))
+	return c.Blob(http.StatusOK, "application/pdf", buf.Bytes())
+}
+
+// GetReceipt ใบเสร็จของการจองเป็น PDF
+// GET /booking/:booking_id/receipt.pdf
+func (h *bookingHandler) GetReceipt(c echo.Context) error {
+	id := c.Param("booking_id")
+	resp, err := h.bookingSrv.GetBookingDetailsByID(c.Request().Context(), &services.GetBookingDetailsByIDRequest{BookingId: id})
+	if err != nil {
+		logs.Error("Failed to get booking", zap.String("bookingId", id), zap.Error(err))
+		return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
+	}
+
+	return renderPDF(c, fmt.Sprintf("receipt_%s.pdf", id), func(w io.Writer) error {
+		return h.documents.RenderReceipt(w, resp.BookingDetail)
+	})
+}
+
+// GetPrepSheet ใบเตรียมอาหารของครัวประจำวันเป็น PDF
+// GET /booking/prep-sheet.pdf?date=2024-12-01
+func (h *bookingHandler) GetPrepSheet(c echo.Context) error {
+	date := c.QueryParam("date")
+	if date == "" {
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("date is required")))
+	}
+
+	sheet, err := h.bookingSrv.GetPrepSheet(c.Request().Context(), &services.GetPrepSheetRequest{Date: date})
+	if err != nil {
+		logs.Error("Failed to get prep sheet", zap.String("date", date), zap.Error(err))
+		if status.Code(err) == codes.InvalidArgument {
+			return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New(status.Convert(err).Message())))
+		}
+		return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
+	}
+
+	return renderPDF(c, fmt.Sprintf("prep_sheet_%s.pdf", date), func(w io.Writer) error {
+		return h.documents.RenderPrepSheet(w, sheet)
+	})
+}
@@ -1,12 +1,17 @@
 package handlers
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"gitlab.com/final_project1240930/api_gateway/internal/export"
 	"gitlab.com/final_project1240930/api_gateway/internal/logs"
 	services "gitlab.com/final_project1240930/api_gateway/internal/services/dashboard"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -76,3 +81,76 @@ func (h *dashboardHandler) GetBestSellers(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, resp)
 }
+
+var salesReportHeaders = []export.Header{
+	{TH: "ช่วงเวลา", EN: "Period"},
+	{TH: "ยอดขายรวม", EN: "Total Sales THB"},
+	{TH: "จำนวนการจอง", EN: "Total Bookings"},
+	{TH: "จำนวนลูกค้า", EN: "Total Customers"},
+}
+
+var bestSellerHeaders = []export.Header{
+	{TH: "ประเภท", EN: "Kind"},
+	{TH: "ชื่อเมนูภาษาไทย", EN: "Menu Name TH"},
+	{TH: "ชื่อเมนูภาษาอังกฤษ", EN: "Menu Name EN"},
+	{TH: "จำนวนที่ขายได้", EN: "Quantity Sold"},
+	{TH: "ยอดขายรวม", EN: "Total Sales THB"},
+}
+
+// ExportReport ส่งออกรายงานของ dashboard เป็น CSV หรือ XLSX
+// GET /dashboard/export?report=daily|monthly|best-sellers&start_date=2024-01-01&end_date=2024-12-31&format=csv|xlsx
+func (h *dashboardHandler) ExportReport(c echo.Context) error {
+	startDate := c.QueryParam("start_date")
+	endDate := c.QueryParam("end_date")
+	if startDate == "" || endDate == "" {
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("start_date and end_date are required")))
+	}
+
+	format, err := export.ParseFormat(c.QueryParam("format"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, createErrorResponse(err))
+	}
+
+	report := c.QueryParam("report")
+	req := &services.ExportReportRequest{StartDate: startDate, EndDate: endDate}
+	name := fmt.Sprintf("%s_%s_%s", report, startDate, endDate)
+	ctx := c.Request().Context()
+
+	var out *export.HTTPWriter
+	switch report {
+	case "daily", "monthly":
+		if report == "monthly" {
+			req.Period = services.ReportPeriod_MONTHLY
+		}
+		out = export.NewHTTPWriter(c.Response(), format, name, "Sales", salesReportHeaders)
+		err = h.dashboardSrv.ExportSalesReport(ctx, req, func(row *services.SalesReportRow) error {
+			return out.WriteRow(row.Period, export.Baht(row.TotalSales), row.TotalBookings, row.TotalCustomers)
+		})
+	case "best-sellers":
+		out = export.NewHTTPWriter(c.Response(), format, name, "Best Sellers", bestSellerHeaders)
+		err = h.dashboardSrv.ExportBestSellers(ctx, req, func(row *services.BestSellerRow) error {
+			return out.WriteRow(row.Kind, row.NameTh, row.NameEn, row.TotalQuantitySold, export.Baht(row.TotalSales))
+		})
+	default:
+		return c.JSON(http.StatusBadRequest, createErrorResponse(fmt.Errorf("unsupported report: %s", report)))
+	}
+
+	if err != nil {
+		logs.Error("Failed to export report", zap.String("report", report), zap.Error(err))
+		if !out.Started() {
+			if status.Code(err) == codes.InvalidArgument {
+				return c.JSON(http.StatusBadRequest, createErrorResponse(err))
+			}
+			return c.JSON(export.ErrorStatus(err), createErrorResponse(err))
+		}
+		return nil
+	}
+
+	if err := out.Close(); err != nil {
+		logs.Error("Failed to finish report export", zap.String("report", report), zap.Error(err))
+		if !out.Started() {
+			return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
+		}
+	}
+	return nil
+}
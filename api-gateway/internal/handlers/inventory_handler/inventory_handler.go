@@ -0,0 +1,463 @@
+package handlers
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"gitlab.com/final_project1240930/api_gateway/internal/document"
+	"gitlab.com/final_project1240930/api_gateway/internal/export"
+	"gitlab.com/final_project1240930/api_gateway/internal/logs"
+	services "gitlab.com/final_project1240930/api_gateway/internal/services/inventory"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type inventoryHandler struct {
+	inventorySrv services.InventoryService
+	documents    *document.Renderer
+}
+
+func NewInventoryHandler(inventorySrv services.InventoryService, documents *document.Renderer) *inventoryHandler {
+	return &inventoryHandler{inventorySrv: inventorySrv, documents: documents}
+}
+
+func createErrorResponse(err error) map[string]string {
+	return map[string]string{"error": err.Error()}
+}
+
+// errorResponse แปลง gRPC status เป็น HTTP status
+func errorResponse(c echo.Context, err error) error {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New(status.Convert(err).Message())))
+	case codes.NotFound:
+		return c.JSON(http.StatusNotFound, createErrorResponse(errors.New(status.Convert(err).Message())))
+	case codes.FailedPrecondition:
+		return c.JSON(http.StatusConflict, createErrorResponse(errors.New(status.Convert(err).Message())))
+	}
+	return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
+}
+
+// queryInt32 อ่าน query parameter ที่เป็นจำนวนเต็ม ว่าง = 0
+func queryInt32(c echo.Context, name string) (int32, error) {
+	value := c.QueryParam(name)
+	if value == "" {
+		return 0, nil
+	}
+	parsed, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return 0, errors.New(name + " must be an integer")
+	}
+	return int32(parsed), nil
+}
+
+// ---------------- Ingredient ------------------------
+
+func (h *inventoryHandler) CreateIngredient(c echo.Context) error {
+	var req services.CreateIngredientRequest
+	if err := c.Bind(&req); err != nil {
+		logs.Error("Invalid request format for CreateIngredient", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("invalid request format")))
+	}
+
+	resp, err := h.inventorySrv.CreateIngredient(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to create ingredient", zap.Error(err))
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, resp)
+}
+
+func (h *inventoryHandler) UpdateIngredient(c echo.Context) error {
+	id := c.Param("id")
+
+	var req services.UpdateIngredientRequest
+	if err := c.Bind(&req); err != nil {
+		logs.Error("Invalid request format for UpdateIngredient", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("invalid request format")))
+	}
+	req.Id = id
+
+	resp, err := h.inventorySrv.UpdateIngredient(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to update ingredient", zap.String("id", id), zap.Error(err))
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// DeleteIngredient ลบได้เฉพาะวัตถุดิบที่ไม่อยู่ในสูตรของเมนูแล้ว
+func (h *inventoryHandler) DeleteIngredient(c echo.Context) error {
+	id := c.Param("id")
+
+	if _, err := h.inventorySrv.DeleteIngredient(c.Request().Context(), &services.IngredientIdRequest{Id: id}); err != nil {
+		logs.Error("Failed to delete ingredient", zap.String("id", id), zap.Error(err))
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "Ingredient deleted successfully"})
+}
+
+func (h *inventoryHandler) GetIngredients(c echo.Context) error {
+	resp, err := h.inventorySrv.GetIngredients(c.Request().Context(), &emptypb.Empty{})
+	if err != nil {
+		logs.Error("Failed to get ingredients", zap.Error(err))
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *inventoryHandler) GetIngredientByID(c echo.Context) error {
+	id := c.Param("id")
+
+	resp, err := h.inventorySrv.GetIngredientById(c.Request().Context(), &services.IngredientIdRequest{Id: id})
+	if err != nil {
+		logs.Error("Failed to get ingredient", zap.String("id", id), zap.Error(err))
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// ---------------- Recipe ------------------------
+
+// SetMenuItemRecipe แทนที่สูตรทั้งหมดของเมนู ส่ง lines ว่างเพื่อลบสูตร
+func (h *inventoryHandler) SetMenuItemRecipe(c echo.Context) error {
+	id := c.Param("id")
+
+	var req services.SetMenuItemRecipeRequest
+	if err := c.Bind(&req); err != nil {
+		logs.Error("Invalid request format for SetMenuItemRecipe", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("invalid request format")))
+	}
+	req.MenuItemId = id
+
+	resp, err := h.inventorySrv.SetMenuItemRecipe(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to set menu item recipe", zap.String("menuItemId", id), zap.Error(err))
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *inventoryHandler) GetMenuItemRecipe(c echo.Context) error {
+	id := c.Param("id")
+
+	resp, err := h.inventorySrv.GetMenuItemRecipe(c.Request().Context(), &services.GetMenuItemRecipeRequest{MenuItemId: id})
+	if err != nil {
+		logs.Error("Failed to get menu item recipe", zap.String("menuItemId", id), zap.Error(err))
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// ---------------- Stock ------------------------
+
+// AdjustStock บันทึกรายการรับเข้า ใช้ไป ทิ้ง ตรวจนับ หรือปรับแก้ของวัตถุดิบ
+func (h *inventoryHandler) AdjustStock(c echo.Context) error {
+	id := c.Param("id")
+
+	var req services.AdjustStockRequest
+	if err := c.Bind(&req); err != nil {
+		logs.Error("Invalid request format for AdjustStock", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("invalid request format")))
+	}
+	req.IngredientId = id
+
+	resp, err := h.inventorySrv.AdjustStock(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to adjust stock", zap.String("id", id), zap.Error(err))
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetStockMovements รายการเคลื่อนไหวใหม่สุดก่อน กรองด้วย ?ingredient_id= ได้
+func (h *inventoryHandler) GetStockMovements(c echo.Context) error {
+	limit, err := queryInt32(c, "limit")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, createErrorResponse(err))
+	}
+
+	resp, err := h.inventorySrv.GetStockMovements(c.Request().Context(), &services.GetStockMovementsRequest{
+		IngredientId: c.QueryParam("ingredient_id"),
+		Limit:        limit,
+	})
+	if err != nil {
+		logs.Error("Failed to get stock movements", zap.Error(err))
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// ---------------- Projection & Alerts ------------------------
+
+// GetConsumptionProjection ?from=YYYY-MM-DD&days=7
+func (h *inventoryHandler) GetConsumptionProjection(c echo.Context) error {
+	days, err := queryInt32(c, "days")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, createErrorResponse(err))
+	}
+
+	resp, err := h.inventorySrv.GetConsumptionProjection(c.Request().Context(), &services.ConsumptionProjectionRequest{
+		FromDate: c.QueryParam("from"),
+		Days:     days,
+	})
+	if err != nil {
+		logs.Error("Failed to get consumption projection", zap.Error(err))
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetLowStockAlerts ?days=7
+func (h *inventoryHandler) GetLowStockAlerts(c echo.Context) error {
+	days, err := queryInt32(c, "days")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, createErrorResponse(err))
+	}
+
+	resp, err := h.inventorySrv.GetLowStockAlerts(c.Request().Context(), &services.LowStockAlertsRequest{Days: days})
+	if err != nil {
+		logs.Error("Failed to get low stock alerts", zap.Error(err))
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// ---------------- Supplier ------------------------
+
+func (h *inventoryHandler) CreateSupplier(c echo.Context) error {
+	var req services.Supplier
+	if err := c.Bind(&req); err != nil {
+		logs.Error("Invalid request format for CreateSupplier", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("invalid request format")))
+	}
+	req.Id = ""
+
+	resp, err := h.inventorySrv.CreateSupplier(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to create supplier", zap.Error(err))
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, resp)
+}
+
+func (h *inventoryHandler) UpdateSupplier(c echo.Context) error {
+	id := c.Param("id")
+
+	var req services.Supplier
+	if err := c.Bind(&req); err != nil {
+		logs.Error("Invalid request format for UpdateSupplier", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("invalid request format")))
+	}
+	req.Id = id
+
+	resp, err := h.inventorySrv.UpdateSupplier(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to update supplier", zap.String("id", id), zap.Error(err))
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// DeleteSupplier ลบได้เฉพาะผู้ขายที่ยังไม่มีใบสั่งซื้อ
+func (h *inventoryHandler) DeleteSupplier(c echo.Context) error {
+	id := c.Param("id")
+
+	if _, err := h.inventorySrv.DeleteSupplier(c.Request().Context(), &services.SupplierIdRequest{Id: id}); err != nil {
+		logs.Error("Failed to delete supplier", zap.String("id", id), zap.Error(err))
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{"message": "Supplier deleted successfully"})
+}
+
+func (h *inventoryHandler) GetSuppliers(c echo.Context) error {
+	resp, err := h.inventorySrv.GetSuppliers(c.Request().Context(), &emptypb.Empty{})
+	if err != nil {
+		logs.Error("Failed to get suppliers", zap.Error(err))
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// ---------------- Purchase Order ------------------------
+
+// GeneratePurchaseOrders สร้างร่างใบสั่งซื้อจากการจองที่ยืนยันแล้วในช่วงวัน แทนที่ร่างเดิมทั้งหมด
+// POST /inventory/purchase-orders/generate {"from_date": "2024-12-01", "to_date": "2024-12-07"}
+func (h *inventoryHandler) GeneratePurchaseOrders(c echo.Context) error {
+	var req services.GeneratePurchaseOrdersRequest
+	if err := c.Bind(&req); err != nil {
+		logs.Error("Invalid request format for GeneratePurchaseOrders", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("invalid request format")))
+	}
+
+	resp, err := h.inventorySrv.GeneratePurchaseOrders(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to generate purchase orders", zap.Error(err))
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetPurchaseOrders ?status=DRAFT|SENT|RECEIVED|CANCELLED
+func (h *inventoryHandler) GetPurchaseOrders(c echo.Context) error {
+	resp, err := h.inventorySrv.GetPurchaseOrders(c.Request().Context(), &services.GetPurchaseOrdersRequest{Status: c.QueryParam("status")})
+	if err != nil {
+		logs.Error("Failed to get purchase orders", zap.Error(err))
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *inventoryHandler) GetPurchaseOrderByID(c echo.Context) error {
+	id := c.Param("id")
+
+	resp, err := h.inventorySrv.GetPurchaseOrderById(c.Request().Context(), &services.PurchaseOrderIdRequest{Id: id})
+	if err != nil {
+		logs.Error("Failed to get purchase order", zap.String("id", id), zap.Error(err))
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// UpdatePurchaseOrderStatus {"status": "SENT|RECEIVED|CANCELLED"} RECEIVED จะรับของเข้าสต็อก
+func (h *inventoryHandler) UpdatePurchaseOrderStatus(c echo.Context) error {
+	id := c.Param("id")
+
+	var req services.UpdatePurchaseOrderStatusRequest
+	if err := c.Bind(&req); err != nil {
+		logs.Error("Invalid request format for UpdatePurchaseOrderStatus", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("invalid request format")))
+	}
+	req.Id = id
+
+	resp, err := h.inventorySrv.UpdatePurchaseOrderStatus(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to update purchase order status", zap.String("id", id), zap.Error(err))
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+var purchaseOrderHeaders = []export.Header{
+	{TH: "เลขที่ใบสั่งซื้อ", EN: "PO ID"},
+	{TH: "สถานะ", EN: "Status"},
+	{TH: "ผู้ขาย", EN: "Supplier"},
+	{TH: "ควรสั่งภายใน", EN: "Order By"},
+	{TH: "ชื่อวัตถุดิบภาษาไทย", EN: "Ingredient TH"},
+	{TH: "ชื่อวัตถุดิบภาษาอังกฤษ", EN: "Ingredient EN"},
+	{TH: "หน่วย", EN: "Unit"},
+	{TH: "ต้องใช้", EN: "Required"},
+	{TH: "คงเหลือ", EN: "On Hand"},
+	{TH: "สั่งแล้ว", EN: "On Order"},
+	{TH: "จำนวนที่สั่ง", EN: "Order Quantity"},
+	{TH: "ราคาต่อหน่วย", EN: "Unit Cost THB"},
+	{TH: "จำนวนเงิน", EN: "Amount THB"},
+}
+
+// ExportPurchaseOrders ส่งออกใบสั่งซื้อตามสถานะ (ค่าเริ่มต้น DRAFT) เป็นใบสั่งซื้อประจำสัปดาห์
+// GET /inventory/purchase-orders/export?status=DRAFT&format=csv|xlsx|pdf
+func (h *inventoryHandler) ExportPurchaseOrders(c echo.Context) error {
+	orderStatus := c.QueryParam("status")
+	if orderStatus == "" {
+		orderStatus = "DRAFT"
+	}
+
+	resp, err := h.inventorySrv.GetPurchaseOrders(c.Request().Context(), &services.GetPurchaseOrdersRequest{Status: orderStatus})
+	if err != nil {
+		logs.Error("Failed to get purchase orders", zap.Error(err))
+		return errorResponse(c, err)
+	}
+
+	return h.exportPurchaseOrders(c, "purchase_orders_"+orderStatus, resp.Orders)
+}
+
+// ExportPurchaseOrder ส่งออกใบสั่งซื้อใบเดียว
+// GET /inventory/purchase-orders/:id/export?format=csv|xlsx|pdf
+func (h *inventoryHandler) ExportPurchaseOrder(c echo.Context) error {
+	id := c.Param("id")
+
+	resp, err := h.inventorySrv.GetPurchaseOrderById(c.Request().Context(), &services.PurchaseOrderIdRequest{Id: id})
+	if err != nil {
+		logs.Error("Failed to get purchase order", zap.String("id", id), zap.Error(err))
+		return errorResponse(c, err)
+	}
+
+	return h.exportPurchaseOrders(c, "purchase_order_"+id, []*services.PurchaseOrder{resp})
+}
+
+func (h *inventoryHandler) exportPurchaseOrders(c echo.Context, name string, orders []*services.PurchaseOrder) error {
+	if c.QueryParam("format") == "pdf" {
+		var buf bytes.Buffer
+		if err := h.documents.RenderPurchaseOrders(&buf, orders); err != nil {
+			logs.Error("Failed to render PDF", zap.String("file", name), zap.Error(err))
+			return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
+		}
+		c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s.pdf"`, name))
+		return c.Blob(http.StatusOK, "application/pdf", buf.Bytes())
+	}
+
+	format, err := export.ParseFormat(c.QueryParam("format"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, createErrorResponse(err))
+	}
+
+	out := export.NewHTTPWriter(c.Response(), format, name, "Purchase Orders", purchaseOrderHeaders)
+	for _, order := range orders {
+		for _, line := range order.Lines {
+			if err := out.WriteRow(
+				order.Id,
+				order.Status,
+				order.Supplier.GetName(),
+				order.OrderByDate,
+				line.NameTh,
+				line.NameEn,
+				line.Unit,
+				line.Required,
+				line.OnHand,
+				line.OnOrder,
+				line.Quantity,
+				export.Baht(line.UnitCost),
+				export.Baht(line.Amount),
+			); err != nil {
+				logs.Error("Failed to export purchase orders", zap.String("file", name), zap.Error(err))
+				if !out.Started() {
+					return c.JSON(export.ErrorStatus(err), createErrorResponse(err))
+				}
+				return nil
+			}
+		}
+	}
+
+	if err := out.Close(); err != nil {
+		logs.Error("Failed to finish purchase order export", zap.String("file", name), zap.Error(err))
+		if !out.Started() {
+			return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,572 @@
+package handlers
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"gitlab.com/final_project1240930/api_gateway/internal/logs"
+	services "gitlab.com/final_project1240930/api_gateway/internal/services/menu"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ขนาดไฟล์นำเข้าสูงสุด (รวมรูปในไฟล์ zip) รูปแต่ละรูปยังจำกัดที่ maxImageSize
+const maxImportSize = 50 * 1024 * 1024
+
+// ชื่อไฟล์ข้อมูลเมนูในไฟล์ zip รูปอยู่ในโฟลเดอร์ images/
+const (
+	catalogJSONFile = "menu.json"
+	catalogCSVFile  = "menu.csv"
+	catalogImageDir = "images/"
+)
+
+// คอลัมน์ของไฟล์ CSV หนึ่งแถวคือหนึ่งรายการ แยกชนิดด้วยคอลัมน์ type (CATEGORY, ITEM, SET)
+// เซตใช้คอลัมน์ name ส่วนหมวดหมู่และเมนูใช้ name_th/name_en รายการหลายค่าคั่นด้วย "|"
+var catalogCSVColumns = []string{
+	"type", "name_th", "name_en", "name", "description", "price", "sort_order", "parent_name_en", "category_name_en",
+	"is_available", "daily_limit", "allergens", "dietary_tags", "archived", "item_names_en",
+	"image_url", "image_thumbnail_url", "image_card_url", "image_file",
+}
+
+const catalogListSeparator = "|"
+
+var imageHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+// ExportMenu ส่งออกเมนูทั้งร้าน format=json (ค่าเริ่มต้น), csv หรือ zip (menu.json พร้อมไฟล์รูป)
+func (h *menuHandler) ExportMenu(c echo.Context) error {
+	format := c.QueryParam("format")
+	if format == "" {
+		format = "json"
+	}
+	if format != "json" && format != "csv" && format != "zip" {
+		return c.JSON(http.StatusBadRequest, createErrorResponse(fmt.Errorf("unsupported export format: %s", format)))
+	}
+
+	catalog, err := h.menuSrv.ExportMenu(c.Request().Context(), &emptypb.Empty{})
+	if err != nil {
+		logs.Error("Failed to export menu", zap.Error(err))
+		return menuItemErrorResponse(c, err)
+	}
+
+	var body bytes.Buffer
+	contentType := "application/json"
+	switch format {
+	case "json":
+		err = writeCatalogJSON(&body, catalog)
+	case "csv":
+		contentType = "text/csv; charset=utf-8"
+		err = writeCatalogCSV(&body, catalog)
+	case "zip":
+		contentType = "application/zip"
+		err = writeCatalogZip(c.Request().Context(), &body, catalog)
+	}
+	if err != nil {
+		logs.Error("Failed to write menu export", zap.String("format", format), zap.Error(err))
+		return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="menu.%s"`, format))
+	return c.Blob(http.StatusOK, contentType, body.Bytes())
+}
+
+// ImportMenu นำเข้าเมนูจาก body ที่เป็น JSON หรือ CSV หรือไฟล์ในฟิลด์ "file" (.json, .csv, .zip)
+// เพิ่มหรือแก้ตาม name_en (เซตตาม name) ในหนึ่ง transaction ?dry_run=true ดูผลโดยไม่บันทึก
+func (h *menuHandler) ImportMenu(c echo.Context) error {
+	dryRun := false
+	if value := c.QueryParam("dry_run"); value != "" {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("dry_run must be true or false")))
+		}
+		dryRun = parsed
+	}
+
+	name, data, err := readImportFile(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, createErrorResponse(err))
+	}
+
+	var catalog *services.MenuCatalog
+	var images map[string][]byte
+	switch strings.ToLower(path.Ext(name)) {
+	case ".json":
+		catalog, err = readCatalogJSON(data)
+	case ".csv":
+		catalog, err = readCatalogCSV(data)
+	case ".zip":
+		catalog, images, err = readCatalogZip(data)
+	default:
+		err = errors.New("import file must be .json, .csv or .zip")
+	}
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, createErrorResponse(err))
+	}
+
+	if err := h.uploadCatalogImages(c.Request().Context(), catalog, images, dryRun); err != nil {
+		logs.Error("Failed to upload imported menu images", zap.Error(err))
+		return menuItemErrorResponse(c, err)
+	}
+
+	resp, err := h.menuSrv.ImportMenu(c.Request().Context(), &services.ImportMenuRequest{Catalog: catalog, DryRun: dryRun})
+	if err != nil {
+		logs.Error("Failed to import menu", zap.Bool("dryRun", dryRun), zap.Error(err))
+		return menuItemErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// readImportFile คืนชื่อไฟล์ (ใช้ดูชนิด) และเนื้อหาของไฟล์ที่นำเข้า
+func readImportFile(c echo.Context) (string, []byte, error) {
+	mediaType, _, _ := mime.ParseMediaType(c.Request().Header.Get(echo.HeaderContentType))
+	if mediaType == echo.MIMEMultipartForm {
+		file, err := c.FormFile("file")
+		if err != nil {
+			return "", nil, errors.New("file is required")
+		}
+		if file.Size > maxImportSize {
+			return "", nil, errors.New("import file is too large (max 50MB)")
+		}
+		src, err := file.Open()
+		if err != nil {
+			return "", nil, errors.New("failed to read import file")
+		}
+		defer src.Close()
+		data, err := readLimited(src, maxImportSize)
+		return file.Filename, data, err
+	}
+
+	data, err := readLimited(c.Request().Body, maxImportSize)
+	switch mediaType {
+	case echo.MIMEApplicationJSON:
+		return catalogJSONFile, data, err
+	case "text/csv":
+		return catalogCSVFile, data, err
+	}
+	return "", nil, errors.New("send the catalog as application/json, text/csv or a multipart file")
+}
+
+func readLimited(r io.Reader, limit int64) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, limit+1))
+	if err != nil {
+		return nil, errors.New("failed to read import file")
+	}
+	if int64(len(data)) > limit {
+		return nil, fmt.Errorf("import file is too large (max %dMB)", limit/(1024*1024))
+	}
+	return data, nil
+}
+
+// uploadCatalogImages อัปโหลดรูปจากไฟล์ zip ของเมนูที่ระบุ image_file แล้วใส่ URL ที่ได้แทน URL เดิม
+// ไม่มีไฟล์ zip จะใช้ image_url ตามเดิม ทดลองนำเข้าไม่อัปโหลด (ผลจะแสดงว่ารูปเปลี่ยน)
+// ถ้านำเข้าไม่สำเร็จหลังอัปโหลดแล้ว รูปที่ไม่มีเมนูอ้างถึงจะถูกลบโดยงาน reconcile-images
+func (h *menuHandler) uploadCatalogImages(ctx context.Context, catalog *services.MenuCatalog, images map[string][]byte, dryRun bool) error {
+	for _, item := range catalog.Items {
+		if item.ImageFile == "" {
+			continue
+		}
+		if images == nil {
+			item.ImageFile = ""
+			continue
+		}
+		data, ok := images[item.ImageFile]
+		if !ok {
+			return status.Errorf(codes.InvalidArgument, "menu item %q: image_file %q not found in zip", item.NameEn, item.ImageFile)
+		}
+		item.Image = nil
+		if dryRun {
+			continue
+		}
+		resp, err := h.menuSrv.UploadImage(ctx, &services.UploadImageRequest{ImageData: data, FileName: path.Base(item.ImageFile)})
+		if err != nil {
+			return err
+		}
+		item.Image = resp.ImageVariants
+	}
+	return nil
+}
+
+// ---------------- JSON ------------------------
+
+func writeCatalogJSON(w io.Writer, catalog *services.MenuCatalog) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(catalog)
+}
+
+func readCatalogJSON(data []byte) (*services.MenuCatalog, error) {
+	var catalog services.MenuCatalog
+	if err := json.Unmarshal(data, &catalog); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %v", err)
+	}
+	return &catalog, nil
+}
+
+// ---------------- CSV ------------------------
+
+func writeCatalogCSV(w io.Writer, catalog *services.MenuCatalog) error {
+	// ใส่ UTF-8 BOM เพื่อให้ Excel อ่านภาษาไทยได้ถูกต้อง
+	if _, err := io.WriteString(w, "\ufeff"); err != nil {
+		return err
+	}
+	cw := csv.NewWriter(w)
+	if err := cw.Write(catalogCSVColumns); err != nil {
+		return err
+	}
+
+	write := func(values map[string]string) error {
+		record := make([]string, len(catalogCSVColumns))
+		for i, column := range catalogCSVColumns {
+			record[i] = values[column]
+		}
+		return cw.Write(record)
+	}
+	for _, category := range catalog.Categories {
+		if err := write(map[string]string{
+			"type":           "CATEGORY",
+			"name_th":        category.NameTh,
+			"name_en":        category.NameEn,
+			"sort_order":     strconv.Itoa(int(category.SortOrder)),
+			"parent_name_en": category.ParentNameEn,
+		}); err != nil {
+			return err
+		}
+	}
+	for _, item := range catalog.Items {
+		values := map[string]string{
+			"type":             "ITEM",
+			"name_th":          item.NameTh,
+			"name_en":          item.NameEn,
+			"description":      item.Description,
+			"price":            strconv.FormatFloat(item.Price, 'f', -1, 64),
+			"category_name_en": item.CategoryNameEn,
+			"is_available":     strconv.FormatBool(item.IsAvailable),
+			"daily_limit":      strconv.Itoa(int(item.DailyLimit)),
+			"allergens":        strings.Join(item.Allergens, catalogListSeparator),
+			"dietary_tags":     strings.Join(item.DietaryTags, catalogListSeparator),
+			"archived":         strconv.FormatBool(item.Archived),
+			"image_file":       item.ImageFile,
+		}
+		if image := item.Image; image != nil {
+			values["image_url"] = image.FullUrl
+			values["image_thumbnail_url"] = image.ThumbnailUrl
+			values["image_card_url"] = image.CardUrl
+		}
+		if err := write(values); err != nil {
+			return err
+		}
+	}
+	for _, menuSet := range catalog.Sets {
+		if err := write(map[string]string{
+			"type":          "SET",
+			"name":          menuSet.Name,
+			"price":         strconv.FormatFloat(menuSet.Price, 'f', -1, 64),
+			"archived":      strconv.FormatBool(menuSet.Archived),
+			"item_names_en": strings.Join(menuSet.ItemNamesEn, catalogListSeparator),
+		}); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// readCatalogCSV อ่านตามชื่อคอลัมน์ในแถวแรก คอลัมน์ที่ไม่มีถือเป็นค่าว่าง
+// is_available ที่ว่างถือว่าเปิดขาย เพื่อให้เขียนไฟล์เองได้สะดวก
+func readCatalogCSV(data []byte) (*services.MenuCatalog, error) {
+	cr := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\ufeff"))))
+	cr.FieldsPerRecord = -1
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV: %v", err)
+	}
+	if len(records) == 0 {
+		return nil, errors.New("CSV file is empty")
+	}
+
+	columns := make(map[string]int, len(records[0]))
+	for i, column := range records[0] {
+		columns[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+	if _, ok := columns["type"]; !ok {
+		return nil, errors.New("CSV must have a type column")
+	}
+
+	catalog := &services.MenuCatalog{}
+	for i, record := range records[1:] {
+		line := i + 2
+		row := csvRow{record: record, columns: columns}
+
+		switch kind := strings.ToUpper(row.get("type")); kind {
+		case "CATEGORY":
+			sortOrder, err := row.int32("sort_order")
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %v", line, err)
+			}
+			catalog.Categories = append(catalog.Categories, &services.CatalogCategory{
+				NameTh:       row.get("name_th"),
+				NameEn:       row.get("name_en"),
+				SortOrder:    sortOrder,
+				ParentNameEn: row.get("parent_name_en"),
+			})
+		case "ITEM":
+			item := &services.CatalogItem{
+				NameTh:         row.get("name_th"),
+				NameEn:         row.get("name_en"),
+				Description:    row.get("description"),
+				CategoryNameEn: row.get("category_name_en"),
+				Allergens:      row.list("allergens"),
+				DietaryTags:    row.list("dietary_tags"),
+				ImageFile:      row.get("image_file"),
+				IsAvailable:    true,
+			}
+			if item.Price, err = row.float("price"); err == nil {
+				item.DailyLimit, err = row.int32("daily_limit")
+			}
+			if err == nil {
+				item.Archived, err = row.bool("archived")
+			}
+			if err == nil && row.get("is_available") != "" {
+				item.IsAvailable, err = row.bool("is_available")
+			}
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %v", line, err)
+			}
+			if fullURL := row.get("image_url"); fullURL != "" {
+				item.Image = &services.ImageVariants{
+					FullUrl:      fullURL,
+					ThumbnailUrl: row.get("image_thumbnail_url"),
+					CardUrl:      row.get("image_card_url"),
+				}
+			}
+			catalog.Items = append(catalog.Items, item)
+		case "SET":
+			menuSet := &services.CatalogSet{
+				Name:        row.get("name"),
+				ItemNamesEn: row.list("item_names_en"),
+			}
+			if menuSet.Price, err = row.float("price"); err == nil {
+				menuSet.Archived, err = row.bool("archived")
+			}
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %v", line, err)
+			}
+			catalog.Sets = append(catalog.Sets, menuSet)
+		case "":
+			// แถวว่าง
+		default:
+			return nil, fmt.Errorf("line %d: unknown type %q (allowed: CATEGORY, ITEM, SET)", line, kind)
+		}
+	}
+	return catalog, nil
+}
+
+type csvRow struct {
+	record  []string
+	columns map[string]int
+}
+
+func (r csvRow) get(column string) string {
+	i, ok := r.columns[column]
+	if !ok || i >= len(r.record) {
+		return ""
+	}
+	return strings.TrimSpace(r.record[i])
+}
+
+func (r csvRow) list(column string) []string {
+	var values []string
+	for _, value := range strings.Split(r.get(column), catalogListSeparator) {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func (r csvRow) float(column string) (float64, error) {
+	value := r.get(column)
+	if value == "" {
+		return 0, nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", column, value)
+	}
+	return f, nil
+}
+
+func (r csvRow) int32(column string) (int32, error) {
+	value := r.get(column)
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", column, value)
+	}
+	return int32(n), nil
+}
+
+func (r csvRow) bool(column string) (bool, error) {
+	value := r.get(column)
+	if value == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s %q", column, value)
+	}
+	return b, nil
+}
+
+// ---------------- ZIP ------------------------
+
+// imageFileName ตัดอักขระที่ใช้เป็นชื่อไฟล์ไม่ได้ออกจาก name_en
+var imageFileName = regexp.MustCompile(`[^a-z0-9]+`)
+
+// writeCatalogZip เขียน menu.json พร้อมรูป full ของทุกเมนูในโฟลเดอร์ images/
+// รูปที่ดาวน์โหลดไม่ได้จะข้ามไป (เมนูนั้นยังมี image_url อยู่)
+func writeCatalogZip(ctx context.Context, w io.Writer, catalog *services.MenuCatalog) error {
+	zw := zip.NewWriter(w)
+	used := make(map[string]bool)
+	for _, item := range catalog.Items {
+		if item.Image == nil || item.Image.FullUrl == "" {
+			continue
+		}
+		data, err := downloadImage(ctx, item.Image.FullUrl)
+		if err != nil {
+			logs.Error("Failed to download menu image for export", zap.String("url", item.Image.FullUrl), zap.Error(err))
+			continue
+		}
+
+		base := strings.Trim(imageFileName.ReplaceAllString(strings.ToLower(item.NameEn), "-"), "-")
+		if base == "" {
+			base = "image"
+		}
+		name := catalogImageDir + base + path.Ext(item.Image.FullUrl)
+		for n := 2; used[name]; n++ {
+			name = fmt.Sprintf("%s%s-%d%s", catalogImageDir, base, n, path.Ext(item.Image.FullUrl))
+		}
+		used[name] = true
+
+		f, err := zw.Create(name)
+		if err != nil {
+			return err
+		}
+		if _, err := f.Write(data); err != nil {
+			return err
+		}
+		item.ImageFile = name
+	}
+
+	f, err := zw.Create(catalogJSONFile)
+	if err != nil {
+		return err
+	}
+	if err := writeCatalogJSON(f, catalog); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+func downloadImage(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := imageHTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxImageSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxImageSize {
+		return nil, errImageTooLarge
+	}
+	return data, nil
+}
+
+// readCatalogZip อ่าน menu.json หรือ menu.csv และไฟล์อื่นทั้งหมดเป็นรูป (key คือ path ในไฟล์ zip)
+func readCatalogZip(data []byte) (*services.MenuCatalog, map[string][]byte, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid zip file: %v", err)
+	}
+
+	var catalog *services.MenuCatalog
+	images := make(map[string][]byte)
+	var total uint64
+	for _, file := range zr.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+		// กันไฟล์ zip ที่แตกออกมาใหญ่กว่าที่ประกาศไว้ (zip bomb)
+		total += file.UncompressedSize64
+		if total > maxImportSize {
+			return nil, nil, errors.New("zip content is too large (max 50MB)")
+		}
+		content, err := readZipFile(file)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		switch file.Name {
+		case catalogJSONFile:
+			catalog, err = readCatalogJSON(content)
+		case catalogCSVFile:
+			catalog, err = readCatalogCSV(content)
+		default:
+			if len(content) > maxImageSize {
+				return nil, nil, fmt.Errorf("%s: %v", file.Name, errImageTooLarge)
+			}
+			images[file.Name] = content
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %v", file.Name, err)
+		}
+	}
+	if catalog == nil {
+		return nil, nil, fmt.Errorf("zip must contain %s or %s", catalogJSONFile, catalogCSVFile)
+	}
+	return catalog, images, nil
+}
+
+func readZipFile(file *zip.File) ([]byte, error) {
+	rc, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", file.Name, err)
+	}
+	defer rc.Close()
+	content, err := io.ReadAll(io.LimitReader(rc, int64(file.UncompressedSize64)+1))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", file.Name, err)
+	}
+	if uint64(len(content)) > file.UncompressedSize64 {
+		return nil, fmt.Errorf("%s: file is larger than its zip header says", file.Name)
+	}
+	return content, nil
+}
@@ -2,10 +2,11 @@ package handlers
 
 import (
 	"errors"
-	"io/ioutil"
+	"io"
 	"mime/multipart"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 	"gitlab.com/final_project1240930/api_gateway/internal/logs"
@@ -35,7 +36,7 @@ func (h *menuHandler) CreateMenuItem(c echo.Context) error {
 	req.NameEn = c.FormValue("name_en")
 	price := c.FormValue("price")
 	req.Description = c.FormValue("description")
-	categoryStr := c.FormValue("category")
+	req.CategoryId = c.FormValue("category_id") // ID จาก GET /menu/categories
 
 	if price == "" {
 		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("price is required")))
@@ -46,52 +47,72 @@ func (h *menuHandler) CreateMenuItem(c echo.Context) error {
 	}
 	req.Price = priceFloat
 
-	if categoryStr == "" {
-		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("category is required")))
+	if req.CategoryId == "" {
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("category_id is required")))
 	}
-	categoryInt, err := strconv.Atoi(categoryStr)
-	if err != nil || categoryInt < 0 || categoryInt > int(services.MenuCategory_DESSERT) { // ตรวจสอบค่า category
-		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("invalid category format")))
-	}
-	req.Category = services.MenuCategory(categoryInt)
 
-	file, err := c.FormFile("image_data")
-	if err == nil {
-		fileContent, err := readFileAsBytes(file)
-		if err != nil {
-			return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("failed to read image file")))
-		}
-		req.ImageData = fileContent
+	req.ImageData, err = readImageFile(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, createErrorResponse(err))
 	}
 
 	resp, err := h.menuSrv.CreateMenuItem(c.Request().Context(), &req)
 	if err != nil {
 		logs.Error("Failed to create menu item", zap.Error(err))
+		// รูปที่ไม่ใช่ JPEG/PNG/WebP หรือไฟล์เสีย
+		if status.Code(err) == codes.InvalidArgument {
+			return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New(status.Convert(err).Message())))
+		}
 		return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
 	}
 
 	return c.JSON(http.StatusOK, resp)
 }
 
+// ขนาดไฟล์รูปสูงสุดที่รับ ชนิดไฟล์จริงตรวจที่ restaurant-service
+const maxImageSize = 5 * 1024 * 1024
+
+var errImageTooLarge = errors.New("image file is too large (max 5MB)")
+
 func readFileAsBytes(file *multipart.FileHeader) ([]byte, error) {
+	// ตรวจสอบขนาดไฟล์ก่อนอ่าน (ไฟล์ไม่เกิน 5MB)
+	if file.Size > maxImageSize {
+		return nil, errImageTooLarge
+	}
+
 	src, err := file.Open()
 	if err != nil {
 		return nil, err
 	}
 	defer src.Close()
 
-	fileBytes, err := ioutil.ReadAll(src)
+	fileBytes, err := io.ReadAll(io.LimitReader(src, maxImageSize+1))
 	if err != nil {
 		return nil, err
 	}
-
-	// ตรวจสอบขนาดไฟล์ (ไฟล์ไม่เกิน 5MB)
-	if len(fileBytes) > 5*1024*1024 {
-		return nil, errors.New("file is too large")
+	if len(fileBytes) > maxImageSize {
+		return nil, errImageTooLarge
 	}
 	return fileBytes, nil
 }
 
+// readImageFile อ่านไฟล์รูปจากฟอร์ม คืนค่า nil ถ้าไม่ได้แนบรูปมา
+func readImageFile(c echo.Context) ([]byte, error) {
+	file, err := c.FormFile("image_data")
+	if err != nil {
+		return nil, nil
+	}
+	fileContent, err := readFileAsBytes(file)
+	if errors.Is(err, errImageTooLarge) {
+		return nil, err
+	}
+	if err != nil {
+		logs.Error("Failed to read image file", zap.Error(err))
+		return nil, errors.New("failed to read image file")
+	}
+	return fileContent, nil
+}
+
 func (h *menuHandler) UpdateMenuItem(c echo.Context) error {
 
 	menuID := c.Param("id")
@@ -107,7 +128,7 @@ func (h *menuHandler) UpdateMenuItem(c echo.Context) error {
 	req.NameEn = c.FormValue("name_en")
 	price := c.FormValue("price")
 	req.Description = c.FormValue("description")
-	categoryStr := c.FormValue("category")
+	req.CategoryId = c.FormValue("category_id") // ID จาก GET /menu/categories
 
 	if price == "" {
 		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("price is required")))
@@ -118,27 +139,22 @@ func (h *menuHandler) UpdateMenuItem(c echo.Context) error {
 	}
 	req.Price = priceFloat
 
-	if categoryStr == "" {
-		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("category is required")))
+	if req.CategoryId == "" {
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("category_id is required")))
 	}
-	categoryInt, err := strconv.Atoi(categoryStr)
-	if err != nil || categoryInt < 0 || categoryInt > int(services.MenuCategory_DESSERT) { // ตรวจสอบค่า category
-		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("invalid category format")))
-	}
-	req.Category = services.MenuCategory(categoryInt)
 
-	file, err := c.FormFile("image_data")
-	if err == nil {
-		fileContent, err := readFileAsBytes(file)
-		if err != nil {
-			return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("failed to read image file")))
-		}
-		req.ImageData = fileContent
+	req.ImageData, err = readImageFile(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, createErrorResponse(err))
 	}
 
 	resp, err := h.menuSrv.UpdateMenuItem(c.Request().Context(), &req)
 	if err != nil {
 		logs.Error("Failed to update menu item", zap.Error(err))
+		// รูปที่ไม่ใช่ JPEG/PNG/WebP หรือไฟล์เสีย
+		if status.Code(err) == codes.InvalidArgument {
+			return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New(status.Convert(err).Message())))
+		}
 		return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
 	}
 
@@ -156,17 +172,64 @@ func (h *menuHandler) DeleteMenuItem(c echo.Context) error {
 
 	resp, err := h.menuSrv.DeleteMenuItem(c.Request().Context(), &req)
 	if err != nil {
+		// การจองหรือเมนูเซ็ตยังอ้างถึงเมนูนี้ได้ 409 ให้เก็บเข้าคลังแทน
 		logs.Error("Failed to delete menu item", zap.Error(err))
-		return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
+		return menuItemErrorResponse(c, err)
 	}
 
 	return c.JSON(http.StatusOK, resp)
 }
 
+// GetMenuItems รับ query ?availability=available|unavailable กรองตามสถานะการขาย
+// ?date=YYYY-MM-DD สำหรับคิดจำนวนคงเหลือ (ไม่ระบุ = วันนี้)
+// ?at=<RFC3339> คืนเฉพาะเมนูที่สั่งได้ในเวลานั้น
+// ?exclude_allergens=SHELLFISH,PEANUT และ ?dietary=HALAL กรองตามแท็ก
+// ?category_id= เอาเฉพาะเมนูในหมวดหมู่นั้นและหมวดหมู่ย่อย
+// ?min_price= / ?max_price= กรองตามราคา และ ?page= / ?page_size= แบ่งหน้า (ไม่ระบุ page_size = คืนทั้งหมด)
+// ไม่คืนเมนูที่เก็บเข้าคลังแล้ว
 func (h *menuHandler) GetMenuItems(c echo.Context) error {
-	resp, err := h.menuSrv.GetMenuItems(c.Request().Context(), &emptypb.Empty{})
+	return h.getMenuItems(c, false)
+}
+
+// GetArchivedMenuItems คืนเฉพาะเมนูที่เก็บเข้าคลังแล้ว รับ query เดียวกับ GetMenuItems
+func (h *menuHandler) GetArchivedMenuItems(c echo.Context) error {
+	return h.getMenuItems(c, true)
+}
+
+func (h *menuHandler) getMenuItems(c echo.Context, archived bool) error {
+	query, err := parseMenuListQuery(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, createErrorResponse(err))
+	}
+	req := services.GetMenuItemsRequest{
+		Date:             c.QueryParam("date"),
+		At:               c.QueryParam("at"),
+		ExcludeAllergens: splitQueryList(c.QueryParam("exclude_allergens")),
+		DietaryTags:      splitQueryList(c.QueryParam("dietary")),
+		CategoryId:       c.QueryParam("category_id"),
+		MinPrice:         query.minPrice,
+		MaxPrice:         query.maxPrice,
+		Page:             query.page,
+		PageSize:         query.pageSize,
+		Archived:         archived,
+	}
+	switch strings.ToLower(c.QueryParam("availability")) {
+	case "", "all":
+		req.Availability = services.AvailabilityFilter_AVAILABILITY_ALL
+	case "available":
+		req.Availability = services.AvailabilityFilter_AVAILABILITY_AVAILABLE
+	case "unavailable":
+		req.Availability = services.AvailabilityFilter_AVAILABILITY_UNAVAILABLE
+	default:
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("availability must be all, available or unavailable")))
+	}
+
+	resp, err := h.menuSrv.GetMenuItems(c.Request().Context(), &req)
 	if err != nil {
 		logs.Error("Failed to get menu items", zap.Error(err))
+		if status.Code(err) == codes.InvalidArgument {
+			return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New(status.Convert(err).Message())))
+		}
 		return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
 	}
 
@@ -190,6 +253,145 @@ func (h *menuHandler) GetMenuItemById(c echo.Context) error {
 	return c.JSON(http.StatusOK, resp)
 }
 
+// ---------------- Menu Search ------------------------
+
+// SearchMenu ค้นหาเมนูและเซตเมนูจากชื่อไทย ชื่ออังกฤษ และรายละเอียด เรียงตามคะแนนความตรง
+// query ?q=ผัดไทย (จำเป็น) รับตัวกรองเดียวกับ GetMenuItems: ?category_id= ?min_price= ?max_price=
+// ?exclude_allergens= ?dietary= ?at= และ ?page= / ?page_size= (ไม่ระบุ page_size = 20)
+func (h *menuHandler) SearchMenu(c echo.Context) error {
+	query, err := parseMenuListQuery(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, createErrorResponse(err))
+	}
+	req := services.SearchMenuRequest{
+		Q:                c.QueryParam("q"),
+		CategoryId:       c.QueryParam("category_id"),
+		MinPrice:         query.minPrice,
+		MaxPrice:         query.maxPrice,
+		ExcludeAllergens: splitQueryList(c.QueryParam("exclude_allergens")),
+		DietaryTags:      splitQueryList(c.QueryParam("dietary")),
+		At:               c.QueryParam("at"),
+		Page:             query.page,
+		PageSize:         query.pageSize,
+	}
+
+	resp, err := h.menuSrv.SearchMenu(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to search menu", zap.String("q", req.Q), zap.Error(err))
+		if status.Code(err) == codes.InvalidArgument {
+			return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New(status.Convert(err).Message())))
+		}
+		return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// menuListQuery คือช่วงราคาและการแบ่งหน้าที่ใช้ร่วมกันของรายการเมนู เซตเมนู และการค้นหา
+type menuListQuery struct {
+	minPrice float64
+	maxPrice float64
+	page     int32
+	pageSize int32
+}
+
+// parseMenuListQuery อ่าน ?min_price= ?max_price= ?page= ?page_size= ค่าที่ไม่ระบุเป็น 0
+func parseMenuListQuery(c echo.Context) (menuListQuery, error) {
+	var query menuListQuery
+	for name, target := range map[string]*float64{"min_price": &query.minPrice, "max_price": &query.maxPrice} {
+		if value := c.QueryParam(name); value != "" {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return menuListQuery{}, errors.New(name + " must be a number")
+			}
+			*target = parsed
+		}
+	}
+	for name, target := range map[string]*int32{"page": &query.page, "page_size": &query.pageSize} {
+		if value := c.QueryParam(name); value != "" {
+			parsed, err := strconv.ParseInt(value, 10, 32)
+			if err != nil {
+				return menuListQuery{}, errors.New(name + " must be an integer")
+			}
+			*target = int32(parsed)
+		}
+	}
+	return query, nil
+}
+
+// ---------------- Menu Category ------------------------
+
+// GetMenuCategories คืนหมวดหมู่ทั้งหมดเรียงตาม sort_order หมวดหมู่ย่อยมี parent_id
+func (h *menuHandler) GetMenuCategories(c echo.Context) error {
+	resp, err := h.menuSrv.GetMenuCategories(c.Request().Context(), &emptypb.Empty{})
+	if err != nil {
+		logs.Error("Failed to get menu categories", zap.Error(err))
+		return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *menuHandler) GetMenuCategoryById(c echo.Context) error {
+	req := services.GetMenuCategoryByIdRequest{Id: c.Param("id")}
+
+	resp, err := h.menuSrv.GetMenuCategoryById(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to get menu category", zap.String("categoryId", req.Id), zap.Error(err))
+		return menuItemErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// CreateMenuCategory body: {"name_th": "ซุป", "name_en": "Soups", "sort_order": 2, "parent_id": ""}
+func (h *menuHandler) CreateMenuCategory(c echo.Context) error {
+	var req services.CreateMenuCategoryRequest
+	if err := c.Bind(&req); err != nil {
+		logs.Error("Invalid request format for CreateMenuCategory", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("invalid request format")))
+	}
+
+	resp, err := h.menuSrv.CreateMenuCategory(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to create menu category", zap.Error(err))
+		return menuItemErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// UpdateMenuCategory แทนที่ข้อมูลทั้งหมดของหมวดหมู่ parent_id ว่าง = ย้ายเป็นหมวดหมู่ระดับบนสุด
+func (h *menuHandler) UpdateMenuCategory(c echo.Context) error {
+	var req services.UpdateMenuCategoryRequest
+	if err := c.Bind(&req); err != nil {
+		logs.Error("Invalid request format for UpdateMenuCategory", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("invalid request format")))
+	}
+	req.Id = c.Param("id")
+
+	resp, err := h.menuSrv.UpdateMenuCategory(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to update menu category", zap.String("categoryId", req.Id), zap.Error(err))
+		return menuItemErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// DeleteMenuCategory ลบได้เฉพาะหมวดหมู่ที่ไม่มีเมนูและหมวดหมู่ย่อยแล้ว (ไม่อย่างนั้นได้ 409)
+func (h *menuHandler) DeleteMenuCategory(c echo.Context) error {
+	req := services.DeleteMenuCategoryRequest{Id: c.Param("id")}
+
+	resp, err := h.menuSrv.DeleteMenuCategory(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to delete menu category", zap.String("categoryId", req.Id), zap.Error(err))
+		return menuItemErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
 func (h *menuHandler) CreateMenuSet(c echo.Context) error {
 	var req services.CreateMenuSetRequest
 	if err := c.Bind(&req); err != nil {
@@ -243,18 +445,50 @@ func (h *menuHandler) DeleteMenuSet(c echo.Context) error {
 
 	resp, err := h.menuSrv.DeleteMenuSet(c.Request().Context(), &req)
 	if err != nil {
+		// การจองยังอ้างถึงเซตนี้ได้ 409 ให้เก็บเข้าคลังแทน
 		logs.Error("Failed to delete menu set", zap.Error(err))
-		return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
+		return menuItemErrorResponse(c, err)
 	}
 
 	return c.JSON(http.StatusOK, resp)
 
 }
 
+// GetMenuSets รับ query ?at=<RFC3339> คืนเฉพาะเซตเมนูที่สั่งได้ในเวลานั้น
+// และ ?exclude_allergens= / ?dietary= / ?min_price= / ?max_price= / ?page= / ?page_size= เหมือน GetMenuItems
+// ?category_id= เอาเฉพาะเซตที่มีเมนูหรือช่องเลือกในหมวดหมู่นั้น (รวมหมวดหมู่ย่อย)
+// ไม่คืนเซตที่เก็บเข้าคลังแล้ว
 func (h *menuHandler) GetMenuSets(c echo.Context) error {
-	resp, err := h.menuSrv.GetMenuSets(c.Request().Context(), &emptypb.Empty{})
+	return h.getMenuSets(c, false)
+}
+
+// GetArchivedMenuSets คืนเฉพาะเซตเมนูที่เก็บเข้าคลังแล้ว รับ query เดียวกับ GetMenuSets
+func (h *menuHandler) GetArchivedMenuSets(c echo.Context) error {
+	return h.getMenuSets(c, true)
+}
+
+func (h *menuHandler) getMenuSets(c echo.Context, archived bool) error {
+	query, err := parseMenuListQuery(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, createErrorResponse(err))
+	}
+	req := services.GetMenuSetsRequest{
+		At:               c.QueryParam("at"),
+		ExcludeAllergens: splitQueryList(c.QueryParam("exclude_allergens")),
+		DietaryTags:      splitQueryList(c.QueryParam("dietary")),
+		CategoryId:       c.QueryParam("category_id"),
+		MinPrice:         query.minPrice,
+		MaxPrice:         query.maxPrice,
+		Page:             query.page,
+		PageSize:         query.pageSize,
+		Archived:         archived,
+	}
+	resp, err := h.menuSrv.GetMenuSets(c.Request().Context(), &req)
 	if err != nil {
 		logs.Error("Failed to get menu sets", zap.Error(err))
+		if status.Code(err) == codes.InvalidArgument {
+			return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New(status.Convert(err).Message())))
+		}
 		return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
 	}
 
@@ -357,3 +591,321 @@ func (h *menuHandler) DeleteMenuSetItem(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, resp)
 }
+
+// ArchiveMenuItem เก็บเมนูเข้าคลังแทนการลบ เมนูไม่แสดงในรายการและสั่งใหม่ไม่ได้ แต่การจองเก่ายังอ้างถึงได้
+func (h *menuHandler) ArchiveMenuItem(c echo.Context) error {
+	req := services.ArchiveMenuRequest{Id: c.Param("id")}
+	resp, err := h.menuSrv.ArchiveMenuItem(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to archive menu item", zap.String("menuItemId", req.Id), zap.Error(err))
+		return menuItemErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// RestoreMenuItem นำเมนูที่เก็บเข้าคลังกลับมาขาย
+func (h *menuHandler) RestoreMenuItem(c echo.Context) error {
+	req := services.ArchiveMenuRequest{Id: c.Param("id")}
+	resp, err := h.menuSrv.RestoreMenuItem(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to restore menu item", zap.String("menuItemId", req.Id), zap.Error(err))
+		return menuItemErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// ArchiveMenuSet เก็บเซตเมนูเข้าคลังแทนการลบ
+func (h *menuHandler) ArchiveMenuSet(c echo.Context) error {
+	req := services.ArchiveMenuRequest{Id: c.Param("id")}
+	resp, err := h.menuSrv.ArchiveMenuSet(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to archive menu set", zap.String("menuSetId", req.Id), zap.Error(err))
+		return menuItemErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// RestoreMenuSet นำเซตเมนูที่เก็บเข้าคลังกลับมาขาย
+func (h *menuHandler) RestoreMenuSet(c echo.Context) error {
+	req := services.ArchiveMenuRequest{Id: c.Param("id")}
+	resp, err := h.menuSrv.RestoreMenuSet(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to restore menu set", zap.String("menuSetId", req.Id), zap.Error(err))
+		return menuItemErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// SetMenuItemAvailability เปิด/ปิดการขายเมนูและตั้งจำนวนที่ขายได้ต่อวัน (0 = ไม่จำกัด)
+func (h *menuHandler) SetMenuItemAvailability(c echo.Context) error {
+	var body struct {
+		IsAvailable *bool `json:"is_available"`
+		DailyLimit  int32 `json:"daily_limit"`
+	}
+	if err := c.Bind(&body); err != nil {
+		logs.Error("Invalid request format for SetMenuItemAvailability", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("invalid request format")))
+	}
+	if body.IsAvailable == nil {
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("is_available is required")))
+	}
+
+	req := services.SetMenuItemAvailabilityRequest{
+		Id:          c.Param("id"),
+		IsAvailable: *body.IsAvailable,
+		DailyLimit:  body.DailyLimit,
+	}
+	resp, err := h.menuSrv.SetMenuItemAvailability(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to set menu item availability", zap.String("menuItemId", req.Id), zap.Error(err))
+		return menuItemErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// SetMenuItemSchedules แทนที่ช่วงเวลาที่สั่งเมนูได้ทั้งหมด ส่ง {"schedules": []} = สั่งได้ตลอด
+func (h *menuHandler) SetMenuItemSchedules(c echo.Context) error {
+	var req services.SetMenuSchedulesRequest
+	if err := c.Bind(&req); err != nil {
+		logs.Error("Invalid request format for SetMenuItemSchedules", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("invalid request format")))
+	}
+	req.Id = c.Param("id")
+
+	resp, err := h.menuSrv.SetMenuItemSchedules(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to set menu item schedules", zap.String("menuItemId", req.Id), zap.Error(err))
+		return menuItemErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// SetMenuSetSchedules แทนที่ช่วงเวลาที่สั่งเซตเมนูได้ทั้งหมด ส่ง {"schedules": []} = สั่งได้ตลอด
+func (h *menuHandler) SetMenuSetSchedules(c echo.Context) error {
+	var req services.SetMenuSchedulesRequest
+	if err := c.Bind(&req); err != nil {
+		logs.Error("Invalid request format for SetMenuSetSchedules", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("invalid request format")))
+	}
+	req.Id = c.Param("id")
+
+	resp, err := h.menuSrv.SetMenuSetSchedules(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to set menu set schedules", zap.String("menuSetId", req.Id), zap.Error(err))
+		return menuItemErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// SetMenuSetSlots แทนที่ช่องเลือกเมนูทั้งหมดของเซตเมนู ส่ง {"slots": []} = เซตแบบกำหนดเมนูไว้แล้ว
+// body: {"slots": [{"name_th": "อาหารจานหลัก", "name_en": "Main", "category_id": "...", "picks": 2,
+// "items": [{"menu_item_id": "...", "upcharge": 50}]}]}
+func (h *menuHandler) SetMenuSetSlots(c echo.Context) error {
+	var req services.SetMenuSetSlotsRequest
+	if err := c.Bind(&req); err != nil {
+		logs.Error("Invalid request format for SetMenuSetSlots", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("invalid request format")))
+	}
+	req.Id = c.Param("id")
+
+	resp, err := h.menuSrv.SetMenuSetSlots(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to set menu set slots", zap.String("menuSetId", req.Id), zap.Error(err))
+		return menuItemErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetMenuItemHistory คืนเวอร์ชันและประวัติราคาของเมนู รวมราคาที่ตั้งล่วงหน้า
+// query ?at=2024-03-15 (หรือ RFC3339) คืน price_at เป็นราคา ณ เวลานั้นด้วย
+func (h *menuHandler) GetMenuItemHistory(c echo.Context) error {
+	req := services.GetMenuHistoryRequest{Id: c.Param("id"), At: c.QueryParam("at")}
+
+	resp, err := h.menuSrv.GetMenuItemHistory(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to get menu item history", zap.String("menuItemId", req.Id), zap.Error(err))
+		return menuItemErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetMenuSetHistory เหมือน GetMenuItemHistory สำหรับเซตเมนู
+func (h *menuHandler) GetMenuSetHistory(c echo.Context) error {
+	req := services.GetMenuHistoryRequest{Id: c.Param("id"), At: c.QueryParam("at")}
+
+	resp, err := h.menuSrv.GetMenuSetHistory(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to get menu set history", zap.String("menuSetId", req.Id), zap.Error(err))
+		return menuItemErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// ScheduleMenuItemPrice ตั้งราคาใหม่ที่มีผลตั้งแต่ 00:00 ของวันที่ระบุ
+// body: {"price": 129, "effective_date": "2024-07-01"}
+func (h *menuHandler) ScheduleMenuItemPrice(c echo.Context) error {
+	var req services.ScheduleMenuPriceRequest
+	if err := c.Bind(&req); err != nil {
+		logs.Error("Invalid request format for ScheduleMenuItemPrice", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("invalid request format")))
+	}
+	req.Id = c.Param("id")
+
+	resp, err := h.menuSrv.ScheduleMenuItemPrice(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to schedule menu item price", zap.String("menuItemId", req.Id), zap.Error(err))
+		return menuItemErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// ScheduleMenuSetPrice เหมือน ScheduleMenuItemPrice สำหรับเซตเมนู
+func (h *menuHandler) ScheduleMenuSetPrice(c echo.Context) error {
+	var req services.ScheduleMenuPriceRequest
+	if err := c.Bind(&req); err != nil {
+		logs.Error("Invalid request format for ScheduleMenuSetPrice", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("invalid request format")))
+	}
+	req.Id = c.Param("id")
+
+	resp, err := h.menuSrv.ScheduleMenuSetPrice(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to schedule menu set price", zap.String("menuSetId", req.Id), zap.Error(err))
+		return menuItemErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// SetMenuItemTags แทนที่แท็กสารก่อภูมิแพ้และข้อมูลด้านอาหารของเมนู
+// body: {"allergens": ["SHELLFISH"], "dietary_tags": ["NO_PORK"]}
+func (h *menuHandler) SetMenuItemTags(c echo.Context) error {
+	var req services.SetMenuItemTagsRequest
+	if err := c.Bind(&req); err != nil {
+		logs.Error("Invalid request format for SetMenuItemTags", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("invalid request format")))
+	}
+	req.Id = c.Param("id")
+
+	resp, err := h.menuSrv.SetMenuItemTags(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to set menu item tags", zap.String("menuItemId", req.Id), zap.Error(err))
+		return menuItemErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// SetMenuItemModifiers แทนที่กลุ่มตัวเลือกทั้งหมดของเมนู ส่ง {"groups": []} = ไม่มีตัวเลือก
+// selection: 0 = เลือกได้อย่างเดียว, 1 = เลือกได้หลายอย่าง (ตาม min_select/max_select)
+// body: {"groups": [{"name_th": "ระดับความเผ็ด", "name_en": "Spice level", "selection": 0, "min_select": 1,
+// "options": [{"name_th": "ไม่เผ็ด", "name_en": "Mild"}, {"name_th": "เผ็ดมาก", "name_en": "Hot", "price_delta": 0}]}]}
+func (h *menuHandler) SetMenuItemModifiers(c echo.Context) error {
+	var req services.SetMenuItemModifiersRequest
+	if err := c.Bind(&req); err != nil {
+		logs.Error("Invalid request format for SetMenuItemModifiers", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("invalid request format")))
+	}
+	req.Id = c.Param("id")
+
+	resp, err := h.menuSrv.SetMenuItemModifiers(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to set menu item modifiers", zap.String("menuItemId", req.Id), zap.Error(err))
+		return menuItemErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// splitQueryList แยกค่าที่คั่นด้วย "," ใน query string
+func splitQueryList(value string) []string {
+	var values []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// menuItemErrorResponse แปลง gRPC status ของแกลเลอรี สถานะการขาย และช่วงเวลาที่สั่งได้เป็น HTTP status
+func menuItemErrorResponse(c echo.Context, err error) error {
+	message := errors.New(status.Convert(err).Message())
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return c.JSON(http.StatusBadRequest, createErrorResponse(message))
+	case codes.NotFound:
+		return c.JSON(http.StatusNotFound, createErrorResponse(message))
+	case codes.FailedPrecondition:
+		return c.JSON(http.StatusConflict, createErrorResponse(message))
+	}
+	return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
+}
+
+func (h *menuHandler) AddMenuItemImage(c echo.Context) error {
+	req := services.AddMenuItemImageRequest{
+		MenuItemId: c.Param("id"),
+		AltTh:      c.FormValue("alt_th"),
+		AltEn:      c.FormValue("alt_en"),
+	}
+
+	imageData, err := readImageFile(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, createErrorResponse(err))
+	}
+	if imageData == nil {
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("image_data is required")))
+	}
+	req.ImageData = imageData
+
+	resp, err := h.menuSrv.AddMenuItemImage(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to add menu item image", zap.String("menuItemId", req.MenuItemId), zap.Error(err))
+		return menuItemErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *menuHandler) ReorderMenuItemImages(c echo.Context) error {
+	var req services.ReorderMenuItemImagesRequest
+	if err := c.Bind(&req); err != nil {
+		logs.Error("Invalid request format for ReorderMenuItemImages", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("invalid request format")))
+	}
+	req.MenuItemId = c.Param("id")
+
+	resp, err := h.menuSrv.ReorderMenuItemImages(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to reorder menu item images", zap.String("menuItemId", req.MenuItemId), zap.Error(err))
+		return menuItemErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *menuHandler) RemoveMenuItemImage(c echo.Context) error {
+	req := services.RemoveMenuItemImageRequest{
+		MenuItemId: c.Param("id"),
+		ImageId:    c.Param("imageId"),
+	}
+
+	resp, err := h.menuSrv.RemoveMenuItemImage(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to remove menu item image", zap.String("menuItemId", req.MenuItemId), zap.String("imageId", req.ImageId), zap.Error(err))
+		return menuItemErrorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
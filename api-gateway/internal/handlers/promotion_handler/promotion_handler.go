@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"gitlab.com/final_project1240930/api_gateway/internal/logs"
+	services "gitlab.com/final_project1240930/api_gateway/internal/services/promotion"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type promotionHandler struct {
+	promotionSrv services.PromotionService
+}
+
+func NewPromotionHandler(promotionSrv services.PromotionService) *promotionHandler {
+	return &promotionHandler{promotionSrv: promotionSrv}
+}
+
+func createErrorResponse(err error) map[string]string {
+	return map[string]string{"error": err.Error()}
+}
+
+// errorResponse แปลง gRPC status เป็น HTTP status
+func errorResponse(c echo.Context, err error) error {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New(status.Convert(err).Message())))
+	case codes.NotFound:
+		return c.JSON(http.StatusNotFound, createErrorResponse(errors.New("promotion not found")))
+	case codes.FailedPrecondition:
+		return c.JSON(http.StatusConflict, createErrorResponse(errors.New(status.Convert(err).Message())))
+	}
+	return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
+}
+
+func (h *promotionHandler) CreatePromotion(c echo.Context) error {
+	var req services.Promotion
+	if err := c.Bind(&req); err != nil {
+		logs.Error("Invalid request format for CreatePromotion", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("invalid request format")))
+	}
+
+	resp, err := h.promotionSrv.CreatePromotion(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to create promotion", zap.Error(err))
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *promotionHandler) UpdatePromotion(c echo.Context) error {
+	id := c.Param("id")
+	if id == "" {
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("promotion ID is required")))
+	}
+
+	var req services.Promotion
+	if err := c.Bind(&req); err != nil {
+		logs.Error("Invalid request format for UpdatePromotion", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("invalid request format")))
+	}
+	req.PromotionId = id
+
+	resp, err := h.promotionSrv.UpdatePromotion(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to update promotion", zap.String("promotionId", id), zap.Error(err))
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *promotionHandler) DeletePromotion(c echo.Context) error {
+	id := c.Param("id")
+
+	resp, err := h.promotionSrv.DeletePromotion(c.Request().Context(), &services.PromotionIDRequest{PromotionId: id})
+	if err != nil {
+		logs.Error("Failed to delete promotion", zap.String("promotionId", id), zap.Error(err))
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *promotionHandler) GetPromotions(c echo.Context) error {
+	resp, err := h.promotionSrv.GetPromotions(c.Request().Context(), &emptypb.Empty{})
+	if err != nil {
+		logs.Error("Failed to get promotions", zap.Error(err))
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *promotionHandler) GetPromotionByID(c echo.Context) error {
+	id := c.Param("id")
+
+	resp, err := h.promotionSrv.GetPromotionByID(c.Request().Context(), &services.PromotionIDRequest{PromotionId: id})
+	if err != nil {
+		logs.Error("Failed to get promotion", zap.String("promotionId", id), zap.Error(err))
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+func (h *promotionHandler) GetPromotionRedemptions(c echo.Context) error {
+	id := c.Param("id")
+
+	resp, err := h.promotionSrv.GetPromotionRedemptions(c.Request().Context(), &services.PromotionIDRequest{PromotionId: id})
+	if err != nil {
+		logs.Error("Failed to get promotion redemptions", zap.String("promotionId", id), zap.Error(err))
+		return errorResponse(c, err)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
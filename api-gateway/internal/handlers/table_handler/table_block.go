@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"gitlab.com/final_project1240930/api_gateway/internal/logs"
+	services "gitlab.com/final_project1240930/api_gateway/internal/services/table"
+	"go.uber.org/zap"
+)
+
+// CreateTableBlock ปิดโต๊ะชั่วคราว (ชำรุด/กันไว้ให้พนักงาน) แทนการลบโต๊ะ created_by มาจากผู้ที่ login
+func (h *tableHandler) CreateTableBlock(c echo.Context) error {
+	var req services.CreateTableBlockRequest
+	if err := unmarshalTableRequest(c, &req); err != nil {
+		logs.Error("Invalid request format for CreateTableBlock", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(err))
+	}
+	if username := c.Get("username"); username != nil {
+		req.CreatedBy = fmt.Sprint(username)
+	}
+
+	resp, err := h.tableSrv.CreateTableBlock(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to create table block", zap.String("tableId", req.TableId), zap.Error(err))
+		return tableErrorResponse(c, err)
+	}
+	return h.respondTableMessage(c, resp)
+}
+
+func (h *tableHandler) DeleteTableBlock(c echo.Context) error {
+	req := services.DeleteTableBlockRequest{Id: c.Param("id")}
+
+	resp, err := h.tableSrv.DeleteTableBlock(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to delete table block", zap.String("blockId", req.Id), zap.Error(err))
+		return tableErrorResponse(c, err)
+	}
+	return h.respondTableMessage(c, resp)
+}
+
+// ListTableBlocks ?date=YYYY-MM-DD คืน block ที่คาบเกี่ยวกับวันนั้น
+func (h *tableHandler) ListTableBlocks(c echo.Context) error {
+	date := c.QueryParam("date")
+	if date == "" {
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("date is required")))
+	}
+
+	resp, err := h.tableSrv.ListTableBlocks(c.Request().Context(), &services.ListTableBlocksRequest{Date: date})
+	if err != nil {
+		logs.Error("Failed to list table blocks", zap.String("Date", date), zap.Error(err))
+		return tableErrorResponse(c, err)
+	}
+	return h.respondTableMessage(c, resp)
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"gitlab.com/final_project1240930/api_gateway/internal/logs"
+	services "gitlab.com/final_project1240930/api_gateway/internal/services/table"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (h *tableHandler) CreateTableCombination(c echo.Context) error {
+	var req services.CreateTableCombinationRequest
+	if err := unmarshalTableRequest(c, &req); err != nil {
+		logs.Error("Invalid request format for CreateTableCombination", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(err))
+	}
+
+	resp, err := h.tableSrv.CreateTableCombination(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to create table combination", zap.Error(err))
+		return tableErrorResponse(c, err)
+	}
+	return h.respondTableMessage(c, resp)
+}
+
+// UpdateTableCombination แทนที่ชื่อ จำนวนที่นั่ง และโต๊ะในชุดทั้งหมด
+func (h *tableHandler) UpdateTableCombination(c echo.Context) error {
+	var req services.UpdateTableCombinationRequest
+	if err := unmarshalTableRequest(c, &req); err != nil {
+		logs.Error("Invalid request format for UpdateTableCombination", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(err))
+	}
+	req.Id = c.Param("id")
+
+	resp, err := h.tableSrv.UpdateTableCombination(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to update table combination", zap.String("combinationId", req.Id), zap.Error(err))
+		return tableErrorResponse(c, err)
+	}
+	return h.respondTableMessage(c, resp)
+}
+
+func (h *tableHandler) DeleteTableCombination(c echo.Context) error {
+	req := services.DeleteTableCombinationRequest{Id: c.Param("id")}
+
+	resp, err := h.tableSrv.DeleteTableCombination(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to delete table combination", zap.String("combinationId", req.Id), zap.Error(err))
+		return tableErrorResponse(c, err)
+	}
+	return h.respondTableMessage(c, resp)
+}
+
+func (h *tableHandler) ListTableCombinations(c echo.Context) error {
+	resp, err := h.tableSrv.ListTableCombinations(c.Request().Context(), &emptypb.Empty{})
+	if err != nil {
+		logs.Error("Failed to list table combinations", zap.Error(err))
+		return tableErrorResponse(c, err)
+	}
+	return h.respondTableMessage(c, resp)
+}
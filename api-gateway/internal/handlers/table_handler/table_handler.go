@@ -64,14 +64,14 @@ func (h *tableHandler) CreateTable(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("invalid request format")))
 	}
 
-	if req.Type == services.TableType_TABLE_TYPE_UNKNOWN {
-		logs.Error("Invalid table type", zap.Any("Type", req.Type))
-		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("invalid table type")))
+	if req.TypeId == "" {
+		logs.Error("Table type is required")
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("type_id is required")))
 	}
 	resp, err := h.tableSrv.CreateTable(c.Request().Context(), &req)
 	if err != nil {
 		logs.Error("Failed to create table", zap.Error(err))
-		return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
+		return tableErrorResponse(c, err)
 	}
 
 	result, err := marshalProtoMessage(resp)
@@ -109,9 +109,9 @@ func (h *tableHandler) UpdateTable(c echo.Context) error {
 
 	req.Id = id
 
-	if req.Type == services.TableType_TABLE_TYPE_UNKNOWN {
-		logs.Error("Invalid table type", zap.Any("Type", req.Type))
-		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("invalid table type")))
+	if req.TypeId == "" {
+		logs.Error("Table type is required")
+		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("type_id is required")))
 	}
 
 	if req.NumTable <= 0 {
@@ -122,7 +122,7 @@ func (h *tableHandler) UpdateTable(c echo.Context) error {
 	resp, err := h.tableSrv.UpdateTable(c.Request().Context(), &req)
 	if err != nil {
 		logs.Error("Failed to update table", zap.Error(err))
-		return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
+		return tableErrorResponse(c, err)
 	}
 
 	result, err := marshalProtoMessage(resp)
@@ -141,7 +141,7 @@ func (h *tableHandler) DeleteTable(c echo.Context) error {
 	resp, err := h.tableSrv.DeleteTable(c.Request().Context(), &req)
 	if err != nil {
 		logs.Error("Failed to delete table", zap.String("tableId", id), zap.Error(err))
-		return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
+		return tableErrorResponse(c, err)
 	}
 
 	return c.JSON(http.StatusOK, resp)
@@ -189,15 +189,16 @@ func (h *tableHandler) GetTableByNumTable(c echo.Context) error {
 	return c.JSON(http.StatusOK, result)
 }
 
+// GetAvailableTables ระบุ ?zone_id= เพื่อดูเฉพาะโต๊ะในโซนที่ลูกค้าต้องการ
 func (h *tableHandler) GetAvailableTables(c echo.Context) error {
 	date := c.Param("date")
 
-	req := services.GetAvailableTablesRequest{Date: date}
+	req := services.GetAvailableTablesRequest{Date: date, ZoneId: c.QueryParam("zone_id")}
 
 	resp, err := h.tableSrv.GetAvailableTables(c.Request().Context(), &req)
 	if err != nil {
 		logs.Error("Failed to get table by number", zap.String("Date", date), zap.Error(err))
-		return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
+		return tableErrorResponse(c, err)
 	}
 
 	result, err := marshalProtoMessage(resp)
@@ -209,42 +210,48 @@ func (h *tableHandler) GetAvailableTables(c echo.Context) error {
 	return c.JSON(http.StatusOK, result)
 }
 
-func (h *tableHandler) UpdateTableType(c echo.Context) error {
+func (h *tableHandler) CreateTableType(c echo.Context) error {
+	var req services.CreateTableTypeRequest
+	if err := unmarshalTableRequest(c, &req); err != nil {
+		logs.Error("Invalid request format for CreateTableType", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(err))
+	}
 
-	body, err := io.ReadAll(c.Request().Body)
+	resp, err := h.tableSrv.CreateTableType(c.Request().Context(), &req)
 	if err != nil {
-		logs.Error("Failed to read request body", zap.Error(err))
-		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("failed to read request body")))
+		logs.Error("Failed to create table type", zap.Error(err))
+		return tableErrorResponse(c, err)
 	}
+	return h.respondTableMessage(c, resp)
+}
 
+// UpdateTableType แทนที่ข้อมูลทั้งหมดของประเภทโต๊ะ
+func (h *tableHandler) UpdateTableType(c echo.Context) error {
 	var req services.UpdateTableTypeRequest
-	unmarshaler := protojson.UnmarshalOptions{
-		DiscardUnknown: true,
-	}
-
-	if err := unmarshaler.Unmarshal(body, &req); err != nil {
+	if err := unmarshalTableRequest(c, &req); err != nil {
 		logs.Error("Invalid request format for UpdateTableType", zap.Error(err))
-		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("invalid request format")))
-	}
-
-	if req.Type == services.TableType_TABLE_TYPE_UNKNOWN {
-		logs.Error("Invalid table type", zap.Any("Type", req.Type))
-		return c.JSON(http.StatusBadRequest, createErrorResponse(errors.New("invalid table type")))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(err))
 	}
+	req.Id = c.Param("id")
 
 	resp, err := h.tableSrv.UpdateTableType(c.Request().Context(), &req)
 	if err != nil {
-		logs.Error("Failed to update table type", zap.Error(err))
-		return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
+		logs.Error("Failed to update table type", zap.String("typeId", req.Id), zap.Error(err))
+		return tableErrorResponse(c, err)
 	}
+	return h.respondTableMessage(c, resp)
+}
 
-	result, err := marshalProtoMessage(resp)
+// DeleteTableType ลบได้เฉพาะประเภทที่ไม่มีโต๊ะใช้อยู่แล้ว (ไม่เช่นนั้นได้ 409)
+func (h *tableHandler) DeleteTableType(c echo.Context) error {
+	req := services.DeleteTableTypeRequest{Id: c.Param("id")}
+
+	resp, err := h.tableSrv.DeleteTableType(c.Request().Context(), &req)
 	if err != nil {
-		logs.Error("Failed to marshal UpdateTableType response", zap.Error(err))
-		return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
+		logs.Error("Failed to delete table type", zap.String("typeId", req.Id), zap.Error(err))
+		return tableErrorResponse(c, err)
 	}
-
-	return c.JSON(http.StatusOK, result)
+	return h.respondTableMessage(c, resp)
 }
 
 func (h *tableHandler) ListTableTypes(c echo.Context) error {
@@ -0,0 +1,172 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"gitlab.com/final_project1240930/api_gateway/internal/logs"
+	services "gitlab.com/final_project1240930/api_gateway/internal/services/table"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// tableErrorResponse แปลง gRPC status เป็น HTTP status ที่ตรงกัน
+func tableErrorResponse(c echo.Context, err error) error {
+	message := errors.New(status.Convert(err).Message())
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return c.JSON(http.StatusBadRequest, createErrorResponse(message))
+	case codes.NotFound:
+		return c.JSON(http.StatusNotFound, createErrorResponse(message))
+	case codes.AlreadyExists, codes.FailedPrecondition:
+		return c.JSON(http.StatusConflict, createErrorResponse(message))
+	}
+	return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
+}
+
+// unmarshalTableRequest ใช้ protojson เพื่อให้ส่ง enum เป็นชื่อได้ (เช่น "shape": "ROUND")
+func unmarshalTableRequest(c echo.Context, req proto.Message) error {
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return errors.New("failed to read request body")
+	}
+	unmarshaler := protojson.UnmarshalOptions{
+		DiscardUnknown: true,
+	}
+	if err := unmarshaler.Unmarshal(body, req); err != nil {
+		return errors.New("invalid request format")
+	}
+	return nil
+}
+
+func (h *tableHandler) respondTableMessage(c echo.Context, resp proto.Message) error {
+	result, err := marshalProtoMessage(resp)
+	if err != nil {
+		logs.Error("Failed to marshal table response", zap.Error(err))
+		return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+func (h *tableHandler) CreateTableZone(c echo.Context) error {
+	var req services.CreateTableZoneRequest
+	if err := unmarshalTableRequest(c, &req); err != nil {
+		logs.Error("Invalid request format for CreateTableZone", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(err))
+	}
+
+	resp, err := h.tableSrv.CreateTableZone(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to create table zone", zap.Error(err))
+		return tableErrorResponse(c, err)
+	}
+	return h.respondTableMessage(c, resp)
+}
+
+// UpdateTableZone แทนที่ข้อมูลทั้งหมดของโซน
+func (h *tableHandler) UpdateTableZone(c echo.Context) error {
+	var req services.UpdateTableZoneRequest
+	if err := unmarshalTableRequest(c, &req); err != nil {
+		logs.Error("Invalid request format for UpdateTableZone", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(err))
+	}
+	req.Id = c.Param("id")
+
+	resp, err := h.tableSrv.UpdateTableZone(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to update table zone", zap.String("zoneId", req.Id), zap.Error(err))
+		return tableErrorResponse(c, err)
+	}
+	return h.respondTableMessage(c, resp)
+}
+
+// DeleteTableZone ลบได้เฉพาะโซนที่ไม่มีโต๊ะแล้ว (ไม่เช่นนั้นได้ 409)
+func (h *tableHandler) DeleteTableZone(c echo.Context) error {
+	req := services.DeleteTableZoneRequest{Id: c.Param("id")}
+
+	resp, err := h.tableSrv.DeleteTableZone(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to delete table zone", zap.String("zoneId", req.Id), zap.Error(err))
+		return tableErrorResponse(c, err)
+	}
+	return h.respondTableMessage(c, resp)
+}
+
+func (h *tableHandler) ListTableZones(c echo.Context) error {
+	resp, err := h.tableSrv.ListTableZones(c.Request().Context(), &emptypb.Empty{})
+	if err != nil {
+		logs.Error("Failed to list table zones", zap.Error(err))
+		return tableErrorResponse(c, err)
+	}
+	return h.respondTableMessage(c, resp)
+}
+
+// GetFloorPlan คืนโซนทั้งหมดพร้อมโต๊ะและตำแหน่งบนผัง สำหรับหน้าจอแผนผังร้าน
+func (h *tableHandler) GetFloorPlan(c echo.Context) error {
+	zones, err := h.tableSrv.ListTableZones(c.Request().Context(), &emptypb.Empty{})
+	if err != nil {
+		logs.Error("Failed to list table zones", zap.Error(err))
+		return tableErrorResponse(c, err)
+	}
+	tables, err := h.tableSrv.GetTables(c.Request().Context(), &emptypb.Empty{})
+	if err != nil {
+		logs.Error("Failed to get tables", zap.Error(err))
+		return tableErrorResponse(c, err)
+	}
+
+	zonesResult, err := marshalProtoMessage(zones)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
+	}
+	tablesResult, err := marshalProtoMessage(tables)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, createErrorResponse(err))
+	}
+
+	// โต๊ะที่ยังไม่ได้จัดโซนอยู่ใน unassigned_tables
+	tablesByZone := make(map[string][]interface{})
+	var unassigned []interface{}
+	tableList, _ := tablesResult["tables"].([]interface{})
+	for i, table := range tables.GetTables() {
+		zoneID := table.GetLayout().GetZoneId()
+		if zoneID == "" {
+			unassigned = append(unassigned, tableList[i])
+			continue
+		}
+		tablesByZone[zoneID] = append(tablesByZone[zoneID], tableList[i])
+	}
+
+	zoneList, _ := zonesResult["zones"].([]interface{})
+	for i, zone := range zones.GetZones() {
+		if entry, ok := zoneList[i].(map[string]interface{}); ok {
+			entry["tables"] = append([]interface{}{}, tablesByZone[zone.GetId()]...)
+		}
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"zones":             append([]interface{}{}, zoneList...),
+		"unassigned_tables": append([]interface{}{}, unassigned...),
+	})
+}
+
+// UpdateTableLayouts บันทึกตำแหน่งโต๊ะหลายตัวจากหน้าจัดผังร้านในครั้งเดียว
+func (h *tableHandler) UpdateTableLayouts(c echo.Context) error {
+	var req services.UpdateTableLayoutsRequest
+	if err := unmarshalTableRequest(c, &req); err != nil {
+		logs.Error("Invalid request format for UpdateTableLayouts", zap.Error(err))
+		return c.JSON(http.StatusBadRequest, createErrorResponse(err))
+	}
+
+	resp, err := h.tableSrv.UpdateTableLayouts(c.Request().Context(), &req)
+	if err != nil {
+		logs.Error("Failed to update table layouts", zap.Error(err))
+		return tableErrorResponse(c, err)
+	}
+	return h.respondTableMessage(c, resp)
+}
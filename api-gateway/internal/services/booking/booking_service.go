@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"gitlab.com/final_project1240930/api_gateway/internal/logs"
@@ -14,7 +15,20 @@ type BookingService interface {
 	DeleteBooking(ctx context.Context, req *DeleteBookingRequest) (*DeleteBookingResponse, error)
 	GetBookingDetails(ctx context.Context, req *GetBookingDetailsRequest) (*GetBookingDetailsResponse, error)
 	GetBookingDetailsByID(ctx context.Context, req *GetBookingDetailsByIDRequest) (*GetBookingDetailsByIDResponse, error)
+	ExportBookings(ctx context.Context, req *ExportBookingsRequest, fn func(*BookingDetail) error) error
+	ImportBookings(ctx context.Context, req *ImportBookingsRequest) (*ImportBookingsResponse, error)
+	GetPrepSheet(ctx context.Context, req *GetPrepSheetRequest) (*PrepSheet, error)
+	QuoteBooking(ctx context.Context, req *CreateBookingRequest) (*PriceBreakdown, error)
+	GetCustomerDietaryRestrictions(ctx context.Context, req *GetCustomerDietaryRestrictionsRequest) (*CustomerDietaryRestrictions, error)
+	SetCustomerDietaryRestrictions(ctx context.Context, req *CustomerDietaryRestrictions) (*CustomerDietaryRestrictions, error)
 }
+
+// export ช่วงวันที่กว้างๆ ใช้เวลานานกว่าการเรียกทั่วไป
+const exportTimeout = time.Minute * 5
+
+// import หลายร้อยแถวใน transaction เดียวใช้เวลานานกว่าการเรียกทั่วไป
+const importTimeout = time.Minute * 2
+
 type bookingService struct {
 	bookingClient BookingServiceClient
 }
@@ -90,3 +104,83 @@ func (s *bookingService) GetBookingDetailsByID(ctx context.Context, req *GetBook
 	}
 	return nil, err
 }
+
+// Business logic for exporting bookings, fn is called once per booking as it arrives from the stream
+func (s *bookingService) ExportBookings(ctx context.Context, req *ExportBookingsRequest, fn func(*BookingDetail) error) error {
+	ctx, cancel := context.WithTimeout(ctx, exportTimeout)
+	defer cancel()
+
+	stream, err := s.bookingClient.ExportBookings(ctx, req)
+	if err != nil {
+		logs.Error("Error: %v", zap.Error(err))
+		return err
+	}
+
+	for {
+		booking, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			logs.Error("Error: %v", zap.Error(err))
+			return err
+		}
+		if err := fn(booking); err != nil {
+			return err
+		}
+	}
+}
+
+// Business logic for importing bookings from a CSV file
+func (s *bookingService) ImportBookings(ctx context.Context, req *ImportBookingsRequest) (*ImportBookingsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, importTimeout)
+	defer cancel()
+
+	res, err := s.bookingClient.ImportBookings(ctx, req)
+	if err != nil {
+		logs.Error("Error: %v", zap.Error(err))
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *bookingService) GetPrepSheet(ctx context.Context, req *GetPrepSheetRequest) (*PrepSheet, error) {
+	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.bookingClient.GetPrepSheet(ctx, req)
+	})
+	if res != nil {
+		return res.(*PrepSheet), nil
+	}
+	return nil, err
+}
+
+// Business logic for calculating a booking total without saving it
+func (s *bookingService) QuoteBooking(ctx context.Context, req *CreateBookingRequest) (*PriceBreakdown, error) {
+	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.bookingClient.QuoteBooking(ctx, req)
+	})
+	if res != nil {
+		return res.(*PriceBreakdown), nil
+	}
+	return nil, err
+}
+
+func (s *bookingService) GetCustomerDietaryRestrictions(ctx context.Context, req *GetCustomerDietaryRestrictionsRequest) (*CustomerDietaryRestrictions, error) {
+	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.bookingClient.GetCustomerDietaryRestrictions(ctx, req)
+	})
+	if res != nil {
+		return res.(*CustomerDietaryRestrictions), nil
+	}
+	return nil, err
+}
+
+func (s *bookingService) SetCustomerDietaryRestrictions(ctx context.Context, req *CustomerDietaryRestrictions) (*CustomerDietaryRestrictions, error) {
+	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.bookingClient.SetCustomerDietaryRestrictions(ctx, req)
+	})
+	if res != nil {
+		return res.(*CustomerDietaryRestrictions), nil
+	}
+	return nil, err
+}
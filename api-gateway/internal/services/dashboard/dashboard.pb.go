@@ -21,6 +21,52 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ReportPeriod int32
+
+const (
+	ReportPeriod_DAILY   ReportPeriod = 0 // รายวัน
+	ReportPeriod_MONTHLY ReportPeriod = 1 // รายเดือน
+)
+
+// Enum value maps for ReportPeriod.
+var (
+	ReportPeriod_name = map[int32]string{
+		0: "DAILY",
+		1: "MONTHLY",
+	}
+	ReportPeriod_value = map[string]int32{
+		"DAILY":   0,
+		"MONTHLY": 1,
+	}
+)
+
+func (x ReportPeriod) Enum() *ReportPeriod {
+	p := new(ReportPeriod)
+	*p = x
+	return p
+}
+
+func (x ReportPeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportPeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_dashboard_proto_enumTypes[0].Descriptor()
+}
+
+func (ReportPeriod) Type() protoreflect.EnumType {
+	return &file_dashboard_proto_enumTypes[0]
+}
+
+func (x ReportPeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportPeriod.Descriptor instead.
+func (ReportPeriod) EnumDescriptor() ([]byte, []int) {
+	return file_dashboard_proto_rawDescGZIP(), []int{0}
+}
+
 // Request และ Response สำหรับข้อมูลสรุปรายวัน
 type GetDailySummaryResponse struct {
 	state         protoimpl.MessageState
@@ -473,6 +519,213 @@ func (x *Menu) GetTotalQuantitySold() int32 {
 	return 0
 }
 
+type ExportReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartDate string       `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`      // วันที่เริ่มต้น (เช่น "2024-12-01")
+	EndDate   string       `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`            // วันที่สิ้นสุด (รวมวันนี้ด้วย)
+	Period    ReportPeriod `protobuf:"varint,3,opt,name=period,proto3,enum=services.ReportPeriod" json:"period,omitempty"` // ช่วงเวลาของแต่ละแถว (ใช้กับรายงานยอดขาย)
+}
+
+func (x *ExportReportRequest) Reset() {
+	*x = ExportReportRequest{}
+	mi := &file_dashboard_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportReportRequest) ProtoMessage() {}
+
+func (x *ExportReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dashboard_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportReportRequest.ProtoReflect.Descriptor instead.
+func (*ExportReportRequest) Descriptor() ([]byte, []int) {
+	return file_dashboard_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ExportReportRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ExportReportRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *ExportReportRequest) GetPeriod() ReportPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return ReportPeriod_DAILY
+}
+
+type SalesReportRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Period         string  `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`                                        // วันที่ "2024-12-01" หรือเดือน "2024-12"
+	TotalSales     float64 `protobuf:"fixed64,2,opt,name=total_sales,json=totalSales,proto3" json:"total_sales,omitempty"`            // ยอดขายรวม
+	TotalBookings  int32   `protobuf:"varint,3,opt,name=total_bookings,json=totalBookings,proto3" json:"total_bookings,omitempty"`    // จำนวนการจอง
+	TotalCustomers int32   `protobuf:"varint,4,opt,name=total_customers,json=totalCustomers,proto3" json:"total_customers,omitempty"` // จำนวนลูกค้า (เด็ก + ผู้ใหญ่)
+}
+
+func (x *SalesReportRow) Reset() {
+	*x = SalesReportRow{}
+	mi := &file_dashboard_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SalesReportRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SalesReportRow) ProtoMessage() {}
+
+func (x *SalesReportRow) ProtoReflect() protoreflect.Message {
+	mi := &file_dashboard_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SalesReportRow.ProtoReflect.Descriptor instead.
+func (*SalesReportRow) Descriptor() ([]byte, []int) {
+	return file_dashboard_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SalesReportRow) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *SalesReportRow) GetTotalSales() float64 {
+	if x != nil {
+		return x.TotalSales
+	}
+	return 0
+}
+
+func (x *SalesReportRow) GetTotalBookings() int32 {
+	if x != nil {
+		return x.TotalBookings
+	}
+	return 0
+}
+
+func (x *SalesReportRow) GetTotalCustomers() int32 {
+	if x != nil {
+		return x.TotalCustomers
+	}
+	return 0
+}
+
+type BestSellerRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind              string  `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`                                                       // "MENU_SET" หรือ "A_LA_CARTE"
+	NameTh            string  `protobuf:"bytes,2,opt,name=name_th,json=nameTh,proto3" json:"name_th,omitempty"`                                     // ชื่อเมนู (ภาษาไทย)
+	NameEn            string  `protobuf:"bytes,3,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`                                     // ชื่อเมนู (ภาษาอังกฤษ)
+	TotalQuantitySold int32   `protobuf:"varint,4,opt,name=total_quantity_sold,json=totalQuantitySold,proto3" json:"total_quantity_sold,omitempty"` // จำนวนที่ขายได้ทั้งหมด
+	TotalSales        float64 `protobuf:"fixed64,5,opt,name=total_sales,json=totalSales,proto3" json:"total_sales,omitempty"`                       // ยอดขายรวมของเมนูนี้
+}
+
+func (x *BestSellerRow) Reset() {
+	*x = BestSellerRow{}
+	mi := &file_dashboard_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BestSellerRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BestSellerRow) ProtoMessage() {}
+
+func (x *BestSellerRow) ProtoReflect() protoreflect.Message {
+	mi := &file_dashboard_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BestSellerRow.ProtoReflect.Descriptor instead.
+func (*BestSellerRow) Descriptor() ([]byte, []int) {
+	return file_dashboard_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BestSellerRow) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *BestSellerRow) GetNameTh() string {
+	if x != nil {
+		return x.NameTh
+	}
+	return ""
+}
+
+func (x *BestSellerRow) GetNameEn() string {
+	if x != nil {
+		return x.NameEn
+	}
+	return ""
+}
+
+func (x *BestSellerRow) GetTotalQuantitySold() int32 {
+	if x != nil {
+		return x.TotalQuantitySold
+	}
+	return 0
+}
+
+func (x *BestSellerRow) GetTotalSales() float64 {
+	if x != nil {
+		return x.TotalSales
+	}
+	return 0
+}
+
 var File_dashboard_proto protoreflect.FileDescriptor
 
 var file_dashboard_proto_rawDesc = []byte{
@@ -536,31 +789,72 @@ var file_dashboard_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x2e,
 	0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
 	0x5f, 0x73, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x6c, 0x64, 0x32, 0xe4,
-	0x02, 0x0a, 0x10, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x53,
-	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x21,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x69,
-	0x6c, 0x79, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x4c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x53,
-	0x61, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x61, 0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x6f, 0x6c, 0x64, 0x22, 0x7f,
+	0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x2e, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22,
+	0x99, 0x01, 0x0a, 0x0e, 0x53, 0x61, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x73, 0x61, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x61, 0x6c, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x73, 0x22, 0xa6, 0x01, 0x0a, 0x0d,
+	0x42, 0x65, 0x73, 0x74, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x6f, 0x77, 0x12, 0x12, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61,
+	0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d,
+	0x65, 0x45, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53,
+	0x6f, 0x6c, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x61, 0x6c,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53,
+	0x61, 0x6c, 0x65, 0x73, 0x2a, 0x26, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x41, 0x49, 0x4c, 0x59, 0x10, 0x00, 0x12,
+	0x0b, 0x0a, 0x07, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x4c, 0x59, 0x10, 0x01, 0x32, 0x83, 0x04, 0x0a,
+	0x10, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x4c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x21, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x6e, 0x74, 0x68,
-	0x6c, 0x79, 0x53, 0x61, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x68, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x42, 0x6f, 0x6f,
-	0x6b, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x64, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x73,
-	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x42, 0x6f,
-	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x64, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0e, 0x47, 0x65, 0x74,
-	0x42, 0x65, 0x73, 0x74, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47,
-	0x65, 0x74, 0x42, 0x65, 0x73, 0x74, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0c, 0x5a, 0x0a, 0x2e, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x69, 0x6c, 0x79,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x53, 0x61, 0x6c,
+	0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x21, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79,
+	0x53, 0x61, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a,
+	0x1d, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x41, 0x6e, 0x64, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x42, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x41, 0x6e, 0x64, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x65,
+	0x73, 0x74, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x42, 0x65, 0x73, 0x74, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x61, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x53, 0x61, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f,
+	0x77, 0x30, 0x01, 0x12, 0x4d, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x65, 0x73,
+	0x74, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x42, 0x65, 0x73, 0x74, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x6f, 0x77,
+	0x30, 0x01, 0x42, 0x0c, 0x5a, 0x0a, 0x2e, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -575,36 +869,46 @@ func file_dashboard_proto_rawDescGZIP() []byte {
 	return file_dashboard_proto_rawDescData
 }
 
-var file_dashboard_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_dashboard_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_dashboard_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_dashboard_proto_goTypes = []any{
-	(*GetDailySummaryResponse)(nil),               // 0: services.GetDailySummaryResponse
-	(*GetMonthlySalesResponse)(nil),               // 1: services.GetMonthlySalesResponse
-	(*MonthlySales)(nil),                          // 2: services.MonthlySales
-	(*GetMonthlyBookingAndCustomersResponse)(nil), // 3: services.GetMonthlyBookingAndCustomersResponse
-	(*MonthlyBookingAndCustomers)(nil),            // 4: services.MonthlyBookingAndCustomers
-	(*GetBestSellersResponse)(nil),                // 5: services.GetBestSellersResponse
-	(*MenuSets)(nil),                              // 6: services.MenuSets
-	(*Menu)(nil),                                  // 7: services.Menu
-	(*emptypb.Empty)(nil),                         // 8: google.protobuf.Empty
+	(ReportPeriod)(0),                             // 0: services.ReportPeriod
+	(*GetDailySummaryResponse)(nil),               // 1: services.GetDailySummaryResponse
+	(*GetMonthlySalesResponse)(nil),               // 2: services.GetMonthlySalesResponse
+	(*MonthlySales)(nil),                          // 3: services.MonthlySales
+	(*GetMonthlyBookingAndCustomersResponse)(nil), // 4: services.GetMonthlyBookingAndCustomersResponse
+	(*MonthlyBookingAndCustomers)(nil),            // 5: services.MonthlyBookingAndCustomers
+	(*GetBestSellersResponse)(nil),                // 6: services.GetBestSellersResponse
+	(*MenuSets)(nil),                              // 7: services.MenuSets
+	(*Menu)(nil),                                  // 8: services.Menu
+	(*ExportReportRequest)(nil),                   // 9: services.ExportReportRequest
+	(*SalesReportRow)(nil),                        // 10: services.SalesReportRow
+	(*BestSellerRow)(nil),                         // 11: services.BestSellerRow
+	(*emptypb.Empty)(nil),                         // 12: google.protobuf.Empty
 }
 var file_dashboard_proto_depIdxs = []int32{
-	2, // 0: services.GetMonthlySalesResponse.sales:type_name -> services.MonthlySales
-	4, // 1: services.GetMonthlyBookingAndCustomersResponse.data:type_name -> services.MonthlyBookingAndCustomers
-	6, // 2: services.GetBestSellersResponse.top_menu_sets:type_name -> services.MenuSets
-	7, // 3: services.GetBestSellersResponse.top_a_la_carte:type_name -> services.Menu
-	8, // 4: services.DashboardService.GetDailySummary:input_type -> google.protobuf.Empty
-	8, // 5: services.DashboardService.GetMonthlySales:input_type -> google.protobuf.Empty
-	8, // 6: services.DashboardService.GetMonthlyBookingAndCustomers:input_type -> google.protobuf.Empty
-	8, // 7: services.DashboardService.GetBestSellers:input_type -> google.protobuf.Empty
-	0, // 8: services.DashboardService.GetDailySummary:output_type -> services.GetDailySummaryResponse
-	1, // 9: services.DashboardService.GetMonthlySales:output_type -> services.GetMonthlySalesResponse
-	3, // 10: services.DashboardService.GetMonthlyBookingAndCustomers:output_type -> services.GetMonthlyBookingAndCustomersResponse
-	5, // 11: services.DashboardService.GetBestSellers:output_type -> services.GetBestSellersResponse
-	8, // [8:12] is the sub-list for method output_type
-	4, // [4:8] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	3,  // 0: services.GetMonthlySalesResponse.sales:type_name -> services.MonthlySales
+	5,  // 1: services.GetMonthlyBookingAndCustomersResponse.data:type_name -> services.MonthlyBookingAndCustomers
+	7,  // 2: services.GetBestSellersResponse.top_menu_sets:type_name -> services.MenuSets
+	8,  // 3: services.GetBestSellersResponse.top_a_la_carte:type_name -> services.Menu
+	0,  // 4: services.ExportReportRequest.period:type_name -> services.ReportPeriod
+	12, // 5: services.DashboardService.GetDailySummary:input_type -> google.protobuf.Empty
+	12, // 6: services.DashboardService.GetMonthlySales:input_type -> google.protobuf.Empty
+	12, // 7: services.DashboardService.GetMonthlyBookingAndCustomers:input_type -> google.protobuf.Empty
+	12, // 8: services.DashboardService.GetBestSellers:input_type -> google.protobuf.Empty
+	9,  // 9: services.DashboardService.ExportSalesReport:input_type -> services.ExportReportRequest
+	9,  // 10: services.DashboardService.ExportBestSellers:input_type -> services.ExportReportRequest
+	1,  // 11: services.DashboardService.GetDailySummary:output_type -> services.GetDailySummaryResponse
+	2,  // 12: services.DashboardService.GetMonthlySales:output_type -> services.GetMonthlySalesResponse
+	4,  // 13: services.DashboardService.GetMonthlyBookingAndCustomers:output_type -> services.GetMonthlyBookingAndCustomersResponse
+	6,  // 14: services.DashboardService.GetBestSellers:output_type -> services.GetBestSellersResponse
+	10, // 15: services.DashboardService.ExportSalesReport:output_type -> services.SalesReportRow
+	11, // 16: services.DashboardService.ExportBestSellers:output_type -> services.BestSellerRow
+	11, // [11:17] is the sub-list for method output_type
+	5,  // [5:11] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_dashboard_proto_init() }
@@ -617,13 +921,14 @@ func file_dashboard_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_dashboard_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      1,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_dashboard_proto_goTypes,
 		DependencyIndexes: file_dashboard_proto_depIdxs,
+		EnumInfos:         file_dashboard_proto_enumTypes,
 		MessageInfos:      file_dashboard_proto_msgTypes,
 	}.Build()
 	File_dashboard_proto = out.File
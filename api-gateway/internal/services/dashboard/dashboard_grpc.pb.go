@@ -24,6 +24,8 @@ const (
 	DashboardService_GetMonthlySales_FullMethodName               = "/services.DashboardService/GetMonthlySales"
 	DashboardService_GetMonthlyBookingAndCustomers_FullMethodName = "/services.DashboardService/GetMonthlyBookingAndCustomers"
 	DashboardService_GetBestSellers_FullMethodName                = "/services.DashboardService/GetBestSellers"
+	DashboardService_ExportSalesReport_FullMethodName             = "/services.DashboardService/ExportSalesReport"
+	DashboardService_ExportBestSellers_FullMethodName             = "/services.DashboardService/ExportBestSellers"
 )
 
 // DashboardServiceClient is the client API for DashboardService service.
@@ -38,6 +40,10 @@ type DashboardServiceClient interface {
 	GetMonthlyBookingAndCustomers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetMonthlyBookingAndCustomersResponse, error)
 	// RPC สำหรับดึงข้อมูลเมนูขายดี
 	GetBestSellers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetBestSellersResponse, error)
+	// RPC สำหรับ export รายงานยอดขาย/ยอดจอง/ลูกค้า ตามช่วงวันที่ (รายวันหรือรายเดือน)
+	ExportSalesReport(ctx context.Context, in *ExportReportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SalesReportRow], error)
+	// RPC สำหรับ export รายงานเมนูขายดีทั้งหมดตามช่วงวันที่
+	ExportBestSellers(ctx context.Context, in *ExportReportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BestSellerRow], error)
 }
 
 type dashboardServiceClient struct {
@@ -88,6 +94,44 @@ func (c *dashboardServiceClient) GetBestSellers(ctx context.Context, in *emptypb
 	return out, nil
 }
 
+func (c *dashboardServiceClient) ExportSalesReport(ctx context.Context, in *ExportReportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SalesReportRow], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DashboardService_ServiceDesc.Streams[0], DashboardService_ExportSalesReport_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportReportRequest, SalesReportRow]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DashboardService_ExportSalesReportClient = grpc.ServerStreamingClient[SalesReportRow]
+
+func (c *dashboardServiceClient) ExportBestSellers(ctx context.Context, in *ExportReportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BestSellerRow], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DashboardService_ServiceDesc.Streams[1], DashboardService_ExportBestSellers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportReportRequest, BestSellerRow]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DashboardService_ExportBestSellersClient = grpc.ServerStreamingClient[BestSellerRow]
+
 // DashboardServiceServer is the server API for DashboardService service.
 // All implementations must embed UnimplementedDashboardServiceServer
 // for forward compatibility.
@@ -100,6 +144,10 @@ type DashboardServiceServer interface {
 	GetMonthlyBookingAndCustomers(context.Context, *emptypb.Empty) (*GetMonthlyBookingAndCustomersResponse, error)
 	// RPC สำหรับดึงข้อมูลเมนูขายดี
 	GetBestSellers(context.Context, *emptypb.Empty) (*GetBestSellersResponse, error)
+	// RPC สำหรับ export รายงานยอดขาย/ยอดจอง/ลูกค้า ตามช่วงวันที่ (รายวันหรือรายเดือน)
+	ExportSalesReport(*ExportReportRequest, grpc.ServerStreamingServer[SalesReportRow]) error
+	// RPC สำหรับ export รายงานเมนูขายดีทั้งหมดตามช่วงวันที่
+	ExportBestSellers(*ExportReportRequest, grpc.ServerStreamingServer[BestSellerRow]) error
 	mustEmbedUnimplementedDashboardServiceServer()
 }
 
@@ -122,6 +170,12 @@ func (UnimplementedDashboardServiceServer) GetMonthlyBookingAndCustomers(context
 func (UnimplementedDashboardServiceServer) GetBestSellers(context.Context, *emptypb.Empty) (*GetBestSellersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBestSellers not implemented")
 }
+func (UnimplementedDashboardServiceServer) ExportSalesReport(*ExportReportRequest, grpc.ServerStreamingServer[SalesReportRow]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportSalesReport not implemented")
+}
+func (UnimplementedDashboardServiceServer) ExportBestSellers(*ExportReportRequest, grpc.ServerStreamingServer[BestSellerRow]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportBestSellers not implemented")
+}
 func (UnimplementedDashboardServiceServer) mustEmbedUnimplementedDashboardServiceServer() {}
 func (UnimplementedDashboardServiceServer) testEmbeddedByValue()                          {}
 
@@ -215,6 +269,28 @@ func _DashboardService_GetBestSellers_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DashboardService_ExportSalesReport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportReportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DashboardServiceServer).ExportSalesReport(m, &grpc.GenericServerStream[ExportReportRequest, SalesReportRow]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DashboardService_ExportSalesReportServer = grpc.ServerStreamingServer[SalesReportRow]
+
+func _DashboardService_ExportBestSellers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportReportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DashboardServiceServer).ExportBestSellers(m, &grpc.GenericServerStream[ExportReportRequest, BestSellerRow]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DashboardService_ExportBestSellersServer = grpc.ServerStreamingServer[BestSellerRow]
+
 // DashboardService_ServiceDesc is the grpc.ServiceDesc for DashboardService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -239,6 +315,17 @@ var DashboardService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _DashboardService_GetBestSellers_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportSalesReport",
+			Handler:       _DashboardService_ExportSalesReport_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportBestSellers",
+			Handler:       _DashboardService_ExportBestSellers_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "dashboard.proto",
 }
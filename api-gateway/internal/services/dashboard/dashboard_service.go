@@ -2,6 +2,7 @@ package services
 
 import (
 	context "context"
+	"io"
 	"time"
 
 	"gitlab.com/final_project1240930/api_gateway/internal/logs"
@@ -14,8 +15,15 @@ type DashBoardService interface {
 	GetMonthlySales(ctx context.Context, req *emptypb.Empty) (*GetMonthlySalesResponse, error)
 	GetMonthlyBookingAndCustomers(ctx context.Context, req *emptypb.Empty) (*GetMonthlyBookingAndCustomersResponse, error)
 	GetBestSellers(ctx context.Context, req *emptypb.Empty) (*GetBestSellersResponse, error)
+
+	// Export ส่งข้อมูลให้ fn ทีละแถวตามที่ได้รับจาก stream
+	ExportSalesReport(ctx context.Context, req *ExportReportRequest, fn func(*SalesReportRow) error) error
+	ExportBestSellers(ctx context.Context, req *ExportReportRequest, fn func(*BestSellerRow) error) error
 }
 
+// export ช่วงวันที่กว้างๆ ใช้เวลานานกว่าการเรียกทั่วไป
+const exportTimeout = time.Minute * 5
+
 type dashboardService struct {
 	dashboardClient DashboardServiceClient
 }
@@ -74,3 +82,53 @@ func (s *dashboardService) GetBestSellers(ctx context.Context, req *emptypb.Empt
 	}
 	return nil, err
 }
+
+func (s *dashboardService) ExportSalesReport(ctx context.Context, req *ExportReportRequest, fn func(*SalesReportRow) error) error {
+	ctx, cancel := context.WithTimeout(ctx, exportTimeout)
+	defer cancel()
+
+	stream, err := s.dashboardClient.ExportSalesReport(ctx, req)
+	if err != nil {
+		logs.Error("Error calling service", zap.Error(err))
+		return err
+	}
+
+	for {
+		row, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			logs.Error("Error receiving sales report", zap.Error(err))
+			return err
+		}
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *dashboardService) ExportBestSellers(ctx context.Context, req *ExportReportRequest, fn func(*BestSellerRow) error) error {
+	ctx, cancel := context.WithTimeout(ctx, exportTimeout)
+	defer cancel()
+
+	stream, err := s.dashboardClient.ExportBestSellers(ctx, req)
+	if err != nil {
+		logs.Error("Error calling service", zap.Error(err))
+		return err
+	}
+
+	for {
+		row, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			logs.Error("Error receiving best sellers", zap.Error(err))
+			return err
+		}
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,243 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"gitlab.com/final_project1240930/api_gateway/internal/logs"
+	"go.uber.org/zap"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+type InventoryService interface {
+	CreateIngredient(ctx context.Context, req *CreateIngredientRequest) (*Ingredient, error)
+	UpdateIngredient(ctx context.Context, req *UpdateIngredientRequest) (*Ingredient, error)
+	DeleteIngredient(ctx context.Context, req *IngredientIdRequest) (*emptypb.Empty, error)
+	GetIngredients(ctx context.Context, req *emptypb.Empty) (*IngredientList, error)
+	GetIngredientById(ctx context.Context, req *IngredientIdRequest) (*Ingredient, error)
+	SetMenuItemRecipe(ctx context.Context, req *SetMenuItemRecipeRequest) (*Recipe, error)
+	GetMenuItemRecipe(ctx context.Context, req *GetMenuItemRecipeRequest) (*Recipe, error)
+	AdjustStock(ctx context.Context, req *AdjustStockRequest) (*StockMovement, error)
+	GetStockMovements(ctx context.Context, req *GetStockMovementsRequest) (*StockMovementList, error)
+	GetConsumptionProjection(ctx context.Context, req *ConsumptionProjectionRequest) (*ConsumptionProjection, error)
+	GetLowStockAlerts(ctx context.Context, req *LowStockAlertsRequest) (*LowStockAlertList, error)
+	CreateSupplier(ctx context.Context, req *Supplier) (*Supplier, error)
+	UpdateSupplier(ctx context.Context, req *Supplier) (*Supplier, error)
+	DeleteSupplier(ctx context.Context, req *SupplierIdRequest) (*emptypb.Empty, error)
+	GetSuppliers(ctx context.Context, req *emptypb.Empty) (*SupplierList, error)
+	GeneratePurchaseOrders(ctx context.Context, req *GeneratePurchaseOrdersRequest) (*GeneratePurchaseOrdersResponse, error)
+	GetPurchaseOrders(ctx context.Context, req *GetPurchaseOrdersRequest) (*PurchaseOrderList, error)
+	GetPurchaseOrderById(ctx context.Context, req *PurchaseOrderIdRequest) (*PurchaseOrder, error)
+	UpdatePurchaseOrderStatus(ctx context.Context, req *UpdatePurchaseOrderStatusRequest) (*PurchaseOrder, error)
+}
+
+type inventoryService struct {
+	inventoryClient InventoryServiceClient
+}
+
+func NewInventoryService(inventoryClient InventoryServiceClient) InventoryService {
+	return &inventoryService{inventoryClient: inventoryClient}
+}
+
+// wrapper function with timeout, logging, and error handling
+func (s *inventoryService) callWithTimeout(ctx context.Context, call func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*5) // Set timeout
+	defer cancel()
+
+	res, err := call(ctx)
+	if err != nil {
+		logs.Error("Error calling service", zap.Error(err))
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *inventoryService) CreateIngredient(ctx context.Context, req *CreateIngredientRequest) (*Ingredient, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.inventoryClient.CreateIngredient(ctx, req)
+	})
+	if res != nil {
+		return res.(*Ingredient), nil
+	}
+	return nil, err
+}
+
+func (s *inventoryService) UpdateIngredient(ctx context.Context, req *UpdateIngredientRequest) (*Ingredient, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.inventoryClient.UpdateIngredient(ctx, req)
+	})
+	if res != nil {
+		return res.(*Ingredient), nil
+	}
+	return nil, err
+}
+
+func (s *inventoryService) DeleteIngredient(ctx context.Context, req *IngredientIdRequest) (*emptypb.Empty, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.inventoryClient.DeleteIngredient(ctx, req)
+	})
+	if res != nil {
+		return res.(*emptypb.Empty), nil
+	}
+	return nil, err
+}
+
+func (s *inventoryService) GetIngredients(ctx context.Context, req *emptypb.Empty) (*IngredientList, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.inventoryClient.GetIngredients(ctx, req)
+	})
+	if res != nil {
+		return res.(*IngredientList), nil
+	}
+	return nil, err
+}
+
+func (s *inventoryService) GetIngredientById(ctx context.Context, req *IngredientIdRequest) (*Ingredient, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.inventoryClient.GetIngredientById(ctx, req)
+	})
+	if res != nil {
+		return res.(*Ingredient), nil
+	}
+	return nil, err
+}
+
+func (s *inventoryService) SetMenuItemRecipe(ctx context.Context, req *SetMenuItemRecipeRequest) (*Recipe, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.inventoryClient.SetMenuItemRecipe(ctx, req)
+	})
+	if res != nil {
+		return res.(*Recipe), nil
+	}
+	return nil, err
+}
+
+func (s *inventoryService) GetMenuItemRecipe(ctx context.Context, req *GetMenuItemRecipeRequest) (*Recipe, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.inventoryClient.GetMenuItemRecipe(ctx, req)
+	})
+	if res != nil {
+		return res.(*Recipe), nil
+	}
+	return nil, err
+}
+
+func (s *inventoryService) AdjustStock(ctx context.Context, req *AdjustStockRequest) (*StockMovement, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.inventoryClient.AdjustStock(ctx, req)
+	})
+	if res != nil {
+		return res.(*StockMovement), nil
+	}
+	return nil, err
+}
+
+func (s *inventoryService) GetStockMovements(ctx context.Context, req *GetStockMovementsRequest) (*StockMovementList, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.inventoryClient.GetStockMovements(ctx, req)
+	})
+	if res != nil {
+		return res.(*StockMovementList), nil
+	}
+	return nil, err
+}
+
+func (s *inventoryService) GetConsumptionProjection(ctx context.Context, req *ConsumptionProjectionRequest) (*ConsumptionProjection, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.inventoryClient.GetConsumptionProjection(ctx, req)
+	})
+	if res != nil {
+		return res.(*ConsumptionProjection), nil
+	}
+	return nil, err
+}
+
+func (s *inventoryService) GetLowStockAlerts(ctx context.Context, req *LowStockAlertsRequest) (*LowStockAlertList, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.inventoryClient.GetLowStockAlerts(ctx, req)
+	})
+	if res != nil {
+		return res.(*LowStockAlertList), nil
+	}
+	return nil, err
+}
+
+func (s *inventoryService) CreateSupplier(ctx context.Context, req *Supplier) (*Supplier, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.inventoryClient.CreateSupplier(ctx, req)
+	})
+	if res != nil {
+		return res.(*Supplier), nil
+	}
+	return nil, err
+}
+
+func (s *inventoryService) UpdateSupplier(ctx context.Context, req *Supplier) (*Supplier, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.inventoryClient.UpdateSupplier(ctx, req)
+	})
+	if res != nil {
+		return res.(*Supplier), nil
+	}
+	return nil, err
+}
+
+func (s *inventoryService) DeleteSupplier(ctx context.Context, req *SupplierIdRequest) (*emptypb.Empty, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.inventoryClient.DeleteSupplier(ctx, req)
+	})
+	if res != nil {
+		return res.(*emptypb.Empty), nil
+	}
+	return nil, err
+}
+
+func (s *inventoryService) GetSuppliers(ctx context.Context, req *emptypb.Empty) (*SupplierList, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.inventoryClient.GetSuppliers(ctx, req)
+	})
+	if res != nil {
+		return res.(*SupplierList), nil
+	}
+	return nil, err
+}
+
+func (s *inventoryService) GeneratePurchaseOrders(ctx context.Context, req *GeneratePurchaseOrdersRequest) (*GeneratePurchaseOrdersResponse, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.inventoryClient.GeneratePurchaseOrders(ctx, req)
+	})
+	if res != nil {
+		return res.(*GeneratePurchaseOrdersResponse), nil
+	}
+	return nil, err
+}
+
+func (s *inventoryService) GetPurchaseOrders(ctx context.Context, req *GetPurchaseOrdersRequest) (*PurchaseOrderList, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.inventoryClient.GetPurchaseOrders(ctx, req)
+	})
+	if res != nil {
+		return res.(*PurchaseOrderList), nil
+	}
+	return nil, err
+}
+
+func (s *inventoryService) GetPurchaseOrderById(ctx context.Context, req *PurchaseOrderIdRequest) (*PurchaseOrder, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.inventoryClient.GetPurchaseOrderById(ctx, req)
+	})
+	if res != nil {
+		return res.(*PurchaseOrder), nil
+	}
+	return nil, err
+}
+
+func (s *inventoryService) UpdatePurchaseOrderStatus(ctx context.Context, req *UpdatePurchaseOrderStatusRequest) (*PurchaseOrder, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.inventoryClient.UpdatePurchaseOrderStatus(ctx, req)
+	})
+	if res != nil {
+		return res.(*PurchaseOrder), nil
+	}
+	return nil, err
+}
@@ -22,117 +22,172 @@ const (
 )
 
 // ---------------- Enum ------------------------
-type MenuCategory int32
+type Status int32
 
 const (
-	MenuCategory_UNKNOWN     MenuCategory = 0 // ค่าเริ่มต้นหรือค่าไม่รู้จัก
-	MenuCategory_MAIN_COURSE MenuCategory = 1 // อาหารจานหลัก
-	MenuCategory_BEVERAGE    MenuCategory = 2 // เครื่องดื่ม
-	MenuCategory_DESSERT     MenuCategory = 3 // ของหวาน
+	Status_SUCCESS Status = 0 // สำเร็จ
+	Status_FAILURE Status = 1 // ล้มเหลว
 )
 
-// Enum value maps for MenuCategory.
+// Enum value maps for Status.
 var (
-	MenuCategory_name = map[int32]string{
-		0: "UNKNOWN",
-		1: "MAIN_COURSE",
-		2: "BEVERAGE",
-		3: "DESSERT",
-	}
-	MenuCategory_value = map[string]int32{
-		"UNKNOWN":     0,
-		"MAIN_COURSE": 1,
-		"BEVERAGE":    2,
-		"DESSERT":     3,
+	Status_name = map[int32]string{
+		0: "SUCCESS",
+		1: "FAILURE",
+	}
+	Status_value = map[string]int32{
+		"SUCCESS": 0,
+		"FAILURE": 1,
 	}
 )
 
-func (x MenuCategory) Enum() *MenuCategory {
-	p := new(MenuCategory)
+func (x Status) Enum() *Status {
+	p := new(Status)
 	*p = x
 	return p
 }
 
-func (x MenuCategory) String() string {
+func (x Status) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (MenuCategory) Descriptor() protoreflect.EnumDescriptor {
+func (Status) Descriptor() protoreflect.EnumDescriptor {
 	return file_menu_proto_enumTypes[0].Descriptor()
 }
 
-func (MenuCategory) Type() protoreflect.EnumType {
+func (Status) Type() protoreflect.EnumType {
 	return &file_menu_proto_enumTypes[0]
 }
 
-func (x MenuCategory) Number() protoreflect.EnumNumber {
+func (x Status) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use MenuCategory.Descriptor instead.
-func (MenuCategory) EnumDescriptor() ([]byte, []int) {
+// Deprecated: Use Status.Descriptor instead.
+func (Status) EnumDescriptor() ([]byte, []int) {
 	return file_menu_proto_rawDescGZIP(), []int{0}
 }
 
-type Status int32
+type AvailabilityFilter int32
 
 const (
-	Status_SUCCESS Status = 0 // สำเร็จ
-	Status_FAILURE Status = 1 // ล้มเหลว
+	AvailabilityFilter_AVAILABILITY_ALL         AvailabilityFilter = 0 // ทุกเมนู
+	AvailabilityFilter_AVAILABILITY_AVAILABLE   AvailabilityFilter = 1 // เปิดขายและยังเหลือของวันนั้น
+	AvailabilityFilter_AVAILABILITY_UNAVAILABLE AvailabilityFilter = 2 // ปิดขายหรือหมดแล้วของวันนั้น
 )
 
-// Enum value maps for Status.
+// Enum value maps for AvailabilityFilter.
 var (
-	Status_name = map[int32]string{
-		0: "SUCCESS",
-		1: "FAILURE",
+	AvailabilityFilter_name = map[int32]string{
+		0: "AVAILABILITY_ALL",
+		1: "AVAILABILITY_AVAILABLE",
+		2: "AVAILABILITY_UNAVAILABLE",
 	}
-	Status_value = map[string]int32{
-		"SUCCESS": 0,
-		"FAILURE": 1,
+	AvailabilityFilter_value = map[string]int32{
+		"AVAILABILITY_ALL":         0,
+		"AVAILABILITY_AVAILABLE":   1,
+		"AVAILABILITY_UNAVAILABLE": 2,
 	}
 )
 
-func (x Status) Enum() *Status {
-	p := new(Status)
+func (x AvailabilityFilter) Enum() *AvailabilityFilter {
+	p := new(AvailabilityFilter)
 	*p = x
 	return p
 }
 
-func (x Status) String() string {
+func (x AvailabilityFilter) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (Status) Descriptor() protoreflect.EnumDescriptor {
+func (AvailabilityFilter) Descriptor() protoreflect.EnumDescriptor {
 	return file_menu_proto_enumTypes[1].Descriptor()
 }
 
-func (Status) Type() protoreflect.EnumType {
+func (AvailabilityFilter) Type() protoreflect.EnumType {
 	return &file_menu_proto_enumTypes[1]
 }
 
-func (x Status) Number() protoreflect.EnumNumber {
+func (x AvailabilityFilter) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use Status.Descriptor instead.
-func (Status) EnumDescriptor() ([]byte, []int) {
+// Deprecated: Use AvailabilityFilter.Descriptor instead.
+func (AvailabilityFilter) EnumDescriptor() ([]byte, []int) {
 	return file_menu_proto_rawDescGZIP(), []int{1}
 }
 
+// ---------------- Menu Modifier ------------------------
+type ModifierSelection int32
+
+const (
+	ModifierSelection_MODIFIER_SINGLE ModifierSelection = 0 // เลือกได้หนึ่งอย่าง
+	ModifierSelection_MODIFIER_MULTI  ModifierSelection = 1 // เลือกได้หลายอย่างตาม min_select/max_select
+)
+
+// Enum value maps for ModifierSelection.
+var (
+	ModifierSelection_name = map[int32]string{
+		0: "MODIFIER_SINGLE",
+		1: "MODIFIER_MULTI",
+	}
+	ModifierSelection_value = map[string]int32{
+		"MODIFIER_SINGLE": 0,
+		"MODIFIER_MULTI":  1,
+	}
+)
+
+func (x ModifierSelection) Enum() *ModifierSelection {
+	p := new(ModifierSelection)
+	*p = x
+	return p
+}
+
+func (x ModifierSelection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModifierSelection) Descriptor() protoreflect.EnumDescriptor {
+	return file_menu_proto_enumTypes[2].Descriptor()
+}
+
+func (ModifierSelection) Type() protoreflect.EnumType {
+	return &file_menu_proto_enumTypes[2]
+}
+
+func (x ModifierSelection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModifierSelection.Descriptor instead.
+func (ModifierSelection) EnumDescriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{2}
+}
+
 // ---------------- Menu ------------------------
 type MenuItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                         // ID ของเมนู
-	NameTh      string       `protobuf:"bytes,2,opt,name=name_th,json=nameTh,proto3" json:"name_th,omitempty"`                   // ชื่อเมนูภาษาไทย
-	NameEn      string       `protobuf:"bytes,3,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`                   // ชื่อเมนูภาษาอังกฤษ
-	Description string       `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                       // รายละเอียดของเมนู
-	Price       float64      `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`                                 // ราคาของเมนู
-	Category    MenuCategory `protobuf:"varint,6,opt,name=category,proto3,enum=services.MenuCategory" json:"category,omitempty"` // ประเภทของเมนู (เช่น อาหารจานหลัก, เครื่องดื่ม)
-	ImageUrl    string       `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`             // URL รูปภาพของเมนู
+	Id             string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                 // ID ของเมนู
+	NameTh         string               `protobuf:"bytes,2,opt,name=name_th,json=nameTh,proto3" json:"name_th,omitempty"`                           // ชื่อเมนูภาษาไทย
+	NameEn         string               `protobuf:"bytes,3,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`                           // ชื่อเมนูภาษาอังกฤษ
+	Description    string               `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                               // รายละเอียดของเมนู
+	Price          float64              `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`                                         // ราคาของเมนู
+	ImageUrl       string               `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`                     // URL รูปภาพของเมนู (ขนาด full)
+	ImageVariants  *ImageVariants       `protobuf:"bytes,8,opt,name=image_variants,json=imageVariants,proto3" json:"image_variants,omitempty"`      // URL รูปภาพแต่ละขนาด ว่างถ้าไม่มีรูป
+	Images         []*MenuItemImage     `protobuf:"bytes,9,rep,name=images,proto3" json:"images,omitempty"`                                         // แกลเลอรีรูปภาพ เรียงตาม position
+	IsAvailable    bool                 `protobuf:"varint,10,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`          // เปิดขายอยู่หรือไม่
+	DailyLimit     int32                `protobuf:"varint,11,opt,name=daily_limit,json=dailyLimit,proto3" json:"daily_limit,omitempty"`             // จำนวนที่ขายได้ต่อวัน 0 = ไม่จำกัด
+	RemainingStock int32                `protobuf:"varint,12,opt,name=remaining_stock,json=remainingStock,proto3" json:"remaining_stock,omitempty"` // จำนวนที่เหลือของวันที่ขอ มีความหมายเฉพาะเมื่อ daily_limit > 0
+	Schedules      []*MenuSchedule      `protobuf:"bytes,13,rep,name=schedules,proto3" json:"schedules,omitempty"`                                  // ช่วงเวลาที่สั่งได้ ว่าง = สั่งได้ตลอด
+	Allergens      []string             `protobuf:"bytes,14,rep,name=allergens,proto3" json:"allergens,omitempty"`                                  // สารก่อภูมิแพ้ที่มีในเมนู (เช่น PEANUT, SHELLFISH)
+	DietaryTags    []string             `protobuf:"bytes,15,rep,name=dietary_tags,json=dietaryTags,proto3" json:"dietary_tags,omitempty"`           // ข้อมูลด้านอาหาร (เช่น HALAL, VEGETARIAN)
+	CategoryId     string               `protobuf:"bytes,16,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`              // ID ของหมวดหมู่เมนู
+	Category       *MenuCategory        `protobuf:"bytes,17,opt,name=category,proto3" json:"category,omitempty"`                                    // หมวดหมู่เมนู
+	ModifierGroups []*MenuModifierGroup `protobuf:"bytes,18,rep,name=modifier_groups,json=modifierGroups,proto3" json:"modifier_groups,omitempty"`  // กลุ่มตัวเลือก (ระดับความเผ็ด, ท็อปปิ้ง, ขนาด) เรียงตามลำดับ
+	ArchivedAt     string               `protobuf:"bytes,19,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`              // RFC3339 เวลาที่เก็บเข้าคลัง ว่าง = ยังขายอยู่
 }
 
 func (x *MenuItem) Reset() {
@@ -200,16 +255,155 @@ func (x *MenuItem) GetPrice() float64 {
 	return 0
 }
 
-func (x *MenuItem) GetCategory() MenuCategory {
+func (x *MenuItem) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *MenuItem) GetImageVariants() *ImageVariants {
+	if x != nil {
+		return x.ImageVariants
+	}
+	return nil
+}
+
+func (x *MenuItem) GetImages() []*MenuItemImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *MenuItem) GetIsAvailable() bool {
+	if x != nil {
+		return x.IsAvailable
+	}
+	return false
+}
+
+func (x *MenuItem) GetDailyLimit() int32 {
+	if x != nil {
+		return x.DailyLimit
+	}
+	return 0
+}
+
+func (x *MenuItem) GetRemainingStock() int32 {
+	if x != nil {
+		return x.RemainingStock
+	}
+	return 0
+}
+
+func (x *MenuItem) GetSchedules() []*MenuSchedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+func (x *MenuItem) GetAllergens() []string {
+	if x != nil {
+		return x.Allergens
+	}
+	return nil
+}
+
+func (x *MenuItem) GetDietaryTags() []string {
+	if x != nil {
+		return x.DietaryTags
+	}
+	return nil
+}
+
+func (x *MenuItem) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *MenuItem) GetCategory() *MenuCategory {
 	if x != nil {
 		return x.Category
 	}
-	return MenuCategory_UNKNOWN
+	return nil
 }
 
-func (x *MenuItem) GetImageUrl() string {
+func (x *MenuItem) GetModifierGroups() []*MenuModifierGroup {
 	if x != nil {
-		return x.ImageUrl
+		return x.ModifierGroups
+	}
+	return nil
+}
+
+func (x *MenuItem) GetArchivedAt() string {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return ""
+}
+
+// รูปภาพที่ย่อเป็นหลายขนาด (WebP)
+type ImageVariants struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ThumbnailUrl string `protobuf:"bytes,1,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"` // 200x200
+	CardUrl      string `protobuf:"bytes,2,opt,name=card_url,json=cardUrl,proto3" json:"card_url,omitempty"`                // 640x480
+	FullUrl      string `protobuf:"bytes,3,opt,name=full_url,json=fullUrl,proto3" json:"full_url,omitempty"`                // ไม่เกิน 1280px
+}
+
+func (x *ImageVariants) Reset() {
+	*x = ImageVariants{}
+	mi := &file_menu_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImageVariants) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageVariants) ProtoMessage() {}
+
+func (x *ImageVariants) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageVariants.ProtoReflect.Descriptor instead.
+func (*ImageVariants) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ImageVariants) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *ImageVariants) GetCardUrl() string {
+	if x != nil {
+		return x.CardUrl
+	}
+	return ""
+}
+
+func (x *ImageVariants) GetFullUrl() string {
+	if x != nil {
+		return x.FullUrl
 	}
 	return ""
 }
@@ -221,11 +415,12 @@ type MenuItemList struct {
 	unknownFields protoimpl.UnknownFields
 
 	MenuItems []*MenuItem `protobuf:"bytes,1,rep,name=menu_items,json=menuItems,proto3" json:"menu_items,omitempty"` // รายการของเมนูทั้งหมด
+	Total     int32       `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                         // จำนวนเมนูที่ตรงเงื่อนไขทั้งหมด (ก่อนแบ่งหน้า)
 }
 
 func (x *MenuItemList) Reset() {
 	*x = MenuItemList{}
-	mi := &file_menu_proto_msgTypes[1]
+	mi := &file_menu_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -237,7 +432,7 @@ func (x *MenuItemList) String() string {
 func (*MenuItemList) ProtoMessage() {}
 
 func (x *MenuItemList) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_proto_msgTypes[1]
+	mi := &file_menu_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -250,7 +445,7 @@ func (x *MenuItemList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MenuItemList.ProtoReflect.Descriptor instead.
 func (*MenuItemList) Descriptor() ([]byte, []int) {
-	return file_menu_proto_rawDescGZIP(), []int{1}
+	return file_menu_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *MenuItemList) GetMenuItems() []*MenuItem {
@@ -260,23 +455,30 @@ func (x *MenuItemList) GetMenuItems() []*MenuItem {
 	return nil
 }
 
+func (x *MenuItemList) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 // Create New Menu
 type CreateMenuItemRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NameTh      string       `protobuf:"bytes,1,opt,name=name_th,json=nameTh,proto3" json:"name_th,omitempty"`                   // ชื่อเมนูภาษาไทย
-	NameEn      string       `protobuf:"bytes,2,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`                   // ชื่อเมนูภาษาอังกฤษ
-	Price       float64      `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`                                 // ราคาของเมนู
-	Description string       `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                       // รายละเอียดของเมนู
-	Category    MenuCategory `protobuf:"varint,5,opt,name=category,proto3,enum=services.MenuCategory" json:"category,omitempty"` // ประเภทของเมนู
-	ImageData   []byte       `protobuf:"bytes,6,opt,name=image_data,json=imageData,proto3" json:"image_data,omitempty"`          // ข้อมูลไฟล์รูปภาพของเมนู
+	NameTh      string  `protobuf:"bytes,1,opt,name=name_th,json=nameTh,proto3" json:"name_th,omitempty"`             // ชื่อเมนูภาษาไทย
+	NameEn      string  `protobuf:"bytes,2,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`             // ชื่อเมนูภาษาอังกฤษ
+	Price       float64 `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`                           // ราคาของเมนู
+	Description string  `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                 // รายละเอียดของเมนู
+	ImageData   []byte  `protobuf:"bytes,6,opt,name=image_data,json=imageData,proto3" json:"image_data,omitempty"`    // ข้อมูลไฟล์รูปภาพของเมนู
+	CategoryId  string  `protobuf:"bytes,7,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // ID ของหมวดหมู่เมนู
 }
 
 func (x *CreateMenuItemRequest) Reset() {
 	*x = CreateMenuItemRequest{}
-	mi := &file_menu_proto_msgTypes[2]
+	mi := &file_menu_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -288,7 +490,7 @@ func (x *CreateMenuItemRequest) String() string {
 func (*CreateMenuItemRequest) ProtoMessage() {}
 
 func (x *CreateMenuItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_proto_msgTypes[2]
+	mi := &file_menu_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -301,7 +503,7 @@ func (x *CreateMenuItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateMenuItemRequest.ProtoReflect.Descriptor instead.
 func (*CreateMenuItemRequest) Descriptor() ([]byte, []int) {
-	return file_menu_proto_rawDescGZIP(), []int{2}
+	return file_menu_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateMenuItemRequest) GetNameTh() string {
@@ -332,18 +534,18 @@ func (x *CreateMenuItemRequest) GetDescription() string {
 	return ""
 }
 
-func (x *CreateMenuItemRequest) GetCategory() MenuCategory {
+func (x *CreateMenuItemRequest) GetImageData() []byte {
 	if x != nil {
-		return x.Category
+		return x.ImageData
 	}
-	return MenuCategory_UNKNOWN
+	return nil
 }
 
-func (x *CreateMenuItemRequest) GetImageData() []byte {
+func (x *CreateMenuItemRequest) GetCategoryId() string {
 	if x != nil {
-		return x.ImageData
+		return x.CategoryId
 	}
-	return nil
+	return ""
 }
 
 type CreateMenuItemResponse struct {
@@ -357,7 +559,7 @@ type CreateMenuItemResponse struct {
 
 func (x *CreateMenuItemResponse) Reset() {
 	*x = CreateMenuItemResponse{}
-	mi := &file_menu_proto_msgTypes[3]
+	mi := &file_menu_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -369,7 +571,7 @@ func (x *CreateMenuItemResponse) String() string {
 func (*CreateMenuItemResponse) ProtoMessage() {}
 
 func (x *CreateMenuItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_proto_msgTypes[3]
+	mi := &file_menu_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -382,7 +584,7 @@ func (x *CreateMenuItemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateMenuItemResponse.ProtoReflect.Descriptor instead.
 func (*CreateMenuItemResponse) Descriptor() ([]byte, []int) {
-	return file_menu_proto_rawDescGZIP(), []int{3}
+	return file_menu_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateMenuItemResponse) GetId() string {
@@ -405,18 +607,18 @@ type UpdateMenuItemRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                         // ID ของเมนูที่ต้องการอัปเดต
-	NameTh      string       `protobuf:"bytes,2,opt,name=name_th,json=nameTh,proto3" json:"name_th,omitempty"`                   // ชื่อเมนูภาษาไทย
-	NameEn      string       `protobuf:"bytes,3,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`                   // ชื่อเมนูภาษาอังกฤษ
-	Description string       `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                       // รายละเอียดของเมนู
-	Price       float64      `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`                                 // ราคาของเมนู
-	Category    MenuCategory `protobuf:"varint,6,opt,name=category,proto3,enum=services.MenuCategory" json:"category,omitempty"` // ประเภทของเมนู
-	ImageData   []byte       `protobuf:"bytes,7,opt,name=image_data,json=imageData,proto3" json:"image_data,omitempty"`          // URL รูปภาพของเมนู
+	Id          string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                   // ID ของเมนูที่ต้องการอัปเดต
+	NameTh      string  `protobuf:"bytes,2,opt,name=name_th,json=nameTh,proto3" json:"name_th,omitempty"`             // ชื่อเมนูภาษาไทย
+	NameEn      string  `protobuf:"bytes,3,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`             // ชื่อเมนูภาษาอังกฤษ
+	Description string  `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                 // รายละเอียดของเมนู
+	Price       float64 `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`                           // ราคาของเมนู
+	ImageData   []byte  `protobuf:"bytes,7,opt,name=image_data,json=imageData,proto3" json:"image_data,omitempty"`    // URL รูปภาพของเมนู
+	CategoryId  string  `protobuf:"bytes,8,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // ID ของหมวดหมู่เมนู
 }
 
 func (x *UpdateMenuItemRequest) Reset() {
 	*x = UpdateMenuItemRequest{}
-	mi := &file_menu_proto_msgTypes[4]
+	mi := &file_menu_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -428,7 +630,7 @@ func (x *UpdateMenuItemRequest) String() string {
 func (*UpdateMenuItemRequest) ProtoMessage() {}
 
 func (x *UpdateMenuItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_proto_msgTypes[4]
+	mi := &file_menu_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -441,7 +643,7 @@ func (x *UpdateMenuItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMenuItemRequest.ProtoReflect.Descriptor instead.
 func (*UpdateMenuItemRequest) Descriptor() ([]byte, []int) {
-	return file_menu_proto_rawDescGZIP(), []int{4}
+	return file_menu_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UpdateMenuItemRequest) GetId() string {
@@ -479,18 +681,18 @@ func (x *UpdateMenuItemRequest) GetPrice() float64 {
 	return 0
 }
 
-func (x *UpdateMenuItemRequest) GetCategory() MenuCategory {
+func (x *UpdateMenuItemRequest) GetImageData() []byte {
 	if x != nil {
-		return x.Category
+		return x.ImageData
 	}
-	return MenuCategory_UNKNOWN
+	return nil
 }
 
-func (x *UpdateMenuItemRequest) GetImageData() []byte {
+func (x *UpdateMenuItemRequest) GetCategoryId() string {
 	if x != nil {
-		return x.ImageData
+		return x.CategoryId
 	}
-	return nil
+	return ""
 }
 
 type UpdateMenuItemResponse struct {
@@ -503,7 +705,7 @@ type UpdateMenuItemResponse struct {
 
 func (x *UpdateMenuItemResponse) Reset() {
 	*x = UpdateMenuItemResponse{}
-	mi := &file_menu_proto_msgTypes[5]
+	mi := &file_menu_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -515,7 +717,7 @@ func (x *UpdateMenuItemResponse) String() string {
 func (*UpdateMenuItemResponse) ProtoMessage() {}
 
 func (x *UpdateMenuItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_proto_msgTypes[5]
+	mi := &file_menu_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -528,7 +730,7 @@ func (x *UpdateMenuItemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMenuItemResponse.ProtoReflect.Descriptor instead.
 func (*UpdateMenuItemResponse) Descriptor() ([]byte, []int) {
-	return file_menu_proto_rawDescGZIP(), []int{5}
+	return file_menu_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateMenuItemResponse) GetStatus() Status {
@@ -549,7 +751,7 @@ type DeleteMenuItemRequest struct {
 
 func (x *DeleteMenuItemRequest) Reset() {
 	*x = DeleteMenuItemRequest{}
-	mi := &file_menu_proto_msgTypes[6]
+	mi := &file_menu_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -561,7 +763,7 @@ func (x *DeleteMenuItemRequest) String() string {
 func (*DeleteMenuItemRequest) ProtoMessage() {}
 
 func (x *DeleteMenuItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_proto_msgTypes[6]
+	mi := &file_menu_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -574,7 +776,7 @@ func (x *DeleteMenuItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMenuItemRequest.ProtoReflect.Descriptor instead.
 func (*DeleteMenuItemRequest) Descriptor() ([]byte, []int) {
-	return file_menu_proto_rawDescGZIP(), []int{6}
+	return file_menu_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DeleteMenuItemRequest) GetId() string {
@@ -594,7 +796,7 @@ type DeleteMenuItemResponse struct {
 
 func (x *DeleteMenuItemResponse) Reset() {
 	*x = DeleteMenuItemResponse{}
-	mi := &file_menu_proto_msgTypes[7]
+	mi := &file_menu_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -606,7 +808,7 @@ func (x *DeleteMenuItemResponse) String() string {
 func (*DeleteMenuItemResponse) ProtoMessage() {}
 
 func (x *DeleteMenuItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_proto_msgTypes[7]
+	mi := &file_menu_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -619,7 +821,7 @@ func (x *DeleteMenuItemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMenuItemResponse.ProtoReflect.Descriptor instead.
 func (*DeleteMenuItemResponse) Descriptor() ([]byte, []int) {
-	return file_menu_proto_rawDescGZIP(), []int{7}
+	return file_menu_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteMenuItemResponse) GetStatus() Status {
@@ -639,7 +841,7 @@ type GetMenuItemByIdRequest struct {
 
 func (x *GetMenuItemByIdRequest) Reset() {
 	*x = GetMenuItemByIdRequest{}
-	mi := &file_menu_proto_msgTypes[8]
+	mi := &file_menu_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -651,7 +853,7 @@ func (x *GetMenuItemByIdRequest) String() string {
 func (*GetMenuItemByIdRequest) ProtoMessage() {}
 
 func (x *GetMenuItemByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_proto_msgTypes[8]
+	mi := &file_menu_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -664,7 +866,7 @@ func (x *GetMenuItemByIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMenuItemByIdRequest.ProtoReflect.Descriptor instead.
 func (*GetMenuItemByIdRequest) Descriptor() ([]byte, []int) {
-	return file_menu_proto_rawDescGZIP(), []int{8}
+	return file_menu_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetMenuItemByIdRequest) GetId() string {
@@ -674,32 +876,39 @@ func (x *GetMenuItemByIdRequest) GetId() string {
 	return ""
 }
 
-// ---------------- Menu Set ------------------------
-type MenuSet struct {
+type GetMenuItemsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`         // ID ของเซตเมนู
-	Name  string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`     // ชื่อของเซตเมนู
-	Price float64 `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"` // ราคาของเซตเมนู
-}
-
-func (x *MenuSet) Reset() {
-	*x = MenuSet{}
-	mi := &file_menu_proto_msgTypes[9]
+	Availability     AvailabilityFilter `protobuf:"varint,1,opt,name=availability,proto3,enum=services.AvailabilityFilter" json:"availability,omitempty"` // กรองตามสถานะการขาย
+	Date             string             `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`                                                   // วันที่ใช้คิดจำนวนคงเหลือ (YYYY-MM-DD) ว่าง = วันนี้ตามเวลาไทย หรือวันของ at
+	At               string             `protobuf:"bytes,3,opt,name=at,proto3" json:"at,omitempty"`                                                       // RFC3339 ถ้าระบุ คืนเฉพาะเมนูที่สั่งได้ในเวลานั้น
+	ExcludeAllergens []string           `protobuf:"bytes,4,rep,name=exclude_allergens,json=excludeAllergens,proto3" json:"exclude_allergens,omitempty"`   // ไม่เอาเมนูที่มีสารก่อภูมิแพ้เหล่านี้
+	DietaryTags      []string           `protobuf:"bytes,5,rep,name=dietary_tags,json=dietaryTags,proto3" json:"dietary_tags,omitempty"`                  // เอาเฉพาะเมนูที่มีแท็กเหล่านี้ครบ
+	CategoryId       string             `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`                     // เอาเฉพาะเมนูในหมวดหมู่นี้ (รวมหมวดหมู่ย่อย)
+	MinPrice         float64            `protobuf:"fixed64,7,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`                         // ราคาต่ำสุด 0 = ไม่จำกัด
+	MaxPrice         float64            `protobuf:"fixed64,8,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`                         // ราคาสูงสุด 0 = ไม่จำกัด
+	Page             int32              `protobuf:"varint,9,opt,name=page,proto3" json:"page,omitempty"`                                                  // หน้าที่ต้องการ เริ่มที่ 1 (0 = หน้าแรก)
+	PageSize         int32              `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                         // จำนวนต่อหน้า 0 = ไม่แบ่งหน้า
+	Archived         bool               `protobuf:"varint,11,opt,name=archived,proto3" json:"archived,omitempty"`                                         // true = คืนเฉพาะเมนูที่เก็บเข้าคลังแล้ว (สำหรับผู้ดูแล) ปกติไม่คืนเมนูที่เก็บแล้ว
+}
+
+func (x *GetMenuItemsRequest) Reset() {
+	*x = GetMenuItemsRequest{}
+	mi := &file_menu_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MenuSet) String() string {
+func (x *GetMenuItemsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MenuSet) ProtoMessage() {}
+func (*GetMenuItemsRequest) ProtoMessage() {}
 
-func (x *MenuSet) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_proto_msgTypes[9]
+func (x *GetMenuItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -710,56 +919,2743 @@ func (x *MenuSet) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MenuSet.ProtoReflect.Descriptor instead.
-func (*MenuSet) Descriptor() ([]byte, []int) {
-	return file_menu_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetMenuItemsRequest.ProtoReflect.Descriptor instead.
+func (*GetMenuItemsRequest) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *MenuSet) GetId() string {
+func (x *GetMenuItemsRequest) GetAvailability() AvailabilityFilter {
 	if x != nil {
-		return x.Id
+		return x.Availability
+	}
+	return AvailabilityFilter_AVAILABILITY_ALL
+}
+
+func (x *GetMenuItemsRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetMenuItemsRequest) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+func (x *GetMenuItemsRequest) GetExcludeAllergens() []string {
+	if x != nil {
+		return x.ExcludeAllergens
+	}
+	return nil
+}
+
+func (x *GetMenuItemsRequest) GetDietaryTags() []string {
+	if x != nil {
+		return x.DietaryTags
+	}
+	return nil
+}
+
+func (x *GetMenuItemsRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *GetMenuItemsRequest) GetMinPrice() float64 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *GetMenuItemsRequest) GetMaxPrice() float64 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+func (x *GetMenuItemsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetMenuItemsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetMenuItemsRequest) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+// เก็บเมนูหรือเซตเมนูเข้าคลัง (เลิกขาย) หรือนำกลับมาขาย
+// ที่เก็บแล้วไม่แสดงในรายการและการค้นหา สั่งใหม่ไม่ได้ แต่การจองเก่ายังอ้างถึงได้
+type ArchiveMenuRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ArchiveMenuRequest) Reset() {
+	*x = ArchiveMenuRequest{}
+	mi := &file_menu_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveMenuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveMenuRequest) ProtoMessage() {}
+
+func (x *ArchiveMenuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveMenuRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveMenuRequest) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ArchiveMenuRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// แทนที่แท็กทั้งหมดของเมนู
+type SetMenuItemTagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                      // ID ของเมนู
+	Allergens   []string `protobuf:"bytes,2,rep,name=allergens,proto3" json:"allergens,omitempty"`                        // PEANUT, TREE_NUT, SHELLFISH, FISH, GLUTEN, DAIRY, EGG, SOY, SESAME
+	DietaryTags []string `protobuf:"bytes,3,rep,name=dietary_tags,json=dietaryTags,proto3" json:"dietary_tags,omitempty"` // HALAL, VEGETARIAN, VEGAN, GLUTEN_FREE, NO_PORK
+}
+
+func (x *SetMenuItemTagsRequest) Reset() {
+	*x = SetMenuItemTagsRequest{}
+	mi := &file_menu_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMenuItemTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMenuItemTagsRequest) ProtoMessage() {}
+
+func (x *SetMenuItemTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMenuItemTagsRequest.ProtoReflect.Descriptor instead.
+func (*SetMenuItemTagsRequest) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetMenuItemTagsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetMenuItemTagsRequest) GetAllergens() []string {
+	if x != nil {
+		return x.Allergens
+	}
+	return nil
+}
+
+func (x *SetMenuItemTagsRequest) GetDietaryTags() []string {
+	if x != nil {
+		return x.DietaryTags
+	}
+	return nil
+}
+
+// เปิด/ปิดการขายและตั้งจำนวนที่ขายได้ต่อวัน
+type SetMenuItemAvailabilityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                       // ID ของเมนู
+	IsAvailable bool   `protobuf:"varint,2,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"` // เปิดขายหรือไม่
+	DailyLimit  int32  `protobuf:"varint,3,opt,name=daily_limit,json=dailyLimit,proto3" json:"daily_limit,omitempty"`    // จำนวนที่ขายได้ต่อวัน 0 = ไม่จำกัด
+}
+
+func (x *SetMenuItemAvailabilityRequest) Reset() {
+	*x = SetMenuItemAvailabilityRequest{}
+	mi := &file_menu_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMenuItemAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMenuItemAvailabilityRequest) ProtoMessage() {}
+
+func (x *SetMenuItemAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMenuItemAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*SetMenuItemAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SetMenuItemAvailabilityRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetMenuItemAvailabilityRequest) GetIsAvailable() bool {
+	if x != nil {
+		return x.IsAvailable
+	}
+	return false
+}
+
+func (x *SetMenuItemAvailabilityRequest) GetDailyLimit() int32 {
+	if x != nil {
+		return x.DailyLimit
+	}
+	return 0
+}
+
+// ---------------- Menu Category ------------------------
+type MenuCategory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                 // ID ของหมวดหมู่
+	NameTh    string `protobuf:"bytes,2,opt,name=name_th,json=nameTh,proto3" json:"name_th,omitempty"`           // ชื่อหมวดหมู่ภาษาไทย
+	NameEn    string `protobuf:"bytes,3,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`           // ชื่อหมวดหมู่ภาษาอังกฤษ
+	SortOrder int32  `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"` // ลำดับการแสดงผล (น้อยไปมาก)
+	ParentId  string `protobuf:"bytes,5,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`     // ID ของหมวดหมู่แม่ ว่าง = หมวดหมู่ระดับบนสุด
+}
+
+func (x *MenuCategory) Reset() {
+	*x = MenuCategory{}
+	mi := &file_menu_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuCategory) ProtoMessage() {}
+
+func (x *MenuCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuCategory.ProtoReflect.Descriptor instead.
+func (*MenuCategory) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MenuCategory) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MenuCategory) GetNameTh() string {
+	if x != nil {
+		return x.NameTh
+	}
+	return ""
+}
+
+func (x *MenuCategory) GetNameEn() string {
+	if x != nil {
+		return x.NameEn
+	}
+	return ""
+}
+
+func (x *MenuCategory) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *MenuCategory) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+type MenuCategoryList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Categories []*MenuCategory `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"` // เรียงตาม sort_order
+}
+
+func (x *MenuCategoryList) Reset() {
+	*x = MenuCategoryList{}
+	mi := &file_menu_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuCategoryList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuCategoryList) ProtoMessage() {}
+
+func (x *MenuCategoryList) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuCategoryList.ProtoReflect.Descriptor instead.
+func (*MenuCategoryList) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MenuCategoryList) GetCategories() []*MenuCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type CreateMenuCategoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NameTh    string `protobuf:"bytes,1,opt,name=name_th,json=nameTh,proto3" json:"name_th,omitempty"`           // ชื่อหมวดหมู่ภาษาไทย
+	NameEn    string `protobuf:"bytes,2,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`           // ชื่อหมวดหมู่ภาษาอังกฤษ
+	SortOrder int32  `protobuf:"varint,3,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"` // ลำดับการแสดงผล
+	ParentId  string `protobuf:"bytes,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`     // ID ของหมวดหมู่แม่ ว่าง = หมวดหมู่ระดับบนสุด
+}
+
+func (x *CreateMenuCategoryRequest) Reset() {
+	*x = CreateMenuCategoryRequest{}
+	mi := &file_menu_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMenuCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMenuCategoryRequest) ProtoMessage() {}
+
+func (x *CreateMenuCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMenuCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateMenuCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateMenuCategoryRequest) GetNameTh() string {
+	if x != nil {
+		return x.NameTh
+	}
+	return ""
+}
+
+func (x *CreateMenuCategoryRequest) GetNameEn() string {
+	if x != nil {
+		return x.NameEn
+	}
+	return ""
+}
+
+func (x *CreateMenuCategoryRequest) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *CreateMenuCategoryRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+type UpdateMenuCategoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                 // ID ของหมวดหมู่ที่ต้องการอัปเดต
+	NameTh    string `protobuf:"bytes,2,opt,name=name_th,json=nameTh,proto3" json:"name_th,omitempty"`           // ชื่อหมวดหมู่ภาษาไทย
+	NameEn    string `protobuf:"bytes,3,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`           // ชื่อหมวดหมู่ภาษาอังกฤษ
+	SortOrder int32  `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"` // ลำดับการแสดงผล
+	ParentId  string `protobuf:"bytes,5,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`     // ID ของหมวดหมู่แม่ ว่าง = หมวดหมู่ระดับบนสุด
+}
+
+func (x *UpdateMenuCategoryRequest) Reset() {
+	*x = UpdateMenuCategoryRequest{}
+	mi := &file_menu_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMenuCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMenuCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateMenuCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMenuCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMenuCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateMenuCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateMenuCategoryRequest) GetNameTh() string {
+	if x != nil {
+		return x.NameTh
+	}
+	return ""
+}
+
+func (x *UpdateMenuCategoryRequest) GetNameEn() string {
+	if x != nil {
+		return x.NameEn
+	}
+	return ""
+}
+
+func (x *UpdateMenuCategoryRequest) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *UpdateMenuCategoryRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+type DeleteMenuCategoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // ID ของหมวดหมู่ที่ต้องการลบ
+}
+
+func (x *DeleteMenuCategoryRequest) Reset() {
+	*x = DeleteMenuCategoryRequest{}
+	mi := &file_menu_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMenuCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMenuCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteMenuCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMenuCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMenuCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeleteMenuCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteMenuCategoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status Status `protobuf:"varint,1,opt,name=status,proto3,enum=services.Status" json:"status,omitempty"` // สถานะการลบหมวดหมู่ (สำเร็จ/ล้มเหลว)
+}
+
+func (x *DeleteMenuCategoryResponse) Reset() {
+	*x = DeleteMenuCategoryResponse{}
+	mi := &file_menu_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMenuCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMenuCategoryResponse) ProtoMessage() {}
+
+func (x *DeleteMenuCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMenuCategoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMenuCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteMenuCategoryResponse) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_SUCCESS
+}
+
+type GetMenuCategoryByIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // ID
+}
+
+func (x *GetMenuCategoryByIdRequest) Reset() {
+	*x = GetMenuCategoryByIdRequest{}
+	mi := &file_menu_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMenuCategoryByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMenuCategoryByIdRequest) ProtoMessage() {}
+
+func (x *GetMenuCategoryByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMenuCategoryByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetMenuCategoryByIdRequest) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetMenuCategoryByIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ---------------- Menu Item Gallery ------------------------
+type MenuItemImage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                            // ID ของรูป
+	MenuItemId    string         `protobuf:"bytes,2,opt,name=menu_item_id,json=menuItemId,proto3" json:"menu_item_id,omitempty"`        // ID ของเมนู
+	Position      int32          `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`                               // ลำดับของรูป เริ่มที่ 0
+	AltTh         string         `protobuf:"bytes,4,opt,name=alt_th,json=altTh,proto3" json:"alt_th,omitempty"`                         // คำอธิบายรูปภาษาไทย
+	AltEn         string         `protobuf:"bytes,5,opt,name=alt_en,json=altEn,proto3" json:"alt_en,omitempty"`                         // คำอธิบายรูปภาษาอังกฤษ
+	ImageUrl      string         `protobuf:"bytes,6,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`                // URL รูปภาพ (ขนาด full)
+	ImageVariants *ImageVariants `protobuf:"bytes,7,opt,name=image_variants,json=imageVariants,proto3" json:"image_variants,omitempty"` // URL รูปภาพแต่ละขนาด
+}
+
+func (x *MenuItemImage) Reset() {
+	*x = MenuItemImage{}
+	mi := &file_menu_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuItemImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuItemImage) ProtoMessage() {}
+
+func (x *MenuItemImage) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuItemImage.ProtoReflect.Descriptor instead.
+func (*MenuItemImage) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MenuItemImage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MenuItemImage) GetMenuItemId() string {
+	if x != nil {
+		return x.MenuItemId
+	}
+	return ""
+}
+
+func (x *MenuItemImage) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *MenuItemImage) GetAltTh() string {
+	if x != nil {
+		return x.AltTh
+	}
+	return ""
+}
+
+func (x *MenuItemImage) GetAltEn() string {
+	if x != nil {
+		return x.AltEn
+	}
+	return ""
+}
+
+func (x *MenuItemImage) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *MenuItemImage) GetImageVariants() *ImageVariants {
+	if x != nil {
+		return x.ImageVariants
+	}
+	return nil
+}
+
+type MenuItemImageList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Images []*MenuItemImage `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+}
+
+func (x *MenuItemImageList) Reset() {
+	*x = MenuItemImageList{}
+	mi := &file_menu_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuItemImageList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuItemImageList) ProtoMessage() {}
+
+func (x *MenuItemImageList) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuItemImageList.ProtoReflect.Descriptor instead.
+func (*MenuItemImageList) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MenuItemImageList) GetImages() []*MenuItemImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+// เพิ่มรูปต่อท้ายแกลเลอรี
+type AddMenuItemImageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MenuItemId string `protobuf:"bytes,1,opt,name=menu_item_id,json=menuItemId,proto3" json:"menu_item_id,omitempty"` // ID ของเมนู
+	ImageData  []byte `protobuf:"bytes,2,opt,name=image_data,json=imageData,proto3" json:"image_data,omitempty"`      // ข้อมูลไฟล์รูปภาพ
+	AltTh      string `protobuf:"bytes,3,opt,name=alt_th,json=altTh,proto3" json:"alt_th,omitempty"`                  // คำอธิบายรูปภาษาไทย
+	AltEn      string `protobuf:"bytes,4,opt,name=alt_en,json=altEn,proto3" json:"alt_en,omitempty"`                  // คำอธิบายรูปภาษาอังกฤษ
+}
+
+func (x *AddMenuItemImageRequest) Reset() {
+	*x = AddMenuItemImageRequest{}
+	mi := &file_menu_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddMenuItemImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMenuItemImageRequest) ProtoMessage() {}
+
+func (x *AddMenuItemImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMenuItemImageRequest.ProtoReflect.Descriptor instead.
+func (*AddMenuItemImageRequest) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AddMenuItemImageRequest) GetMenuItemId() string {
+	if x != nil {
+		return x.MenuItemId
+	}
+	return ""
+}
+
+func (x *AddMenuItemImageRequest) GetImageData() []byte {
+	if x != nil {
+		return x.ImageData
+	}
+	return nil
+}
+
+func (x *AddMenuItemImageRequest) GetAltTh() string {
+	if x != nil {
+		return x.AltTh
+	}
+	return ""
+}
+
+func (x *AddMenuItemImageRequest) GetAltEn() string {
+	if x != nil {
+		return x.AltEn
+	}
+	return ""
+}
+
+// เรียงลำดับรูปใหม่ ต้องส่ง ID ของรูปทั้งหมดของเมนูตามลำดับที่ต้องการ
+type ReorderMenuItemImagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MenuItemId string   `protobuf:"bytes,1,opt,name=menu_item_id,json=menuItemId,proto3" json:"menu_item_id,omitempty"` // ID ของเมนู
+	ImageIds   []string `protobuf:"bytes,2,rep,name=image_ids,json=imageIds,proto3" json:"image_ids,omitempty"`         // ID ของรูปตามลำดับใหม่
+}
+
+func (x *ReorderMenuItemImagesRequest) Reset() {
+	*x = ReorderMenuItemImagesRequest{}
+	mi := &file_menu_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderMenuItemImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderMenuItemImagesRequest) ProtoMessage() {}
+
+func (x *ReorderMenuItemImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderMenuItemImagesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderMenuItemImagesRequest) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReorderMenuItemImagesRequest) GetMenuItemId() string {
+	if x != nil {
+		return x.MenuItemId
+	}
+	return ""
+}
+
+func (x *ReorderMenuItemImagesRequest) GetImageIds() []string {
+	if x != nil {
+		return x.ImageIds
+	}
+	return nil
+}
+
+type RemoveMenuItemImageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MenuItemId string `protobuf:"bytes,1,opt,name=menu_item_id,json=menuItemId,proto3" json:"menu_item_id,omitempty"` // ID ของเมนู
+	ImageId    string `protobuf:"bytes,2,opt,name=image_id,json=imageId,proto3" json:"image_id,omitempty"`            // ID ของรูปที่ต้องการลบ
+}
+
+func (x *RemoveMenuItemImageRequest) Reset() {
+	*x = RemoveMenuItemImageRequest{}
+	mi := &file_menu_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMenuItemImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMenuItemImageRequest) ProtoMessage() {}
+
+func (x *RemoveMenuItemImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMenuItemImageRequest.ProtoReflect.Descriptor instead.
+func (*RemoveMenuItemImageRequest) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RemoveMenuItemImageRequest) GetMenuItemId() string {
+	if x != nil {
+		return x.MenuItemId
+	}
+	return ""
+}
+
+func (x *RemoveMenuItemImageRequest) GetImageId() string {
+	if x != nil {
+		return x.ImageId
+	}
+	return ""
+}
+
+type RemoveMenuItemImageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status Status `protobuf:"varint,1,opt,name=status,proto3,enum=services.Status" json:"status,omitempty"` // สถานะการลบรูป (สำเร็จ/ล้มเหลว)
+}
+
+func (x *RemoveMenuItemImageResponse) Reset() {
+	*x = RemoveMenuItemImageResponse{}
+	mi := &file_menu_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveMenuItemImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveMenuItemImageResponse) ProtoMessage() {}
+
+func (x *RemoveMenuItemImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveMenuItemImageResponse.ProtoReflect.Descriptor instead.
+func (*RemoveMenuItemImageResponse) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RemoveMenuItemImageResponse) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_SUCCESS
+}
+
+// ---------------- Menu Set ------------------------
+type MenuSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                      // ID ของเซตเมนู
+	Name        string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                  // ชื่อของเซตเมนู
+	Price       float64         `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`                              // ราคาของเซตเมนู
+	Schedules   []*MenuSchedule `protobuf:"bytes,4,rep,name=schedules,proto3" json:"schedules,omitempty"`                        // ช่วงเวลาที่สั่งได้ ว่าง = สั่งได้ตลอด
+	Allergens   []string        `protobuf:"bytes,5,rep,name=allergens,proto3" json:"allergens,omitempty"`                        // สารก่อภูมิแพ้ของทุกเมนูในเซต
+	DietaryTags []string        `protobuf:"bytes,6,rep,name=dietary_tags,json=dietaryTags,proto3" json:"dietary_tags,omitempty"` // ข้อมูลด้านอาหารที่ทุกเมนูในเซตมีร่วมกัน
+	Slots       []*MenuSetSlot  `protobuf:"bytes,7,rep,name=slots,proto3" json:"slots,omitempty"`                                // ช่องเลือกเมนู (เช่น เลือกอาหารจานหลัก 2 อย่าง) ว่าง = เซตแบบกำหนดเมนูไว้แล้ว
+	ArchivedAt  string          `protobuf:"bytes,8,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`    // RFC3339 เวลาที่เก็บเข้าคลัง ว่าง = ยังขายอยู่
+}
+
+func (x *MenuSet) Reset() {
+	*x = MenuSet{}
+	mi := &file_menu_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuSet) ProtoMessage() {}
+
+func (x *MenuSet) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuSet.ProtoReflect.Descriptor instead.
+func (*MenuSet) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *MenuSet) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MenuSet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MenuSet) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *MenuSet) GetSchedules() []*MenuSchedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+func (x *MenuSet) GetAllergens() []string {
+	if x != nil {
+		return x.Allergens
+	}
+	return nil
+}
+
+func (x *MenuSet) GetDietaryTags() []string {
+	if x != nil {
+		return x.DietaryTags
+	}
+	return nil
+}
+
+func (x *MenuSet) GetSlots() []*MenuSetSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *MenuSet) GetArchivedAt() string {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return ""
+}
+
+// List Menu Set
+type MenuSetList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MenuSets []*MenuSet `protobuf:"bytes,1,rep,name=menu_sets,json=menuSets,proto3" json:"menu_sets,omitempty"` // รายการของเซตเมนูทั้งหมด
+	Total    int32      `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                      // จำนวนเซตที่ตรงเงื่อนไขทั้งหมด (ก่อนแบ่งหน้า)
+}
+
+func (x *MenuSetList) Reset() {
+	*x = MenuSetList{}
+	mi := &file_menu_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuSetList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuSetList) ProtoMessage() {}
+
+func (x *MenuSetList) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuSetList.ProtoReflect.Descriptor instead.
+func (*MenuSetList) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *MenuSetList) GetMenuSets() []*MenuSet {
+	if x != nil {
+		return x.MenuSets
+	}
+	return nil
+}
+
+func (x *MenuSetList) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Create New Menu Set
+type CreateMenuSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`     // ชื่อของเซตเมนู
+	Price float64 `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"` // ราคาของเซตเมนู
+}
+
+func (x *CreateMenuSetRequest) Reset() {
+	*x = CreateMenuSetRequest{}
+	mi := &file_menu_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMenuSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMenuSetRequest) ProtoMessage() {}
+
+func (x *CreateMenuSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMenuSetRequest.ProtoReflect.Descriptor instead.
+func (*CreateMenuSetRequest) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateMenuSetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateMenuSetRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type CreateMenuSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                               // ID ของเซตเมนูที่ถูกสร้าง
+	Status Status `protobuf:"varint,2,opt,name=status,proto3,enum=services.Status" json:"status,omitempty"` // สถานะของการสร้างเซตเมนู (สำเร็จ/ล้มเหลว)
+}
+
+func (x *CreateMenuSetResponse) Reset() {
+	*x = CreateMenuSetResponse{}
+	mi := &file_menu_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMenuSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMenuSetResponse) ProtoMessage() {}
+
+func (x *CreateMenuSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMenuSetResponse.ProtoReflect.Descriptor instead.
+func (*CreateMenuSetResponse) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateMenuSetResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateMenuSetResponse) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_SUCCESS
+}
+
+// Update Menu Set
+type UpdateMenuSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`         // ID ของเซตเมนูที่ต้องการอัปเดต
+	Name  string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`     // ชื่อของเซตเมนู
+	Price float64 `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"` // ราคาของเซตเมนู
+}
+
+func (x *UpdateMenuSetRequest) Reset() {
+	*x = UpdateMenuSetRequest{}
+	mi := &file_menu_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMenuSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMenuSetRequest) ProtoMessage() {}
+
+func (x *UpdateMenuSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMenuSetRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMenuSetRequest) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateMenuSetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateMenuSetRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateMenuSetRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type UpdateMenuSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status Status `protobuf:"varint,1,opt,name=status,proto3,enum=services.Status" json:"status,omitempty"` // สถานะการอัปเดต (สำเร็จ/ล้มเหลว)
+}
+
+func (x *UpdateMenuSetResponse) Reset() {
+	*x = UpdateMenuSetResponse{}
+	mi := &file_menu_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMenuSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMenuSetResponse) ProtoMessage() {}
+
+func (x *UpdateMenuSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMenuSetResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMenuSetResponse) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UpdateMenuSetResponse) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_SUCCESS
+}
+
+// Delete Menu Set
+type DeleteMenuSetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // ID ของเซตเมนูที่ต้องการลบ
+}
+
+func (x *DeleteMenuSetRequest) Reset() {
+	*x = DeleteMenuSetRequest{}
+	mi := &file_menu_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMenuSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMenuSetRequest) ProtoMessage() {}
+
+func (x *DeleteMenuSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMenuSetRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMenuSetRequest) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteMenuSetRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteMenuSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status Status `protobuf:"varint,1,opt,name=status,proto3,enum=services.Status" json:"status,omitempty"` // สถานะการลบเซตเมนู (สำเร็จ/ล้มเหลว)
+}
+
+func (x *DeleteMenuSetResponse) Reset() {
+	*x = DeleteMenuSetResponse{}
+	mi := &file_menu_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMenuSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMenuSetResponse) ProtoMessage() {}
+
+func (x *DeleteMenuSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMenuSetResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMenuSetResponse) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteMenuSetResponse) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_SUCCESS
+}
+
+type GetMenuSetByIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // ID
+}
+
+func (x *GetMenuSetByIdRequest) Reset() {
+	*x = GetMenuSetByIdRequest{}
+	mi := &file_menu_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMenuSetByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMenuSetByIdRequest) ProtoMessage() {}
+
+func (x *GetMenuSetByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMenuSetByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetMenuSetByIdRequest) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetMenuSetByIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetMenuSetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	At               string   `protobuf:"bytes,1,opt,name=at,proto3" json:"at,omitempty"`                                                     // RFC3339 ถ้าระบุ คืนเฉพาะเซตเมนูที่สั่งได้ในเวลานั้น
+	ExcludeAllergens []string `protobuf:"bytes,2,rep,name=exclude_allergens,json=excludeAllergens,proto3" json:"exclude_allergens,omitempty"` // ไม่เอาเซตที่มีสารก่อภูมิแพ้เหล่านี้
+	DietaryTags      []string `protobuf:"bytes,3,rep,name=dietary_tags,json=dietaryTags,proto3" json:"dietary_tags,omitempty"`                // เอาเฉพาะเซตที่มีแท็กเหล่านี้ครบ
+	CategoryId       string   `protobuf:"bytes,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`                   // เอาเฉพาะเซตที่มีเมนูหรือช่องเลือกในหมวดหมู่นี้ (รวมหมวดหมู่ย่อย)
+	MinPrice         float64  `protobuf:"fixed64,5,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`                       // ราคาต่ำสุด 0 = ไม่จำกัด
+	MaxPrice         float64  `protobuf:"fixed64,6,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`                       // ราคาสูงสุด 0 = ไม่จำกัด
+	Page             int32    `protobuf:"varint,7,opt,name=page,proto3" json:"page,omitempty"`                                                // หน้าที่ต้องการ เริ่มที่ 1 (0 = หน้าแรก)
+	PageSize         int32    `protobuf:"varint,8,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                        // จำนวนต่อหน้า 0 = ไม่แบ่งหน้า
+	Archived         bool     `protobuf:"varint,9,opt,name=archived,proto3" json:"archived,omitempty"`                                        // true = คืนเฉพาะเซตที่เก็บเข้าคลังแล้ว (สำหรับผู้ดูแล)
+}
+
+func (x *GetMenuSetsRequest) Reset() {
+	*x = GetMenuSetsRequest{}
+	mi := &file_menu_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMenuSetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMenuSetsRequest) ProtoMessage() {}
+
+func (x *GetMenuSetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMenuSetsRequest.ProtoReflect.Descriptor instead.
+func (*GetMenuSetsRequest) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetMenuSetsRequest) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+func (x *GetMenuSetsRequest) GetExcludeAllergens() []string {
+	if x != nil {
+		return x.ExcludeAllergens
+	}
+	return nil
+}
+
+func (x *GetMenuSetsRequest) GetDietaryTags() []string {
+	if x != nil {
+		return x.DietaryTags
+	}
+	return nil
+}
+
+func (x *GetMenuSetsRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *GetMenuSetsRequest) GetMinPrice() float64 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *GetMenuSetsRequest) GetMaxPrice() float64 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+func (x *GetMenuSetsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetMenuSetsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetMenuSetsRequest) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+// ---------------- Menu Set Slot ------------------------
+// ช่องเลือกเมนูของเซตเมนู ลูกค้าเลือก picks จานต่อหนึ่งเซต
+// ถ้ามี items ต้องเลือกจาก items ถ้าไม่มี เลือกเมนูใดก็ได้ในหมวดหมู่ category_id (รวมหมวดหมู่ย่อย)
+type MenuSetSlot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                   // ID ของช่อง
+	NameTh     string             `protobuf:"bytes,2,opt,name=name_th,json=nameTh,proto3" json:"name_th,omitempty"`             // เช่น "ของว่าง"
+	NameEn     string             `protobuf:"bytes,3,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`             // เช่น "Appetizer"
+	CategoryId string             `protobuf:"bytes,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // ว่าง = ไม่จำกัดหมวดหมู่
+	Picks      int32              `protobuf:"varint,5,opt,name=picks,proto3" json:"picks,omitempty"`                            // จำนวนจานที่เลือกต่อหนึ่งเซต
+	Items      []*MenuSetSlotItem `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`                             // เมนูที่เลือกได้ เรียงตามลำดับ
+}
+
+func (x *MenuSetSlot) Reset() {
+	*x = MenuSetSlot{}
+	mi := &file_menu_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuSetSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuSetSlot) ProtoMessage() {}
+
+func (x *MenuSetSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuSetSlot.ProtoReflect.Descriptor instead.
+func (*MenuSetSlot) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MenuSetSlot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MenuSetSlot) GetNameTh() string {
+	if x != nil {
+		return x.NameTh
+	}
+	return ""
+}
+
+func (x *MenuSetSlot) GetNameEn() string {
+	if x != nil {
+		return x.NameEn
+	}
+	return ""
+}
+
+func (x *MenuSetSlot) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *MenuSetSlot) GetPicks() int32 {
+	if x != nil {
+		return x.Picks
+	}
+	return 0
+}
+
+func (x *MenuSetSlot) GetItems() []*MenuSetSlotItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type MenuSetSlotItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MenuItemId string  `protobuf:"bytes,1,opt,name=menu_item_id,json=menuItemId,proto3" json:"menu_item_id,omitempty"`
+	NameTh     string  `protobuf:"bytes,2,opt,name=name_th,json=nameTh,proto3" json:"name_th,omitempty"`
+	NameEn     string  `protobuf:"bytes,3,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`
+	Upcharge   float64 `protobuf:"fixed64,4,opt,name=upcharge,proto3" json:"upcharge,omitempty"` // ราคาที่บวกเพิ่มต่อจานที่เลือก (บาท)
+}
+
+func (x *MenuSetSlotItem) Reset() {
+	*x = MenuSetSlotItem{}
+	mi := &file_menu_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuSetSlotItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuSetSlotItem) ProtoMessage() {}
+
+func (x *MenuSetSlotItem) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuSetSlotItem.ProtoReflect.Descriptor instead.
+func (*MenuSetSlotItem) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *MenuSetSlotItem) GetMenuItemId() string {
+	if x != nil {
+		return x.MenuItemId
+	}
+	return ""
+}
+
+func (x *MenuSetSlotItem) GetNameTh() string {
+	if x != nil {
+		return x.NameTh
+	}
+	return ""
+}
+
+func (x *MenuSetSlotItem) GetNameEn() string {
+	if x != nil {
+		return x.NameEn
+	}
+	return ""
+}
+
+func (x *MenuSetSlotItem) GetUpcharge() float64 {
+	if x != nil {
+		return x.Upcharge
+	}
+	return 0
+}
+
+type MenuSetSlotList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slots []*MenuSetSlot `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+}
+
+func (x *MenuSetSlotList) Reset() {
+	*x = MenuSetSlotList{}
+	mi := &file_menu_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuSetSlotList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuSetSlotList) ProtoMessage() {}
+
+func (x *MenuSetSlotList) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuSetSlotList.ProtoReflect.Descriptor instead.
+func (*MenuSetSlotList) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *MenuSetSlotList) GetSlots() []*MenuSetSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+// แทนที่ช่องเลือกทั้งหมดของเซตเมนู ส่งว่าง = ไม่มีช่องเลือก ส่ง id ของช่องเดิมมาเพื่อคง id เดิมไว้
+type SetMenuSetSlotsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // ID ของเซตเมนู
+	Slots []*MenuSetSlot `protobuf:"bytes,2,rep,name=slots,proto3" json:"slots,omitempty"`
+}
+
+func (x *SetMenuSetSlotsRequest) Reset() {
+	*x = SetMenuSetSlotsRequest{}
+	mi := &file_menu_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMenuSetSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMenuSetSlotsRequest) ProtoMessage() {}
+
+func (x *SetMenuSetSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMenuSetSlotsRequest.ProtoReflect.Descriptor instead.
+func (*SetMenuSetSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SetMenuSetSlotsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetMenuSetSlotsRequest) GetSlots() []*MenuSetSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+// ---------------- Menu Schedule ------------------------
+// ช่วงเวลาที่สั่งเมนูหรือเซตเมนูได้ ตามเวลาไทย
+type MenuSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                             // ID ของ schedule
+	DaysOfWeek []int32 `protobuf:"varint,2,rep,packed,name=days_of_week,json=daysOfWeek,proto3" json:"days_of_week,omitempty"` // 0 = อาทิตย์ ... 6 = เสาร์ ว่าง = ทุกวัน
+	StartTime  string  `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`              // HH:MM ว่าง = 00:00
+	EndTime    string  `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                    // HH:MM (ไม่รวม) ว่าง = 24:00
+	ValidFrom  string  `protobuf:"bytes,5,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`              // YYYY-MM-DD ว่าง = ไม่จำกัด
+	ValidUntil string  `protobuf:"bytes,6,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`           // YYYY-MM-DD (รวมวันสุดท้าย) ว่าง = ไม่จำกัด
+}
+
+func (x *MenuSchedule) Reset() {
+	*x = MenuSchedule{}
+	mi := &file_menu_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuSchedule) ProtoMessage() {}
+
+func (x *MenuSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuSchedule.ProtoReflect.Descriptor instead.
+func (*MenuSchedule) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *MenuSchedule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MenuSchedule) GetDaysOfWeek() []int32 {
+	if x != nil {
+		return x.DaysOfWeek
+	}
+	return nil
+}
+
+func (x *MenuSchedule) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *MenuSchedule) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *MenuSchedule) GetValidFrom() string {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return ""
+}
+
+func (x *MenuSchedule) GetValidUntil() string {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return ""
+}
+
+type MenuScheduleList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Schedules []*MenuSchedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+}
+
+func (x *MenuScheduleList) Reset() {
+	*x = MenuScheduleList{}
+	mi := &file_menu_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuScheduleList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuScheduleList) ProtoMessage() {}
+
+func (x *MenuScheduleList) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuScheduleList.ProtoReflect.Descriptor instead.
+func (*MenuScheduleList) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *MenuScheduleList) GetSchedules() []*MenuSchedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+// แทนที่ schedule ทั้งหมดของเมนู/เซตเมนู ส่งว่าง = สั่งได้ตลอด
+type SetMenuSchedulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // ID ของเมนูหรือเซตเมนู
+	Schedules []*MenuSchedule `protobuf:"bytes,2,rep,name=schedules,proto3" json:"schedules,omitempty"`
+}
+
+func (x *SetMenuSchedulesRequest) Reset() {
+	*x = SetMenuSchedulesRequest{}
+	mi := &file_menu_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMenuSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMenuSchedulesRequest) ProtoMessage() {}
+
+func (x *SetMenuSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMenuSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*SetMenuSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SetMenuSchedulesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetMenuSchedulesRequest) GetSchedules() []*MenuSchedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+// ตัวเลือกหนึ่งอย่างในกลุ่ม
+type MenuModifierOption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // ID ของตัวเลือก
+	NameTh     string  `protobuf:"bytes,2,opt,name=name_th,json=nameTh,proto3" json:"name_th,omitempty"`
+	NameEn     string  `protobuf:"bytes,3,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`
+	PriceDelta float64 `protobuf:"fixed64,4,opt,name=price_delta,json=priceDelta,proto3" json:"price_delta,omitempty"` // ราคาที่บวกเพิ่มต่อจาน (บาท) ติดลบได้
+}
+
+func (x *MenuModifierOption) Reset() {
+	*x = MenuModifierOption{}
+	mi := &file_menu_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuModifierOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuModifierOption) ProtoMessage() {}
+
+func (x *MenuModifierOption) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuModifierOption.ProtoReflect.Descriptor instead.
+func (*MenuModifierOption) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *MenuModifierOption) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MenuModifierOption) GetNameTh() string {
+	if x != nil {
+		return x.NameTh
+	}
+	return ""
+}
+
+func (x *MenuModifierOption) GetNameEn() string {
+	if x != nil {
+		return x.NameEn
+	}
+	return ""
+}
+
+func (x *MenuModifierOption) GetPriceDelta() float64 {
+	if x != nil {
+		return x.PriceDelta
+	}
+	return 0
+}
+
+// กลุ่มตัวเลือกของเมนู เช่น ระดับความเผ็ด
+type MenuModifierGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // ID ของกลุ่ม
+	NameTh    string                `protobuf:"bytes,2,opt,name=name_th,json=nameTh,proto3" json:"name_th,omitempty"`
+	NameEn    string                `protobuf:"bytes,3,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`
+	Selection ModifierSelection     `protobuf:"varint,4,opt,name=selection,proto3,enum=services.ModifierSelection" json:"selection,omitempty"`
+	MinSelect int32                 `protobuf:"varint,5,opt,name=min_select,json=minSelect,proto3" json:"min_select,omitempty"` // ต้องเลือกอย่างน้อยกี่อย่าง 0 = ไม่บังคับ
+	MaxSelect int32                 `protobuf:"varint,6,opt,name=max_select,json=maxSelect,proto3" json:"max_select,omitempty"` // เลือกได้มากสุดกี่อย่าง (SINGLE = 1) 0 = เท่าจำนวนตัวเลือก
+	Options   []*MenuModifierOption `protobuf:"bytes,7,rep,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *MenuModifierGroup) Reset() {
+	*x = MenuModifierGroup{}
+	mi := &file_menu_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuModifierGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuModifierGroup) ProtoMessage() {}
+
+func (x *MenuModifierGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuModifierGroup.ProtoReflect.Descriptor instead.
+func (*MenuModifierGroup) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *MenuModifierGroup) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MenuModifierGroup) GetNameTh() string {
+	if x != nil {
+		return x.NameTh
+	}
+	return ""
+}
+
+func (x *MenuModifierGroup) GetNameEn() string {
+	if x != nil {
+		return x.NameEn
+	}
+	return ""
+}
+
+func (x *MenuModifierGroup) GetSelection() ModifierSelection {
+	if x != nil {
+		return x.Selection
+	}
+	return ModifierSelection_MODIFIER_SINGLE
+}
+
+func (x *MenuModifierGroup) GetMinSelect() int32 {
+	if x != nil {
+		return x.MinSelect
+	}
+	return 0
+}
+
+func (x *MenuModifierGroup) GetMaxSelect() int32 {
+	if x != nil {
+		return x.MaxSelect
+	}
+	return 0
+}
+
+func (x *MenuModifierGroup) GetOptions() []*MenuModifierOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type MenuModifierGroupList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groups []*MenuModifierGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *MenuModifierGroupList) Reset() {
+	*x = MenuModifierGroupList{}
+	mi := &file_menu_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuModifierGroupList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuModifierGroupList) ProtoMessage() {}
+
+func (x *MenuModifierGroupList) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuModifierGroupList.ProtoReflect.Descriptor instead.
+func (*MenuModifierGroupList) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *MenuModifierGroupList) GetGroups() []*MenuModifierGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// แทนที่กลุ่มตัวเลือกทั้งหมดของเมนู ส่งว่าง = ไม่มีตัวเลือก
+// ส่ง id ของกลุ่ม/ตัวเลือกเดิมมาเพื่อคง id เดิมไว้
+type SetMenuItemModifiersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // ID ของเมนู
+	Groups []*MenuModifierGroup `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *SetMenuItemModifiersRequest) Reset() {
+	*x = SetMenuItemModifiersRequest{}
+	mi := &file_menu_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMenuItemModifiersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMenuItemModifiersRequest) ProtoMessage() {}
+
+func (x *SetMenuItemModifiersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMenuItemModifiersRequest.ProtoReflect.Descriptor instead.
+func (*SetMenuItemModifiersRequest) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SetMenuItemModifiersRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetMenuItemModifiersRequest) GetGroups() []*MenuModifierGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// ---------------- Menu History ------------------------
+// ข้อมูลเมนูหรือเมนูเซ็ตหนึ่งช่วงเวลา [effective_from, effective_to) เวลาเป็น RFC3339
+type MenuVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	NameTh        string  `protobuf:"bytes,2,opt,name=name_th,json=nameTh,proto3" json:"name_th,omitempty"` // เมนูเซ็ตใช้ name_th เป็นชื่อเซ็ต
+	NameEn        string  `protobuf:"bytes,3,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`
+	Description   string  `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price         float64 `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	CategoryId    string  `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	EffectiveFrom string  `protobuf:"bytes,7,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	EffectiveTo   string  `protobuf:"bytes,8,opt,name=effective_to,json=effectiveTo,proto3" json:"effective_to,omitempty"` // ว่าง = ยังใช้อยู่
+}
+
+func (x *MenuVersion) Reset() {
+	*x = MenuVersion{}
+	mi := &file_menu_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuVersion) ProtoMessage() {}
+
+func (x *MenuVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuVersion.ProtoReflect.Descriptor instead.
+func (*MenuVersion) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *MenuVersion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MenuVersion) GetNameTh() string {
+	if x != nil {
+		return x.NameTh
+	}
+	return ""
+}
+
+func (x *MenuVersion) GetNameEn() string {
+	if x != nil {
+		return x.NameEn
+	}
+	return ""
+}
+
+func (x *MenuVersion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MenuVersion) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *MenuVersion) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *MenuVersion) GetEffectiveFrom() string {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return ""
+}
+
+func (x *MenuVersion) GetEffectiveTo() string {
+	if x != nil {
+		return x.EffectiveTo
+	}
+	return ""
+}
+
+// ราคาหนึ่งช่วงเวลา
+type MenuPricePeriod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Price         float64 `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	EffectiveFrom string  `protobuf:"bytes,3,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	EffectiveTo   string  `protobuf:"bytes,4,opt,name=effective_to,json=effectiveTo,proto3" json:"effective_to,omitempty"` // ว่าง = ไม่มีกำหนดสิ้นสุด
+	Scheduled     bool    `protobuf:"varint,5,opt,name=scheduled,proto3" json:"scheduled,omitempty"`                       // ยังไม่ถึงเวลาที่มีผล (ตั้งล่วงหน้า)
+}
+
+func (x *MenuPricePeriod) Reset() {
+	*x = MenuPricePeriod{}
+	mi := &file_menu_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuPricePeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuPricePeriod) ProtoMessage() {}
+
+func (x *MenuPricePeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuPricePeriod.ProtoReflect.Descriptor instead.
+func (*MenuPricePeriod) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *MenuPricePeriod) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MenuPricePeriod) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *MenuPricePeriod) GetEffectiveFrom() string {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return ""
+}
+
+func (x *MenuPricePeriod) GetEffectiveTo() string {
+	if x != nil {
+		return x.EffectiveTo
+	}
+	return ""
+}
+
+func (x *MenuPricePeriod) GetScheduled() bool {
+	if x != nil {
+		return x.Scheduled
+	}
+	return false
+}
+
+type MenuHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                          // ID ของเมนูหรือเมนูเซ็ต
+	Versions []*MenuVersion     `protobuf:"bytes,2,rep,name=versions,proto3" json:"versions,omitempty"`              // เรียงตาม effective_from
+	Prices   []*MenuPricePeriod `protobuf:"bytes,3,rep,name=prices,proto3" json:"prices,omitempty"`                  // เรียงตาม effective_from รวมราคาที่ตั้งล่วงหน้า
+	PriceAt  *MenuPricePeriod   `protobuf:"bytes,4,opt,name=price_at,json=priceAt,proto3" json:"price_at,omitempty"` // ช่วงราคาที่ครอบเวลา at ของ request (ถ้าระบุ)
+}
+
+func (x *MenuHistory) Reset() {
+	*x = MenuHistory{}
+	mi := &file_menu_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MenuHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuHistory) ProtoMessage() {}
+
+func (x *MenuHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuHistory.ProtoReflect.Descriptor instead.
+func (*MenuHistory) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *MenuHistory) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MenuHistory) GetVersions() []*MenuVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *MenuHistory) GetPrices() []*MenuPricePeriod {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *MenuHistory) GetPriceAt() *MenuPricePeriod {
+	if x != nil {
+		return x.PriceAt
+	}
+	return nil
+}
+
+type GetMenuHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	At string `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"` // RFC3339 หรือ YYYY-MM-DD (00:00 เวลาไทย) ว่าง = ไม่ต้องหาราคา ณ เวลานั้น
+}
+
+func (x *GetMenuHistoryRequest) Reset() {
+	*x = GetMenuHistoryRequest{}
+	mi := &file_menu_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMenuHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMenuHistoryRequest) ProtoMessage() {}
+
+func (x *GetMenuHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMenuHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetMenuHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetMenuHistoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetMenuHistoryRequest) GetAt() string {
+	if x != nil {
+		return x.At
 	}
 	return ""
 }
 
-func (x *MenuSet) GetName() string {
+// ตั้งราคาที่มีผลตั้งแต่ 00:00 เวลาไทยของวันที่ effective_date ถ้าตั้งวันเดิมซ้ำจะแทนที่ราคาเดิม
+type ScheduleMenuPriceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Price         float64 `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	EffectiveDate string  `protobuf:"bytes,3,opt,name=effective_date,json=effectiveDate,proto3" json:"effective_date,omitempty"` // YYYY-MM-DD ต้องเป็นวันในอนาคต
+}
+
+func (x *ScheduleMenuPriceRequest) Reset() {
+	*x = ScheduleMenuPriceRequest{}
+	mi := &file_menu_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleMenuPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMenuPriceRequest) ProtoMessage() {}
+
+func (x *ScheduleMenuPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMenuPriceRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleMenuPriceRequest) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ScheduleMenuPriceRequest) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-func (x *MenuSet) GetPrice() float64 {
+func (x *ScheduleMenuPriceRequest) GetPrice() float64 {
 	if x != nil {
 		return x.Price
 	}
 	return 0
 }
 
-// List Menu Set
-type MenuSetList struct {
+func (x *ScheduleMenuPriceRequest) GetEffectiveDate() string {
+	if x != nil {
+		return x.EffectiveDate
+	}
+	return ""
+}
+
+// ---------------- Menu Search ------------------------
+// ค้นหาเมนูและเซตเมนูจากชื่อไทย ชื่ออังกฤษ และรายละเอียด เรียงตามคะแนนความตรง
+type SearchMenuRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MenuSets []*MenuSet `protobuf:"bytes,1,rep,name=menu_sets,json=menuSets,proto3" json:"menu_sets,omitempty"` // รายการของเซตเมนูทั้งหมด
+	Q                string   `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`                                                       // คำค้น ภาษาไทยหรืออังกฤษ
+	CategoryId       string   `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`                   // เอาเฉพาะในหมวดหมู่นี้ (รวมหมวดหมู่ย่อย)
+	MinPrice         float64  `protobuf:"fixed64,3,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`                       // ราคาต่ำสุด 0 = ไม่จำกัด
+	MaxPrice         float64  `protobuf:"fixed64,4,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`                       // ราคาสูงสุด 0 = ไม่จำกัด
+	ExcludeAllergens []string `protobuf:"bytes,5,rep,name=exclude_allergens,json=excludeAllergens,proto3" json:"exclude_allergens,omitempty"` // ไม่เอาที่มีสารก่อภูมิแพ้เหล่านี้
+	DietaryTags      []string `protobuf:"bytes,6,rep,name=dietary_tags,json=dietaryTags,proto3" json:"dietary_tags,omitempty"`                // เอาเฉพาะที่มีแท็กเหล่านี้ครบ
+	At               string   `protobuf:"bytes,7,opt,name=at,proto3" json:"at,omitempty"`                                                     // RFC3339 ถ้าระบุ คืนเฉพาะที่สั่งได้ในเวลานั้น
+	Page             int32    `protobuf:"varint,8,opt,name=page,proto3" json:"page,omitempty"`                                                // หน้าที่ต้องการ เริ่มที่ 1 (0 = หน้าแรก)
+	PageSize         int32    `protobuf:"varint,9,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                        // จำนวนต่อหน้า 0 = ค่าเริ่มต้น 20
 }
 
-func (x *MenuSetList) Reset() {
-	*x = MenuSetList{}
-	mi := &file_menu_proto_msgTypes[10]
+func (x *SearchMenuRequest) Reset() {
+	*x = SearchMenuRequest{}
+	mi := &file_menu_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MenuSetList) String() string {
+func (x *SearchMenuRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MenuSetList) ProtoMessage() {}
+func (*SearchMenuRequest) ProtoMessage() {}
 
-func (x *MenuSetList) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_proto_msgTypes[10]
+func (x *SearchMenuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -770,43 +3666,160 @@ func (x *MenuSetList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MenuSetList.ProtoReflect.Descriptor instead.
-func (*MenuSetList) Descriptor() ([]byte, []int) {
-	return file_menu_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use SearchMenuRequest.ProtoReflect.Descriptor instead.
+func (*SearchMenuRequest) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *MenuSetList) GetMenuSets() []*MenuSet {
+func (x *SearchMenuRequest) GetQ() string {
 	if x != nil {
-		return x.MenuSets
+		return x.Q
+	}
+	return ""
+}
+
+func (x *SearchMenuRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *SearchMenuRequest) GetMinPrice() float64 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *SearchMenuRequest) GetMaxPrice() float64 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+func (x *SearchMenuRequest) GetExcludeAllergens() []string {
+	if x != nil {
+		return x.ExcludeAllergens
 	}
 	return nil
 }
 
-// Create New Menu Set
-type CreateMenuSetRequest struct {
+func (x *SearchMenuRequest) GetDietaryTags() []string {
+	if x != nil {
+		return x.DietaryTags
+	}
+	return nil
+}
+
+func (x *SearchMenuRequest) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+func (x *SearchMenuRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchMenuRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ผลการค้นหาหนึ่งรายการ มีค่า menu_item หรือ menu_set อย่างใดอย่างหนึ่ง
+type MenuSearchHit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`     // ชื่อของเซตเมนู
-	Price float64 `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"` // ราคาของเซตเมนู
+	Score    float64   `protobuf:"fixed64,1,opt,name=score,proto3" json:"score,omitempty"`                     // คะแนนความตรง 0-1
+	MenuItem *MenuItem `protobuf:"bytes,2,opt,name=menu_item,json=menuItem,proto3" json:"menu_item,omitempty"` // ผลลัพธ์ที่เป็นเมนู
+	MenuSet  *MenuSet  `protobuf:"bytes,3,opt,name=menu_set,json=menuSet,proto3" json:"menu_set,omitempty"`    // ผลลัพธ์ที่เป็นเซตเมนู
 }
 
-func (x *CreateMenuSetRequest) Reset() {
-	*x = CreateMenuSetRequest{}
-	mi := &file_menu_proto_msgTypes[11]
+func (x *MenuSearchHit) Reset() {
+	*x = MenuSearchHit{}
+	mi := &file_menu_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateMenuSetRequest) String() string {
+func (x *MenuSearchHit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMenuSetRequest) ProtoMessage() {}
+func (*MenuSearchHit) ProtoMessage() {}
 
-func (x *CreateMenuSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_proto_msgTypes[11]
+func (x *MenuSearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuSearchHit.ProtoReflect.Descriptor instead.
+func (*MenuSearchHit) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *MenuSearchHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *MenuSearchHit) GetMenuItem() *MenuItem {
+	if x != nil {
+		return x.MenuItem
+	}
+	return nil
+}
+
+func (x *MenuSearchHit) GetMenuSet() *MenuSet {
+	if x != nil {
+		return x.MenuSet
+	}
+	return nil
+}
+
+type SearchMenuResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hits  []*MenuSearchHit `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`    // ผลการค้นหาของหน้านี้
+	Total int32            `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // จำนวนผลลัพธ์ทั้งหมด (ก่อนแบ่งหน้า)
+}
+
+func (x *SearchMenuResponse) Reset() {
+	*x = SearchMenuResponse{}
+	mi := &file_menu_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchMenuResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMenuResponse) ProtoMessage() {}
+
+func (x *SearchMenuResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -814,52 +3827,258 @@ func (x *CreateMenuSetRequest) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMenuResponse.ProtoReflect.Descriptor instead.
+func (*SearchMenuResponse) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SearchMenuResponse) GetHits() []*MenuSearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchMenuResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// ---------------- Menu Import/Export ------------------------
+// หมวดหมู่และเมนูอ้างถึงด้วย name_en เมนูเซ็ตอ้างถึงด้วย name
+type CatalogCategory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NameTh       string `protobuf:"bytes,1,opt,name=name_th,json=nameTh,proto3" json:"name_th,omitempty"`
+	NameEn       string `protobuf:"bytes,2,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`
+	SortOrder    int32  `protobuf:"varint,3,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	ParentNameEn string `protobuf:"bytes,4,opt,name=parent_name_en,json=parentNameEn,proto3" json:"parent_name_en,omitempty"` // name_en ของหมวดหมู่แม่ ว่าง = ระดับบนสุด
+}
+
+func (x *CatalogCategory) Reset() {
+	*x = CatalogCategory{}
+	mi := &file_menu_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogCategory) ProtoMessage() {}
+
+func (x *CatalogCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogCategory.ProtoReflect.Descriptor instead.
+func (*CatalogCategory) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CatalogCategory) GetNameTh() string {
+	if x != nil {
+		return x.NameTh
+	}
+	return ""
+}
+
+func (x *CatalogCategory) GetNameEn() string {
+	if x != nil {
+		return x.NameEn
+	}
+	return ""
+}
+
+func (x *CatalogCategory) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *CatalogCategory) GetParentNameEn() string {
+	if x != nil {
+		return x.ParentNameEn
+	}
+	return ""
+}
+
+type CatalogItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NameTh         string         `protobuf:"bytes,1,opt,name=name_th,json=nameTh,proto3" json:"name_th,omitempty"`
+	NameEn         string         `protobuf:"bytes,2,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`
+	Description    string         `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Price          float64        `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	CategoryNameEn string         `protobuf:"bytes,5,opt,name=category_name_en,json=categoryNameEn,proto3" json:"category_name_en,omitempty"` // name_en ของหมวดหมู่
+	IsAvailable    bool           `protobuf:"varint,6,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
+	DailyLimit     int32          `protobuf:"varint,7,opt,name=daily_limit,json=dailyLimit,proto3" json:"daily_limit,omitempty"`
+	Allergens      []string       `protobuf:"bytes,8,rep,name=allergens,proto3" json:"allergens,omitempty"`
+	DietaryTags    []string       `protobuf:"bytes,9,rep,name=dietary_tags,json=dietaryTags,proto3" json:"dietary_tags,omitempty"`
+	Archived       bool           `protobuf:"varint,10,opt,name=archived,proto3" json:"archived,omitempty"`                   // เก็บเข้าคลังแล้ว
+	Image          *ImageVariants `protobuf:"bytes,11,opt,name=image,proto3" json:"image,omitempty"`                          // รูปในที่เก็บรูปภาพของร้าน ว่าง = ไม่เปลี่ยนรูปเดิม
+	ImageFile      string         `protobuf:"bytes,12,opt,name=image_file,json=imageFile,proto3" json:"image_file,omitempty"` // ชื่อไฟล์รูปในไฟล์ zip ที่นำออก/นำเข้า (gateway อัปโหลดให้ก่อนเรียก ImportMenu)
+}
+
+func (x *CatalogItem) Reset() {
+	*x = CatalogItem{}
+	mi := &file_menu_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogItem) ProtoMessage() {}
+
+func (x *CatalogItem) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogItem.ProtoReflect.Descriptor instead.
+func (*CatalogItem) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CatalogItem) GetNameTh() string {
+	if x != nil {
+		return x.NameTh
+	}
+	return ""
+}
+
+func (x *CatalogItem) GetNameEn() string {
+	if x != nil {
+		return x.NameEn
+	}
+	return ""
+}
+
+func (x *CatalogItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CatalogItem) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CatalogItem) GetCategoryNameEn() string {
+	if x != nil {
+		return x.CategoryNameEn
+	}
+	return ""
+}
+
+func (x *CatalogItem) GetIsAvailable() bool {
+	if x != nil {
+		return x.IsAvailable
+	}
+	return false
+}
+
+func (x *CatalogItem) GetDailyLimit() int32 {
+	if x != nil {
+		return x.DailyLimit
+	}
+	return 0
+}
+
+func (x *CatalogItem) GetAllergens() []string {
+	if x != nil {
+		return x.Allergens
+	}
+	return nil
+}
+
+func (x *CatalogItem) GetDietaryTags() []string {
+	if x != nil {
+		return x.DietaryTags
+	}
+	return nil
 }
 
-// Deprecated: Use CreateMenuSetRequest.ProtoReflect.Descriptor instead.
-func (*CreateMenuSetRequest) Descriptor() ([]byte, []int) {
-	return file_menu_proto_rawDescGZIP(), []int{11}
+func (x *CatalogItem) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
 }
 
-func (x *CreateMenuSetRequest) GetName() string {
+func (x *CatalogItem) GetImage() *ImageVariants {
 	if x != nil {
-		return x.Name
+		return x.Image
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateMenuSetRequest) GetPrice() float64 {
+func (x *CatalogItem) GetImageFile() string {
 	if x != nil {
-		return x.Price
+		return x.ImageFile
 	}
-	return 0
+	return ""
 }
 
-type CreateMenuSetResponse struct {
+type CatalogSet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                               // ID ของเซตเมนูที่ถูกสร้าง
-	Status Status `protobuf:"varint,2,opt,name=status,proto3,enum=services.Status" json:"status,omitempty"` // สถานะของการสร้างเซตเมนู (สำเร็จ/ล้มเหลว)
+	Name        string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Price       float64  `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	ItemNamesEn []string `protobuf:"bytes,3,rep,name=item_names_en,json=itemNamesEn,proto3" json:"item_names_en,omitempty"` // เมนูในเซต
+	Archived    bool     `protobuf:"varint,4,opt,name=archived,proto3" json:"archived,omitempty"`
 }
 
-func (x *CreateMenuSetResponse) Reset() {
-	*x = CreateMenuSetResponse{}
-	mi := &file_menu_proto_msgTypes[12]
+func (x *CatalogSet) Reset() {
+	*x = CatalogSet{}
+	mi := &file_menu_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateMenuSetResponse) String() string {
+func (x *CatalogSet) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateMenuSetResponse) ProtoMessage() {}
+func (*CatalogSet) ProtoMessage() {}
 
-func (x *CreateMenuSetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_proto_msgTypes[12]
+func (x *CatalogSet) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -870,51 +4089,65 @@ func (x *CreateMenuSetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateMenuSetResponse.ProtoReflect.Descriptor instead.
-func (*CreateMenuSetResponse) Descriptor() ([]byte, []int) {
-	return file_menu_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use CatalogSet.ProtoReflect.Descriptor instead.
+func (*CatalogSet) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *CreateMenuSetResponse) GetId() string {
+func (x *CatalogSet) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateMenuSetResponse) GetStatus() Status {
+func (x *CatalogSet) GetPrice() float64 {
 	if x != nil {
-		return x.Status
+		return x.Price
 	}
-	return Status_SUCCESS
+	return 0
 }
 
-// Update Menu Set
-type UpdateMenuSetRequest struct {
+func (x *CatalogSet) GetItemNamesEn() []string {
+	if x != nil {
+		return x.ItemNamesEn
+	}
+	return nil
+}
+
+func (x *CatalogSet) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+// เมนูทั้งร้าน รวมที่เก็บเข้าคลังแล้ว (ไม่รวมแกลเลอรี ช่วงเวลาขาย ตัวเลือก และช่องเลือกของเซต)
+type MenuCatalog struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`         // ID ของเซตเมนูที่ต้องการอัปเดต
-	Name  string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`     // ชื่อของเซตเมนู
-	Price float64 `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"` // ราคาของเซตเมนู
+	Categories []*CatalogCategory `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	Items      []*CatalogItem     `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Sets       []*CatalogSet      `protobuf:"bytes,3,rep,name=sets,proto3" json:"sets,omitempty"`
 }
 
-func (x *UpdateMenuSetRequest) Reset() {
-	*x = UpdateMenuSetRequest{}
-	mi := &file_menu_proto_msgTypes[13]
+func (x *MenuCatalog) Reset() {
+	*x = MenuCatalog{}
+	mi := &file_menu_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMenuSetRequest) String() string {
+func (x *MenuCatalog) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMenuSetRequest) ProtoMessage() {}
+func (*MenuCatalog) ProtoMessage() {}
 
-func (x *UpdateMenuSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_proto_msgTypes[13]
+func (x *MenuCatalog) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -925,55 +4158,57 @@ func (x *UpdateMenuSetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMenuSetRequest.ProtoReflect.Descriptor instead.
-func (*UpdateMenuSetRequest) Descriptor() ([]byte, []int) {
-	return file_menu_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use MenuCatalog.ProtoReflect.Descriptor instead.
+func (*MenuCatalog) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *UpdateMenuSetRequest) GetId() string {
+func (x *MenuCatalog) GetCategories() []*CatalogCategory {
 	if x != nil {
-		return x.Id
+		return x.Categories
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateMenuSetRequest) GetName() string {
+func (x *MenuCatalog) GetItems() []*CatalogItem {
 	if x != nil {
-		return x.Name
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateMenuSetRequest) GetPrice() float64 {
+func (x *MenuCatalog) GetSets() []*CatalogSet {
 	if x != nil {
-		return x.Price
+		return x.Sets
 	}
-	return 0
+	return nil
 }
 
-type UpdateMenuSetResponse struct {
+// เพิ่มหรือแก้ตามชื่อในหนึ่ง transaction รายการที่ไม่อยู่ใน catalog ไม่ถูกแตะ
+type ImportMenuRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status Status `protobuf:"varint,1,opt,name=status,proto3,enum=services.Status" json:"status,omitempty"` // สถานะการอัปเดต (สำเร็จ/ล้มเหลว)
+	Catalog *MenuCatalog `protobuf:"bytes,1,opt,name=catalog,proto3" json:"catalog,omitempty"`
+	DryRun  bool         `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // true = ดูผลการเปลี่ยนแปลงโดยไม่บันทึก
 }
 
-func (x *UpdateMenuSetResponse) Reset() {
-	*x = UpdateMenuSetResponse{}
-	mi := &file_menu_proto_msgTypes[14]
+func (x *ImportMenuRequest) Reset() {
+	*x = ImportMenuRequest{}
+	mi := &file_menu_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateMenuSetResponse) String() string {
+func (x *ImportMenuRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateMenuSetResponse) ProtoMessage() {}
+func (*ImportMenuRequest) ProtoMessage() {}
 
-func (x *UpdateMenuSetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_proto_msgTypes[14]
+func (x *ImportMenuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -984,42 +4219,51 @@ func (x *UpdateMenuSetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateMenuSetResponse.ProtoReflect.Descriptor instead.
-func (*UpdateMenuSetResponse) Descriptor() ([]byte, []int) {
-	return file_menu_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ImportMenuRequest.ProtoReflect.Descriptor instead.
+func (*ImportMenuRequest) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *UpdateMenuSetResponse) GetStatus() Status {
+func (x *ImportMenuRequest) GetCatalog() *MenuCatalog {
 	if x != nil {
-		return x.Status
+		return x.Catalog
 	}
-	return Status_SUCCESS
+	return nil
 }
 
-// Delete Menu Set
-type DeleteMenuSetRequest struct {
+func (x *ImportMenuRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type MenuImportChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // ID ของเซตเมนูที่ต้องการลบ
+	Kind   string   `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`     // CATEGORY, ITEM, SET
+	Name   string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`     // name_en (เซตใช้ name)
+	Action string   `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"` // CREATE, UPDATE, UNCHANGED
+	Fields []string `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"` // ฟิลด์ที่เปลี่ยน (เฉพาะ UPDATE)
 }
 
-func (x *DeleteMenuSetRequest) Reset() {
-	*x = DeleteMenuSetRequest{}
-	mi := &file_menu_proto_msgTypes[15]
+func (x *MenuImportChange) Reset() {
+	*x = MenuImportChange{}
+	mi := &file_menu_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMenuSetRequest) String() string {
+func (x *MenuImportChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMenuSetRequest) ProtoMessage() {}
+func (*MenuImportChange) ProtoMessage() {}
 
-func (x *DeleteMenuSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_proto_msgTypes[15]
+func (x *MenuImportChange) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1030,41 +4274,66 @@ func (x *DeleteMenuSetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMenuSetRequest.ProtoReflect.Descriptor instead.
-func (*DeleteMenuSetRequest) Descriptor() ([]byte, []int) {
-	return file_menu_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use MenuImportChange.ProtoReflect.Descriptor instead.
+func (*MenuImportChange) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *DeleteMenuSetRequest) GetId() string {
+func (x *MenuImportChange) GetKind() string {
 	if x != nil {
-		return x.Id
+		return x.Kind
 	}
 	return ""
 }
 
-type DeleteMenuSetResponse struct {
+func (x *MenuImportChange) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MenuImportChange) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *MenuImportChange) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type ImportMenuResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status Status `protobuf:"varint,1,opt,name=status,proto3,enum=services.Status" json:"status,omitempty"` // สถานะการลบเซตเมนู (สำเร็จ/ล้มเหลว)
+	DryRun    bool                `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Changes   []*MenuImportChange `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"` // หมวดหมู่ เมนู แล้วเซต ตามลำดับในไฟล์
+	Created   int32               `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	Updated   int32               `protobuf:"varint,4,opt,name=updated,proto3" json:"updated,omitempty"`
+	Unchanged int32               `protobuf:"varint,5,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
 }
 
-func (x *DeleteMenuSetResponse) Reset() {
-	*x = DeleteMenuSetResponse{}
-	mi := &file_menu_proto_msgTypes[16]
+func (x *ImportMenuResponse) Reset() {
+	*x = ImportMenuResponse{}
+	mi := &file_menu_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteMenuSetResponse) String() string {
+func (x *ImportMenuResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMenuSetResponse) ProtoMessage() {}
+func (*ImportMenuResponse) ProtoMessage() {}
 
-func (x *DeleteMenuSetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_proto_msgTypes[16]
+func (x *ImportMenuResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1075,61 +4344,44 @@ func (x *DeleteMenuSetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMenuSetResponse.ProtoReflect.Descriptor instead.
-func (*DeleteMenuSetResponse) Descriptor() ([]byte, []int) {
-	return file_menu_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ImportMenuResponse.ProtoReflect.Descriptor instead.
+func (*ImportMenuResponse) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *DeleteMenuSetResponse) GetStatus() Status {
+func (x *ImportMenuResponse) GetDryRun() bool {
 	if x != nil {
-		return x.Status
+		return x.DryRun
 	}
-	return Status_SUCCESS
-}
-
-type GetMenuSetByIdRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // ID
-}
-
-func (x *GetMenuSetByIdRequest) Reset() {
-	*x = GetMenuSetByIdRequest{}
-	mi := &file_menu_proto_msgTypes[17]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return false
 }
 
-func (x *GetMenuSetByIdRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ImportMenuResponse) GetChanges() []*MenuImportChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
 }
 
-func (*GetMenuSetByIdRequest) ProtoMessage() {}
-
-func (x *GetMenuSetByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_proto_msgTypes[17]
+func (x *ImportMenuResponse) GetCreated() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Created
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetMenuSetByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetMenuSetByIdRequest) Descriptor() ([]byte, []int) {
-	return file_menu_proto_rawDescGZIP(), []int{17}
+func (x *ImportMenuResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
 }
 
-func (x *GetMenuSetByIdRequest) GetId() string {
+func (x *ImportMenuResponse) GetUnchanged() int32 {
 	if x != nil {
-		return x.Id
+		return x.Unchanged
 	}
-	return ""
+	return 0
 }
 
 // ---------------- Menu Set Item ------------------------
@@ -1145,7 +4397,7 @@ type CreateMenuSetItemRequest struct {
 
 func (x *CreateMenuSetItemRequest) Reset() {
 	*x = CreateMenuSetItemRequest{}
-	mi := &file_menu_proto_msgTypes[18]
+	mi := &file_menu_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1157,7 +4409,7 @@ func (x *CreateMenuSetItemRequest) String() string {
 func (*CreateMenuSetItemRequest) ProtoMessage() {}
 
 func (x *CreateMenuSetItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_proto_msgTypes[18]
+	mi := &file_menu_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1170,7 +4422,7 @@ func (x *CreateMenuSetItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateMenuSetItemRequest.ProtoReflect.Descriptor instead.
 func (*CreateMenuSetItemRequest) Descriptor() ([]byte, []int) {
-	return file_menu_proto_rawDescGZIP(), []int{18}
+	return file_menu_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *CreateMenuSetItemRequest) GetMenuSetId() string {
@@ -1197,7 +4449,7 @@ type CreateMenuSetItemResponse struct {
 
 func (x *CreateMenuSetItemResponse) Reset() {
 	*x = CreateMenuSetItemResponse{}
-	mi := &file_menu_proto_msgTypes[19]
+	mi := &file_menu_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1209,7 +4461,7 @@ func (x *CreateMenuSetItemResponse) String() string {
 func (*CreateMenuSetItemResponse) ProtoMessage() {}
 
 func (x *CreateMenuSetItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_proto_msgTypes[19]
+	mi := &file_menu_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1222,7 +4474,7 @@ func (x *CreateMenuSetItemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateMenuSetItemResponse.ProtoReflect.Descriptor instead.
 func (*CreateMenuSetItemResponse) Descriptor() ([]byte, []int) {
-	return file_menu_proto_rawDescGZIP(), []int{19}
+	return file_menu_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *CreateMenuSetItemResponse) GetStatus() Status {
@@ -1243,7 +4495,7 @@ type GetMenuSetItemByIdRequest struct {
 
 func (x *GetMenuSetItemByIdRequest) Reset() {
 	*x = GetMenuSetItemByIdRequest{}
-	mi := &file_menu_proto_msgTypes[20]
+	mi := &file_menu_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1255,7 +4507,7 @@ func (x *GetMenuSetItemByIdRequest) String() string {
 func (*GetMenuSetItemByIdRequest) ProtoMessage() {}
 
 func (x *GetMenuSetItemByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_proto_msgTypes[20]
+	mi := &file_menu_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1268,7 +4520,7 @@ func (x *GetMenuSetItemByIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMenuSetItemByIdRequest.ProtoReflect.Descriptor instead.
 func (*GetMenuSetItemByIdRequest) Descriptor() ([]byte, []int) {
-	return file_menu_proto_rawDescGZIP(), []int{20}
+	return file_menu_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *GetMenuSetItemByIdRequest) GetMenuSetId() string {
@@ -1288,7 +4540,7 @@ type MenuSetItemList struct {
 
 func (x *MenuSetItemList) Reset() {
 	*x = MenuSetItemList{}
-	mi := &file_menu_proto_msgTypes[21]
+	mi := &file_menu_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1300,7 +4552,7 @@ func (x *MenuSetItemList) String() string {
 func (*MenuSetItemList) ProtoMessage() {}
 
 func (x *MenuSetItemList) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_proto_msgTypes[21]
+	mi := &file_menu_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1313,7 +4565,7 @@ func (x *MenuSetItemList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MenuSetItemList.ProtoReflect.Descriptor instead.
 func (*MenuSetItemList) Descriptor() ([]byte, []int) {
-	return file_menu_proto_rawDescGZIP(), []int{21}
+	return file_menu_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *MenuSetItemList) GetMenuSetItems() []*MenuSetItem {
@@ -1335,7 +4587,7 @@ type UpdateMenuSetItemRequest struct {
 
 func (x *UpdateMenuSetItemRequest) Reset() {
 	*x = UpdateMenuSetItemRequest{}
-	mi := &file_menu_proto_msgTypes[22]
+	mi := &file_menu_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1347,7 +4599,7 @@ func (x *UpdateMenuSetItemRequest) String() string {
 func (*UpdateMenuSetItemRequest) ProtoMessage() {}
 
 func (x *UpdateMenuSetItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_proto_msgTypes[22]
+	mi := &file_menu_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1360,7 +4612,7 @@ func (x *UpdateMenuSetItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMenuSetItemRequest.ProtoReflect.Descriptor instead.
 func (*UpdateMenuSetItemRequest) Descriptor() ([]byte, []int) {
-	return file_menu_proto_rawDescGZIP(), []int{22}
+	return file_menu_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *UpdateMenuSetItemRequest) GetMenuSetId() string {
@@ -1387,7 +4639,7 @@ type UpdateMenuSetItemResponse struct {
 
 func (x *UpdateMenuSetItemResponse) Reset() {
 	*x = UpdateMenuSetItemResponse{}
-	mi := &file_menu_proto_msgTypes[23]
+	mi := &file_menu_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1399,7 +4651,7 @@ func (x *UpdateMenuSetItemResponse) String() string {
 func (*UpdateMenuSetItemResponse) ProtoMessage() {}
 
 func (x *UpdateMenuSetItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_proto_msgTypes[23]
+	mi := &file_menu_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1412,7 +4664,7 @@ func (x *UpdateMenuSetItemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMenuSetItemResponse.ProtoReflect.Descriptor instead.
 func (*UpdateMenuSetItemResponse) Descriptor() ([]byte, []int) {
-	return file_menu_proto_rawDescGZIP(), []int{23}
+	return file_menu_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *UpdateMenuSetItemResponse) GetStatus() Status {
@@ -1433,7 +4685,7 @@ type DeleteMenuSetItemRequest struct {
 
 func (x *DeleteMenuSetItemRequest) Reset() {
 	*x = DeleteMenuSetItemRequest{}
-	mi := &file_menu_proto_msgTypes[24]
+	mi := &file_menu_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1445,7 +4697,7 @@ func (x *DeleteMenuSetItemRequest) String() string {
 func (*DeleteMenuSetItemRequest) ProtoMessage() {}
 
 func (x *DeleteMenuSetItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_proto_msgTypes[24]
+	mi := &file_menu_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1458,7 +4710,7 @@ func (x *DeleteMenuSetItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMenuSetItemRequest.ProtoReflect.Descriptor instead.
 func (*DeleteMenuSetItemRequest) Descriptor() ([]byte, []int) {
-	return file_menu_proto_rawDescGZIP(), []int{24}
+	return file_menu_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *DeleteMenuSetItemRequest) GetMenuSetId() string {
@@ -1478,7 +4730,7 @@ type DeleteMenuSetItemResponse struct {
 
 func (x *DeleteMenuSetItemResponse) Reset() {
 	*x = DeleteMenuSetItemResponse{}
-	mi := &file_menu_proto_msgTypes[25]
+	mi := &file_menu_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1490,7 +4742,7 @@ func (x *DeleteMenuSetItemResponse) String() string {
 func (*DeleteMenuSetItemResponse) ProtoMessage() {}
 
 func (x *DeleteMenuSetItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_proto_msgTypes[25]
+	mi := &file_menu_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1503,7 +4755,7 @@ func (x *DeleteMenuSetItemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMenuSetItemResponse.ProtoReflect.Descriptor instead.
 func (*DeleteMenuSetItemResponse) Descriptor() ([]byte, []int) {
-	return file_menu_proto_rawDescGZIP(), []int{25}
+	return file_menu_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *DeleteMenuSetItemResponse) GetStatus() Status {
@@ -1525,13 +4777,13 @@ type MenuSetItem struct {
 	MenuNameTh   string  `protobuf:"bytes,5,opt,name=menu_name_th,json=menuNameTh,proto3" json:"menu_name_th,omitempty"`         // ชื่อเมนู (ภาษาไทย)
 	MenuNameEn   string  `protobuf:"bytes,6,opt,name=menu_name_en,json=menuNameEn,proto3" json:"menu_name_en,omitempty"`         // ชื่อเมนู (ภาษาอังกฤษ)
 	MenuPrice    float32 `protobuf:"fixed32,7,opt,name=menu_price,json=menuPrice,proto3" json:"menu_price,omitempty"`            // ราคาของเมนู
-	MenuCategory string  `protobuf:"bytes,8,opt,name=menu_category,json=menuCategory,proto3" json:"menu_category,omitempty"`     // หมวดหมู่ของเมนู (เช่น MAIN_COURSE, BEVERAGE, DESSERT)
+	MenuCategory string  `protobuf:"bytes,8,opt,name=menu_category,json=menuCategory,proto3" json:"menu_category,omitempty"`     // ชื่อหมวดหมู่ของเมนูภาษาอังกฤษ (เช่น Main Course, Beverage)
 	ImageUrl     string  `protobuf:"bytes,9,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`                 // URL ของภาพเมนู
 }
 
 func (x *MenuSetItem) Reset() {
 	*x = MenuSetItem{}
-	mi := &file_menu_proto_msgTypes[26]
+	mi := &file_menu_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1543,7 +4795,7 @@ func (x *MenuSetItem) String() string {
 func (*MenuSetItem) ProtoMessage() {}
 
 func (x *MenuSetItem) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_proto_msgTypes[26]
+	mi := &file_menu_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1556,7 +4808,7 @@ func (x *MenuSetItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MenuSetItem.ProtoReflect.Descriptor instead.
 func (*MenuSetItem) Descriptor() ([]byte, []int) {
-	return file_menu_proto_rawDescGZIP(), []int{26}
+	return file_menu_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *MenuSetItem) GetMenuSetId() string {
@@ -1634,7 +4886,7 @@ type UploadImageRequest struct {
 
 func (x *UploadImageRequest) Reset() {
 	*x = UploadImageRequest{}
-	mi := &file_menu_proto_msgTypes[27]
+	mi := &file_menu_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1646,7 +4898,7 @@ func (x *UploadImageRequest) String() string {
 func (*UploadImageRequest) ProtoMessage() {}
 
 func (x *UploadImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_proto_msgTypes[27]
+	mi := &file_menu_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1659,7 +4911,7 @@ func (x *UploadImageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadImageRequest.ProtoReflect.Descriptor instead.
 func (*UploadImageRequest) Descriptor() ([]byte, []int) {
-	return file_menu_proto_rawDescGZIP(), []int{27}
+	return file_menu_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *UploadImageRequest) GetImageData() []byte {
@@ -1681,13 +4933,14 @@ type UploadImageResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ImageUrl string `protobuf:"bytes,1,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`   // URL ของรูปภาพที่อัปโหลดสำเร็จ
-	Status   Status `protobuf:"varint,2,opt,name=status,proto3,enum=services.Status" json:"status,omitempty"` // สถานะ (สำเร็จ/ล้มเหลว)
+	ImageUrl      string         `protobuf:"bytes,1,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`                // URL ของรูปภาพที่อัปโหลดสำเร็จ (ขนาด full)
+	Status        Status         `protobuf:"varint,2,opt,name=status,proto3,enum=services.Status" json:"status,omitempty"`              // สถานะ (สำเร็จ/ล้มเหลว)
+	ImageVariants *ImageVariants `protobuf:"bytes,3,opt,name=image_variants,json=imageVariants,proto3" json:"image_variants,omitempty"` // URL รูปภาพแต่ละขนาด
 }
 
 func (x *UploadImageResponse) Reset() {
 	*x = UploadImageResponse{}
-	mi := &file_menu_proto_msgTypes[28]
+	mi := &file_menu_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1699,7 +4952,7 @@ func (x *UploadImageResponse) String() string {
 func (*UploadImageResponse) ProtoMessage() {}
 
 func (x *UploadImageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_proto_msgTypes[28]
+	mi := &file_menu_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1712,7 +4965,7 @@ func (x *UploadImageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadImageResponse.ProtoReflect.Descriptor instead.
 func (*UploadImageResponse) Descriptor() ([]byte, []int) {
-	return file_menu_proto_rawDescGZIP(), []int{28}
+	return file_menu_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *UploadImageResponse) GetImageUrl() string {
@@ -1729,18 +4982,25 @@ func (x *UploadImageResponse) GetStatus() Status {
 	return Status_SUCCESS
 }
 
+func (x *UploadImageResponse) GetImageVariants() *ImageVariants {
+	if x != nil {
+		return x.ImageVariants
+	}
+	return nil
+}
+
 // Delete Img
 type DeleteImageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ImageUrl string `protobuf:"bytes,1,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"` // URL ของรูปภาพที่ต้องการลบ
+	ImageUrl string `protobuf:"bytes,1,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"` // URL ของรูปภาพที่ต้องการลบ (ขนาดใดก็ได้ จะลบทุกขนาด)
 }
 
 func (x *DeleteImageRequest) Reset() {
 	*x = DeleteImageRequest{}
-	mi := &file_menu_proto_msgTypes[29]
+	mi := &file_menu_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1752,7 +5012,7 @@ func (x *DeleteImageRequest) String() string {
 func (*DeleteImageRequest) ProtoMessage() {}
 
 func (x *DeleteImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_proto_msgTypes[29]
+	mi := &file_menu_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1765,7 +5025,7 @@ func (x *DeleteImageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteImageRequest.ProtoReflect.Descriptor instead.
 func (*DeleteImageRequest) Descriptor() ([]byte, []int) {
-	return file_menu_proto_rawDescGZIP(), []int{29}
+	return file_menu_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *DeleteImageRequest) GetImageUrl() string {
@@ -1785,7 +5045,7 @@ type DeleteImageResponse struct {
 
 func (x *DeleteImageResponse) Reset() {
 	*x = DeleteImageResponse{}
-	mi := &file_menu_proto_msgTypes[30]
+	mi := &file_menu_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1797,7 +5057,7 @@ func (x *DeleteImageResponse) String() string {
 func (*DeleteImageResponse) ProtoMessage() {}
 
 func (x *DeleteImageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_menu_proto_msgTypes[30]
+	mi := &file_menu_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1810,7 +5070,7 @@ func (x *DeleteImageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteImageResponse.ProtoReflect.Descriptor instead.
 func (*DeleteImageResponse) Descriptor() ([]byte, []int) {
-	return file_menu_proto_rawDescGZIP(), []int{30}
+	return file_menu_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *DeleteImageResponse) GetStatus() Status {
@@ -1826,7 +5086,7 @@ var file_menu_proto_rawDesc = []byte{
 	0x0a, 0x0a, 0x6d, 0x65, 0x6e, 0x75, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x73, 0x65,
 	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xd5, 0x01, 0x0a, 0x08, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d,
+	0x6f, 0x74, 0x6f, 0x22, 0xb8, 0x05, 0x0a, 0x08, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d,
 	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
 	0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d,
@@ -1834,270 +5094,841 @@ var file_menu_proto_rawDesc = []byte{
 	0x45, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
 	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
 	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x63, 0x61,
-	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x43, 0x61, 0x74, 0x65,
-	0x67, 0x6f, 0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1b,
-	0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x22, 0x41, 0x0a, 0x0c, 0x4d,
-	0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0a, 0x6d,
-	0x65, 0x6e, 0x75, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x49,
-	0x74, 0x65, 0x6d, 0x52, 0x09, 0x6d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xd4,
-	0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65,
-	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65,
-	0x5f, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x54,
-	0x68, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x4d, 0x65, 0x6e, 0x75, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x08, 0x63, 0x61,
-	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x52, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d,
-	0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xe4, 0x01, 0x0a, 0x15, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x68, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x68, 0x12, 0x17, 0x0a, 0x07,
-	0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e,
-	0x61, 0x6d, 0x65, 0x45, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x32, 0x0a,
-	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x3e, 0x0a, 0x0e, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x0d, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x69, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64,
+	0x61, 0x69, 0x6c, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x27, 0x0a, 0x0f,
+	0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x34, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x65,
+	0x74, 0x61, 0x72, 0x79, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x32, 0x0a,
+	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x43,
 	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
-	0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61,
-	0x22, 0x42, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74,
-	0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x22, 0x27, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65,
-	0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x42, 0x0a,
-	0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x79, 0x12, 0x44, 0x0a, 0x0f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0e, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x22, 0x6a,
+	0x0a, 0x0d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69,
+	0x6c, 0x55, 0x72, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x61, 0x72, 0x64, 0x55, 0x72, 0x6c, 0x12,
+	0x19, 0x0a, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x66, 0x75, 0x6c, 0x6c, 0x55, 0x72, 0x6c, 0x22, 0x57, 0x0a, 0x0c, 0x4d, 0x65,
+	0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0a, 0x6d, 0x65,
+	0x6e, 0x75, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x09, 0x6d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x22, 0xc7, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65,
+	0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6e, 0x61, 0x6d, 0x65, 0x54, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x22, 0x52, 0x0a,
+	0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
 	0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0x28, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d,
-	0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x43, 0x0a, 0x07, 0x4d,
-	0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x22, 0x3d, 0x0a, 0x0b, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12,
-	0x2e, 0x0a, 0x09, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65,
-	0x6e, 0x75, 0x53, 0x65, 0x74, 0x52, 0x08, 0x6d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x73, 0x22,
-	0x40, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x73, 0x22, 0xd7, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6e,
+	0x61, 0x6d, 0x65, 0x5f, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x61,
+	0x6d, 0x65, 0x54, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x49, 0x64, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x22, 0x42, 0x0a, 0x16, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x27, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x42, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x28, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x79, 0x49, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xf3, 0x02, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x65,
+	0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40,
+	0x0a, 0x0c, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x0c, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x61, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
+	0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x41, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x74, 0x61, 0x67,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79,
+	0x54, 0x61, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x22, 0x24, 0x0a, 0x12,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x69, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65,
+	0x6d, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69,
+	0x65, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x54, 0x61, 0x67, 0x73, 0x22, 0x74, 0x0a,
+	0x1e, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x41, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x0c, 0x4d, 0x65, 0x6e, 0x75, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x68, 0x12, 0x17, 0x0a,
+	0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x22, 0x4a, 0x0a, 0x10, 0x4d, 0x65, 0x6e, 0x75, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x89,
+	0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e,
+	0x61, 0x6d, 0x65, 0x54, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x99, 0x01, 0x0a, 0x19, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65,
+	0x5f, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x54,
+	0x68, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f,
+	0x72, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x2b, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4d, 0x65, 0x6e, 0x75, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x46, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e,
+	0x75, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2c, 0x0a, 0x1a, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x42, 0x79,
+	0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xe8, 0x01, 0x0a, 0x0d, 0x4d, 0x65,
+	0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x6d,
+	0x65, 0x6e, 0x75, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x6c, 0x74,
+	0x5f, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x74, 0x54, 0x68,
+	0x12, 0x15, 0x0a, 0x06, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x61, 0x6c, 0x74, 0x45, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x55, 0x72, 0x6c, 0x12, 0x3e, 0x0a, 0x0e, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x76, 0x61,
+	0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x74, 0x73, 0x52, 0x0d, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x56, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x73, 0x22, 0x44, 0x0a, 0x11, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x17, 0x41,
+	0x64, 0x64, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x69,
+	0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65,
+	0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x6c, 0x74, 0x5f, 0x74,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x74, 0x54, 0x68, 0x12, 0x15,
+	0x0a, 0x06, 0x61, 0x6c, 0x74, 0x5f, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x61, 0x6c, 0x74, 0x45, 0x6e, 0x22, 0x5d, 0x0a, 0x1c, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x69, 0x74,
+	0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x6e,
+	0x75, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x49, 0x64, 0x73, 0x22, 0x59, 0x0a, 0x1a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65,
+	0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x6e, 0x75, 0x49, 0x74,
+	0x65, 0x6d, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22,
+	0x47, 0x0a, 0x1b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65,
+	0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x88, 0x02, 0x0a, 0x07, 0x4d, 0x65, 0x6e,
+	0x75, 0x53, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x34,
+	0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e,
+	0x75, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65,
+	0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x74, 0x61,
+	0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x65, 0x74, 0x61, 0x72,
+	0x79, 0x54, 0x61, 0x67, 0x73, 0x12, 0x2b, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x73, 0x6c, 0x6f,
+	0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x53, 0x0a, 0x0b, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x2e, 0x0a, 0x09, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x73, 0x65, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x52, 0x08, 0x6d, 0x65, 0x6e, 0x75, 0x53, 0x65,
+	0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x40, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x51, 0x0a, 0x15, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x50, 0x0a,
+	0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22,
+	0x41, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75,
+	0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x41, 0x0a, 0x15, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x27, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x9c, 0x02, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x65,
+	0x6e, 0x75, 0x53, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x61, 0x74, 0x12, 0x2b, 0x0a,
+	0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x41, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69,
+	0x65, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x64, 0x22, 0xb7, 0x01, 0x0a, 0x0b, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65,
+	0x74, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x68, 0x12, 0x17,
+	0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x69, 0x63, 0x6b,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x2f,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74,
+	0x53, 0x6c, 0x6f, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22,
+	0x81, 0x01, 0x0a, 0x0f, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x69, 0x74, 0x65, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x6e, 0x75, 0x49,
+	0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x68, 0x12, 0x17,
+	0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x70, 0x63, 0x68, 0x61,
+	0x72, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x75, 0x70, 0x63, 0x68, 0x61,
+	0x72, 0x67, 0x65, 0x22, 0x3e, 0x0a, 0x0f, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x53, 0x6c,
+	0x6f, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x73, 0x6c,
+	0x6f, 0x74, 0x73, 0x22, 0x55, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65,
+	0x74, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a,
+	0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x53,
+	0x6c, 0x6f, 0x74, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x0c, 0x4d,
+	0x65, 0x6e, 0x75, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x64,
+	0x61, 0x79, 0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x77, 0x65, 0x65, 0x6b, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x05, 0x52, 0x0a, 0x64, 0x61, 0x79, 0x73, 0x4f, 0x66, 0x57, 0x65, 0x65, 0x6b, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f,
+	0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x48, 0x0a, 0x10, 0x4d, 0x65, 0x6e, 0x75, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x09, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x73, 0x22, 0x5f, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x09,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x73, 0x22, 0x77, 0x0a, 0x12, 0x4d, 0x65, 0x6e, 0x75, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65,
+	0x5f, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x54,
+	0x68, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x22, 0x86, 0x02, 0x0a, 0x11,
+	0x4d, 0x65, 0x6e, 0x75, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61,
+	0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d,
+	0x65, 0x45, 0x6e, 0x12, 0x39, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x36, 0x0a, 0x07,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4c, 0x0a, 0x15, 0x4d, 0x65, 0x6e, 0x75, 0x4d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x33, 0x0a,
+	0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x22, 0x62, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65,
+	0x6d, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x33, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e,
+	0x75, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0xf2, 0x01, 0x0a, 0x0b, 0x4d, 0x65, 0x6e, 0x75, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x68, 0x12,
+	0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49,
+	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x22, 0x9f, 0x01, 0x0a, 0x0f,
+	0x4d, 0x65, 0x6e, 0x75, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65,
+	0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x21, 0x0a, 0x0c,
+	0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x22, 0xb9, 0x01,
+	0x0a, 0x0b, 0x4d, 0x65, 0x6e, 0x75, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x31, 0x0a,
+	0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x31, 0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x52, 0x07, 0x70, 0x72, 0x69, 0x63, 0x65, 0x41, 0x74, 0x22, 0x37, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x6e, 0x75, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x61, 0x74, 0x22, 0x67, 0x0a, 0x18, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65,
+	0x6e, 0x75, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x61, 0x74, 0x65, 0x22, 0x8d, 0x02, 0x0a, 0x11,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0c, 0x0a, 0x01, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x71, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x41, 0x6c,
+	0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x65, 0x74, 0x61,
+	0x72, 0x79, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x54, 0x61, 0x67, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x61, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x0d,
+	0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x69, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x69, 0x74, 0x65, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x08, 0x6d, 0x65, 0x6e, 0x75,
+	0x49, 0x74, 0x65, 0x6d, 0x12, 0x2c, 0x0a, 0x08, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x73, 0x65, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x52, 0x07, 0x6d, 0x65, 0x6e, 0x75, 0x53,
+	0x65, 0x74, 0x22, 0x57, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x65, 0x6e, 0x75,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x68, 0x69, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x69, 0x74, 0x52,
+	0x04, 0x68, 0x69, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x88, 0x01, 0x0a, 0x0f,
+	0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12,
+	0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65,
+	0x5f, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x45,
+	0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x12, 0x24, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f,
+	0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x22, 0x90, 0x03, 0x0a, 0x0b, 0x43, 0x61, 0x74, 0x61, 0x6c,
+	0x6f, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x68, 0x12,
+	0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x28, 0x0a, 0x10, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x5f, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73,
+	0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x69, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x64, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x54, 0x61, 0x67, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x05, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x73, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x22, 0x76, 0x0a, 0x0a, 0x43, 0x61, 0x74,
+	0x61, 0x6c, 0x6f, 0x67, 0x53, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70,
 	0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x22, 0x51, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53,
-	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x22, 0x50, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65,
-	0x6e, 0x75, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x41, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x41, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53,
-	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x22, 0x27, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x53,
-	0x65, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5c, 0x0a,
-	0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74,
+	0x65, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x5f,
+	0x65, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x74, 0x65, 0x6d, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x45, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x22, 0x9f, 0x01, 0x0a, 0x0b, 0x4d, 0x65, 0x6e, 0x75, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f,
+	0x67, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x73, 0x65, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x53, 0x65, 0x74, 0x52, 0x04, 0x73,
+	0x65, 0x74, 0x73, 0x22, 0x5d, 0x0a, 0x11, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x65, 0x6e,
+	0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x63, 0x61, 0x74, 0x61,
+	0x6c, 0x6f, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67,
+	0x52, 0x07, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79,
+	0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x22, 0x6a, 0x0a, 0x10, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xb5,
+	0x01, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x34,
+	0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x6e, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x75, 0x6e, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x22, 0x5c, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74,
+	0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x6e, 0x75, 0x49, 0x74,
+	0x65, 0x6d, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65,
+	0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3b, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x79, 0x49,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x65, 0x6e, 0x75,
+	0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d,
+	0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x64, 0x22, 0x4e, 0x0a, 0x0f, 0x4d, 0x65, 0x6e, 0x75,
+	0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x0e, 0x6d,
+	0x65, 0x6e, 0x75, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d,
+	0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0c, 0x6d, 0x65, 0x6e, 0x75,
+	0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x5c, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x73, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x6e, 0x75, 0x53,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x69, 0x74, 0x65,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x6e, 0x75,
+	0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3a, 0x0a,
+	0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74,
 	0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x65, 0x6e,
 	0x75, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x6d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x65, 0x6e,
-	0x75, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0a, 0x6d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x19, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x3b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74,
-	0x49, 0x74, 0x65, 0x6d, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1e, 0x0a, 0x0b, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x64, 0x22,
-	0x4e, 0x0a, 0x0f, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x3b, 0x0a, 0x0e, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69,
-	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65,
-	0x6d, 0x52, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x22,
-	0x5c, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74,
-	0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x6d,
-	0x65, 0x6e, 0x75, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x6d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x6d,
-	0x65, 0x6e, 0x75, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0a, 0x6d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x22, 0x45, 0x0a,
-	0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74,
-	0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x22, 0x3a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65,
-	0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x6d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x19, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0xbe, 0x02, 0x0a, 0x0b, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d,
 	0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x64,
-	0x22, 0x45, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65,
-	0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xbe, 0x02, 0x0a, 0x0b, 0x4d, 0x65, 0x6e, 0x75,
-	0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x65, 0x6e, 0x75, 0x5f,
-	0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65,
-	0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x5f,
-	0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d,
-	0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x65, 0x6e,
-	0x75, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x6d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a,
-	0x0e, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x5f, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x6e, 0x75, 0x4e,
-	0x61, 0x6d, 0x65, 0x54, 0x68, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x6e,
-	0x75, 0x4e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x6e, 0x75, 0x5f,
-	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x6d, 0x65, 0x6e,
-	0x75, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x63,
-	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d,
-	0x65, 0x6e, 0x75, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x69,
-	0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x22, 0x50, 0x0a, 0x12, 0x55, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a,
-	0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x5c, 0x0a, 0x13, 0x55, 0x70,
-	0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x28,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x31, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
-	0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x22, 0x3f, 0x0a, 0x13, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2a, 0x47, 0x0a, 0x0c,
-	0x4d, 0x65, 0x6e, 0x75, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x0b, 0x0a, 0x07,
-	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x41, 0x49,
-	0x4e, 0x5f, 0x43, 0x4f, 0x55, 0x52, 0x53, 0x45, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x42, 0x45,
-	0x56, 0x45, 0x52, 0x41, 0x47, 0x45, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x53, 0x53,
-	0x45, 0x52, 0x54, 0x10, 0x03, 0x2a, 0x22, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
-	0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x01, 0x32, 0xe4, 0x0a, 0x0a, 0x0b, 0x4d, 0x65,
-	0x6e, 0x75, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1f, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e,
-	0x75, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65,
-	0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53,
-	0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d,
-	0x12, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d,
+	0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x6e, 0x75, 0x53,
+	0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x73,
+	0x65, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c,
+	0x6d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0c,
+	0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x6e, 0x75, 0x4e, 0x61, 0x6d, 0x65, 0x54, 0x68, 0x12, 0x20,
+	0x0a, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x6e, 0x75, 0x4e, 0x61, 0x6d, 0x65, 0x45, 0x6e,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x6d, 0x65, 0x6e, 0x75, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72,
+	0x6c, 0x22, 0x50, 0x0a, 0x12, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x9c, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x3e, 0x0a, 0x0e, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x73, 0x52, 0x0d, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x74, 0x73, 0x22, 0x31, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x55, 0x72, 0x6c, 0x22, 0x3f, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2a, 0x22, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x00, 0x12, 0x0b, 0x0a,
+	0x07, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x01, 0x2a, 0x64, 0x0a, 0x12, 0x41, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x14, 0x0a, 0x10, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x49, 0x4c, 0x49, 0x54, 0x59,
+	0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41,
+	0x42, 0x49, 0x4c, 0x49, 0x54, 0x59, 0x5f, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45,
+	0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x49, 0x4c, 0x49,
+	0x54, 0x59, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x02,
+	0x2a, 0x3c, 0x0a, 0x11, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x49, 0x45,
+	0x52, 0x5f, 0x53, 0x49, 0x4e, 0x47, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x4f,
+	0x44, 0x49, 0x46, 0x49, 0x45, 0x52, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x10, 0x01, 0x32, 0xc8,
+	0x1a, 0x0a, 0x0b, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53,
+	0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d,
+	0x12, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61,
 	0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x55, 0x70, 0x64,
+	0x74, 0x1a, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x72, 0x65,
 	0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e,
+	0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e,
 	0x75, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4d,
-	0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75,
-	0x49, 0x74, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d,
-	0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x79, 0x49, 0x64, 0x12, 0x20, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74,
-	0x65, 0x6d, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65,
-	0x6d, 0x12, 0x50, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53,
-	0x65, 0x74, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e,
-	0x75, 0x53, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d,
-	0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x65,
-	0x6e, 0x75, 0x53, 0x65, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x15,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65,
-	0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75,
-	0x53, 0x65, 0x74, 0x42, 0x79, 0x49, 0x64, 0x12, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x42, 0x79, 0x49,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x12, 0x5c, 0x0a, 0x11, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d,
-	0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65,
-	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0f, 0x47, 0x65, 0x74,
-	0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x16, 0x2e, 0x67,
+	0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1f, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e,
+	0x75, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a,
+	0x0c, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x49,
+	0x74, 0x65, 0x6d, 0x42, 0x79, 0x49, 0x64, 0x12, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x79,
+	0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x57, 0x0a,
+	0x17, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x41, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x28, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65,
+	0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x55, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x6e,
+	0x75, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x21,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x6e,
+	0x75, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e,
+	0x75, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x47, 0x0a,
+	0x0f, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x67, 0x73,
+	0x12, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x4d,
+	0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65,
+	0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x5e, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x6e,
+	0x75, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x25,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x6e,
+	0x75, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x0f, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4d, 0x65, 0x6e, 0x75,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x43, 0x0a, 0x0f, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1c,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d,
+	0x12, 0x51, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x23, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x12, 0x51, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e,
+	0x75, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x23, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x5f, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4d, 0x65, 0x6e, 0x75, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x23, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65,
+	0x6e, 0x75, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x65,
+	0x6e, 0x75, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12,
-	0x5b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65,
-	0x6d, 0x42, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x44, 0x12, 0x23, 0x2e, 0x73,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x4d, 0x65, 0x6e, 0x75, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x53, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x42, 0x79, 0x49, 0x64, 0x12, 0x24, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x4e, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x4d, 0x65, 0x6e, 0x75,
+	0x49, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x21, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x5c, 0x0a, 0x15, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x26,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x62, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6e,
+	0x75, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x24, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6e, 0x75,
+	0x49, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0d, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75,
+	0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75,
+	0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0d, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65,
+	0x6e, 0x75, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65,
+	0x6e, 0x75, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a,
+	0x0b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x73,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x53,
-	0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e,
-	0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x5c, 0x0a, 0x11,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65,
-	0x6d, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74,
-	0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x11, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12,
-	0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x55, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x42, 0x0c, 0x5a, 0x0a, 0x2e, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x44, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x42,
+	0x79, 0x49, 0x64, 0x12, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x12, 0x54, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x4d, 0x65,
+	0x6e, 0x75, 0x53, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x21,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x6e,
+	0x75, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e,
+	0x75, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4e, 0x0a,
+	0x0f, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x73,
+	0x12, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x4d,
+	0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65,
+	0x6e, 0x75, 0x53, 0x65, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x41, 0x0a,
+	0x0e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x12,
+	0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74,
+	0x12, 0x41, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53,
+	0x65, 0x74, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75,
+	0x53, 0x65, 0x74, 0x12, 0x4c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74,
+	0x65, 0x6d, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x12, 0x4b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x52,
+	0x0a, 0x15, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74,
+	0x65, 0x6d, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x51, 0x0a, 0x14, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65,
+	0x6e, 0x75, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x65,
+	0x6e, 0x75, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x47, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d,
+	0x65, 0x6e, 0x75, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b,
+	0x0a, 0x0a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x4d, 0x65, 0x6e, 0x75, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x12, 0x47, 0x0a, 0x0a, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65,
+	0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53,
+	0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d,
+	0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x44, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74,
+	0x49, 0x74, 0x65, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x5b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4d,
+	0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x79, 0x4d, 0x65, 0x6e, 0x75,
+	0x53, 0x65, 0x74, 0x49, 0x44, 0x12, 0x23, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x42,
+	0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x5c, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75,
+	0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e,
+	0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65,
+	0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x12, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a,
+	0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0c, 0x5a, 0x0a, 0x2e, 0x2f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2112,101 +5943,229 @@ func file_menu_proto_rawDescGZIP() []byte {
 	return file_menu_proto_rawDescData
 }
 
-var file_menu_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_menu_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_menu_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_menu_proto_msgTypes = make([]protoimpl.MessageInfo, 76)
 var file_menu_proto_goTypes = []any{
-	(MenuCategory)(0),                 // 0: services.MenuCategory
-	(Status)(0),                       // 1: services.Status
-	(*MenuItem)(nil),                  // 2: services.MenuItem
-	(*MenuItemList)(nil),              // 3: services.MenuItemList
-	(*CreateMenuItemRequest)(nil),     // 4: services.CreateMenuItemRequest
-	(*CreateMenuItemResponse)(nil),    // 5: services.CreateMenuItemResponse
-	(*UpdateMenuItemRequest)(nil),     // 6: services.UpdateMenuItemRequest
-	(*UpdateMenuItemResponse)(nil),    // 7: services.UpdateMenuItemResponse
-	(*DeleteMenuItemRequest)(nil),     // 8: services.DeleteMenuItemRequest
-	(*DeleteMenuItemResponse)(nil),    // 9: services.DeleteMenuItemResponse
-	(*GetMenuItemByIdRequest)(nil),    // 10: services.GetMenuItemByIdRequest
-	(*MenuSet)(nil),                   // 11: services.MenuSet
-	(*MenuSetList)(nil),               // 12: services.MenuSetList
-	(*CreateMenuSetRequest)(nil),      // 13: services.CreateMenuSetRequest
-	(*CreateMenuSetResponse)(nil),     // 14: services.CreateMenuSetResponse
-	(*UpdateMenuSetRequest)(nil),      // 15: services.UpdateMenuSetRequest
-	(*UpdateMenuSetResponse)(nil),     // 16: services.UpdateMenuSetResponse
-	(*DeleteMenuSetRequest)(nil),      // 17: services.DeleteMenuSetRequest
-	(*DeleteMenuSetResponse)(nil),     // 18: services.DeleteMenuSetResponse
-	(*GetMenuSetByIdRequest)(nil),     // 19: services.GetMenuSetByIdRequest
-	(*CreateMenuSetItemRequest)(nil),  // 20: services.CreateMenuSetItemRequest
-	(*CreateMenuSetItemResponse)(nil), // 21: services.CreateMenuSetItemResponse
-	(*GetMenuSetItemByIdRequest)(nil), // 22: services.GetMenuSetItemByIdRequest
-	(*MenuSetItemList)(nil),           // 23: services.MenuSetItemList
-	(*UpdateMenuSetItemRequest)(nil),  // 24: services.UpdateMenuSetItemRequest
-	(*UpdateMenuSetItemResponse)(nil), // 25: services.UpdateMenuSetItemResponse
-	(*DeleteMenuSetItemRequest)(nil),  // 26: services.DeleteMenuSetItemRequest
-	(*DeleteMenuSetItemResponse)(nil), // 27: services.DeleteMenuSetItemResponse
-	(*MenuSetItem)(nil),               // 28: services.MenuSetItem
-	(*UploadImageRequest)(nil),        // 29: services.UploadImageRequest
-	(*UploadImageResponse)(nil),       // 30: services.UploadImageResponse
-	(*DeleteImageRequest)(nil),        // 31: services.DeleteImageRequest
-	(*DeleteImageResponse)(nil),       // 32: services.DeleteImageResponse
-	(*emptypb.Empty)(nil),             // 33: google.protobuf.Empty
+	(Status)(0),                            // 0: services.Status
+	(AvailabilityFilter)(0),                // 1: services.AvailabilityFilter
+	(ModifierSelection)(0),                 // 2: services.ModifierSelection
+	(*MenuItem)(nil),                       // 3: services.MenuItem
+	(*ImageVariants)(nil),                  // 4: services.ImageVariants
+	(*MenuItemList)(nil),                   // 5: services.MenuItemList
+	(*CreateMenuItemRequest)(nil),          // 6: services.CreateMenuItemRequest
+	(*CreateMenuItemResponse)(nil),         // 7: services.CreateMenuItemResponse
+	(*UpdateMenuItemRequest)(nil),          // 8: services.UpdateMenuItemRequest
+	(*UpdateMenuItemResponse)(nil),         // 9: services.UpdateMenuItemResponse
+	(*DeleteMenuItemRequest)(nil),          // 10: services.DeleteMenuItemRequest
+	(*DeleteMenuItemResponse)(nil),         // 11: services.DeleteMenuItemResponse
+	(*GetMenuItemByIdRequest)(nil),         // 12: services.GetMenuItemByIdRequest
+	(*GetMenuItemsRequest)(nil),            // 13: services.GetMenuItemsRequest
+	(*ArchiveMenuRequest)(nil),             // 14: services.ArchiveMenuRequest
+	(*SetMenuItemTagsRequest)(nil),         // 15: services.SetMenuItemTagsRequest
+	(*SetMenuItemAvailabilityRequest)(nil), // 16: services.SetMenuItemAvailabilityRequest
+	(*MenuCategory)(nil),                   // 17: services.MenuCategory
+	(*MenuCategoryList)(nil),               // 18: services.MenuCategoryList
+	(*CreateMenuCategoryRequest)(nil),      // 19: services.CreateMenuCategoryRequest
+	(*UpdateMenuCategoryRequest)(nil),      // 20: services.UpdateMenuCategoryRequest
+	(*DeleteMenuCategoryRequest)(nil),      // 21: services.DeleteMenuCategoryRequest
+	(*DeleteMenuCategoryResponse)(nil),     // 22: services.DeleteMenuCategoryResponse
+	(*GetMenuCategoryByIdRequest)(nil),     // 23: services.GetMenuCategoryByIdRequest
+	(*MenuItemImage)(nil),                  // 24: services.MenuItemImage
+	(*MenuItemImageList)(nil),              // 25: services.MenuItemImageList
+	(*AddMenuItemImageRequest)(nil),        // 26: services.AddMenuItemImageRequest
+	(*ReorderMenuItemImagesRequest)(nil),   // 27: services.ReorderMenuItemImagesRequest
+	(*RemoveMenuItemImageRequest)(nil),     // 28: services.RemoveMenuItemImageRequest
+	(*RemoveMenuItemImageResponse)(nil),    // 29: services.RemoveMenuItemImageResponse
+	(*MenuSet)(nil),                        // 30: services.MenuSet
+	(*MenuSetList)(nil),                    // 31: services.MenuSetList
+	(*CreateMenuSetRequest)(nil),           // 32: services.CreateMenuSetRequest
+	(*CreateMenuSetResponse)(nil),          // 33: services.CreateMenuSetResponse
+	(*UpdateMenuSetRequest)(nil),           // 34: services.UpdateMenuSetRequest
+	(*UpdateMenuSetResponse)(nil),          // 35: services.UpdateMenuSetResponse
+	(*DeleteMenuSetRequest)(nil),           // 36: services.DeleteMenuSetRequest
+	(*DeleteMenuSetResponse)(nil),          // 37: services.DeleteMenuSetResponse
+	(*GetMenuSetByIdRequest)(nil),          // 38: services.GetMenuSetByIdRequest
+	(*GetMenuSetsRequest)(nil),             // 39: services.GetMenuSetsRequest
+	(*MenuSetSlot)(nil),                    // 40: services.MenuSetSlot
+	(*MenuSetSlotItem)(nil),                // 41: services.MenuSetSlotItem
+	(*MenuSetSlotList)(nil),                // 42: services.MenuSetSlotList
+	(*SetMenuSetSlotsRequest)(nil),         // 43: services.SetMenuSetSlotsRequest
+	(*MenuSchedule)(nil),                   // 44: services.MenuSchedule
+	(*MenuScheduleList)(nil),               // 45: services.MenuScheduleList
+	(*SetMenuSchedulesRequest)(nil),        // 46: services.SetMenuSchedulesRequest
+	(*MenuModifierOption)(nil),             // 47: services.MenuModifierOption
+	(*MenuModifierGroup)(nil),              // 48: services.MenuModifierGroup
+	(*MenuModifierGroupList)(nil),          // 49: services.MenuModifierGroupList
+	(*SetMenuItemModifiersRequest)(nil),    // 50: services.SetMenuItemModifiersRequest
+	(*MenuVersion)(nil),                    // 51: services.MenuVersion
+	(*MenuPricePeriod)(nil),                // 52: services.MenuPricePeriod
+	(*MenuHistory)(nil),                    // 53: services.MenuHistory
+	(*GetMenuHistoryRequest)(nil),          // 54: services.GetMenuHistoryRequest
+	(*ScheduleMenuPriceRequest)(nil),       // 55: services.ScheduleMenuPriceRequest
+	(*SearchMenuRequest)(nil),              // 56: services.SearchMenuRequest
+	(*MenuSearchHit)(nil),                  // 57: services.MenuSearchHit
+	(*SearchMenuResponse)(nil),             // 58: services.SearchMenuResponse
+	(*CatalogCategory)(nil),                // 59: services.CatalogCategory
+	(*CatalogItem)(nil),                    // 60: services.CatalogItem
+	(*CatalogSet)(nil),                     // 61: services.CatalogSet
+	(*MenuCatalog)(nil),                    // 62: services.MenuCatalog
+	(*ImportMenuRequest)(nil),              // 63: services.ImportMenuRequest
+	(*MenuImportChange)(nil),               // 64: services.MenuImportChange
+	(*ImportMenuResponse)(nil),             // 65: services.ImportMenuResponse
+	(*CreateMenuSetItemRequest)(nil),       // 66: services.CreateMenuSetItemRequest
+	(*CreateMenuSetItemResponse)(nil),      // 67: services.CreateMenuSetItemResponse
+	(*GetMenuSetItemByIdRequest)(nil),      // 68: services.GetMenuSetItemByIdRequest
+	(*MenuSetItemList)(nil),                // 69: services.MenuSetItemList
+	(*UpdateMenuSetItemRequest)(nil),       // 70: services.UpdateMenuSetItemRequest
+	(*UpdateMenuSetItemResponse)(nil),      // 71: services.UpdateMenuSetItemResponse
+	(*DeleteMenuSetItemRequest)(nil),       // 72: services.DeleteMenuSetItemRequest
+	(*DeleteMenuSetItemResponse)(nil),      // 73: services.DeleteMenuSetItemResponse
+	(*MenuSetItem)(nil),                    // 74: services.MenuSetItem
+	(*UploadImageRequest)(nil),             // 75: services.UploadImageRequest
+	(*UploadImageResponse)(nil),            // 76: services.UploadImageResponse
+	(*DeleteImageRequest)(nil),             // 77: services.DeleteImageRequest
+	(*DeleteImageResponse)(nil),            // 78: services.DeleteImageResponse
+	(*emptypb.Empty)(nil),                  // 79: google.protobuf.Empty
 }
 var file_menu_proto_depIdxs = []int32{
-	0,  // 0: services.MenuItem.category:type_name -> services.MenuCategory
-	2,  // 1: services.MenuItemList.menu_items:type_name -> services.MenuItem
-	0,  // 2: services.CreateMenuItemRequest.category:type_name -> services.MenuCategory
-	1,  // 3: services.CreateMenuItemResponse.status:type_name -> services.Status
-	0,  // 4: services.UpdateMenuItemRequest.category:type_name -> services.MenuCategory
-	1,  // 5: services.UpdateMenuItemResponse.status:type_name -> services.Status
-	1,  // 6: services.DeleteMenuItemResponse.status:type_name -> services.Status
-	11, // 7: services.MenuSetList.menu_sets:type_name -> services.MenuSet
-	1,  // 8: services.CreateMenuSetResponse.status:type_name -> services.Status
-	1,  // 9: services.UpdateMenuSetResponse.status:type_name -> services.Status
-	1,  // 10: services.DeleteMenuSetResponse.status:type_name -> services.Status
-	1,  // 11: services.CreateMenuSetItemResponse.status:type_name -> services.Status
-	28, // 12: services.MenuSetItemList.menu_set_items:type_name -> services.MenuSetItem
-	1,  // 13: services.UpdateMenuSetItemResponse.status:type_name -> services.Status
-	1,  // 14: services.DeleteMenuSetItemResponse.status:type_name -> services.Status
-	1,  // 15: services.UploadImageResponse.status:type_name -> services.Status
-	1,  // 16: services.DeleteImageResponse.status:type_name -> services.Status
-	4,  // 17: services.MenuService.CreateMenuItem:input_type -> services.CreateMenuItemRequest
-	6,  // 18: services.MenuService.UpdateMenuItem:input_type -> services.UpdateMenuItemRequest
-	8,  // 19: services.MenuService.DeleteMenuItem:input_type -> services.DeleteMenuItemRequest
-	33, // 20: services.MenuService.GetMenuItems:input_type -> google.protobuf.Empty
-	10, // 21: services.MenuService.GetMenuItemById:input_type -> services.GetMenuItemByIdRequest
-	13, // 22: services.MenuService.CreateMenuSet:input_type -> services.CreateMenuSetRequest
-	15, // 23: services.MenuService.UpdateMenuSet:input_type -> services.UpdateMenuSetRequest
-	17, // 24: services.MenuService.DeleteMenuSet:input_type -> services.DeleteMenuSetRequest
-	33, // 25: services.MenuService.GetMenuSets:input_type -> google.protobuf.Empty
-	19, // 26: services.MenuService.GetMenuSetById:input_type -> services.GetMenuSetByIdRequest
-	20, // 27: services.MenuService.CreateMenuSetItem:input_type -> services.CreateMenuSetItemRequest
-	33, // 28: services.MenuService.GetMenuSetItems:input_type -> google.protobuf.Empty
-	22, // 29: services.MenuService.GetMenuSetItemByMenuSetID:input_type -> services.GetMenuSetItemByIdRequest
-	24, // 30: services.MenuService.UpdateMenuSetItem:input_type -> services.UpdateMenuSetItemRequest
-	26, // 31: services.MenuService.DeleteMenuSetItem:input_type -> services.DeleteMenuSetItemRequest
-	29, // 32: services.MenuService.UploadImage:input_type -> services.UploadImageRequest
-	31, // 33: services.MenuService.DeleteImage:input_type -> services.DeleteImageRequest
-	5,  // 34: services.MenuService.CreateMenuItem:output_type -> services.CreateMenuItemResponse
-	7,  // 35: services.MenuService.UpdateMenuItem:output_type -> services.UpdateMenuItemResponse
-	9,  // 36: services.MenuService.DeleteMenuItem:output_type -> services.DeleteMenuItemResponse
-	3,  // 37: services.MenuService.GetMenuItems:output_type -> services.MenuItemList
-	2,  // 38: services.MenuService.GetMenuItemById:output_type -> services.MenuItem
-	14, // 39: services.MenuService.CreateMenuSet:output_type -> services.CreateMenuSetResponse
-	16, // 40: services.MenuService.UpdateMenuSet:output_type -> services.UpdateMenuSetResponse
-	18, // 41: services.MenuService.DeleteMenuSet:output_type -> services.DeleteMenuSetResponse
-	12, // 42: services.MenuService.GetMenuSets:output_type -> services.MenuSetList
-	11, // 43: services.MenuService.GetMenuSetById:output_type -> services.MenuSet
-	21, // 44: services.MenuService.CreateMenuSetItem:output_type -> services.CreateMenuSetItemResponse
-	23, // 45: services.MenuService.GetMenuSetItems:output_type -> services.MenuSetItemList
-	23, // 46: services.MenuService.GetMenuSetItemByMenuSetID:output_type -> services.MenuSetItemList
-	25, // 47: services.MenuService.UpdateMenuSetItem:output_type -> services.UpdateMenuSetItemResponse
-	27, // 48: services.MenuService.DeleteMenuSetItem:output_type -> services.DeleteMenuSetItemResponse
-	30, // 49: services.MenuService.UploadImage:output_type -> services.UploadImageResponse
-	32, // 50: services.MenuService.DeleteImage:output_type -> services.DeleteImageResponse
-	34, // [34:51] is the sub-list for method output_type
-	17, // [17:34] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	4,  // 0: services.MenuItem.image_variants:type_name -> services.ImageVariants
+	24, // 1: services.MenuItem.images:type_name -> services.MenuItemImage
+	44, // 2: services.MenuItem.schedules:type_name -> services.MenuSchedule
+	17, // 3: services.MenuItem.category:type_name -> services.MenuCategory
+	48, // 4: services.MenuItem.modifier_groups:type_name -> services.MenuModifierGroup
+	3,  // 5: services.MenuItemList.menu_items:type_name -> services.MenuItem
+	0,  // 6: services.CreateMenuItemResponse.status:type_name -> services.Status
+	0,  // 7: services.UpdateMenuItemResponse.status:type_name -> services.Status
+	0,  // 8: services.DeleteMenuItemResponse.status:type_name -> services.Status
+	1,  // 9: services.GetMenuItemsRequest.availability:type_name -> services.AvailabilityFilter
+	17, // 10: services.MenuCategoryList.categories:type_name -> services.MenuCategory
+	0,  // 11: services.DeleteMenuCategoryResponse.status:type_name -> services.Status
+	4,  // 12: services.MenuItemImage.image_variants:type_name -> services.ImageVariants
+	24, // 13: services.MenuItemImageList.images:type_name -> services.MenuItemImage
+	0,  // 14: services.RemoveMenuItemImageResponse.status:type_name -> services.Status
+	44, // 15: services.MenuSet.schedules:type_name -> services.MenuSchedule
+	40, // 16: services.MenuSet.slots:type_name -> services.MenuSetSlot
+	30, // 17: services.MenuSetList.menu_sets:type_name -> services.MenuSet
+	0,  // 18: services.CreateMenuSetResponse.status:type_name -> services.Status
+	0,  // 19: services.UpdateMenuSetResponse.status:type_name -> services.Status
+	0,  // 20: services.DeleteMenuSetResponse.status:type_name -> services.Status
+	41, // 21: services.MenuSetSlot.items:type_name -> services.MenuSetSlotItem
+	40, // 22: services.MenuSetSlotList.slots:type_name -> services.MenuSetSlot
+	40, // 23: services.SetMenuSetSlotsRequest.slots:type_name -> services.MenuSetSlot
+	44, // 24: services.MenuScheduleList.schedules:type_name -> services.MenuSchedule
+	44, // 25: services.SetMenuSchedulesRequest.schedules:type_name -> services.MenuSchedule
+	2,  // 26: services.MenuModifierGroup.selection:type_name -> services.ModifierSelection
+	47, // 27: services.MenuModifierGroup.options:type_name -> services.MenuModifierOption
+	48, // 28: services.MenuModifierGroupList.groups:type_name -> services.MenuModifierGroup
+	48, // 29: services.SetMenuItemModifiersRequest.groups:type_name -> services.MenuModifierGroup
+	51, // 30: services.MenuHistory.versions:type_name -> services.MenuVersion
+	52, // 31: services.MenuHistory.prices:type_name -> services.MenuPricePeriod
+	52, // 32: services.MenuHistory.price_at:type_name -> services.MenuPricePeriod
+	3,  // 33: services.MenuSearchHit.menu_item:type_name -> services.MenuItem
+	30, // 34: services.MenuSearchHit.menu_set:type_name -> services.MenuSet
+	57, // 35: services.SearchMenuResponse.hits:type_name -> services.MenuSearchHit
+	4,  // 36: services.CatalogItem.image:type_name -> services.ImageVariants
+	59, // 37: services.MenuCatalog.categories:type_name -> services.CatalogCategory
+	60, // 38: services.MenuCatalog.items:type_name -> services.CatalogItem
+	61, // 39: services.MenuCatalog.sets:type_name -> services.CatalogSet
+	62, // 40: services.ImportMenuRequest.catalog:type_name -> services.MenuCatalog
+	64, // 41: services.ImportMenuResponse.changes:type_name -> services.MenuImportChange
+	0,  // 42: services.CreateMenuSetItemResponse.status:type_name -> services.Status
+	74, // 43: services.MenuSetItemList.menu_set_items:type_name -> services.MenuSetItem
+	0,  // 44: services.UpdateMenuSetItemResponse.status:type_name -> services.Status
+	0,  // 45: services.DeleteMenuSetItemResponse.status:type_name -> services.Status
+	0,  // 46: services.UploadImageResponse.status:type_name -> services.Status
+	4,  // 47: services.UploadImageResponse.image_variants:type_name -> services.ImageVariants
+	0,  // 48: services.DeleteImageResponse.status:type_name -> services.Status
+	6,  // 49: services.MenuService.CreateMenuItem:input_type -> services.CreateMenuItemRequest
+	8,  // 50: services.MenuService.UpdateMenuItem:input_type -> services.UpdateMenuItemRequest
+	10, // 51: services.MenuService.DeleteMenuItem:input_type -> services.DeleteMenuItemRequest
+	13, // 52: services.MenuService.GetMenuItems:input_type -> services.GetMenuItemsRequest
+	12, // 53: services.MenuService.GetMenuItemById:input_type -> services.GetMenuItemByIdRequest
+	16, // 54: services.MenuService.SetMenuItemAvailability:input_type -> services.SetMenuItemAvailabilityRequest
+	46, // 55: services.MenuService.SetMenuItemSchedules:input_type -> services.SetMenuSchedulesRequest
+	15, // 56: services.MenuService.SetMenuItemTags:input_type -> services.SetMenuItemTagsRequest
+	50, // 57: services.MenuService.SetMenuItemModifiers:input_type -> services.SetMenuItemModifiersRequest
+	14, // 58: services.MenuService.ArchiveMenuItem:input_type -> services.ArchiveMenuRequest
+	14, // 59: services.MenuService.RestoreMenuItem:input_type -> services.ArchiveMenuRequest
+	19, // 60: services.MenuService.CreateMenuCategory:input_type -> services.CreateMenuCategoryRequest
+	20, // 61: services.MenuService.UpdateMenuCategory:input_type -> services.UpdateMenuCategoryRequest
+	21, // 62: services.MenuService.DeleteMenuCategory:input_type -> services.DeleteMenuCategoryRequest
+	79, // 63: services.MenuService.GetMenuCategories:input_type -> google.protobuf.Empty
+	23, // 64: services.MenuService.GetMenuCategoryById:input_type -> services.GetMenuCategoryByIdRequest
+	26, // 65: services.MenuService.AddMenuItemImage:input_type -> services.AddMenuItemImageRequest
+	27, // 66: services.MenuService.ReorderMenuItemImages:input_type -> services.ReorderMenuItemImagesRequest
+	28, // 67: services.MenuService.RemoveMenuItemImage:input_type -> services.RemoveMenuItemImageRequest
+	32, // 68: services.MenuService.CreateMenuSet:input_type -> services.CreateMenuSetRequest
+	34, // 69: services.MenuService.UpdateMenuSet:input_type -> services.UpdateMenuSetRequest
+	36, // 70: services.MenuService.DeleteMenuSet:input_type -> services.DeleteMenuSetRequest
+	39, // 71: services.MenuService.GetMenuSets:input_type -> services.GetMenuSetsRequest
+	38, // 72: services.MenuService.GetMenuSetById:input_type -> services.GetMenuSetByIdRequest
+	46, // 73: services.MenuService.SetMenuSetSchedules:input_type -> services.SetMenuSchedulesRequest
+	43, // 74: services.MenuService.SetMenuSetSlots:input_type -> services.SetMenuSetSlotsRequest
+	14, // 75: services.MenuService.ArchiveMenuSet:input_type -> services.ArchiveMenuRequest
+	14, // 76: services.MenuService.RestoreMenuSet:input_type -> services.ArchiveMenuRequest
+	54, // 77: services.MenuService.GetMenuItemHistory:input_type -> services.GetMenuHistoryRequest
+	54, // 78: services.MenuService.GetMenuSetHistory:input_type -> services.GetMenuHistoryRequest
+	55, // 79: services.MenuService.ScheduleMenuItemPrice:input_type -> services.ScheduleMenuPriceRequest
+	55, // 80: services.MenuService.ScheduleMenuSetPrice:input_type -> services.ScheduleMenuPriceRequest
+	56, // 81: services.MenuService.SearchMenu:input_type -> services.SearchMenuRequest
+	79, // 82: services.MenuService.ExportMenu:input_type -> google.protobuf.Empty
+	63, // 83: services.MenuService.ImportMenu:input_type -> services.ImportMenuRequest
+	66, // 84: services.MenuService.CreateMenuSetItem:input_type -> services.CreateMenuSetItemRequest
+	79, // 85: services.MenuService.GetMenuSetItems:input_type -> google.protobuf.Empty
+	68, // 86: services.MenuService.GetMenuSetItemByMenuSetID:input_type -> services.GetMenuSetItemByIdRequest
+	70, // 87: services.MenuService.UpdateMenuSetItem:input_type -> services.UpdateMenuSetItemRequest
+	72, // 88: services.MenuService.DeleteMenuSetItem:input_type -> services.DeleteMenuSetItemRequest
+	75, // 89: services.MenuService.UploadImage:input_type -> services.UploadImageRequest
+	77, // 90: services.MenuService.DeleteImage:input_type -> services.DeleteImageRequest
+	7,  // 91: services.MenuService.CreateMenuItem:output_type -> services.CreateMenuItemResponse
+	9,  // 92: services.MenuService.UpdateMenuItem:output_type -> services.UpdateMenuItemResponse
+	11, // 93: services.MenuService.DeleteMenuItem:output_type -> services.DeleteMenuItemResponse
+	5,  // 94: services.MenuService.GetMenuItems:output_type -> services.MenuItemList
+	3,  // 95: services.MenuService.GetMenuItemById:output_type -> services.MenuItem
+	3,  // 96: services.MenuService.SetMenuItemAvailability:output_type -> services.MenuItem
+	45, // 97: services.MenuService.SetMenuItemSchedules:output_type -> services.MenuScheduleList
+	3,  // 98: services.MenuService.SetMenuItemTags:output_type -> services.MenuItem
+	49, // 99: services.MenuService.SetMenuItemModifiers:output_type -> services.MenuModifierGroupList
+	3,  // 100: services.MenuService.ArchiveMenuItem:output_type -> services.MenuItem
+	3,  // 101: services.MenuService.RestoreMenuItem:output_type -> services.MenuItem
+	17, // 102: services.MenuService.CreateMenuCategory:output_type -> services.MenuCategory
+	17, // 103: services.MenuService.UpdateMenuCategory:output_type -> services.MenuCategory
+	22, // 104: services.MenuService.DeleteMenuCategory:output_type -> services.DeleteMenuCategoryResponse
+	18, // 105: services.MenuService.GetMenuCategories:output_type -> services.MenuCategoryList
+	17, // 106: services.MenuService.GetMenuCategoryById:output_type -> services.MenuCategory
+	24, // 107: services.MenuService.AddMenuItemImage:output_type -> services.MenuItemImage
+	25, // 108: services.MenuService.ReorderMenuItemImages:output_type -> services.MenuItemImageList
+	29, // 109: services.MenuService.RemoveMenuItemImage:output_type -> services.RemoveMenuItemImageResponse
+	33, // 110: services.MenuService.CreateMenuSet:output_type -> services.CreateMenuSetResponse
+	35, // 111: services.MenuService.UpdateMenuSet:output_type -> services.UpdateMenuSetResponse
+	37, // 112: services.MenuService.DeleteMenuSet:output_type -> services.DeleteMenuSetResponse
+	31, // 113: services.MenuService.GetMenuSets:output_type -> services.MenuSetList
+	30, // 114: services.MenuService.GetMenuSetById:output_type -> services.MenuSet
+	45, // 115: services.MenuService.SetMenuSetSchedules:output_type -> services.MenuScheduleList
+	42, // 116: services.MenuService.SetMenuSetSlots:output_type -> services.MenuSetSlotList
+	30, // 117: services.MenuService.ArchiveMenuSet:output_type -> services.MenuSet
+	30, // 118: services.MenuService.RestoreMenuSet:output_type -> services.MenuSet
+	53, // 119: services.MenuService.GetMenuItemHistory:output_type -> services.MenuHistory
+	53, // 120: services.MenuService.GetMenuSetHistory:output_type -> services.MenuHistory
+	53, // 121: services.MenuService.ScheduleMenuItemPrice:output_type -> services.MenuHistory
+	53, // 122: services.MenuService.ScheduleMenuSetPrice:output_type -> services.MenuHistory
+	58, // 123: services.MenuService.SearchMenu:output_type -> services.SearchMenuResponse
+	62, // 124: services.MenuService.ExportMenu:output_type -> services.MenuCatalog
+	65, // 125: services.MenuService.ImportMenu:output_type -> services.ImportMenuResponse
+	67, // 126: services.MenuService.CreateMenuSetItem:output_type -> services.CreateMenuSetItemResponse
+	69, // 127: services.MenuService.GetMenuSetItems:output_type -> services.MenuSetItemList
+	69, // 128: services.MenuService.GetMenuSetItemByMenuSetID:output_type -> services.MenuSetItemList
+	71, // 129: services.MenuService.UpdateMenuSetItem:output_type -> services.UpdateMenuSetItemResponse
+	73, // 130: services.MenuService.DeleteMenuSetItem:output_type -> services.DeleteMenuSetItemResponse
+	76, // 131: services.MenuService.UploadImage:output_type -> services.UploadImageResponse
+	78, // 132: services.MenuService.DeleteImage:output_type -> services.DeleteImageResponse
+	91, // [91:133] is the sub-list for method output_type
+	49, // [49:91] is the sub-list for method input_type
+	49, // [49:49] is the sub-list for extension type_name
+	49, // [49:49] is the sub-list for extension extendee
+	0,  // [0:49] is the sub-list for field type_name
 }
 
 func init() { file_menu_proto_init() }
@@ -2219,8 +6178,8 @@ func file_menu_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_menu_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   31,
+			NumEnums:      3,
+			NumMessages:   76,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,11 +25,36 @@ const (
 	MenuService_DeleteMenuItem_FullMethodName            = "/services.MenuService/DeleteMenuItem"
 	MenuService_GetMenuItems_FullMethodName              = "/services.MenuService/GetMenuItems"
 	MenuService_GetMenuItemById_FullMethodName           = "/services.MenuService/GetMenuItemById"
+	MenuService_SetMenuItemAvailability_FullMethodName   = "/services.MenuService/SetMenuItemAvailability"
+	MenuService_SetMenuItemSchedules_FullMethodName      = "/services.MenuService/SetMenuItemSchedules"
+	MenuService_SetMenuItemTags_FullMethodName           = "/services.MenuService/SetMenuItemTags"
+	MenuService_SetMenuItemModifiers_FullMethodName      = "/services.MenuService/SetMenuItemModifiers"
+	MenuService_ArchiveMenuItem_FullMethodName           = "/services.MenuService/ArchiveMenuItem"
+	MenuService_RestoreMenuItem_FullMethodName           = "/services.MenuService/RestoreMenuItem"
+	MenuService_CreateMenuCategory_FullMethodName        = "/services.MenuService/CreateMenuCategory"
+	MenuService_UpdateMenuCategory_FullMethodName        = "/services.MenuService/UpdateMenuCategory"
+	MenuService_DeleteMenuCategory_FullMethodName        = "/services.MenuService/DeleteMenuCategory"
+	MenuService_GetMenuCategories_FullMethodName         = "/services.MenuService/GetMenuCategories"
+	MenuService_GetMenuCategoryById_FullMethodName       = "/services.MenuService/GetMenuCategoryById"
+	MenuService_AddMenuItemImage_FullMethodName          = "/services.MenuService/AddMenuItemImage"
+	MenuService_ReorderMenuItemImages_FullMethodName     = "/services.MenuService/ReorderMenuItemImages"
+	MenuService_RemoveMenuItemImage_FullMethodName       = "/services.MenuService/RemoveMenuItemImage"
 	MenuService_CreateMenuSet_FullMethodName             = "/services.MenuService/CreateMenuSet"
 	MenuService_UpdateMenuSet_FullMethodName             = "/services.MenuService/UpdateMenuSet"
 	MenuService_DeleteMenuSet_FullMethodName             = "/services.MenuService/DeleteMenuSet"
 	MenuService_GetMenuSets_FullMethodName               = "/services.MenuService/GetMenuSets"
 	MenuService_GetMenuSetById_FullMethodName            = "/services.MenuService/GetMenuSetById"
+	MenuService_SetMenuSetSchedules_FullMethodName       = "/services.MenuService/SetMenuSetSchedules"
+	MenuService_SetMenuSetSlots_FullMethodName           = "/services.MenuService/SetMenuSetSlots"
+	MenuService_ArchiveMenuSet_FullMethodName            = "/services.MenuService/ArchiveMenuSet"
+	MenuService_RestoreMenuSet_FullMethodName            = "/services.MenuService/RestoreMenuSet"
+	MenuService_GetMenuItemHistory_FullMethodName        = "/services.MenuService/GetMenuItemHistory"
+	MenuService_GetMenuSetHistory_FullMethodName         = "/services.MenuService/GetMenuSetHistory"
+	MenuService_ScheduleMenuItemPrice_FullMethodName     = "/services.MenuService/ScheduleMenuItemPrice"
+	MenuService_ScheduleMenuSetPrice_FullMethodName      = "/services.MenuService/ScheduleMenuSetPrice"
+	MenuService_SearchMenu_FullMethodName                = "/services.MenuService/SearchMenu"
+	MenuService_ExportMenu_FullMethodName                = "/services.MenuService/ExportMenu"
+	MenuService_ImportMenu_FullMethodName                = "/services.MenuService/ImportMenu"
 	MenuService_CreateMenuSetItem_FullMethodName         = "/services.MenuService/CreateMenuSetItem"
 	MenuService_GetMenuSetItems_FullMethodName           = "/services.MenuService/GetMenuSetItems"
 	MenuService_GetMenuSetItemByMenuSetID_FullMethodName = "/services.MenuService/GetMenuSetItemByMenuSetID"
@@ -47,14 +72,44 @@ type MenuServiceClient interface {
 	CreateMenuItem(ctx context.Context, in *CreateMenuItemRequest, opts ...grpc.CallOption) (*CreateMenuItemResponse, error)
 	UpdateMenuItem(ctx context.Context, in *UpdateMenuItemRequest, opts ...grpc.CallOption) (*UpdateMenuItemResponse, error)
 	DeleteMenuItem(ctx context.Context, in *DeleteMenuItemRequest, opts ...grpc.CallOption) (*DeleteMenuItemResponse, error)
-	GetMenuItems(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MenuItemList, error)
+	GetMenuItems(ctx context.Context, in *GetMenuItemsRequest, opts ...grpc.CallOption) (*MenuItemList, error)
 	GetMenuItemById(ctx context.Context, in *GetMenuItemByIdRequest, opts ...grpc.CallOption) (*MenuItem, error)
+	SetMenuItemAvailability(ctx context.Context, in *SetMenuItemAvailabilityRequest, opts ...grpc.CallOption) (*MenuItem, error)
+	SetMenuItemSchedules(ctx context.Context, in *SetMenuSchedulesRequest, opts ...grpc.CallOption) (*MenuScheduleList, error)
+	SetMenuItemTags(ctx context.Context, in *SetMenuItemTagsRequest, opts ...grpc.CallOption) (*MenuItem, error)
+	SetMenuItemModifiers(ctx context.Context, in *SetMenuItemModifiersRequest, opts ...grpc.CallOption) (*MenuModifierGroupList, error)
+	ArchiveMenuItem(ctx context.Context, in *ArchiveMenuRequest, opts ...grpc.CallOption) (*MenuItem, error)
+	RestoreMenuItem(ctx context.Context, in *ArchiveMenuRequest, opts ...grpc.CallOption) (*MenuItem, error)
+	// Handle Menu Category
+	CreateMenuCategory(ctx context.Context, in *CreateMenuCategoryRequest, opts ...grpc.CallOption) (*MenuCategory, error)
+	UpdateMenuCategory(ctx context.Context, in *UpdateMenuCategoryRequest, opts ...grpc.CallOption) (*MenuCategory, error)
+	DeleteMenuCategory(ctx context.Context, in *DeleteMenuCategoryRequest, opts ...grpc.CallOption) (*DeleteMenuCategoryResponse, error)
+	GetMenuCategories(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MenuCategoryList, error)
+	GetMenuCategoryById(ctx context.Context, in *GetMenuCategoryByIdRequest, opts ...grpc.CallOption) (*MenuCategory, error)
+	// Handle Menu Item Gallery
+	AddMenuItemImage(ctx context.Context, in *AddMenuItemImageRequest, opts ...grpc.CallOption) (*MenuItemImage, error)
+	ReorderMenuItemImages(ctx context.Context, in *ReorderMenuItemImagesRequest, opts ...grpc.CallOption) (*MenuItemImageList, error)
+	RemoveMenuItemImage(ctx context.Context, in *RemoveMenuItemImageRequest, opts ...grpc.CallOption) (*RemoveMenuItemImageResponse, error)
 	// Handle Menu Set
 	CreateMenuSet(ctx context.Context, in *CreateMenuSetRequest, opts ...grpc.CallOption) (*CreateMenuSetResponse, error)
 	UpdateMenuSet(ctx context.Context, in *UpdateMenuSetRequest, opts ...grpc.CallOption) (*UpdateMenuSetResponse, error)
 	DeleteMenuSet(ctx context.Context, in *DeleteMenuSetRequest, opts ...grpc.CallOption) (*DeleteMenuSetResponse, error)
-	GetMenuSets(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MenuSetList, error)
+	GetMenuSets(ctx context.Context, in *GetMenuSetsRequest, opts ...grpc.CallOption) (*MenuSetList, error)
 	GetMenuSetById(ctx context.Context, in *GetMenuSetByIdRequest, opts ...grpc.CallOption) (*MenuSet, error)
+	SetMenuSetSchedules(ctx context.Context, in *SetMenuSchedulesRequest, opts ...grpc.CallOption) (*MenuScheduleList, error)
+	SetMenuSetSlots(ctx context.Context, in *SetMenuSetSlotsRequest, opts ...grpc.CallOption) (*MenuSetSlotList, error)
+	ArchiveMenuSet(ctx context.Context, in *ArchiveMenuRequest, opts ...grpc.CallOption) (*MenuSet, error)
+	RestoreMenuSet(ctx context.Context, in *ArchiveMenuRequest, opts ...grpc.CallOption) (*MenuSet, error)
+	// Handle Menu History (ประวัติและราคาที่ตั้งล่วงหน้า)
+	GetMenuItemHistory(ctx context.Context, in *GetMenuHistoryRequest, opts ...grpc.CallOption) (*MenuHistory, error)
+	GetMenuSetHistory(ctx context.Context, in *GetMenuHistoryRequest, opts ...grpc.CallOption) (*MenuHistory, error)
+	ScheduleMenuItemPrice(ctx context.Context, in *ScheduleMenuPriceRequest, opts ...grpc.CallOption) (*MenuHistory, error)
+	ScheduleMenuSetPrice(ctx context.Context, in *ScheduleMenuPriceRequest, opts ...grpc.CallOption) (*MenuHistory, error)
+	// Handle Menu Search
+	SearchMenu(ctx context.Context, in *SearchMenuRequest, opts ...grpc.CallOption) (*SearchMenuResponse, error)
+	// Handle Menu Import/Export (ย้ายเมนูทั้งร้าน อ้างถึงกันด้วยชื่อแทน ID)
+	ExportMenu(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MenuCatalog, error)
+	ImportMenu(ctx context.Context, in *ImportMenuRequest, opts ...grpc.CallOption) (*ImportMenuResponse, error)
 	// Handle Menu Set Item
 	CreateMenuSetItem(ctx context.Context, in *CreateMenuSetItemRequest, opts ...grpc.CallOption) (*CreateMenuSetItemResponse, error)
 	GetMenuSetItems(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MenuSetItemList, error)
@@ -104,7 +159,7 @@ func (c *menuServiceClient) DeleteMenuItem(ctx context.Context, in *DeleteMenuIt
 	return out, nil
 }
 
-func (c *menuServiceClient) GetMenuItems(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MenuItemList, error) {
+func (c *menuServiceClient) GetMenuItems(ctx context.Context, in *GetMenuItemsRequest, opts ...grpc.CallOption) (*MenuItemList, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MenuItemList)
 	err := c.cc.Invoke(ctx, MenuService_GetMenuItems_FullMethodName, in, out, cOpts...)
@@ -124,6 +179,146 @@ func (c *menuServiceClient) GetMenuItemById(ctx context.Context, in *GetMenuItem
 	return out, nil
 }
 
+func (c *menuServiceClient) SetMenuItemAvailability(ctx context.Context, in *SetMenuItemAvailabilityRequest, opts ...grpc.CallOption) (*MenuItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MenuItem)
+	err := c.cc.Invoke(ctx, MenuService_SetMenuItemAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) SetMenuItemSchedules(ctx context.Context, in *SetMenuSchedulesRequest, opts ...grpc.CallOption) (*MenuScheduleList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MenuScheduleList)
+	err := c.cc.Invoke(ctx, MenuService_SetMenuItemSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) SetMenuItemTags(ctx context.Context, in *SetMenuItemTagsRequest, opts ...grpc.CallOption) (*MenuItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MenuItem)
+	err := c.cc.Invoke(ctx, MenuService_SetMenuItemTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) SetMenuItemModifiers(ctx context.Context, in *SetMenuItemModifiersRequest, opts ...grpc.CallOption) (*MenuModifierGroupList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MenuModifierGroupList)
+	err := c.cc.Invoke(ctx, MenuService_SetMenuItemModifiers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) ArchiveMenuItem(ctx context.Context, in *ArchiveMenuRequest, opts ...grpc.CallOption) (*MenuItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MenuItem)
+	err := c.cc.Invoke(ctx, MenuService_ArchiveMenuItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) RestoreMenuItem(ctx context.Context, in *ArchiveMenuRequest, opts ...grpc.CallOption) (*MenuItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MenuItem)
+	err := c.cc.Invoke(ctx, MenuService_RestoreMenuItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) CreateMenuCategory(ctx context.Context, in *CreateMenuCategoryRequest, opts ...grpc.CallOption) (*MenuCategory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MenuCategory)
+	err := c.cc.Invoke(ctx, MenuService_CreateMenuCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) UpdateMenuCategory(ctx context.Context, in *UpdateMenuCategoryRequest, opts ...grpc.CallOption) (*MenuCategory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MenuCategory)
+	err := c.cc.Invoke(ctx, MenuService_UpdateMenuCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) DeleteMenuCategory(ctx context.Context, in *DeleteMenuCategoryRequest, opts ...grpc.CallOption) (*DeleteMenuCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMenuCategoryResponse)
+	err := c.cc.Invoke(ctx, MenuService_DeleteMenuCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) GetMenuCategories(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MenuCategoryList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MenuCategoryList)
+	err := c.cc.Invoke(ctx, MenuService_GetMenuCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) GetMenuCategoryById(ctx context.Context, in *GetMenuCategoryByIdRequest, opts ...grpc.CallOption) (*MenuCategory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MenuCategory)
+	err := c.cc.Invoke(ctx, MenuService_GetMenuCategoryById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) AddMenuItemImage(ctx context.Context, in *AddMenuItemImageRequest, opts ...grpc.CallOption) (*MenuItemImage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MenuItemImage)
+	err := c.cc.Invoke(ctx, MenuService_AddMenuItemImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) ReorderMenuItemImages(ctx context.Context, in *ReorderMenuItemImagesRequest, opts ...grpc.CallOption) (*MenuItemImageList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MenuItemImageList)
+	err := c.cc.Invoke(ctx, MenuService_ReorderMenuItemImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) RemoveMenuItemImage(ctx context.Context, in *RemoveMenuItemImageRequest, opts ...grpc.CallOption) (*RemoveMenuItemImageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveMenuItemImageResponse)
+	err := c.cc.Invoke(ctx, MenuService_RemoveMenuItemImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *menuServiceClient) CreateMenuSet(ctx context.Context, in *CreateMenuSetRequest, opts ...grpc.CallOption) (*CreateMenuSetResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateMenuSetResponse)
@@ -154,7 +349,7 @@ func (c *menuServiceClient) DeleteMenuSet(ctx context.Context, in *DeleteMenuSet
 	return out, nil
 }
 
-func (c *menuServiceClient) GetMenuSets(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MenuSetList, error) {
+func (c *menuServiceClient) GetMenuSets(ctx context.Context, in *GetMenuSetsRequest, opts ...grpc.CallOption) (*MenuSetList, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MenuSetList)
 	err := c.cc.Invoke(ctx, MenuService_GetMenuSets_FullMethodName, in, out, cOpts...)
@@ -174,6 +369,116 @@ func (c *menuServiceClient) GetMenuSetById(ctx context.Context, in *GetMenuSetBy
 	return out, nil
 }
 
+func (c *menuServiceClient) SetMenuSetSchedules(ctx context.Context, in *SetMenuSchedulesRequest, opts ...grpc.CallOption) (*MenuScheduleList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MenuScheduleList)
+	err := c.cc.Invoke(ctx, MenuService_SetMenuSetSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) SetMenuSetSlots(ctx context.Context, in *SetMenuSetSlotsRequest, opts ...grpc.CallOption) (*MenuSetSlotList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MenuSetSlotList)
+	err := c.cc.Invoke(ctx, MenuService_SetMenuSetSlots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) ArchiveMenuSet(ctx context.Context, in *ArchiveMenuRequest, opts ...grpc.CallOption) (*MenuSet, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MenuSet)
+	err := c.cc.Invoke(ctx, MenuService_ArchiveMenuSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) RestoreMenuSet(ctx context.Context, in *ArchiveMenuRequest, opts ...grpc.CallOption) (*MenuSet, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MenuSet)
+	err := c.cc.Invoke(ctx, MenuService_RestoreMenuSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) GetMenuItemHistory(ctx context.Context, in *GetMenuHistoryRequest, opts ...grpc.CallOption) (*MenuHistory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MenuHistory)
+	err := c.cc.Invoke(ctx, MenuService_GetMenuItemHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) GetMenuSetHistory(ctx context.Context, in *GetMenuHistoryRequest, opts ...grpc.CallOption) (*MenuHistory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MenuHistory)
+	err := c.cc.Invoke(ctx, MenuService_GetMenuSetHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) ScheduleMenuItemPrice(ctx context.Context, in *ScheduleMenuPriceRequest, opts ...grpc.CallOption) (*MenuHistory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MenuHistory)
+	err := c.cc.Invoke(ctx, MenuService_ScheduleMenuItemPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) ScheduleMenuSetPrice(ctx context.Context, in *ScheduleMenuPriceRequest, opts ...grpc.CallOption) (*MenuHistory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MenuHistory)
+	err := c.cc.Invoke(ctx, MenuService_ScheduleMenuSetPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) SearchMenu(ctx context.Context, in *SearchMenuRequest, opts ...grpc.CallOption) (*SearchMenuResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchMenuResponse)
+	err := c.cc.Invoke(ctx, MenuService_SearchMenu_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) ExportMenu(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MenuCatalog, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MenuCatalog)
+	err := c.cc.Invoke(ctx, MenuService_ExportMenu_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) ImportMenu(ctx context.Context, in *ImportMenuRequest, opts ...grpc.CallOption) (*ImportMenuResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportMenuResponse)
+	err := c.cc.Invoke(ctx, MenuService_ImportMenu_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *menuServiceClient) CreateMenuSetItem(ctx context.Context, in *CreateMenuSetItemRequest, opts ...grpc.CallOption) (*CreateMenuSetItemResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateMenuSetItemResponse)
@@ -252,14 +557,44 @@ type MenuServiceServer interface {
 	CreateMenuItem(context.Context, *CreateMenuItemRequest) (*CreateMenuItemResponse, error)
 	UpdateMenuItem(context.Context, *UpdateMenuItemRequest) (*UpdateMenuItemResponse, error)
 	DeleteMenuItem(context.Context, *DeleteMenuItemRequest) (*DeleteMenuItemResponse, error)
-	GetMenuItems(context.Context, *emptypb.Empty) (*MenuItemList, error)
+	GetMenuItems(context.Context, *GetMenuItemsRequest) (*MenuItemList, error)
 	GetMenuItemById(context.Context, *GetMenuItemByIdRequest) (*MenuItem, error)
+	SetMenuItemAvailability(context.Context, *SetMenuItemAvailabilityRequest) (*MenuItem, error)
+	SetMenuItemSchedules(context.Context, *SetMenuSchedulesRequest) (*MenuScheduleList, error)
+	SetMenuItemTags(context.Context, *SetMenuItemTagsRequest) (*MenuItem, error)
+	SetMenuItemModifiers(context.Context, *SetMenuItemModifiersRequest) (*MenuModifierGroupList, error)
+	ArchiveMenuItem(context.Context, *ArchiveMenuRequest) (*MenuItem, error)
+	RestoreMenuItem(context.Context, *ArchiveMenuRequest) (*MenuItem, error)
+	// Handle Menu Category
+	CreateMenuCategory(context.Context, *CreateMenuCategoryRequest) (*MenuCategory, error)
+	UpdateMenuCategory(context.Context, *UpdateMenuCategoryRequest) (*MenuCategory, error)
+	DeleteMenuCategory(context.Context, *DeleteMenuCategoryRequest) (*DeleteMenuCategoryResponse, error)
+	GetMenuCategories(context.Context, *emptypb.Empty) (*MenuCategoryList, error)
+	GetMenuCategoryById(context.Context, *GetMenuCategoryByIdRequest) (*MenuCategory, error)
+	// Handle Menu Item Gallery
+	AddMenuItemImage(context.Context, *AddMenuItemImageRequest) (*MenuItemImage, error)
+	ReorderMenuItemImages(context.Context, *ReorderMenuItemImagesRequest) (*MenuItemImageList, error)
+	RemoveMenuItemImage(context.Context, *RemoveMenuItemImageRequest) (*RemoveMenuItemImageResponse, error)
 	// Handle Menu Set
 	CreateMenuSet(context.Context, *CreateMenuSetRequest) (*CreateMenuSetResponse, error)
 	UpdateMenuSet(context.Context, *UpdateMenuSetRequest) (*UpdateMenuSetResponse, error)
 	DeleteMenuSet(context.Context, *DeleteMenuSetRequest) (*DeleteMenuSetResponse, error)
-	GetMenuSets(context.Context, *emptypb.Empty) (*MenuSetList, error)
+	GetMenuSets(context.Context, *GetMenuSetsRequest) (*MenuSetList, error)
 	GetMenuSetById(context.Context, *GetMenuSetByIdRequest) (*MenuSet, error)
+	SetMenuSetSchedules(context.Context, *SetMenuSchedulesRequest) (*MenuScheduleList, error)
+	SetMenuSetSlots(context.Context, *SetMenuSetSlotsRequest) (*MenuSetSlotList, error)
+	ArchiveMenuSet(context.Context, *ArchiveMenuRequest) (*MenuSet, error)
+	RestoreMenuSet(context.Context, *ArchiveMenuRequest) (*MenuSet, error)
+	// Handle Menu History (ประวัติและราคาที่ตั้งล่วงหน้า)
+	GetMenuItemHistory(context.Context, *GetMenuHistoryRequest) (*MenuHistory, error)
+	GetMenuSetHistory(context.Context, *GetMenuHistoryRequest) (*MenuHistory, error)
+	ScheduleMenuItemPrice(context.Context, *ScheduleMenuPriceRequest) (*MenuHistory, error)
+	ScheduleMenuSetPrice(context.Context, *ScheduleMenuPriceRequest) (*MenuHistory, error)
+	// Handle Menu Search
+	SearchMenu(context.Context, *SearchMenuRequest) (*SearchMenuResponse, error)
+	// Handle Menu Import/Export (ย้ายเมนูทั้งร้าน อ้างถึงกันด้วยชื่อแทน ID)
+	ExportMenu(context.Context, *emptypb.Empty) (*MenuCatalog, error)
+	ImportMenu(context.Context, *ImportMenuRequest) (*ImportMenuResponse, error)
 	// Handle Menu Set Item
 	CreateMenuSetItem(context.Context, *CreateMenuSetItemRequest) (*CreateMenuSetItemResponse, error)
 	GetMenuSetItems(context.Context, *emptypb.Empty) (*MenuSetItemList, error)
@@ -288,12 +623,54 @@ func (UnimplementedMenuServiceServer) UpdateMenuItem(context.Context, *UpdateMen
 func (UnimplementedMenuServiceServer) DeleteMenuItem(context.Context, *DeleteMenuItemRequest) (*DeleteMenuItemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteMenuItem not implemented")
 }
-func (UnimplementedMenuServiceServer) GetMenuItems(context.Context, *emptypb.Empty) (*MenuItemList, error) {
+func (UnimplementedMenuServiceServer) GetMenuItems(context.Context, *GetMenuItemsRequest) (*MenuItemList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMenuItems not implemented")
 }
 func (UnimplementedMenuServiceServer) GetMenuItemById(context.Context, *GetMenuItemByIdRequest) (*MenuItem, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMenuItemById not implemented")
 }
+func (UnimplementedMenuServiceServer) SetMenuItemAvailability(context.Context, *SetMenuItemAvailabilityRequest) (*MenuItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMenuItemAvailability not implemented")
+}
+func (UnimplementedMenuServiceServer) SetMenuItemSchedules(context.Context, *SetMenuSchedulesRequest) (*MenuScheduleList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMenuItemSchedules not implemented")
+}
+func (UnimplementedMenuServiceServer) SetMenuItemTags(context.Context, *SetMenuItemTagsRequest) (*MenuItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMenuItemTags not implemented")
+}
+func (UnimplementedMenuServiceServer) SetMenuItemModifiers(context.Context, *SetMenuItemModifiersRequest) (*MenuModifierGroupList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMenuItemModifiers not implemented")
+}
+func (UnimplementedMenuServiceServer) ArchiveMenuItem(context.Context, *ArchiveMenuRequest) (*MenuItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveMenuItem not implemented")
+}
+func (UnimplementedMenuServiceServer) RestoreMenuItem(context.Context, *ArchiveMenuRequest) (*MenuItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreMenuItem not implemented")
+}
+func (UnimplementedMenuServiceServer) CreateMenuCategory(context.Context, *CreateMenuCategoryRequest) (*MenuCategory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMenuCategory not implemented")
+}
+func (UnimplementedMenuServiceServer) UpdateMenuCategory(context.Context, *UpdateMenuCategoryRequest) (*MenuCategory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMenuCategory not implemented")
+}
+func (UnimplementedMenuServiceServer) DeleteMenuCategory(context.Context, *DeleteMenuCategoryRequest) (*DeleteMenuCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMenuCategory not implemented")
+}
+func (UnimplementedMenuServiceServer) GetMenuCategories(context.Context, *emptypb.Empty) (*MenuCategoryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMenuCategories not implemented")
+}
+func (UnimplementedMenuServiceServer) GetMenuCategoryById(context.Context, *GetMenuCategoryByIdRequest) (*MenuCategory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMenuCategoryById not implemented")
+}
+func (UnimplementedMenuServiceServer) AddMenuItemImage(context.Context, *AddMenuItemImageRequest) (*MenuItemImage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMenuItemImage not implemented")
+}
+func (UnimplementedMenuServiceServer) ReorderMenuItemImages(context.Context, *ReorderMenuItemImagesRequest) (*MenuItemImageList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderMenuItemImages not implemented")
+}
+func (UnimplementedMenuServiceServer) RemoveMenuItemImage(context.Context, *RemoveMenuItemImageRequest) (*RemoveMenuItemImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMenuItemImage not implemented")
+}
 func (UnimplementedMenuServiceServer) CreateMenuSet(context.Context, *CreateMenuSetRequest) (*CreateMenuSetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateMenuSet not implemented")
 }
@@ -303,12 +680,45 @@ func (UnimplementedMenuServiceServer) UpdateMenuSet(context.Context, *UpdateMenu
 func (UnimplementedMenuServiceServer) DeleteMenuSet(context.Context, *DeleteMenuSetRequest) (*DeleteMenuSetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteMenuSet not implemented")
 }
-func (UnimplementedMenuServiceServer) GetMenuSets(context.Context, *emptypb.Empty) (*MenuSetList, error) {
+func (UnimplementedMenuServiceServer) GetMenuSets(context.Context, *GetMenuSetsRequest) (*MenuSetList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMenuSets not implemented")
 }
 func (UnimplementedMenuServiceServer) GetMenuSetById(context.Context, *GetMenuSetByIdRequest) (*MenuSet, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMenuSetById not implemented")
 }
+func (UnimplementedMenuServiceServer) SetMenuSetSchedules(context.Context, *SetMenuSchedulesRequest) (*MenuScheduleList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMenuSetSchedules not implemented")
+}
+func (UnimplementedMenuServiceServer) SetMenuSetSlots(context.Context, *SetMenuSetSlotsRequest) (*MenuSetSlotList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMenuSetSlots not implemented")
+}
+func (UnimplementedMenuServiceServer) ArchiveMenuSet(context.Context, *ArchiveMenuRequest) (*MenuSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveMenuSet not implemented")
+}
+func (UnimplementedMenuServiceServer) RestoreMenuSet(context.Context, *ArchiveMenuRequest) (*MenuSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreMenuSet not implemented")
+}
+func (UnimplementedMenuServiceServer) GetMenuItemHistory(context.Context, *GetMenuHistoryRequest) (*MenuHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMenuItemHistory not implemented")
+}
+func (UnimplementedMenuServiceServer) GetMenuSetHistory(context.Context, *GetMenuHistoryRequest) (*MenuHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMenuSetHistory not implemented")
+}
+func (UnimplementedMenuServiceServer) ScheduleMenuItemPrice(context.Context, *ScheduleMenuPriceRequest) (*MenuHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMenuItemPrice not implemented")
+}
+func (UnimplementedMenuServiceServer) ScheduleMenuSetPrice(context.Context, *ScheduleMenuPriceRequest) (*MenuHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMenuSetPrice not implemented")
+}
+func (UnimplementedMenuServiceServer) SearchMenu(context.Context, *SearchMenuRequest) (*SearchMenuResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMenu not implemented")
+}
+func (UnimplementedMenuServiceServer) ExportMenu(context.Context, *emptypb.Empty) (*MenuCatalog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportMenu not implemented")
+}
+func (UnimplementedMenuServiceServer) ImportMenu(context.Context, *ImportMenuRequest) (*ImportMenuResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportMenu not implemented")
+}
 func (UnimplementedMenuServiceServer) CreateMenuSetItem(context.Context, *CreateMenuSetItemRequest) (*CreateMenuSetItemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateMenuSetItem not implemented")
 }
@@ -406,7 +816,7 @@ func _MenuService_DeleteMenuItem_Handler(srv interface{}, ctx context.Context, d
 }
 
 func _MenuService_GetMenuItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(GetMenuItemsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -418,7 +828,7 @@ func _MenuService_GetMenuItems_Handler(srv interface{}, ctx context.Context, dec
 		FullMethod: MenuService_GetMenuItems_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MenuServiceServer).GetMenuItems(ctx, req.(*emptypb.Empty))
+		return srv.(MenuServiceServer).GetMenuItems(ctx, req.(*GetMenuItemsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -441,179 +851,629 @@ func _MenuService_GetMenuItemById_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MenuService_CreateMenuSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateMenuSetRequest)
+func _MenuService_SetMenuItemAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMenuItemAvailabilityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MenuServiceServer).CreateMenuSet(ctx, in)
+		return srv.(MenuServiceServer).SetMenuItemAvailability(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MenuService_CreateMenuSet_FullMethodName,
+		FullMethod: MenuService_SetMenuItemAvailability_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MenuServiceServer).CreateMenuSet(ctx, req.(*CreateMenuSetRequest))
+		return srv.(MenuServiceServer).SetMenuItemAvailability(ctx, req.(*SetMenuItemAvailabilityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MenuService_UpdateMenuSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateMenuSetRequest)
+func _MenuService_SetMenuItemSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMenuSchedulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MenuServiceServer).UpdateMenuSet(ctx, in)
+		return srv.(MenuServiceServer).SetMenuItemSchedules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MenuService_UpdateMenuSet_FullMethodName,
+		FullMethod: MenuService_SetMenuItemSchedules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MenuServiceServer).UpdateMenuSet(ctx, req.(*UpdateMenuSetRequest))
+		return srv.(MenuServiceServer).SetMenuItemSchedules(ctx, req.(*SetMenuSchedulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MenuService_DeleteMenuSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteMenuSetRequest)
+func _MenuService_SetMenuItemTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMenuItemTagsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MenuServiceServer).DeleteMenuSet(ctx, in)
+		return srv.(MenuServiceServer).SetMenuItemTags(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MenuService_DeleteMenuSet_FullMethodName,
+		FullMethod: MenuService_SetMenuItemTags_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MenuServiceServer).DeleteMenuSet(ctx, req.(*DeleteMenuSetRequest))
+		return srv.(MenuServiceServer).SetMenuItemTags(ctx, req.(*SetMenuItemTagsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MenuService_GetMenuSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _MenuService_SetMenuItemModifiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMenuItemModifiersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MenuServiceServer).GetMenuSets(ctx, in)
+		return srv.(MenuServiceServer).SetMenuItemModifiers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MenuService_GetMenuSets_FullMethodName,
+		FullMethod: MenuService_SetMenuItemModifiers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MenuServiceServer).GetMenuSets(ctx, req.(*emptypb.Empty))
+		return srv.(MenuServiceServer).SetMenuItemModifiers(ctx, req.(*SetMenuItemModifiersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MenuService_GetMenuSetById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMenuSetByIdRequest)
+func _MenuService_ArchiveMenuItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveMenuRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MenuServiceServer).GetMenuSetById(ctx, in)
+		return srv.(MenuServiceServer).ArchiveMenuItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MenuService_GetMenuSetById_FullMethodName,
+		FullMethod: MenuService_ArchiveMenuItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MenuServiceServer).GetMenuSetById(ctx, req.(*GetMenuSetByIdRequest))
+		return srv.(MenuServiceServer).ArchiveMenuItem(ctx, req.(*ArchiveMenuRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MenuService_CreateMenuSetItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateMenuSetItemRequest)
+func _MenuService_RestoreMenuItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveMenuRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MenuServiceServer).CreateMenuSetItem(ctx, in)
+		return srv.(MenuServiceServer).RestoreMenuItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MenuService_CreateMenuSetItem_FullMethodName,
+		FullMethod: MenuService_RestoreMenuItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MenuServiceServer).CreateMenuSetItem(ctx, req.(*CreateMenuSetItemRequest))
+		return srv.(MenuServiceServer).RestoreMenuItem(ctx, req.(*ArchiveMenuRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MenuService_GetMenuSetItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _MenuService_CreateMenuCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMenuCategoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MenuServiceServer).GetMenuSetItems(ctx, in)
+		return srv.(MenuServiceServer).CreateMenuCategory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MenuService_GetMenuSetItems_FullMethodName,
+		FullMethod: MenuService_CreateMenuCategory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MenuServiceServer).GetMenuSetItems(ctx, req.(*emptypb.Empty))
+		return srv.(MenuServiceServer).CreateMenuCategory(ctx, req.(*CreateMenuCategoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MenuService_GetMenuSetItemByMenuSetID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMenuSetItemByIdRequest)
+func _MenuService_UpdateMenuCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMenuCategoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MenuServiceServer).GetMenuSetItemByMenuSetID(ctx, in)
+		return srv.(MenuServiceServer).UpdateMenuCategory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MenuService_GetMenuSetItemByMenuSetID_FullMethodName,
+		FullMethod: MenuService_UpdateMenuCategory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MenuServiceServer).GetMenuSetItemByMenuSetID(ctx, req.(*GetMenuSetItemByIdRequest))
+		return srv.(MenuServiceServer).UpdateMenuCategory(ctx, req.(*UpdateMenuCategoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MenuService_UpdateMenuSetItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateMenuSetItemRequest)
+func _MenuService_DeleteMenuCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMenuCategoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MenuServiceServer).UpdateMenuSetItem(ctx, in)
+		return srv.(MenuServiceServer).DeleteMenuCategory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MenuService_UpdateMenuSetItem_FullMethodName,
+		FullMethod: MenuService_DeleteMenuCategory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MenuServiceServer).UpdateMenuSetItem(ctx, req.(*UpdateMenuSetItemRequest))
+		return srv.(MenuServiceServer).DeleteMenuCategory(ctx, req.(*DeleteMenuCategoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MenuService_DeleteMenuSetItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteMenuSetItemRequest)
+func _MenuService_GetMenuCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MenuServiceServer).DeleteMenuSetItem(ctx, in)
+		return srv.(MenuServiceServer).GetMenuCategories(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MenuService_DeleteMenuSetItem_FullMethodName,
+		FullMethod: MenuService_GetMenuCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).GetMenuCategories(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_GetMenuCategoryById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMenuCategoryByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).GetMenuCategoryById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_GetMenuCategoryById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).GetMenuCategoryById(ctx, req.(*GetMenuCategoryByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_AddMenuItemImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMenuItemImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).AddMenuItemImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_AddMenuItemImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).AddMenuItemImage(ctx, req.(*AddMenuItemImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_ReorderMenuItemImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderMenuItemImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).ReorderMenuItemImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_ReorderMenuItemImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).ReorderMenuItemImages(ctx, req.(*ReorderMenuItemImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_RemoveMenuItemImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMenuItemImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).RemoveMenuItemImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_RemoveMenuItemImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).RemoveMenuItemImage(ctx, req.(*RemoveMenuItemImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_CreateMenuSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMenuSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).CreateMenuSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_CreateMenuSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).CreateMenuSet(ctx, req.(*CreateMenuSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_UpdateMenuSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMenuSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).UpdateMenuSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_UpdateMenuSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).UpdateMenuSet(ctx, req.(*UpdateMenuSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_DeleteMenuSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMenuSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).DeleteMenuSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_DeleteMenuSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).DeleteMenuSet(ctx, req.(*DeleteMenuSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_GetMenuSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMenuSetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).GetMenuSets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_GetMenuSets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).GetMenuSets(ctx, req.(*GetMenuSetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_GetMenuSetById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMenuSetByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).GetMenuSetById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_GetMenuSetById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).GetMenuSetById(ctx, req.(*GetMenuSetByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_SetMenuSetSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMenuSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).SetMenuSetSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_SetMenuSetSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).SetMenuSetSchedules(ctx, req.(*SetMenuSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_SetMenuSetSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMenuSetSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).SetMenuSetSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_SetMenuSetSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).SetMenuSetSlots(ctx, req.(*SetMenuSetSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_ArchiveMenuSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveMenuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).ArchiveMenuSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_ArchiveMenuSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).ArchiveMenuSet(ctx, req.(*ArchiveMenuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_RestoreMenuSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveMenuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).RestoreMenuSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_RestoreMenuSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).RestoreMenuSet(ctx, req.(*ArchiveMenuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_GetMenuItemHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMenuHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).GetMenuItemHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_GetMenuItemHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).GetMenuItemHistory(ctx, req.(*GetMenuHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_GetMenuSetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMenuHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).GetMenuSetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_GetMenuSetHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).GetMenuSetHistory(ctx, req.(*GetMenuHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_ScheduleMenuItemPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleMenuPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).ScheduleMenuItemPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_ScheduleMenuItemPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).ScheduleMenuItemPrice(ctx, req.(*ScheduleMenuPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_ScheduleMenuSetPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleMenuPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).ScheduleMenuSetPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_ScheduleMenuSetPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).ScheduleMenuSetPrice(ctx, req.(*ScheduleMenuPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_SearchMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMenuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).SearchMenu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_SearchMenu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).SearchMenu(ctx, req.(*SearchMenuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_ExportMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).ExportMenu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_ExportMenu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).ExportMenu(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_ImportMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportMenuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).ImportMenu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_ImportMenu_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).ImportMenu(ctx, req.(*ImportMenuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_CreateMenuSetItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMenuSetItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).CreateMenuSetItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_CreateMenuSetItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).CreateMenuSetItem(ctx, req.(*CreateMenuSetItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_GetMenuSetItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).GetMenuSetItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_GetMenuSetItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).GetMenuSetItems(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_GetMenuSetItemByMenuSetID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMenuSetItemByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).GetMenuSetItemByMenuSetID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_GetMenuSetItemByMenuSetID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).GetMenuSetItemByMenuSetID(ctx, req.(*GetMenuSetItemByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_UpdateMenuSetItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMenuSetItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).UpdateMenuSetItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_UpdateMenuSetItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).UpdateMenuSetItem(ctx, req.(*UpdateMenuSetItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_DeleteMenuSetItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMenuSetItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).DeleteMenuSetItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_DeleteMenuSetItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(MenuServiceServer).DeleteMenuSetItem(ctx, req.(*DeleteMenuSetItemRequest))
@@ -684,6 +1544,62 @@ var MenuService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMenuItemById",
 			Handler:    _MenuService_GetMenuItemById_Handler,
 		},
+		{
+			MethodName: "SetMenuItemAvailability",
+			Handler:    _MenuService_SetMenuItemAvailability_Handler,
+		},
+		{
+			MethodName: "SetMenuItemSchedules",
+			Handler:    _MenuService_SetMenuItemSchedules_Handler,
+		},
+		{
+			MethodName: "SetMenuItemTags",
+			Handler:    _MenuService_SetMenuItemTags_Handler,
+		},
+		{
+			MethodName: "SetMenuItemModifiers",
+			Handler:    _MenuService_SetMenuItemModifiers_Handler,
+		},
+		{
+			MethodName: "ArchiveMenuItem",
+			Handler:    _MenuService_ArchiveMenuItem_Handler,
+		},
+		{
+			MethodName: "RestoreMenuItem",
+			Handler:    _MenuService_RestoreMenuItem_Handler,
+		},
+		{
+			MethodName: "CreateMenuCategory",
+			Handler:    _MenuService_CreateMenuCategory_Handler,
+		},
+		{
+			MethodName: "UpdateMenuCategory",
+			Handler:    _MenuService_UpdateMenuCategory_Handler,
+		},
+		{
+			MethodName: "DeleteMenuCategory",
+			Handler:    _MenuService_DeleteMenuCategory_Handler,
+		},
+		{
+			MethodName: "GetMenuCategories",
+			Handler:    _MenuService_GetMenuCategories_Handler,
+		},
+		{
+			MethodName: "GetMenuCategoryById",
+			Handler:    _MenuService_GetMenuCategoryById_Handler,
+		},
+		{
+			MethodName: "AddMenuItemImage",
+			Handler:    _MenuService_AddMenuItemImage_Handler,
+		},
+		{
+			MethodName: "ReorderMenuItemImages",
+			Handler:    _MenuService_ReorderMenuItemImages_Handler,
+		},
+		{
+			MethodName: "RemoveMenuItemImage",
+			Handler:    _MenuService_RemoveMenuItemImage_Handler,
+		},
 		{
 			MethodName: "CreateMenuSet",
 			Handler:    _MenuService_CreateMenuSet_Handler,
@@ -704,6 +1620,50 @@ var MenuService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMenuSetById",
 			Handler:    _MenuService_GetMenuSetById_Handler,
 		},
+		{
+			MethodName: "SetMenuSetSchedules",
+			Handler:    _MenuService_SetMenuSetSchedules_Handler,
+		},
+		{
+			MethodName: "SetMenuSetSlots",
+			Handler:    _MenuService_SetMenuSetSlots_Handler,
+		},
+		{
+			MethodName: "ArchiveMenuSet",
+			Handler:    _MenuService_ArchiveMenuSet_Handler,
+		},
+		{
+			MethodName: "RestoreMenuSet",
+			Handler:    _MenuService_RestoreMenuSet_Handler,
+		},
+		{
+			MethodName: "GetMenuItemHistory",
+			Handler:    _MenuService_GetMenuItemHistory_Handler,
+		},
+		{
+			MethodName: "GetMenuSetHistory",
+			Handler:    _MenuService_GetMenuSetHistory_Handler,
+		},
+		{
+			MethodName: "ScheduleMenuItemPrice",
+			Handler:    _MenuService_ScheduleMenuItemPrice_Handler,
+		},
+		{
+			MethodName: "ScheduleMenuSetPrice",
+			Handler:    _MenuService_ScheduleMenuSetPrice_Handler,
+		},
+		{
+			MethodName: "SearchMenu",
+			Handler:    _MenuService_SearchMenu_Handler,
+		},
+		{
+			MethodName: "ExportMenu",
+			Handler:    _MenuService_ExportMenu_Handler,
+		},
+		{
+			MethodName: "ImportMenu",
+			Handler:    _MenuService_ImportMenu_Handler,
+		},
 		{
 			MethodName: "CreateMenuSetItem",
 			Handler:    _MenuService_CreateMenuSetItem_Handler,
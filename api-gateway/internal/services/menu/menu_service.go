@@ -14,15 +14,50 @@ type MenuService interface {
 	CreateMenuItem(ctx context.Context, req *CreateMenuItemRequest) (*CreateMenuItemResponse, error)
 	UpdateMenuItem(ctx context.Context, req *UpdateMenuItemRequest) (*UpdateMenuItemResponse, error)
 	DeleteMenuItem(ctx context.Context, req *DeleteMenuItemRequest) (*DeleteMenuItemResponse, error)
-	GetMenuItems(ctx context.Context, req *emptypb.Empty) (*MenuItemList, error)
+	GetMenuItems(ctx context.Context, req *GetMenuItemsRequest) (*MenuItemList, error)
 	GetMenuItemById(ctx context.Context, req *GetMenuItemByIdRequest) (*MenuItem, error)
+	SetMenuItemAvailability(ctx context.Context, req *SetMenuItemAvailabilityRequest) (*MenuItem, error)
+	SetMenuItemSchedules(ctx context.Context, req *SetMenuSchedulesRequest) (*MenuScheduleList, error)
+	SetMenuItemTags(ctx context.Context, req *SetMenuItemTagsRequest) (*MenuItem, error)
+	SetMenuItemModifiers(ctx context.Context, req *SetMenuItemModifiersRequest) (*MenuModifierGroupList, error)
+	ArchiveMenuItem(ctx context.Context, req *ArchiveMenuRequest) (*MenuItem, error)
+	RestoreMenuItem(ctx context.Context, req *ArchiveMenuRequest) (*MenuItem, error)
+
+	// Handle Menu Category
+	CreateMenuCategory(ctx context.Context, req *CreateMenuCategoryRequest) (*MenuCategory, error)
+	UpdateMenuCategory(ctx context.Context, req *UpdateMenuCategoryRequest) (*MenuCategory, error)
+	DeleteMenuCategory(ctx context.Context, req *DeleteMenuCategoryRequest) (*DeleteMenuCategoryResponse, error)
+	GetMenuCategories(ctx context.Context, req *emptypb.Empty) (*MenuCategoryList, error)
+	GetMenuCategoryById(ctx context.Context, req *GetMenuCategoryByIdRequest) (*MenuCategory, error)
+
+	// Handle Menu Item Gallery
+	AddMenuItemImage(ctx context.Context, req *AddMenuItemImageRequest) (*MenuItemImage, error)
+	ReorderMenuItemImages(ctx context.Context, req *ReorderMenuItemImagesRequest) (*MenuItemImageList, error)
+	RemoveMenuItemImage(ctx context.Context, req *RemoveMenuItemImageRequest) (*RemoveMenuItemImageResponse, error)
 
 	// Handle Menu Set
 	CreateMenuSet(ctx context.Context, req *CreateMenuSetRequest) (*CreateMenuSetResponse, error)
 	UpdateMenuSet(ctx context.Context, req *UpdateMenuSetRequest) (*UpdateMenuSetResponse, error)
 	DeleteMenuSet(ctx context.Context, req *DeleteMenuSetRequest) (*DeleteMenuSetResponse, error)
-	GetMenuSets(ctx context.Context, req *emptypb.Empty) (*MenuSetList, error)
+	GetMenuSets(ctx context.Context, req *GetMenuSetsRequest) (*MenuSetList, error)
 	GetMenuSetById(ctx context.Context, req *GetMenuSetByIdRequest) (*MenuSet, error)
+	SetMenuSetSchedules(ctx context.Context, req *SetMenuSchedulesRequest) (*MenuScheduleList, error)
+	SetMenuSetSlots(ctx context.Context, req *SetMenuSetSlotsRequest) (*MenuSetSlotList, error)
+	ArchiveMenuSet(ctx context.Context, req *ArchiveMenuRequest) (*MenuSet, error)
+	RestoreMenuSet(ctx context.Context, req *ArchiveMenuRequest) (*MenuSet, error)
+
+	// Handle Menu History
+	GetMenuItemHistory(ctx context.Context, req *GetMenuHistoryRequest) (*MenuHistory, error)
+	GetMenuSetHistory(ctx context.Context, req *GetMenuHistoryRequest) (*MenuHistory, error)
+	ScheduleMenuItemPrice(ctx context.Context, req *ScheduleMenuPriceRequest) (*MenuHistory, error)
+	ScheduleMenuSetPrice(ctx context.Context, req *ScheduleMenuPriceRequest) (*MenuHistory, error)
+
+	// Handle Menu Search
+	SearchMenu(ctx context.Context, req *SearchMenuRequest) (*SearchMenuResponse, error)
+
+	// Handle Menu Import/Export
+	ExportMenu(ctx context.Context, req *emptypb.Empty) (*MenuCatalog, error)
+	ImportMenu(ctx context.Context, req *ImportMenuRequest) (*ImportMenuResponse, error)
 
 	// Handle Menu Set Item
 	CreateMenuSetItem(ctx context.Context, req *CreateMenuSetItemRequest) (*CreateMenuSetItemResponse, error)
@@ -86,7 +121,7 @@ func (s *menuService) DeleteMenuItem(ctx context.Context, req *DeleteMenuItemReq
 	return nil, err
 }
 
-func (s *menuService) GetMenuItems(ctx context.Context, req *emptypb.Empty) (*MenuItemList, error) {
+func (s *menuService) GetMenuItems(ctx context.Context, req *GetMenuItemsRequest) (*MenuItemList, error) {
 	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
 		return s.menuClient.GetMenuItems(ctx, req)
 	})
@@ -106,6 +141,148 @@ func (s *menuService) GetMenuItemById(ctx context.Context, req *GetMenuItemByIdR
 	return nil, err
 }
 
+func (s *menuService) SetMenuItemAvailability(ctx context.Context, req *SetMenuItemAvailabilityRequest) (*MenuItem, error) {
+	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.menuClient.SetMenuItemAvailability(ctx, req)
+	})
+	if res != nil {
+		return res.(*MenuItem), nil
+	}
+	return nil, err
+}
+
+func (s *menuService) SetMenuItemSchedules(ctx context.Context, req *SetMenuSchedulesRequest) (*MenuScheduleList, error) {
+	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.menuClient.SetMenuItemSchedules(ctx, req)
+	})
+	if res != nil {
+		return res.(*MenuScheduleList), nil
+	}
+	return nil, err
+}
+
+func (s *menuService) SetMenuItemTags(ctx context.Context, req *SetMenuItemTagsRequest) (*MenuItem, error) {
+	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.menuClient.SetMenuItemTags(ctx, req)
+	})
+	if res != nil {
+		return res.(*MenuItem), nil
+	}
+	return nil, err
+}
+
+func (s *menuService) SetMenuItemModifiers(ctx context.Context, req *SetMenuItemModifiersRequest) (*MenuModifierGroupList, error) {
+	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.menuClient.SetMenuItemModifiers(ctx, req)
+	})
+	if res != nil {
+		return res.(*MenuModifierGroupList), nil
+	}
+	return nil, err
+}
+
+func (s *menuService) ArchiveMenuItem(ctx context.Context, req *ArchiveMenuRequest) (*MenuItem, error) {
+	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.menuClient.ArchiveMenuItem(ctx, req)
+	})
+	if res != nil {
+		return res.(*MenuItem), nil
+	}
+	return nil, err
+}
+
+func (s *menuService) RestoreMenuItem(ctx context.Context, req *ArchiveMenuRequest) (*MenuItem, error) {
+	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.menuClient.RestoreMenuItem(ctx, req)
+	})
+	if res != nil {
+		return res.(*MenuItem), nil
+	}
+	return nil, err
+}
+
+// Handle Menu Category
+func (s *menuService) CreateMenuCategory(ctx context.Context, req *CreateMenuCategoryRequest) (*MenuCategory, error) {
+	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.menuClient.CreateMenuCategory(ctx, req)
+	})
+	if res != nil {
+		return res.(*MenuCategory), nil
+	}
+	return nil, err
+}
+
+func (s *menuService) UpdateMenuCategory(ctx context.Context, req *UpdateMenuCategoryRequest) (*MenuCategory, error) {
+	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.menuClient.UpdateMenuCategory(ctx, req)
+	})
+	if res != nil {
+		return res.(*MenuCategory), nil
+	}
+	return nil, err
+}
+
+func (s *menuService) DeleteMenuCategory(ctx context.Context, req *DeleteMenuCategoryRequest) (*DeleteMenuCategoryResponse, error) {
+	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.menuClient.DeleteMenuCategory(ctx, req)
+	})
+	if res != nil {
+		return res.(*DeleteMenuCategoryResponse), nil
+	}
+	return nil, err
+}
+
+func (s *menuService) GetMenuCategories(ctx context.Context, req *emptypb.Empty) (*MenuCategoryList, error) {
+	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.menuClient.GetMenuCategories(ctx, req)
+	})
+	if res != nil {
+		return res.(*MenuCategoryList), nil
+	}
+	return nil, err
+}
+
+func (s *menuService) GetMenuCategoryById(ctx context.Context, req *GetMenuCategoryByIdRequest) (*MenuCategory, error) {
+	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.menuClient.GetMenuCategoryById(ctx, req)
+	})
+	if res != nil {
+		return res.(*MenuCategory), nil
+	}
+	return nil, err
+}
+
+// Handle Menu Item Gallery
+func (s *menuService) AddMenuItemImage(ctx context.Context, req *AddMenuItemImageRequest) (*MenuItemImage, error) {
+	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.menuClient.AddMenuItemImage(ctx, req)
+	})
+	if res != nil {
+		return res.(*MenuItemImage), nil
+	}
+	return nil, err
+}
+
+func (s *menuService) ReorderMenuItemImages(ctx context.Context, req *ReorderMenuItemImagesRequest) (*MenuItemImageList, error) {
+	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.menuClient.ReorderMenuItemImages(ctx, req)
+	})
+	if res != nil {
+		return res.(*MenuItemImageList), nil
+	}
+	return nil, err
+}
+
+func (s *menuService) RemoveMenuItemImage(ctx context.Context, req *RemoveMenuItemImageRequest) (*RemoveMenuItemImageResponse, error) {
+	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.menuClient.RemoveMenuItemImage(ctx, req)
+	})
+	if res != nil {
+		return res.(*RemoveMenuItemImageResponse), nil
+	}
+	return nil, err
+}
+
 // Handle Menu Set
 func (s *menuService) CreateMenuSet(ctx context.Context, req *CreateMenuSetRequest) (*CreateMenuSetResponse, error) {
 	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
@@ -137,7 +314,7 @@ func (s *menuService) DeleteMenuSet(ctx context.Context, req *DeleteMenuSetReque
 	return nil, err
 }
 
-func (s *menuService) GetMenuSets(ctx context.Context, req *emptypb.Empty) (*MenuSetList, error) {
+func (s *menuService) GetMenuSets(ctx context.Context, req *GetMenuSetsRequest) (*MenuSetList, error) {
 	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
 		return s.menuClient.GetMenuSets(ctx, req)
 	})
@@ -157,6 +334,119 @@ func (s *menuService) GetMenuSetById(ctx context.Context, req *GetMenuSetByIdReq
 	return nil, err
 }
 
+func (s *menuService) SetMenuSetSchedules(ctx context.Context, req *SetMenuSchedulesRequest) (*MenuScheduleList, error) {
+	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.menuClient.SetMenuSetSchedules(ctx, req)
+	})
+	if res != nil {
+		return res.(*MenuScheduleList), nil
+	}
+	return nil, err
+}
+
+func (s *menuService) SetMenuSetSlots(ctx context.Context, req *SetMenuSetSlotsRequest) (*MenuSetSlotList, error) {
+	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.menuClient.SetMenuSetSlots(ctx, req)
+	})
+	if res != nil {
+		return res.(*MenuSetSlotList), nil
+	}
+	return nil, err
+}
+
+func (s *menuService) ArchiveMenuSet(ctx context.Context, req *ArchiveMenuRequest) (*MenuSet, error) {
+	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.menuClient.ArchiveMenuSet(ctx, req)
+	})
+	if res != nil {
+		return res.(*MenuSet), nil
+	}
+	return nil, err
+}
+
+func (s *menuService) RestoreMenuSet(ctx context.Context, req *ArchiveMenuRequest) (*MenuSet, error) {
+	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.menuClient.RestoreMenuSet(ctx, req)
+	})
+	if res != nil {
+		return res.(*MenuSet), nil
+	}
+	return nil, err
+}
+
+// Handle Menu History
+func (s *menuService) GetMenuItemHistory(ctx context.Context, req *GetMenuHistoryRequest) (*MenuHistory, error) {
+	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.menuClient.GetMenuItemHistory(ctx, req)
+	})
+	if res != nil {
+		return res.(*MenuHistory), nil
+	}
+	return nil, err
+}
+
+func (s *menuService) GetMenuSetHistory(ctx context.Context, req *GetMenuHistoryRequest) (*MenuHistory, error) {
+	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.menuClient.GetMenuSetHistory(ctx, req)
+	})
+	if res != nil {
+		return res.(*MenuHistory), nil
+	}
+	return nil, err
+}
+
+func (s *menuService) ScheduleMenuItemPrice(ctx context.Context, req *ScheduleMenuPriceRequest) (*MenuHistory, error) {
+	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.menuClient.ScheduleMenuItemPrice(ctx, req)
+	})
+	if res != nil {
+		return res.(*MenuHistory), nil
+	}
+	return nil, err
+}
+
+func (s *menuService) ScheduleMenuSetPrice(ctx context.Context, req *ScheduleMenuPriceRequest) (*MenuHistory, error) {
+	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.menuClient.ScheduleMenuSetPrice(ctx, req)
+	})
+	if res != nil {
+		return res.(*MenuHistory), nil
+	}
+	return nil, err
+}
+
+// Handle Menu Search
+func (s *menuService) SearchMenu(ctx context.Context, req *SearchMenuRequest) (*SearchMenuResponse, error) {
+	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.menuClient.SearchMenu(ctx, req)
+	})
+	if res != nil {
+		return res.(*SearchMenuResponse), nil
+	}
+	return nil, err
+}
+
+// Handle Menu Import/Export
+func (s *menuService) ExportMenu(ctx context.Context, req *emptypb.Empty) (*MenuCatalog, error) {
+	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.menuClient.ExportMenu(ctx, req)
+	})
+	if res != nil {
+		return res.(*MenuCatalog), nil
+	}
+	return nil, err
+}
+
+func (s *menuService) ImportMenu(ctx context.Context, req *ImportMenuRequest) (*ImportMenuResponse, error) {
+	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.menuClient.ImportMenu(ctx, req)
+	})
+	if res != nil {
+		return res.(*ImportMenuResponse), nil
+	}
+	return nil, err
+}
+
 // Handle Menu Set Item
 func (s *menuService) CreateMenuSetItem(ctx context.Context, req *CreateMenuSetItemRequest) (*CreateMenuSetItemResponse, error) {
 	res, err := s.createWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
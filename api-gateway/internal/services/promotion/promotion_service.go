@@ -0,0 +1,100 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"gitlab.com/final_project1240930/api_gateway/internal/logs"
+	"go.uber.org/zap"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+type PromotionService interface {
+	CreatePromotion(ctx context.Context, req *Promotion) (*CreatePromotionResponse, error)
+	UpdatePromotion(ctx context.Context, req *Promotion) (*UpdatePromotionResponse, error)
+	DeletePromotion(ctx context.Context, req *PromotionIDRequest) (*DeletePromotionResponse, error)
+	GetPromotions(ctx context.Context, req *emptypb.Empty) (*GetPromotionsResponse, error)
+	GetPromotionByID(ctx context.Context, req *PromotionIDRequest) (*Promotion, error)
+	GetPromotionRedemptions(ctx context.Context, req *PromotionIDRequest) (*GetPromotionRedemptionsResponse, error)
+}
+
+type promotionService struct {
+	promotionClient PromotionServiceClient
+}
+
+func NewPromotionService(promotionClient PromotionServiceClient) PromotionService {
+	return &promotionService{promotionClient: promotionClient}
+}
+
+// wrapper function with timeout, logging, and error handling
+func (s *promotionService) callWithTimeout(ctx context.Context, call func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*5) // Set timeout
+	defer cancel()
+
+	res, err := call(ctx)
+	if err != nil {
+		logs.Error("Error calling service", zap.Error(err))
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *promotionService) CreatePromotion(ctx context.Context, req *Promotion) (*CreatePromotionResponse, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.promotionClient.CreatePromotion(ctx, req)
+	})
+	if res != nil {
+		return res.(*CreatePromotionResponse), nil
+	}
+	return nil, err
+}
+
+func (s *promotionService) UpdatePromotion(ctx context.Context, req *Promotion) (*UpdatePromotionResponse, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.promotionClient.UpdatePromotion(ctx, req)
+	})
+	if res != nil {
+		return res.(*UpdatePromotionResponse), nil
+	}
+	return nil, err
+}
+
+func (s *promotionService) DeletePromotion(ctx context.Context, req *PromotionIDRequest) (*DeletePromotionResponse, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.promotionClient.DeletePromotion(ctx, req)
+	})
+	if res != nil {
+		return res.(*DeletePromotionResponse), nil
+	}
+	return nil, err
+}
+
+func (s *promotionService) GetPromotions(ctx context.Context, req *emptypb.Empty) (*GetPromotionsResponse, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.promotionClient.GetPromotions(ctx, req)
+	})
+	if res != nil {
+		return res.(*GetPromotionsResponse), nil
+	}
+	return nil, err
+}
+
+func (s *promotionService) GetPromotionByID(ctx context.Context, req *PromotionIDRequest) (*Promotion, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.promotionClient.GetPromotionByID(ctx, req)
+	})
+	if res != nil {
+		return res.(*Promotion), nil
+	}
+	return nil, err
+}
+
+func (s *promotionService) GetPromotionRedemptions(ctx context.Context, req *PromotionIDRequest) (*GetPromotionRedemptionsResponse, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.promotionClient.GetPromotionRedemptions(ctx, req)
+	})
+	if res != nil {
+		return res.(*GetPromotionRedemptionsResponse), nil
+	}
+	return nil, err
+}
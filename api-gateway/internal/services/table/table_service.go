@@ -18,9 +18,29 @@ type TableService interface {
 	GetTableByNumTable(ctx context.Context, req *GetTableByNumTableRequest) (*GetTableByNumTableResponse, error)
 	GetAvailableTables(ctx context.Context, req *GetAvailableTablesRequest) (*GetAvailableTablesResponse, error)
 
-	// Handle Table Type Count
-	UpdateTableType(ctx context.Context, req *UpdateTableTypeRequest) (*UpdateTableTypeResponse, error)
+	// Handle Floor Plan
+	CreateTableZone(ctx context.Context, req *CreateTableZoneRequest) (*TableZone, error)
+	UpdateTableZone(ctx context.Context, req *UpdateTableZoneRequest) (*TableZone, error)
+	DeleteTableZone(ctx context.Context, req *DeleteTableZoneRequest) (*DeleteTableZoneResponse, error)
+	ListTableZones(ctx context.Context, req *emptypb.Empty) (*TableZoneList, error)
+	UpdateTableLayouts(ctx context.Context, req *UpdateTableLayoutsRequest) (*UpdateTableLayoutsResponse, error)
+
+	// Handle Table Combination
+	CreateTableCombination(ctx context.Context, req *CreateTableCombinationRequest) (*TableCombination, error)
+	UpdateTableCombination(ctx context.Context, req *UpdateTableCombinationRequest) (*TableCombination, error)
+	DeleteTableCombination(ctx context.Context, req *DeleteTableCombinationRequest) (*DeleteTableCombinationResponse, error)
+	ListTableCombinations(ctx context.Context, req *emptypb.Empty) (*TableCombinationList, error)
+
+	// Handle Table Type
+	CreateTableType(ctx context.Context, req *CreateTableTypeRequest) (*TableType, error)
+	UpdateTableType(ctx context.Context, req *UpdateTableTypeRequest) (*TableType, error)
+	DeleteTableType(ctx context.Context, req *DeleteTableTypeRequest) (*DeleteTableTypeResponse, error)
 	ListTableTypes(ctx context.Context, req *emptypb.Empty) (*TableTypeList, error)
+
+	// Handle Table Block
+	CreateTableBlock(ctx context.Context, req *CreateTableBlockRequest) (*TableBlock, error)
+	DeleteTableBlock(ctx context.Context, req *DeleteTableBlockRequest) (*DeleteTableBlockResponse, error)
+	ListTableBlocks(ctx context.Context, req *ListTableBlocksRequest) (*TableBlockList, error)
 }
 
 type tableService struct {
@@ -106,12 +126,122 @@ func (s *tableService) GetAvailableTables(ctx context.Context, req *GetAvailable
 	return nil, err
 }
 
-func (s *tableService) UpdateTableType(ctx context.Context, req *UpdateTableTypeRequest) (*UpdateTableTypeResponse, error) {
+func (s *tableService) CreateTableZone(ctx context.Context, req *CreateTableZoneRequest) (*TableZone, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.tableClient.CreateTableZone(ctx, req)
+	})
+	if res != nil {
+		return res.(*TableZone), nil
+	}
+	return nil, err
+}
+
+func (s *tableService) UpdateTableZone(ctx context.Context, req *UpdateTableZoneRequest) (*TableZone, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.tableClient.UpdateTableZone(ctx, req)
+	})
+	if res != nil {
+		return res.(*TableZone), nil
+	}
+	return nil, err
+}
+
+func (s *tableService) DeleteTableZone(ctx context.Context, req *DeleteTableZoneRequest) (*DeleteTableZoneResponse, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.tableClient.DeleteTableZone(ctx, req)
+	})
+	if res != nil {
+		return res.(*DeleteTableZoneResponse), nil
+	}
+	return nil, err
+}
+
+func (s *tableService) ListTableZones(ctx context.Context, req *emptypb.Empty) (*TableZoneList, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.tableClient.ListTableZones(ctx, req)
+	})
+	if res != nil {
+		return res.(*TableZoneList), nil
+	}
+	return nil, err
+}
+
+func (s *tableService) UpdateTableLayouts(ctx context.Context, req *UpdateTableLayoutsRequest) (*UpdateTableLayoutsResponse, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.tableClient.UpdateTableLayouts(ctx, req)
+	})
+	if res != nil {
+		return res.(*UpdateTableLayoutsResponse), nil
+	}
+	return nil, err
+}
+
+func (s *tableService) CreateTableCombination(ctx context.Context, req *CreateTableCombinationRequest) (*TableCombination, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.tableClient.CreateTableCombination(ctx, req)
+	})
+	if res != nil {
+		return res.(*TableCombination), nil
+	}
+	return nil, err
+}
+
+func (s *tableService) UpdateTableCombination(ctx context.Context, req *UpdateTableCombinationRequest) (*TableCombination, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.tableClient.UpdateTableCombination(ctx, req)
+	})
+	if res != nil {
+		return res.(*TableCombination), nil
+	}
+	return nil, err
+}
+
+func (s *tableService) DeleteTableCombination(ctx context.Context, req *DeleteTableCombinationRequest) (*DeleteTableCombinationResponse, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.tableClient.DeleteTableCombination(ctx, req)
+	})
+	if res != nil {
+		return res.(*DeleteTableCombinationResponse), nil
+	}
+	return nil, err
+}
+
+func (s *tableService) ListTableCombinations(ctx context.Context, req *emptypb.Empty) (*TableCombinationList, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.tableClient.ListTableCombinations(ctx, req)
+	})
+	if res != nil {
+		return res.(*TableCombinationList), nil
+	}
+	return nil, err
+}
+
+func (s *tableService) CreateTableType(ctx context.Context, req *CreateTableTypeRequest) (*TableType, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.tableClient.CreateTableType(ctx, req)
+	})
+	if res != nil {
+		return res.(*TableType), nil
+	}
+	return nil, err
+}
+
+func (s *tableService) UpdateTableType(ctx context.Context, req *UpdateTableTypeRequest) (*TableType, error) {
 	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
 		return s.tableClient.UpdateTableType(ctx, req)
 	})
 	if res != nil {
-		return res.(*UpdateTableTypeResponse), nil
+		return res.(*TableType), nil
+	}
+	return nil, err
+}
+
+func (s *tableService) DeleteTableType(ctx context.Context, req *DeleteTableTypeRequest) (*DeleteTableTypeResponse, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.tableClient.DeleteTableType(ctx, req)
+	})
+	if res != nil {
+		return res.(*DeleteTableTypeResponse), nil
 	}
 	return nil, err
 }
@@ -125,3 +255,33 @@ func (s *tableService) ListTableTypes(ctx context.Context, req *emptypb.Empty) (
 	}
 	return nil, err
 }
+
+func (s *tableService) CreateTableBlock(ctx context.Context, req *CreateTableBlockRequest) (*TableBlock, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.tableClient.CreateTableBlock(ctx, req)
+	})
+	if res != nil {
+		return res.(*TableBlock), nil
+	}
+	return nil, err
+}
+
+func (s *tableService) DeleteTableBlock(ctx context.Context, req *DeleteTableBlockRequest) (*DeleteTableBlockResponse, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.tableClient.DeleteTableBlock(ctx, req)
+	})
+	if res != nil {
+		return res.(*DeleteTableBlockResponse), nil
+	}
+	return nil, err
+}
+
+func (s *tableService) ListTableBlocks(ctx context.Context, req *ListTableBlocksRequest) (*TableBlockList, error) {
+	res, err := s.callWithTimeout(ctx, func(ctx context.Context) (interface{}, error) {
+		return s.tableClient.ListTableBlocks(ctx, req)
+	})
+	if res != nil {
+		return res.(*TableBlockList), nil
+	}
+	return nil, err
+}
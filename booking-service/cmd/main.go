@@ -8,6 +8,7 @@ import (
 
 	"github.com/joho/godotenv"
 	"gitlab.com/final_project1240930/booking_service/internal/logs"
+	"gitlab.com/final_project1240930/booking_service/internal/pricing"
 	"gitlab.com/final_project1240930/booking_service/internal/repository"
 	"gitlab.com/final_project1240930/booking_service/internal/services"
 	"go.uber.org/zap"
@@ -63,7 +64,12 @@ func main() {
 
 	// --------------------------- Booking -------------------------------
 
-	bookingRepositoryDB := repository.NewBookingRepository(db)
+	pricingConfig, err := pricing.ConfigFromEnv()
+	if err != nil {
+		logs.Fatal("Invalid pricing configuration", zap.Error(err))
+	}
+
+	bookingRepositoryDB := repository.NewBookingRepository(db, pricingConfig)
 	services.RegisterBookingServiceServer(s, services.NewBookingServer(bookingRepositoryDB))
 
 	// --------------------------- Dashboard -------------------------------
@@ -71,6 +77,11 @@ func main() {
 	dashboardRepositoryDB := repository.NewDashboardRepository(db)
 	services.RegisterDashboardServiceServer(s, services.NewDashboardServer(dashboardRepositoryDB))
 
+	// --------------------------- Promotion -------------------------------
+
+	promotionRepositoryDB := repository.NewPromotionRepository(db)
+	services.RegisterPromotionServiceServer(s, services.NewPromotionServer(promotionRepositoryDB))
+
 	// -----------------------------------------------------------------
 
 	logs.Info("Server running on port " + appPort)
@@ -0,0 +1,253 @@
+// Package pricing คำนวณยอดเงินของการจอง (ส่วนลด ค่าบริการ VAT และการปัดเศษ)
+// จำนวนเงินทั้งหมดเป็นหน่วยสตางค์ (int64) เพื่อไม่ให้เกิดความคลาดเคลื่อนของ float
+package pricing
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Config คือค่าตั้งค่าการคิดราคา
+type Config struct {
+	ServiceChargePercent float64 // ค่าบริการ คิดจากยอดหลังหักส่วนลด (เช่น 10)
+	VATPercent           float64 // ภาษีมูลค่าเพิ่ม (เช่น 7)
+	VATInclusive         bool    // true = ราคาเมนูรวม VAT แล้ว, false = บวก VAT เพิ่ม
+	RoundingUnit         int64   // ปัดยอดสุทธิเป็นทวีคูณของกี่สตางค์ (1 = ไม่ปัด, 25 = ปัดเป็น 0.25 บาท, 100 = ปัดเป็นบาท)
+}
+
+// DefaultConfig ค่าบริการ 10% และ VAT 7% แบบบวกเพิ่ม ไม่ปัดเศษ
+var DefaultConfig = Config{
+	ServiceChargePercent: 10,
+	VATPercent:           7,
+	VATInclusive:         false,
+	RoundingUnit:         1,
+}
+
+// ConfigFromEnv อ่านค่าจาก SERVICE_CHARGE_PERCENT, VAT_PERCENT, VAT_INCLUSIVE และ PRICE_ROUNDING_UNIT
+// ตัวแปรที่ไม่ได้ตั้งค่าจะใช้ค่าจาก DefaultConfig
+func ConfigFromEnv() (Config, error) {
+	cfg := DefaultConfig
+
+	if value := os.Getenv("SERVICE_CHARGE_PERCENT"); value != "" {
+		percent, err := parsePercent(value)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid SERVICE_CHARGE_PERCENT: %w", err)
+		}
+		cfg.ServiceChargePercent = percent
+	}
+	if value := os.Getenv("VAT_PERCENT"); value != "" {
+		percent, err := parsePercent(value)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid VAT_PERCENT: %w", err)
+		}
+		cfg.VATPercent = percent
+	}
+	if value := os.Getenv("VAT_INCLUSIVE"); value != "" {
+		inclusive, err := strconv.ParseBool(value)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid VAT_INCLUSIVE: %w", err)
+		}
+		cfg.VATInclusive = inclusive
+	}
+	if value := os.Getenv("PRICE_ROUNDING_UNIT"); value != "" {
+		unit, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || unit <= 0 {
+			return Config{}, fmt.Errorf("invalid PRICE_ROUNDING_UNIT: %q", value)
+		}
+		cfg.RoundingUnit = unit
+	}
+
+	return cfg, nil
+}
+
+func parsePercent(value string) (float64, error) {
+	percent, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, err
+	}
+	if percent < 0 || percent > 100 {
+		return 0, errors.New("must be between 0 and 100")
+	}
+	return percent, nil
+}
+
+// FromBaht แปลงราคาบาทเป็นสตางค์ (ปัดครึ่งขึ้น)
+func FromBaht(baht float64) int64 {
+	return int64(math.Round(baht * 100))
+}
+
+// ToBaht แปลงสตางค์เป็นบาท ใช้กับคอลัมน์ total_price เดิมเท่านั้น
+func ToBaht(satang int64) float64 {
+	return float64(satang) / 100
+}
+
+// Discount คือส่วนลดของรายการหรือของทั้งบิล ถ้ากำหนดทั้งสองแบบจะหักเปอร์เซ็นต์ก่อนแล้วหักจำนวนเงิน
+type Discount struct {
+	Percent float64 // 0-100
+	Amount  int64   // สตางค์
+}
+
+func (d Discount) validate() error {
+	if d.Percent < 0 || d.Percent > 100 {
+		return fmt.Errorf("discount percent must be between 0 and 100")
+	}
+	if d.Amount < 0 {
+		return fmt.Errorf("discount amount must not be negative")
+	}
+	return nil
+}
+
+// of คืนค่าส่วนลดของยอด amount โดยไม่เกินยอดนั้น
+func (d Discount) of(amount int64) int64 {
+	discount := percentOf(amount, d.Percent) + d.Amount
+	if discount > amount {
+		return amount
+	}
+	return discount
+}
+
+// percentOf คิดเปอร์เซ็นต์แล้วปัดครึ่งขึ้นเป็นสตางค์
+func percentOf(amount int64, percent float64) int64 {
+	return int64(math.Round(float64(amount) * percent / 100))
+}
+
+// ประเภทของรายการในบิล
+const (
+	KindMenuSet  = "MENU_SET"
+	KindMenuItem = "MENU_ITEM"
+)
+
+// Line คือหนึ่งรายการในบิล
+type Line struct {
+	Kind      string // KindMenuSet หรือ KindMenuItem
+	RefID     string
+	Name      string
+	UnitPrice int64 // รวมราคาของ Modifiers แล้ว
+	Quantity  int32
+	Discount  Discount
+	Modifiers []Modifier
+}
+
+// Modifier คือตัวเลือกที่เลือกของรายการ (เช่น เผ็ดมาก, เพิ่มไข่ดาว หรือเมนูที่เลือกในเซ็ต)
+// Price คือราคาที่บวกเพิ่มต่อหนึ่งที่เลือก รายการหนึ่งหน่วยจึงแพงขึ้น Price x Quantity
+type Modifier struct {
+	RefID    string
+	GroupID  string
+	Group    string
+	Name     string
+	Price    int64
+	Quantity int32
+}
+
+type LineResult struct {
+	Line
+	Gross          int64 // ราคาต่อหน่วย x จำนวน
+	DiscountAmount int64
+	Net            int64
+}
+
+// Breakdown คือรายละเอียดยอดเงินของการจอง
+type Breakdown struct {
+	Lines         []LineResult
+	Subtotal      int64 // ผลรวมก่อนหักส่วนลด
+	LineDiscount  int64
+	OrderDiscount int64
+	// ส่วนลดจากโค้ดโปรโมชั่น หักหลังส่วนลดท้ายบิล
+	PromotionDiscount int64
+	PromotionID       string
+	PromotionCode     string
+	ServiceCharge     int64
+	VAT               int64 // กรณี VAT รวมในราคาแล้ว เป็นยอด VAT ที่แยกออกมาแสดงเท่านั้น
+	Rounding          int64 // ส่วนต่างจากการปัดเศษ (บวกหรือลบ)
+	Total             int64
+
+	ServiceChargePercent float64
+	VATPercent           float64
+	VATInclusive         bool
+}
+
+// Order คือข้อมูลของการจองที่ใช้คิดราคา
+type Order struct {
+	Lines           []Line
+	Discount        Discount   // ส่วนลดท้ายบิล
+	Promotion       *Promotion // nil = ไม่ใช้โค้ดโปรโมชั่น
+	NumAdults       int32
+	NumChildren     int32
+	BookingDateTime time.Time
+}
+
+// Calculate คิดยอดเงินตามลำดับ: ส่วนลดรายการ -> ส่วนลดท้ายบิล -> โปรโมชั่น -> ค่าบริการ -> VAT -> ปัดเศษ
+func Calculate(cfg Config, order Order) (Breakdown, error) {
+	if err := order.Discount.validate(); err != nil {
+		return Breakdown{}, fmt.Errorf("order %w", err)
+	}
+
+	b := Breakdown{
+		ServiceChargePercent: cfg.ServiceChargePercent,
+		VATPercent:           cfg.VATPercent,
+		VATInclusive:         cfg.VATInclusive,
+	}
+
+	for _, line := range order.Lines {
+		if line.Quantity <= 0 {
+			return Breakdown{}, fmt.Errorf("quantity of %s must be at least 1", line.Name)
+		}
+		if line.UnitPrice < 0 {
+			return Breakdown{}, fmt.Errorf("price of %s must not be negative", line.Name)
+		}
+		if err := line.Discount.validate(); err != nil {
+			return Breakdown{}, fmt.Errorf("%s: %w", line.Name, err)
+		}
+
+		gross := line.UnitPrice * int64(line.Quantity)
+		discount := line.Discount.of(gross)
+		b.Lines = append(b.Lines, LineResult{Line: line, Gross: gross, DiscountAmount: discount, Net: gross - discount})
+		b.Subtotal += gross
+		b.LineDiscount += discount
+	}
+
+	net := b.Subtotal - b.LineDiscount
+	b.OrderDiscount = order.Discount.of(net)
+	net -= b.OrderDiscount
+
+	if promotion := order.Promotion; promotion != nil {
+		if err := promotion.eligible(order); err != nil {
+			return Breakdown{}, err
+		}
+		discount, err := promotion.discount(order, b.Lines, net)
+		if err != nil {
+			return Breakdown{}, err
+		}
+		b.PromotionDiscount = discount
+		b.PromotionID = promotion.ID
+		b.PromotionCode = promotion.Code
+		net -= discount
+	}
+
+	b.ServiceCharge = percentOf(net, cfg.ServiceChargePercent)
+	taxable := net + b.ServiceCharge
+
+	total := taxable
+	if cfg.VATInclusive {
+		b.VAT = int64(math.Round(float64(taxable) * cfg.VATPercent / (100 + cfg.VATPercent)))
+	} else {
+		b.VAT = percentOf(taxable, cfg.VATPercent)
+		total += b.VAT
+	}
+
+	b.Total = roundTo(total, cfg.RoundingUnit)
+	b.Rounding = b.Total - total
+	return b, nil
+}
+
+// roundTo ปัดครึ่งขึ้นเป็นทวีคูณของ unit
+func roundTo(amount, unit int64) int64 {
+	if unit <= 1 {
+		return amount
+	}
+	return (amount + unit/2) / unit * unit
+}
@@ -0,0 +1,242 @@
+package pricing
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// noCharges ไม่มีค่าบริการ VAT และการปัดเศษ ใช้ทดสอบส่วนลดอย่างเดียว
+var noCharges = Config{RoundingUnit: 1}
+
+func setLine(id string, unitPrice int64, quantity int32) Line {
+	return Line{Kind: KindMenuSet, RefID: id, Name: id, UnitPrice: unitPrice, Quantity: quantity}
+}
+
+func TestCalculateTotals(t *testing.T) {
+	tests := []struct {
+		name  string
+		cfg   Config
+		order Order
+		want  Breakdown
+	}{
+		{
+			name:  "exclusive VAT is added on top of service charge",
+			cfg:   Config{ServiceChargePercent: 10, VATPercent: 7, RoundingUnit: 1},
+			order: Order{Lines: []Line{setLine("A", 100000, 1)}},
+			want:  Breakdown{Subtotal: 100000, ServiceCharge: 10000, VAT: 7700, Total: 117700},
+		},
+		{
+			name:  "inclusive VAT is extracted from the total",
+			cfg:   Config{ServiceChargePercent: 10, VATPercent: 7, VATInclusive: true, RoundingUnit: 1},
+			order: Order{Lines: []Line{setLine("A", 100000, 1)}},
+			// 110000 * 7 / 107 = 7196.26
+			want: Breakdown{Subtotal: 100000, ServiceCharge: 10000, VAT: 7196, Total: 110000},
+		},
+		{
+			name:  "service charge rounds half up to the satang",
+			cfg:   Config{ServiceChargePercent: 10, RoundingUnit: 1},
+			order: Order{Lines: []Line{setLine("A", 105, 1)}},
+			want:  Breakdown{Subtotal: 105, ServiceCharge: 11, Total: 116},
+		},
+		{
+			name:  "rounding to 25 satang rounds down below the midpoint",
+			cfg:   Config{RoundingUnit: 25},
+			order: Order{Lines: []Line{setLine("A", 112, 1)}},
+			want:  Breakdown{Subtotal: 112, Rounding: -12, Total: 100},
+		},
+		{
+			name:  "rounding to 25 satang rounds up from the midpoint",
+			cfg:   Config{RoundingUnit: 25},
+			order: Order{Lines: []Line{setLine("A", 113, 1)}},
+			want:  Breakdown{Subtotal: 113, Rounding: 12, Total: 125},
+		},
+		{
+			name:  "rounding to whole baht",
+			cfg:   Config{RoundingUnit: 100},
+			order: Order{Lines: []Line{setLine("A", 10050, 1)}},
+			want:  Breakdown{Subtotal: 10050, Rounding: 50, Total: 10100},
+		},
+		{
+			name: "line discount percent is taken before amount",
+			cfg:  noCharges,
+			order: Order{Lines: []Line{{
+				Kind: KindMenuItem, RefID: "B", Name: "B", UnitPrice: 10000, Quantity: 1,
+				Discount: Discount{Percent: 10, Amount: 2000},
+			}}},
+			want: Breakdown{Subtotal: 10000, LineDiscount: 3000, Total: 7000},
+		},
+		{
+			name: "line discount larger than the line is capped at the line",
+			cfg:  noCharges,
+			order: Order{Lines: []Line{
+				{Kind: KindMenuItem, RefID: "B", Name: "B", UnitPrice: 10000, Quantity: 2, Discount: Discount{Amount: 50000}},
+				setLine("A", 5000, 1),
+			}},
+			want: Breakdown{Subtotal: 25000, LineDiscount: 20000, Total: 5000},
+		},
+		{
+			name: "order discount larger than the bill is capped at the bill",
+			cfg:  Config{ServiceChargePercent: 10, VATPercent: 7, RoundingUnit: 1},
+			order: Order{
+				Lines:    []Line{setLine("A", 10000, 1)},
+				Discount: Discount{Amount: 99999},
+			},
+			want: Breakdown{Subtotal: 10000, OrderDiscount: 10000, Total: 0},
+		},
+		{
+			name: "discounts apply in order: line, order, promotion, service charge, VAT",
+			cfg:  Config{ServiceChargePercent: 10, VATPercent: 7, RoundingUnit: 1},
+			order: Order{
+				Lines:     []Line{setLine("A", 100000, 1)},
+				Discount:  Discount{Percent: 10},
+				Promotion: &Promotion{Code: "TEN", DiscountType: PromotionPercent, Percent: 10, Active: true},
+			},
+			// 100000 -> 90000 -> 81000, ค่าบริการ 8100, VAT 7% ของ 89100 = 6237
+			want: Breakdown{Subtotal: 100000, OrderDiscount: 10000, PromotionDiscount: 9000, PromotionCode: "TEN", ServiceCharge: 8100, VAT: 6237, Total: 95337},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Calculate(tt.cfg, tt.order)
+			if err != nil {
+				t.Fatalf("Calculate() error = %v", err)
+			}
+			if got.Subtotal != tt.want.Subtotal || got.LineDiscount != tt.want.LineDiscount ||
+				got.OrderDiscount != tt.want.OrderDiscount || got.PromotionDiscount != tt.want.PromotionDiscount ||
+				got.PromotionCode != tt.want.PromotionCode || got.ServiceCharge != tt.want.ServiceCharge ||
+				got.VAT != tt.want.VAT || got.Rounding != tt.want.Rounding || got.Total != tt.want.Total {
+				t.Errorf("Calculate() = %+v, want %+v", got, tt.want)
+			}
+
+			var net int64
+			for _, line := range got.Lines {
+				if line.Net != line.Gross-line.DiscountAmount {
+					t.Errorf("line %s net = %d, want gross %d - discount %d", line.RefID, line.Net, line.Gross, line.DiscountAmount)
+				}
+				net += line.Net
+			}
+			if net != got.Subtotal-got.LineDiscount {
+				t.Errorf("sum of line net = %d, want subtotal - line discount = %d", net, got.Subtotal-got.LineDiscount)
+			}
+		})
+	}
+}
+
+func TestCalculatePromotionDiscount(t *testing.T) {
+	tests := []struct {
+		name      string
+		lines     []Line
+		children  int32
+		promotion Promotion
+		want      int64
+	}{
+		{
+			name:      "whole-bill percent",
+			lines:     []Line{setLine("A", 30000, 2), setLine("B", 40000, 1)},
+			promotion: Promotion{DiscountType: PromotionPercent, Percent: 15},
+			want:      15000,
+		},
+		{
+			name:      "whole-bill amount is capped at the bill",
+			lines:     []Line{setLine("A", 30000, 1)},
+			promotion: Promotion{DiscountType: PromotionAmount, Amount: 50000},
+			want:      30000,
+		},
+		{
+			name:      "menu-set percent applies only to listed sets",
+			lines:     []Line{setLine("A", 30000, 2), setLine("B", 40000, 1)},
+			promotion: Promotion{DiscountType: PromotionPercent, Percent: 10, MenuSetIDs: []string{"B"}},
+			want:      4000,
+		},
+		{
+			name:      "menu-set amount is per set and capped at each line",
+			lines:     []Line{setLine("A", 3000, 2), setLine("B", 40000, 1)},
+			promotion: Promotion{DiscountType: PromotionAmount, Amount: 5000, MenuSetIDs: []string{"A", "B"}},
+			// A: min(5000 x 2, 6000) = 6000, B: min(5000, 40000) = 5000
+			want: 11000,
+		},
+		{
+			name:      "children eat the cheapest sets free",
+			lines:     []Line{setLine("A", 50000, 2), setLine("B", 20000, 1)},
+			children:  2,
+			promotion: Promotion{DiscountType: PromotionChildrenFree},
+			want:      70000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.promotion.Code = "PROMO"
+			tt.promotion.Active = true
+			got, err := Calculate(noCharges, Order{Lines: tt.lines, NumAdults: 2, NumChildren: tt.children, Promotion: &tt.promotion})
+			if err != nil {
+				t.Fatalf("Calculate() error = %v", err)
+			}
+			if got.PromotionDiscount != tt.want {
+				t.Errorf("PromotionDiscount = %d, want %d", got.PromotionDiscount, tt.want)
+			}
+			if got.Total != got.Subtotal-got.PromotionDiscount {
+				t.Errorf("Total = %d, want %d", got.Total, got.Subtotal-got.PromotionDiscount)
+			}
+		})
+	}
+}
+
+func TestCalculatePromotionEligibility(t *testing.T) {
+	bookingTime := time.Date(2024, 12, 16, 18, 0, 0, 0, time.UTC) // วันจันทร์
+	before := bookingTime.AddDate(0, 0, -1)
+
+	tests := []struct {
+		name      string
+		promotion Promotion
+		wantErr   bool
+	}{
+		{name: "active promotion applies", promotion: Promotion{Active: true}},
+		{name: "inactive promotion is rejected", promotion: Promotion{}, wantErr: true},
+		{name: "expired promotion is rejected", promotion: Promotion{Active: true, ValidUntil: &before}, wantErr: true},
+		{name: "wrong day of week is rejected", promotion: Promotion{Active: true, DaysOfWeek: []time.Weekday{time.Sunday}}, wantErr: true},
+		{name: "party too small is rejected", promotion: Promotion{Active: true, MinPartySize: 5}, wantErr: true},
+		{name: "usage limit reached is rejected", promotion: Promotion{Active: true, UsageLimit: 3, UsedCount: 3}, wantErr: true},
+		{name: "already redeemed skips the checks", promotion: Promotion{ValidUntil: &before, UsageLimit: 3, UsedCount: 3, Redeemed: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.promotion.Code = "PROMO"
+			tt.promotion.DiscountType = PromotionPercent
+			tt.promotion.Percent = 10
+			_, err := Calculate(noCharges, Order{
+				Lines:           []Line{setLine("A", 10000, 1)},
+				NumAdults:       2,
+				BookingDateTime: bookingTime,
+				Promotion:       &tt.promotion,
+			})
+			var promotionErr *PromotionError
+			if tt.wantErr != errors.As(err, &promotionErr) {
+				t.Errorf("Calculate() error = %v, want PromotionError: %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCalculateRejectsInvalidInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		order Order
+	}{
+		{name: "zero quantity", order: Order{Lines: []Line{setLine("A", 10000, 0)}}},
+		{name: "negative price", order: Order{Lines: []Line{setLine("A", -1, 1)}}},
+		{name: "line discount over 100 percent", order: Order{Lines: []Line{{Kind: KindMenuSet, RefID: "A", UnitPrice: 100, Quantity: 1, Discount: Discount{Percent: 150}}}}},
+		{name: "negative order discount", order: Order{Lines: []Line{setLine("A", 10000, 1)}, Discount: Discount{Amount: -1}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Calculate(noCharges, tt.order); err == nil {
+				t.Error("Calculate() error = nil, want error")
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package pricing
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ประเภทส่วนลดของโปรโมชั่น
+const (
+	PromotionPercent      = "PERCENT"       // ลดเป็นเปอร์เซ็นต์
+	PromotionAmount       = "AMOUNT"        // ลดเป็นจำนวนเงิน (ทั้งบิล หรือต่อหนึ่งเซ็ตถ้ากำหนดเมนูเซ็ต)
+	PromotionChildrenFree = "CHILDREN_FREE" // เด็กทานฟรี: ฟรีเมนูเซ็ตที่ถูกที่สุดตามจำนวนเด็ก
+)
+
+// Promotion คือเงื่อนไขของโค้ดส่วนลด
+type Promotion struct {
+	ID           string
+	Code         string
+	DiscountType string
+	Percent      float64
+	Amount       int64 // สตางค์
+	MenuSetIDs   []string
+	ValidFrom    *time.Time
+	ValidUntil   *time.Time
+	DaysOfWeek   []time.Weekday // ว่าง = ทุกวัน
+	MinPartySize int32
+	MaxPartySize int32 // 0 = ไม่จำกัด
+	UsageLimit   int32 // 0 = ไม่จำกัด
+	UsedCount    int32 // จำนวนครั้งที่ใช้ไปแล้ว (ไม่รวมการจองที่กำลังคำนวณ)
+	Active       bool
+	// Redeemed = การจองที่กำลังคำนวณใช้โค้ดนี้อยู่แล้ว ไม่ตรวจเงื่อนไขการใช้ซ้ำ
+	// เพื่อให้แก้ไขหรือยกเลิกการจองได้แม้โค้ดหมดอายุ ถูกปิด หรือครบจำนวนแล้ว
+	Redeemed bool
+}
+
+// PromotionError คือเหตุผลที่การจองนี้ใช้โค้ดโปรโมชั่นไม่ได้
+type PromotionError struct {
+	Code   string
+	Reason string
+}
+
+func (e *PromotionError) Error() string {
+	return fmt.Sprintf("promotion %s %s", e.Code, e.Reason)
+}
+
+func (p *Promotion) errorf(format string, args ...interface{}) error {
+	return &PromotionError{Code: p.Code, Reason: fmt.Sprintf(format, args...)}
+}
+
+// ValidatePromotion ตรวจสอบค่าของโปรโมชั่นก่อนบันทึก
+func ValidatePromotion(p Promotion) error {
+	if p.Code == "" {
+		return fmt.Errorf("code is required")
+	}
+	switch p.DiscountType {
+	case PromotionPercent:
+		if p.Percent <= 0 || p.Percent > 100 {
+			return fmt.Errorf("percent must be between 0 and 100")
+		}
+	case PromotionAmount:
+		if p.Amount <= 0 {
+			return fmt.Errorf("amount must be greater than 0")
+		}
+	case PromotionChildrenFree:
+	default:
+		return fmt.Errorf("unknown discount type: %s", p.DiscountType)
+	}
+	if p.ValidFrom != nil && p.ValidUntil != nil && p.ValidUntil.Before(*p.ValidFrom) {
+		return fmt.Errorf("valid_until must not be before valid_from")
+	}
+	if p.MinPartySize < 0 || p.MaxPartySize < 0 || p.UsageLimit < 0 {
+		return fmt.Errorf("party size and usage limit must not be negative")
+	}
+	if p.MaxPartySize > 0 && p.MaxPartySize < p.MinPartySize {
+		return fmt.Errorf("max_party_size must not be less than min_party_size")
+	}
+	return nil
+}
+
+// eligible ตรวจสอบว่าการจองนี้ใช้โปรโมชั่นได้หรือไม่ (ช่วงเวลาและวันในสัปดาห์ดูจากวันเวลาที่จอง)
+func (p *Promotion) eligible(order Order) error {
+	if p.Redeemed {
+		return nil
+	}
+	if !p.Active {
+		return p.errorf("is not active")
+	}
+	if p.ValidFrom != nil && order.BookingDateTime.Before(*p.ValidFrom) {
+		return p.errorf("is not valid before %s", p.ValidFrom.Format("2006-01-02"))
+	}
+	if p.ValidUntil != nil && order.BookingDateTime.After(*p.ValidUntil) {
+		return p.errorf("expired on %s", p.ValidUntil.Format("2006-01-02"))
+	}
+	if len(p.DaysOfWeek) > 0 {
+		weekday := order.BookingDateTime.Weekday()
+		allowed := false
+		for _, day := range p.DaysOfWeek {
+			if day == weekday {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return p.errorf("is not valid on %s", weekday)
+		}
+	}
+
+	partySize := order.NumAdults + order.NumChildren
+	if partySize < p.MinPartySize {
+		return p.errorf("requires at least %d guests", p.MinPartySize)
+	}
+	if p.MaxPartySize > 0 && partySize > p.MaxPartySize {
+		return p.errorf("allows at most %d guests", p.MaxPartySize)
+	}
+	if p.UsageLimit > 0 && p.UsedCount >= p.UsageLimit {
+		return p.errorf("has reached its usage limit")
+	}
+	return nil
+}
+
+// appliesTo บอกว่ารายการนี้อยู่ในเมนูเซ็ตที่ร่วมโปรโมชั่นหรือไม่ (ไม่กำหนดเมนูเซ็ต = ทุกเมนูเซ็ต)
+func (p *Promotion) appliesTo(line LineResult) bool {
+	if line.Kind != KindMenuSet {
+		return false
+	}
+	if len(p.MenuSetIDs) == 0 {
+		return true
+	}
+	for _, id := range p.MenuSetIDs {
+		if id == line.RefID {
+			return true
+		}
+	}
+	return false
+}
+
+// discount คำนวณส่วนลดของโปรโมชั่นจากรายการที่หักส่วนลดปกติแล้ว และยอดหลังหักส่วนลดท้ายบิล
+func (p *Promotion) discount(order Order, lines []LineResult, net int64) (int64, error) {
+	// โปรโมชั่นทั้งบิล
+	if len(p.MenuSetIDs) == 0 && p.DiscountType != PromotionChildrenFree {
+		return Discount{Percent: p.Percent, Amount: p.Amount}.of(net), nil
+	}
+
+	var eligible []LineResult
+	for _, line := range lines {
+		if p.appliesTo(line) {
+			eligible = append(eligible, line)
+		}
+	}
+	if len(eligible) == 0 {
+		return 0, p.errorf("does not apply to the selected menu sets")
+	}
+
+	var total int64
+	switch p.DiscountType {
+	case PromotionPercent:
+		for _, line := range eligible {
+			total += percentOf(line.Net, p.Percent)
+		}
+	case PromotionAmount:
+		for _, line := range eligible {
+			total += min(p.Amount*int64(line.Quantity), line.Net)
+		}
+	case PromotionChildrenFree:
+		if order.NumChildren == 0 {
+			return 0, p.errorf("requires at least one child")
+		}
+		// ให้เซ็ตที่ถูกที่สุดฟรีก่อน ตามจำนวนเด็ก
+		sort.SliceStable(eligible, func(i, j int) bool { return eligible[i].UnitPrice < eligible[j].UnitPrice })
+		remaining := int64(order.NumChildren)
+		for _, line := range eligible {
+			units := min(remaining, int64(line.Quantity))
+			total += min(units*line.UnitPrice, line.Net)
+			remaining -= units
+			if remaining == 0 {
+				break
+			}
+		}
+	}
+
+	return min(total, net), nil
+}
@@ -3,6 +3,8 @@ package repository
 import (
 	"context"
 	"time"
+
+	"gitlab.com/final_project1240930/booking_service/internal/pricing"
 )
 
 type Booking struct {
@@ -19,6 +21,40 @@ type Booking struct {
 	BookingMenuSets  []BookingMenuSet  `json:"menu_sets"`
 	BookingMenuItems []BookingMenuItem `json:"menu_items"`
 	Status           string            `json:"status"`
+	Pricing          BookingPricing    `json:"pricing"`
+}
+
+// BookingPricing คือยอดเงินที่บันทึกไว้ตอนสร้าง/แก้ไขการจอง (หน่วยสตางค์)
+type BookingPricing struct {
+	SubtotalSatang          int64   `gorm:"column:subtotal_satang" json:"subtotal_satang"`
+	LineDiscountSatang      int64   `gorm:"column:line_discount_satang" json:"line_discount_satang"`
+	OrderDiscountSatang     int64   `gorm:"column:order_discount_satang" json:"order_discount_satang"`
+	PromotionCode           string  `gorm:"column:promotion_code" json:"promotion_code"`
+	PromotionDiscountSatang int64   `gorm:"column:promotion_discount_satang" json:"promotion_discount_satang"`
+	ServiceChargeSatang     int64   `gorm:"column:service_charge_satang" json:"service_charge_satang"`
+	ServiceChargePercent    float64 `gorm:"column:service_charge_percent" json:"service_charge_percent"`
+	VATSatang               int64   `gorm:"column:vat_satang" json:"vat_satang"`
+	VATPercent              float64 `gorm:"column:vat_percent" json:"vat_percent"`
+	VATInclusive            bool    `gorm:"column:vat_inclusive" json:"vat_inclusive"`
+	RoundingSatang          int64   `gorm:"column:rounding_satang" json:"rounding_satang"`
+	TotalSatang             int64   `gorm:"column:total_satang" json:"total_satang"`
+}
+
+func newBookingPricing(b pricing.Breakdown) BookingPricing {
+	return BookingPricing{
+		SubtotalSatang:          b.Subtotal,
+		LineDiscountSatang:      b.LineDiscount,
+		OrderDiscountSatang:     b.OrderDiscount,
+		PromotionCode:           b.PromotionCode,
+		PromotionDiscountSatang: b.PromotionDiscount,
+		ServiceChargeSatang:     b.ServiceCharge,
+		ServiceChargePercent:    b.ServiceChargePercent,
+		VATSatang:               b.VAT,
+		VATPercent:              b.VATPercent,
+		VATInclusive:            b.VATInclusive,
+		RoundingSatang:          b.Rounding,
+		TotalSatang:             b.Total,
+	}
 }
 
 type BookingTable struct {
@@ -28,12 +64,36 @@ type BookingTable struct {
 	SeatCount   int32  `json:"seat_count"`
 }
 
+// BookingMenuSet MenuItems คือเมนูที่ได้จริงในเซ็ต เมนูที่เลือกจากช่องเลือกมี Quantity เป็นจำนวนจานต่อหนึ่งเซ็ต
+// ส่วนเมนูที่ตายตัวของเซ็ตมี Quantity เป็น 0
 type BookingMenuSet struct {
-	MenuSetID    string            `json:"menu_set_id"`
-	MenuSetName  string            `json:"menu_set_name"`
-	MenuSetPrice float32           `json:"menu_set_price"`
-	Quantity     int32             `json:"quantity"`
-	MenuItems    []BookingMenuItem `json:"menu_items"`
+	MenuSetID       string                 `json:"menu_set_id"`
+	MenuSetName     string                 `json:"menu_set_name"`
+	MenuSetPrice    float32                `json:"menu_set_price"`
+	Quantity        int32                  `json:"quantity"`
+	MenuItems       []BookingMenuItem      `json:"menu_items"`
+	UnitPriceSatang int64                  `json:"unit_price_satang"`
+	DiscountSatang  int64                  `json:"discount_satang"`
+	LineID          string                 `json:"line_id"`
+	Choices         []BookingMenuSetChoice `json:"choices"`
+}
+
+// BookingMenuSetChoice คือเมนูที่ลูกค้าเลือกในช่องเลือกของเซ็ต เก็บชื่อช่องและราคาเพิ่ม ณ เวลาที่จอง
+type BookingMenuSetChoice struct {
+	BookingMenuSetID string `gorm:"column:booking_menu_set_id;primaryKey" json:"-"`
+	SlotID           string `gorm:"column:slot_id;primaryKey" json:"slot_id"`
+	MenuItemID       string `gorm:"column:menu_item_id;primaryKey" json:"menu_item_id"`
+	SlotNameTh       string `gorm:"column:slot_name_th" json:"slot_name_th"`
+	SlotNameEn       string `gorm:"column:slot_name_en" json:"slot_name_en"`
+	Quantity         int32  `gorm:"column:quantity" json:"quantity"`
+	UpchargeSatang   int64  `gorm:"column:upcharge_satang" json:"upcharge_satang"`
+	Position         int32  `gorm:"column:position" json:"-"`
+	NameTh           string `gorm:"-" json:"name_th"`
+	NameEn           string `gorm:"-" json:"name_en"`
+}
+
+func (BookingMenuSetChoice) TableName() string {
+	return "booking_menu_set_choices"
 }
 
 type BookingMenuItem struct {
@@ -45,6 +105,27 @@ type BookingMenuItem struct {
 	Category    string  `json:"category"`
 	ImageURL    string  `json:"image_url"`
 	Quantity    int32   `json:"quantity"`
+	// ราคา ณ เวลาที่จอง มีค่าเฉพาะรายการที่สั่งแยก (ไม่ใช่เมนูในเซ็ต) UnitPriceSatang รวมราคาตัวเลือกแล้ว
+	UnitPriceSatang int64                     `json:"unit_price_satang"`
+	DiscountSatang  int64                     `json:"discount_satang"`
+	LineID          string                    `json:"line_id"`
+	Modifiers       []BookingMenuItemModifier `json:"modifiers"`
+}
+
+// BookingMenuItemModifier คือตัวเลือกที่ลูกค้าเลือก เก็บชื่อและราคา ณ เวลาที่จอง
+type BookingMenuItemModifier struct {
+	BookingMenuItemID string `gorm:"column:booking_menu_item_id;primaryKey" json:"-"`
+	ModifierOptionID  string `gorm:"column:modifier_option_id;primaryKey" json:"modifier_option_id"`
+	GroupNameTh       string `gorm:"column:group_name_th" json:"group_name_th"`
+	GroupNameEn       string `gorm:"column:group_name_en" json:"group_name_en"`
+	NameTh            string `gorm:"column:name_th" json:"name_th"`
+	NameEn            string `gorm:"column:name_en" json:"name_en"`
+	PriceDeltaSatang  int64  `gorm:"column:price_delta_satang" json:"price_delta_satang"`
+	Position          int32  `gorm:"column:position" json:"-"`
+}
+
+func (BookingMenuItemModifier) TableName() string {
+	return "booking_menu_item_modifiers"
 }
 
 type BookingEntity struct {
@@ -65,6 +146,7 @@ type BookingEntity struct {
 	MenuSetName                 string    `gorm:"column:menu_set_name"`
 	MenuSetQuantity             int32     `gorm:"column:menu_set_quantity"`
 	MenuSetPrice                float64   `gorm:"column:menu_set_price"`
+	MenuSetLineID               string    `gorm:"column:menu_set_line_id"`
 	MenuItemID                  string    `gorm:"column:menu_item_id"`
 	MenuItemNameTh              string    `gorm:"column:menu_item_name_th"`
 	MenuItemNameEn              string    `gorm:"column:menu_item_name_en"`
@@ -81,7 +163,14 @@ type BookingEntity struct {
 	SeparateMenuItemCategory    string    `gorm:"column:separate_menu_item_category"`
 	SeparateMenuItemImageURL    string    `gorm:"column:separate_menu_item_image_url"`
 	SeparateMenuItemQuantity    int32     `gorm:"column:separate_menu_item_quantity"`
+	SeparateMenuItemLineID      string    `gorm:"column:separate_menu_item_line_id"`
 	Status                      string    `json:"status"`
+
+	MenuSetUnitPriceSatang          int64 `gorm:"column:menu_set_unit_price_satang"`
+	MenuSetDiscountSatang           int64 `gorm:"column:menu_set_discount_satang"`
+	SeparateMenuItemUnitPriceSatang int64 `gorm:"column:separate_menu_item_unit_price_satang"`
+	SeparateMenuItemDiscountSatang  int64 `gorm:"column:separate_menu_item_discount_satang"`
+	BookingPricing                  `gorm:"embedded"`
 }
 
 // Request
@@ -98,7 +187,11 @@ type CreateBookingRequest struct {
 	MenuSets        []CreateBookingMenuSet  `json:"menu_sets"`                            // รายการเมนูเซ็ต
 	MenuItems       []CreateBookingMenuItem `json:"menu_items"`                           // รายการเมนูอาหาร
 	Status          string                  `gorm:"column:status" json:"status"`
-	TotalPrice      float64                 `gorm:"column:total_price" json:"total_price"`
+	TotalPrice      float64                 `gorm:"column:total_price" json:"total_price"` // ไม่ใช้แล้ว ยอดเงินคำนวณจากราคาเมนูเสมอ
+	OrderDiscount   pricing.Discount        `json:"order_discount"`                        // ส่วนลดท้ายบิล
+	PromotionCode   string                  `json:"promotion_code"`                        // โค้ดโปรโมชั่น (ถ้ามี)
+	ZoneID          string                  `json:"zone_id"`                               // โซนที่ลูกค้าต้องการ (ว่าง = ไม่ระบุ)
+	CombinationID   string                  `json:"combination_id"`                        // ชุดโต๊ะต่อ (ว่าง = ไม่ได้จองเป็นชุด)
 }
 
 type CreateBookingTable struct {
@@ -106,13 +199,23 @@ type CreateBookingTable struct {
 }
 
 type CreateBookingMenuSet struct {
-	MenuSetID string `json:"menu_set_id" binding:"required"`
-	Quantity  int32  `json:"quantity" binding:"required"`
+	MenuSetID string                       `json:"menu_set_id" binding:"required"`
+	Quantity  int32                        `json:"quantity" binding:"required"`
+	Discount  pricing.Discount             `json:"discount"`
+	Choices   []CreateBookingMenuSetChoice `json:"choices"` // เมนูที่เลือกในช่องเลือกของเซ็ต (ต่อหนึ่งเซ็ต)
 }
 
-type CreateBookingMenuItem struct {
+type CreateBookingMenuSetChoice struct {
+	SlotID     string `json:"slot_id" binding:"required"`
 	MenuItemID string `json:"menu_item_id" binding:"required"`
-	Quantity   int32  `json:"quantity" binding:"required"`
+	Quantity   int32  `json:"quantity"` // จำนวนจานต่อหนึ่งเซ็ต (0 ถือเป็น 1)
+}
+
+type CreateBookingMenuItem struct {
+	MenuItemID        string           `json:"menu_item_id" binding:"required"`
+	Quantity          int32            `json:"quantity" binding:"required"`
+	Discount          pricing.Discount `json:"discount"`
+	ModifierOptionIDs []string         `json:"modifier_option_ids"` // ตัวเลือกที่เลือก (ต้องเป็นของเมนูนี้)
 }
 
 type CreateBooking struct {
@@ -126,6 +229,7 @@ type CreateBooking struct {
 	NumTables       int32     `gorm:"column:num_tables" json:"num_tables"`
 	Status          string    `gorm:"column:status" json:"status"`
 	TotalPrice      float64   `gorm:"column:total_price" json:"total_price"`
+	BookingPricing  `gorm:"embedded"`
 }
 
 func (CreateBooking) TableName() string {
@@ -142,9 +246,12 @@ func (BookingTableEntity) TableName() string {
 }
 
 type BookingMenuSetEntity struct {
-	BookingID string `gorm:"column:booking_id;primaryKey"`
-	MenuSetID string `gorm:"column:menu_set_id"`
-	Quantity  int32  `gorm:"column:quantity"`
+	UUID            string `gorm:"column:uuid;primaryKey"`
+	BookingID       string `gorm:"column:booking_id"`
+	MenuSetID       string `gorm:"column:menu_set_id"`
+	Quantity        int32  `gorm:"column:quantity"`
+	UnitPriceSatang int64  `gorm:"column:unit_price_satang"`
+	DiscountSatang  int64  `gorm:"column:discount_satang"`
 }
 
 func (BookingMenuSetEntity) TableName() string {
@@ -152,15 +259,38 @@ func (BookingMenuSetEntity) TableName() string {
 }
 
 type BookingMenuItemEntity struct {
-	BookingID  string `gorm:"column:booking_id;primaryKey"`
-	MenuItemID string `gorm:"column:menu_item_id"`
-	Quantity   int32  `gorm:"column:quantity"`
+	UUID            string `gorm:"column:uuid;primaryKey"`
+	BookingID       string `gorm:"column:booking_id"`
+	MenuItemID      string `gorm:"column:menu_item_id"`
+	Quantity        int32  `gorm:"column:quantity"`
+	UnitPriceSatang int64  `gorm:"column:unit_price_satang"`
+	DiscountSatang  int64  `gorm:"column:discount_satang"`
 }
 
 func (BookingMenuItemEntity) TableName() string {
 	return "booking_menu_items"
 }
 
+// ImportBookingRow คือหนึ่งแถวจากไฟล์ import ที่ผ่านการตรวจสอบรูปแบบแล้ว
+// โต๊ะและเมนูเซ็ตยังเป็นหมายเลขและชื่อ repository จะแปลงเป็น ID ให้
+type ImportBookingRow struct {
+	Row          int
+	Booking      CreateBookingRequest
+	TableNumbers []string
+	MenuSets     []ImportBookingMenuSet
+}
+
+type ImportBookingMenuSet struct {
+	Name     string
+	Quantity int32
+}
+
+type ImportBookingResult struct {
+	Row       int
+	BookingID string
+	Error     string
+}
+
 type BookingRepository interface {
 	GetBookingDetails(ctx context.Context) ([]Booking, error)
 	GetBookingDetailsByID(ctx context.Context, bookingID string) (*Booking, error)
@@ -168,4 +298,16 @@ type BookingRepository interface {
 	CreateBooking(ctx context.Context, booking *CreateBookingRequest) error
 	UpdateBooking(ctx context.Context, bookingID string, req *CreateBookingRequest) error
 	DeleteBooking(ctx context.Context, bookingID string) error
+
+	StreamBookingDetails(ctx context.Context, startDate, endDate string, fn func(Booking) error) error
+	ImportBookings(ctx context.Context, rows []ImportBookingRow, dryRun bool) ([]ImportBookingResult, error)
+
+	// QuoteBooking คำนวณยอดเงินจากราคาเมนูปัจจุบันโดยไม่บันทึก
+	QuoteBooking(ctx context.Context, req *CreateBookingRequest) (pricing.Breakdown, error)
+
+	// ข้อจำกัดด้านอาหารของลูกค้า
+	GetDietaryRestrictions(ctx context.Context, phoneNumber string) (DietaryRestrictions, error)
+	SetDietaryRestrictions(ctx context.Context, restrictions DietaryRestrictions) error
+	// DietaryWarnings คืนคำเตือนเมื่อเมนูที่สั่งขัดกับข้อจำกัดด้านอาหารที่บันทึกไว้ของลูกค้า
+	DietaryWarnings(ctx context.Context, req *CreateBookingRequest) ([]string, error)
 }
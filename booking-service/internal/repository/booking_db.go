@@ -2,20 +2,25 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"gitlab.com/final_project1240930/booking_service/internal/pricing"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
 
 type bookingRepository struct {
-	DB *gorm.DB
+	DB      *gorm.DB
+	pricing pricing.Config
 }
 
-func NewBookingRepository(db *gorm.DB) BookingRepository {
-	return &bookingRepository{DB: db}
+func NewBookingRepository(db *gorm.DB, pricingConfig pricing.Config) BookingRepository {
+	return &bookingRepository{DB: db, pricing: pricingConfig}
 }
 
 // NewDatabase creates a new database connection
@@ -60,6 +65,7 @@ func (r *bookingRepository) mapBookingDetails(entities []BookingEntity) []Bookin
 				BookingMenuSets:  []BookingMenuSet{},  // เริ่มต้น slice ของ Menu Sets
 				BookingMenuItems: []BookingMenuItem{}, // เริ่มต้น slice ของ Menu
 				Status:           entity.Status,
+				Pricing:          entity.BookingPricing,
 			}
 		}
 
@@ -89,10 +95,10 @@ func (r *bookingRepository) mapBookingDetails(entities []BookingEntity) []Bookin
 
 		// จัดการข้อมูล Menu Items (จาก Separate Menu Item)
 		if entity.SeparateMenuItemID != "" {
-			// เช็คว่ามีการเพิ่ม Menu Item นี้ไปแล้วหรือไม่
+			// เช็คว่ามีการเพิ่มรายการนี้ไปแล้วหรือไม่ (เมนูเดียวกันอาจมีหลายรายการที่เลือกตัวเลือกต่างกัน)
 			menuItemExists := false
 			for _, item := range booking.BookingMenuItems {
-				if item.MenuItemID == entity.SeparateMenuItemID {
+				if item.LineID == entity.SeparateMenuItemLineID {
 					// ถ้า Menu Item ซ้ำกัน ไม่ต้องบวก Quantity, ใช้ค่าเดิม
 					menuItemExists = true
 					break
@@ -109,17 +115,21 @@ func (r *bookingRepository) mapBookingDetails(entities []BookingEntity) []Bookin
 					Category:    entity.SeparateMenuItemCategory,
 					ImageURL:    entity.SeparateMenuItemImageURL,
 					Quantity:    entity.SeparateMenuItemQuantity,
+
+					UnitPriceSatang: entity.SeparateMenuItemUnitPriceSatang,
+					DiscountSatang:  entity.SeparateMenuItemDiscountSatang,
+					LineID:          entity.SeparateMenuItemLineID,
 				})
 			}
 		}
 
 		// จัดการข้อมูล Menu Sets
 		if entity.MenuSetID != "" {
-			// ตรวจสอบว่ามีการเพิ่ม Menu Set นี้แล้วหรือยัง
+			// ตรวจสอบว่ามีการเพิ่ม Menu Set นี้แล้วหรือยัง (เซ็ตเดียวกันอาจมีหลายรายการที่เลือกเมนูต่างกัน)
 			menuSetExists := false
 			var updatedMenuSet *BookingMenuSet
 			for i, menuSet := range booking.BookingMenuSets {
-				if menuSet.MenuSetID == entity.MenuSetID {
+				if menuSet.LineID == entity.MenuSetLineID {
 					// ถ้ามี Menu Set นี้แล้ว จะเพิ่ม Menu Items เข้าไป
 					menuSetExists = true
 					updatedMenuSet = &booking.BookingMenuSets[i]
@@ -130,24 +140,31 @@ func (r *bookingRepository) mapBookingDetails(entities []BookingEntity) []Bookin
 			// ถ้า Menu Set นี้ยังไม่เคยถูกเพิ่มเข้าไป ให้เพิ่มเข้าไปใหม่
 			if !menuSetExists {
 				// เพิ่ม Menu Set ใหม่
-				booking.BookingMenuSets = append(booking.BookingMenuSets, BookingMenuSet{
+				menuSet := BookingMenuSet{
 					MenuSetID:    entity.MenuSetID,
 					MenuSetName:  entity.MenuSetName,
 					MenuSetPrice: float32(entity.MenuSetPrice),
 					Quantity:     entity.MenuSetQuantity,
-					MenuItems: []BookingMenuItem{
-						{
-							MenuItemID:  entity.MenuItemID,
-							NameTh:      entity.MenuItemNameTh,
-							NameEn:      entity.MenuItemNameEn,
-							Description: entity.MenuItemDescription,
-							Price:       float32(entity.MenuItemPrice),
-							Category:    entity.MenuItemCategory,
-							ImageURL:    entity.MenuItemImageURL,
-						},
-					},
-				})
-			} else {
+
+					UnitPriceSatang: entity.MenuSetUnitPriceSatang,
+					DiscountSatang:  entity.MenuSetDiscountSatang,
+					LineID:          entity.MenuSetLineID,
+					MenuItems:       []BookingMenuItem{},
+				}
+				// เซ็ตที่มีแต่ช่องเลือกไม่มีเมนูตายตัว เมนูที่เลือกจะใส่ภายหลังใน attachBookingSetChoices
+				if entity.MenuItemID != "" {
+					menuSet.MenuItems = append(menuSet.MenuItems, BookingMenuItem{
+						MenuItemID:  entity.MenuItemID,
+						NameTh:      entity.MenuItemNameTh,
+						NameEn:      entity.MenuItemNameEn,
+						Description: entity.MenuItemDescription,
+						Price:       float32(entity.MenuItemPrice),
+						Category:    entity.MenuItemCategory,
+						ImageURL:    entity.MenuItemImageURL,
+					})
+				}
+				booking.BookingMenuSets = append(booking.BookingMenuSets, menuSet)
+			} else if entity.MenuItemID != "" {
 				// ถ้า Menu Set นี้มีอยู่แล้ว ให้เพิ่ม Menu Items ลงไป
 				// ตรวจสอบว่ามี Menu Item ซ้ำกันในรายการหรือไม่
 				menuItemExists := false
@@ -184,11 +201,8 @@ func (r *bookingRepository) mapBookingDetails(entities []BookingEntity) []Bookin
 	return results
 }
 
-// GetBookingDetails ดึงข้อมูลการจองจากฐานข้อมูลและแปลงเป็น BookingDetails
-func (r *bookingRepository) GetBookingDetails(ctx context.Context) ([]Booking, error) {
-	var entities []BookingEntity
-
-	query := `
+// bookingDetailsQuery คือ SELECT หลักของรายละเอียดการจอง (ยังไม่มี WHERE / ORDER BY)
+const bookingDetailsQuery = `
 		SELECT 
 			b.uuid AS booking_id,
 			b.customer_name,
@@ -199,12 +213,27 @@ func (r *bookingRepository) GetBookingDetails(ctx context.Context) ([]Booking, e
 			b.num_adults,
 			b.num_tables,
 			b.total_price,
+			b.subtotal_satang,
+			b.line_discount_satang,
+			b.order_discount_satang,
+			b.promotion_code,
+			b.promotion_discount_satang,
+			b.service_charge_satang,
+			b.service_charge_percent,
+			b.vat_satang,
+			b.vat_percent,
+			b.vat_inclusive,
+			b.rounding_satang,
+			b.total_satang,
 			bt.table_id,
 			t.num_table,
-			t.type,
-			tt.seat_count,
+			tt.name AS type,
+			tt.max_seats AS seat_count,
 			ms.menu_set_id,
+			ms.uuid AS menu_set_line_id,
 			ms.quantity as menu_set_quantity,
+			ms.unit_price_satang AS menu_set_unit_price_satang,
+			ms.discount_satang AS menu_set_discount_satang,
 			set_menu.name AS menu_set_name,
 			set_menu.price AS menu_set_price,
 			mi.uuid AS menu_item_id,
@@ -212,27 +241,47 @@ func (r *bookingRepository) GetBookingDetails(ctx context.Context) ([]Booking, e
 			mi.name_en AS menu_item_name_en,
 			mi.description AS menu_item_description,
 			mi.price AS menu_item_price,
-			mi.category AS menu_item_category,
+			mc.name_en AS menu_item_category,
 			mi.image_url AS menu_item_image_url,
 			bmi.quantity as separate_menu_item_quantity,
+			bmi.uuid AS separate_menu_item_line_id,
+			bmi.unit_price_satang AS separate_menu_item_unit_price_satang,
+			bmi.discount_satang AS separate_menu_item_discount_satang,
 			mi2.uuid AS separate_menu_item_id,
 			mi2.name_th AS separate_menu_item_name_th,
 			mi2.name_en AS separate_menu_item_name_en,
 			mi2.description AS separate_menu_item_description,
 			mi2.price AS separate_menu_item_price,
-			mi2.category AS separate_menu_item_category,
+			mc2.name_en AS separate_menu_item_category,
 			mi2.image_url AS separate_menu_item_image_url,
 			b.status 
 		FROM bookings b
 		LEFT JOIN booking_tables bt ON bt.booking_id = b.uuid
 		LEFT JOIN tables t ON bt.table_id = t.uuid
-		LEFT JOIN table_types tt ON t.type = tt.type
+		LEFT JOIN table_types tt ON tt.uuid = t.type_id
 		LEFT JOIN booking_menu_sets ms ON ms.booking_id = b.uuid
 		LEFT JOIN menu_sets set_menu ON ms.menu_set_id = set_menu.uuid
 		LEFT JOIN menu_set_items msi ON msi.menu_set_id = ms.menu_set_id
 		LEFT JOIN menu_items mi ON msi.menu_item_id = mi.uuid
+		LEFT JOIN menu_categories mc ON mc.uuid = mi.category_id
 		LEFT JOIN booking_menu_items bmi ON bmi.booking_id = b.uuid
 		LEFT JOIN menu_items mi2 ON bmi.menu_item_id = mi2.uuid 
+		LEFT JOIN menu_categories mc2 ON mc2.uuid = mi2.category_id
+`
+
+// attachBookingLineDetails ใส่ตัวเลือกของเมนูที่สั่งแยกและเมนูที่เลือกของเมนูเซ็ตให้การจอง
+func attachBookingLineDetails(db *gorm.DB, bookings []Booking) error {
+	if err := attachBookingModifiers(db, bookings); err != nil {
+		return err
+	}
+	return attachBookingSetChoices(db, bookings)
+}
+
+// GetBookingDetails ดึงข้อมูลการจองจากฐานข้อมูลและแปลงเป็น BookingDetails
+func (r *bookingRepository) GetBookingDetails(ctx context.Context) ([]Booking, error) {
+	var entities []BookingEntity
+
+	query := bookingDetailsQuery + `
 		ORDER BY b.booking_date_time;
 	`
 
@@ -241,58 +290,18 @@ func (r *bookingRepository) GetBookingDetails(ctx context.Context) ([]Booking, e
 		return nil, fmt.Errorf("failed to query bookings: %w", err)
 	}
 
-	return r.mapBookingDetails(entities), nil
+	bookings := r.mapBookingDetails(entities)
+	if err := attachBookingLineDetails(r.DB, bookings); err != nil {
+		return nil, err
+	}
+	return bookings, nil
 }
 
 // GetBookingDetailsByID
 func (r *bookingRepository) GetBookingDetailsByID(ctx context.Context, bookingID string) (*Booking, error) {
 	var entities []BookingEntity
 
-	query := `
-		SELECT 
-			b.uuid AS booking_id,
-			b.customer_name,
-			b.company_name,
-			b.booking_date_time,
-			b.phone_number,
-			b.num_children,
-			b.num_adults,
-			b.num_tables,
-			b.total_price,
-			bt.table_id,
-			t.num_table,
-			t.type,
-			tt.seat_count,
-			ms.menu_set_id,
-			ms.quantity as menu_set_quantity,
-			set_menu.name AS menu_set_name,
-			set_menu.price AS menu_set_price,
-			mi.uuid AS menu_item_id,
-			mi.name_th AS menu_item_name_th,
-			mi.name_en AS menu_item_name_en,
-			mi.description AS menu_item_description,
-			mi.price AS menu_item_price,
-			mi.category AS menu_item_category,
-			mi.image_url AS menu_item_image_url,
-			bmi.quantity as separate_menu_item_quantity,
-			mi2.uuid AS separate_menu_item_id,
-			mi2.name_th AS separate_menu_item_name_th,
-			mi2.name_en AS separate_menu_item_name_en,
-			mi2.description AS separate_menu_item_description,
-			mi2.price AS separate_menu_item_price,
-			mi2.category AS separate_menu_item_category,
-			mi2.image_url AS separate_menu_item_image_url,
-			b.status 
-		FROM bookings b
-		LEFT JOIN booking_tables bt ON bt.booking_id = b.uuid
-		LEFT JOIN tables t ON bt.table_id = t.uuid
-		LEFT JOIN table_types tt ON t.type = tt.type
-		LEFT JOIN booking_menu_sets ms ON ms.booking_id = b.uuid
-		LEFT JOIN menu_sets set_menu ON ms.menu_set_id = set_menu.uuid
-		LEFT JOIN menu_set_items msi ON msi.menu_set_id = ms.menu_set_id
-		LEFT JOIN menu_items mi ON msi.menu_item_id = mi.uuid
-		LEFT JOIN booking_menu_items bmi ON bmi.booking_id = b.uuid
-		LEFT JOIN menu_items mi2 ON bmi.menu_item_id = mi2.uuid 
+	query := bookingDetailsQuery + `
 		WHERE b.uuid = ? 
 		ORDER BY b.booking_date_time;
 	`
@@ -309,10 +318,61 @@ func (r *bookingRepository) GetBookingDetailsByID(ctx context.Context, bookingID
 	if len(bookings) == 0 {
 		return nil, fmt.Errorf("booking not found for ID: %s", bookingID)
 	}
+	if err := attachBookingLineDetails(r.DB, bookings); err != nil {
+		return nil, err
+	}
 
 	return &bookings[0], nil
 }
 
+// StreamBookingDetails อ่านการจองในช่วงวันที่ทีละแถวจากฐานข้อมูล แล้วส่งให้ fn ทีละการจอง
+// เพื่อไม่ต้องโหลดข้อมูลทั้งหมดไว้ในหน่วยความจำ (startDate/endDate เป็น "2006-01-02" และรวมวันสิ้นสุด)
+func (r *bookingRepository) StreamBookingDetails(ctx context.Context, startDate, endDate string, fn func(Booking) error) error {
+	query := bookingDetailsQuery + `
+		WHERE b.booking_date_time >= ?::date
+			AND b.booking_date_time < ?::date + interval '1 day'
+		ORDER BY b.booking_date_time, b.uuid;
+	`
+
+	rows, err := r.DB.WithContext(ctx).Raw(query, startDate, endDate).Rows()
+	if err != nil {
+		return fmt.Errorf("failed to query bookings for export: %w", err)
+	}
+	defer rows.Close()
+
+	// แถวของการจองเดียวกันจะเรียงติดกัน จึงเก็บไว้เฉพาะของการจองปัจจุบัน
+	var pending []BookingEntity
+	flush := func() error {
+		if len(pending) == 0 {
+			return nil
+		}
+		bookings := r.mapBookingDetails(pending)
+		pending = pending[:0]
+		if err := attachBookingLineDetails(r.DB.WithContext(ctx), bookings); err != nil {
+			return err
+		}
+		return fn(bookings[0])
+	}
+
+	for rows.Next() {
+		var entity BookingEntity
+		if err := r.DB.ScanRows(rows, &entity); err != nil {
+			return fmt.Errorf("failed to scan booking row: %w", err)
+		}
+		if len(pending) > 0 && pending[0].BookingID != entity.BookingID {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+		pending = append(pending, entity)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to read booking rows: %w", err)
+	}
+
+	return flush()
+}
+
 func (r *bookingRepository) CreateBooking(ctx context.Context, req *CreateBookingRequest) error {
 	// Start a transaction
 	tx := r.DB.WithContext(ctx).Begin()
@@ -320,17 +380,54 @@ func (r *bookingRepository) CreateBooking(ctx context.Context, req *CreateBookin
 		return tx.Error
 	}
 
+	if _, err := r.createBookingTx(tx, req); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	// Commit the transaction if all operations succeed
+	return tx.Commit().Error
+}
+
+// createBookingTx บันทึกการจองพร้อมโต๊ะและเมนูภายใน transaction ที่ได้รับมา และคืนค่า ID ของการจองที่สร้าง
+// ผู้เรียกต้องเป็นคน commit หรือ rollback เอง
+func (r *bookingRepository) createBookingTx(tx *gorm.DB, req *CreateBookingRequest) (string, error) {
 	// ตรวจสอบการจองในเวลาเดียวกัน (ไม่ตรวจสอบเบอร์โทรซ้ำในเวลาต่างกัน)
 	var existingBooking CreateBooking
 	err := tx.Where("booking_date_time = ?", req.BookingDateTime).First(&existingBooking).Error
 	if err == nil {
 		// ถ้ามีข้อมูลที่ซ้ำกัน (ไม่พบ error) ให้คืนค่าผลลัพธ์ว่า Booking นี้มีอยู่แล้ว
-		tx.Rollback() // Rollback the transaction
-		return fmt.Errorf("Booking at the same time already exists")
+		return "", fmt.Errorf("Booking at the same time already exists")
 	} else if err != gorm.ErrRecordNotFound {
 		// ถ้ามีข้อผิดพลาดอื่นๆ ในการค้นหา
-		tx.Rollback()
-		return fmt.Errorf("Error checking existing booking: %s", err)
+		return "", fmt.Errorf("Error checking existing booking: %s", err)
+	}
+
+	if err := expandTableCombinationTx(tx, req); err != nil {
+		return "", err
+	}
+
+	breakdown, err := r.priceBookingTx(tx, "", req)
+	if err != nil {
+		return "", err
+	}
+	if err := checkMenuSetArchivedTx(tx, "", req); err != nil {
+		return "", err
+	}
+	if err := checkMenuScheduleTx(tx, req); err != nil {
+		return "", err
+	}
+	if err := checkMenuStockTx(tx, "", req); err != nil {
+		return "", err
+	}
+	if err := checkTableZoneTx(tx, req); err != nil {
+		return "", err
+	}
+	if err := checkTableConflictsTx(tx, "", req); err != nil {
+		return "", err
+	}
+	if err := checkTableBlocksTx(tx, req); err != nil {
+		return "", err
 	}
 
 	// สร้าง UUID สำหรับ Booking
@@ -347,12 +444,12 @@ func (r *bookingRepository) CreateBooking(ctx context.Context, req *CreateBookin
 		NumAdults:       req.NumAdults,
 		NumTables:       req.NumTables,
 		Status:          "CONFIRMED",
-		TotalPrice:      req.TotalPrice,
+		TotalPrice:      pricing.ToBaht(breakdown.Total),
+		BookingPricing:  newBookingPricing(breakdown),
 	}
 
 	if err := tx.Create(&booking).Error; err != nil {
-		tx.Rollback()
-		return err
+		return "", err
 	}
 
 	for _, tableID := range req.Tables {
@@ -361,37 +458,206 @@ func (r *bookingRepository) CreateBooking(ctx context.Context, req *CreateBookin
 			TableID:   tableID.TableID,
 		}
 		if err := tx.Create(&tableEntity).Error; err != nil {
-			tx.Rollback()
-			return err
+			return "", err
 		}
 	}
 
-	for _, menuSet := range req.MenuSets {
-		menuSetEntity := BookingMenuSetEntity{
-			BookingID: bookingID,
-			MenuSetID: menuSet.MenuSetID,
-			Quantity:  menuSet.Quantity,
+	if err := createBookingLinesTx(tx, bookingID, breakdown); err != nil {
+		return "", err
+	}
+	if err := saveRedemptionTx(tx, bookingID, breakdown); err != nil {
+		return "", err
+	}
+
+	return bookingID, nil
+}
+
+// createBookingLinesTx บันทึกเมนูเซ็ตและเมนูที่สั่งแยกพร้อมราคา ณ เวลาที่จอง
+func createBookingLinesTx(tx *gorm.DB, bookingID string, breakdown pricing.Breakdown) error {
+	for _, line := range breakdown.Lines {
+		switch line.Kind {
+		case pricing.KindMenuSet:
+			lineID := uuid.New().String()
+			if err := tx.Create(&BookingMenuSetEntity{
+				UUID:            lineID,
+				BookingID:       bookingID,
+				MenuSetID:       line.RefID,
+				Quantity:        line.Quantity,
+				UnitPriceSatang: line.UnitPrice,
+				DiscountSatang:  line.DiscountAmount,
+			}).Error; err != nil {
+				return fmt.Errorf("error creating menu set: %v", err)
+			}
+			if err := createBookingSetChoicesTx(tx, lineID, line.Modifiers); err != nil {
+				return err
+			}
+		case pricing.KindMenuItem:
+			lineID := uuid.New().String()
+			if err := tx.Create(&BookingMenuItemEntity{
+				UUID:            lineID,
+				BookingID:       bookingID,
+				MenuItemID:      line.RefID,
+				Quantity:        line.Quantity,
+				UnitPriceSatang: line.UnitPrice,
+				DiscountSatang:  line.DiscountAmount,
+			}).Error; err != nil {
+				return err
+			}
+			if err := createBookingModifiersTx(tx, lineID, line.Modifiers); err != nil {
+				return err
+			}
 		}
-		if err := tx.Create(&menuSetEntity).Error; err != nil {
-			tx.Rollback()
-			return fmt.Errorf("error creating menu set: %v", err)
+	}
+	return nil
+}
+
+// priceBookingTx ดึงราคาเมนูปัจจุบันและโปรโมชั่น (ถ้ามี) แล้วคำนวณยอดเงินของการจอง
+// bookingID คือการจองที่กำลังแก้ไข (ว่างถ้าเป็นการจองใหม่) ใช้เพื่อไม่นับการใช้โค้ดของการจองนี้ซ้ำ
+// และให้รายการเดิมของการจองใช้ราคาที่บันทึกไว้
+func (r *bookingRepository) priceBookingTx(tx *gorm.DB, bookingID string, req *CreateBookingRequest) (pricing.Breakdown, error) {
+	type menuPrice struct {
+		UUID  string  `gorm:"column:uuid"`
+		Name  string  `gorm:"column:name"`
+		Price float64 `gorm:"column:price"`
+	}
+
+	var lines []pricing.Line
+
+	if len(req.MenuSets) > 0 {
+		ids := make([]string, len(req.MenuSets))
+		for i, menuSet := range req.MenuSets {
+			ids[i] = menuSet.MenuSetID
+		}
+		var prices []menuPrice
+		if err := tx.Raw(`SELECT uuid, name, price FROM menu_sets WHERE uuid IN ?`, ids).Scan(&prices).Error; err != nil {
+			return pricing.Breakdown{}, fmt.Errorf("failed to look up menu set prices: %w", err)
+		}
+		byID := make(map[string]menuPrice, len(prices))
+		names := make(map[string]string, len(prices))
+		for _, price := range prices {
+			byID[price.UUID] = price
+			names[price.UUID] = price.Name
+		}
+		for _, menuSet := range req.MenuSets {
+			if _, ok := byID[menuSet.MenuSetID]; !ok {
+				return pricing.Breakdown{}, fmt.Errorf("menu set %s not found", menuSet.MenuSetID)
+			}
+		}
+		choices, err := selectMenuSetChoicesTx(tx, req.MenuSets, names)
+		if err != nil {
+			return pricing.Breakdown{}, err
+		}
+		for i, menuSet := range req.MenuSets {
+			price := byID[menuSet.MenuSetID]
+			// ราคาต่อเซ็ตรวมราคาเพิ่มของเมนูที่เลือก
+			unitPrice := pricing.FromBaht(price.Price)
+			for _, choice := range choices[i] {
+				unitPrice += choice.Price * int64(choice.Quantity)
+			}
+			lines = append(lines, pricing.Line{
+				Kind:      pricing.KindMenuSet,
+				RefID:     menuSet.MenuSetID,
+				Name:      price.Name,
+				UnitPrice: unitPrice,
+				Quantity:  menuSet.Quantity,
+				Discount:  menuSet.Discount,
+				Modifiers: choices[i],
+			})
 		}
 	}
 
-	for _, menuItem := range req.MenuItems {
-		menuItemEntity := BookingMenuItemEntity{
-			BookingID:  bookingID,
-			MenuItemID: menuItem.MenuItemID,
-			Quantity:   menuItem.Quantity,
+	if len(req.MenuItems) > 0 {
+		ids := make([]string, len(req.MenuItems))
+		for i, menuItem := range req.MenuItems {
+			ids[i] = menuItem.MenuItemID
 		}
-		if err := tx.Create(&menuItemEntity).Error; err != nil {
-			tx.Rollback()
-			return err
+		var prices []menuPrice
+		if err := tx.Raw(`SELECT uuid, name_th AS name, price FROM menu_items WHERE uuid IN ?`, ids).Scan(&prices).Error; err != nil {
+			return pricing.Breakdown{}, fmt.Errorf("failed to look up menu item prices: %w", err)
+		}
+		byID := make(map[string]menuPrice, len(prices))
+		names := make(map[string]string, len(prices))
+		for _, price := range prices {
+			byID[price.UUID] = price
+			names[price.UUID] = price.Name
+		}
+		for _, menuItem := range req.MenuItems {
+			if _, ok := byID[menuItem.MenuItemID]; !ok {
+				return pricing.Breakdown{}, fmt.Errorf("menu item %s not found", menuItem.MenuItemID)
+			}
+		}
+		modifiers, err := selectMenuModifiersTx(tx, req.MenuItems, names)
+		if err != nil {
+			return pricing.Breakdown{}, err
+		}
+		for i, menuItem := range req.MenuItems {
+			price := byID[menuItem.MenuItemID]
+			// ราคาต่อหน่วยรวมราคาตัวเลือกที่เลือก
+			unitPrice := pricing.FromBaht(price.Price)
+			for _, modifier := range modifiers[i] {
+				unitPrice += modifier.Price * int64(modifier.Quantity)
+			}
+			lines = append(lines, pricing.Line{
+				Kind:      pricing.KindMenuItem,
+				RefID:     menuItem.MenuItemID,
+				Name:      price.Name,
+				UnitPrice: unitPrice,
+				Quantity:  menuItem.Quantity,
+				Discount:  menuItem.Discount,
+				Modifiers: modifiers[i],
+			})
 		}
 	}
 
-	// Commit the transaction if all operations succeed
-	return tx.Commit().Error
+	if bookingID != "" {
+		if err := keepStoredLinePricesTx(tx, bookingID, lines); err != nil {
+			return pricing.Breakdown{}, err
+		}
+	}
+
+	order := pricing.Order{
+		Lines:           lines,
+		Discount:        req.OrderDiscount,
+		NumAdults:       req.NumAdults,
+		NumChildren:     req.NumChildren,
+		BookingDateTime: req.BookingDateTime,
+	}
+	// วันในสัปดาห์ของโปรโมชั่นดูตามเวลาไทย
+	if bangkok, err := time.LoadLocation("Asia/Bangkok"); err == nil {
+		order.BookingDateTime = order.BookingDateTime.In(bangkok)
+	}
+	// ยกเลิกการจองได้เสมอ ไม่ว่าโค้ดโปรโมชั่นจะยังใช้ได้หรือไม่
+	cancelling := req.Status == "CANCELLED"
+	if req.PromotionCode != "" {
+		promotion, err := loadPromotionTx(tx, req.PromotionCode, bookingID)
+		var promotionErr *pricing.PromotionError
+		switch {
+		case err == nil:
+			promotion.Redeemed = promotion.Redeemed || cancelling
+			order.Promotion = promotion
+		case cancelling && errors.As(err, &promotionErr):
+			// โค้ดถูกลบไปแล้ว ยกเลิกโดยไม่ใช้โค้ด
+		default:
+			return pricing.Breakdown{}, err
+		}
+	}
+
+	breakdown, err := pricing.Calculate(r.pricing, order)
+	var promotionErr *pricing.PromotionError
+	if cancelling && order.Promotion != nil && errors.As(err, &promotionErr) {
+		order.Promotion = nil
+		return pricing.Calculate(r.pricing, order)
+	}
+	return breakdown, err
+}
+
+// QuoteBooking ถ้า req.BookingID มีค่า จะคิดราคาเหมือนแก้ไขการจองนั้น (ไม่นับการใช้โค้ดของการจองนั้นซ้ำ)
+func (r *bookingRepository) QuoteBooking(ctx context.Context, req *CreateBookingRequest) (pricing.Breakdown, error) {
+	db := r.DB.WithContext(ctx)
+	if err := checkMenuSetArchivedTx(db, req.BookingID, req); err != nil {
+		return pricing.Breakdown{}, err
+	}
+	return r.priceBookingTx(db, req.BookingID, req)
 }
 
 func (r *bookingRepository) UpdateBooking(ctx context.Context, bookingID string, req *CreateBookingRequest) error {
@@ -408,17 +674,70 @@ func (r *bookingRepository) UpdateBooking(ctx context.Context, bookingID string,
 		return fmt.Errorf("failed to query booking by ID: %w", err)
 	}
 
+	if err := expandTableCombinationTx(tx, req); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	// คำนวณยอดเงินใหม่จากรายการที่แก้ไข
+	breakdown, err := r.priceBookingTx(tx, bookingID, req)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	bookingPricing := newBookingPricing(breakdown)
+
+	// การจองที่ยกเลิกแล้วไม่ตัดสต็อกและไม่กันโต๊ะ จึงตรวจเวลาที่สั่งได้ สต็อก และโต๊ะเฉพาะเมื่อสถานะเป็น CONFIRMED
+	if req.Status == "CONFIRMED" {
+		if err := checkMenuSetArchivedTx(tx, bookingID, req); err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err := checkMenuScheduleTx(tx, req); err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err := checkMenuStockTx(tx, bookingID, req); err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err := checkTableZoneTx(tx, req); err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err := checkTableConflictsTx(tx, bookingID, req); err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err := checkTableBlocksTx(tx, req); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
 	// อัปเดตข้อมูลการจองหลัก
 	if err := tx.Model(&booking).Where("uuid = ?", bookingID).Updates(map[string]interface{}{
-		"customer_name":     req.CustomerName,
-		"company_name":      req.CompanyName,
-		"booking_date_time": req.BookingDateTime,
-		"phone_number":      req.PhoneNumber,
-		"num_children":      req.NumChildren,
-		"num_adults":        req.NumAdults,
-		"num_tables":        req.NumTables,
-		"total_price":       req.TotalPrice,
-		"status":            req.Status,
+		"customer_name":             req.CustomerName,
+		"company_name":              req.CompanyName,
+		"booking_date_time":         req.BookingDateTime,
+		"phone_number":              req.PhoneNumber,
+		"num_children":              req.NumChildren,
+		"num_adults":                req.NumAdults,
+		"num_tables":                req.NumTables,
+		"total_price":               pricing.ToBaht(breakdown.Total),
+		"status":                    req.Status,
+		"subtotal_satang":           bookingPricing.SubtotalSatang,
+		"line_discount_satang":      bookingPricing.LineDiscountSatang,
+		"order_discount_satang":     bookingPricing.OrderDiscountSatang,
+		"promotion_code":            bookingPricing.PromotionCode,
+		"promotion_discount_satang": bookingPricing.PromotionDiscountSatang,
+		"service_charge_satang":     bookingPricing.ServiceChargeSatang,
+		"service_charge_percent":    bookingPricing.ServiceChargePercent,
+		"vat_satang":                bookingPricing.VATSatang,
+		"vat_percent":               bookingPricing.VATPercent,
+		"vat_inclusive":             bookingPricing.VATInclusive,
+		"rounding_satang":           bookingPricing.RoundingSatang,
+		"total_satang":              bookingPricing.TotalSatang,
 	}).Error; err != nil {
 		tx.Rollback()
 		return fmt.Errorf("failed to : %w", err)
@@ -440,26 +759,13 @@ func (r *bookingRepository) UpdateBooking(ctx context.Context, bookingID string,
 		}
 	}
 
-	for _, menuSet := range req.MenuSets {
-		if err := tx.Create(&BookingMenuSetEntity{
-			BookingID: bookingID,
-			MenuSetID: menuSet.MenuSetID,
-			Quantity:  menuSet.Quantity,
-		}).Error; err != nil {
-			tx.Rollback()
-			return err
-		}
+	if err := createBookingLinesTx(tx, bookingID, breakdown); err != nil {
+		tx.Rollback()
+		return err
 	}
-
-	for _, menuItem := range req.MenuItems {
-		if err := tx.Create(&BookingMenuItemEntity{
-			BookingID:  bookingID,
-			MenuItemID: menuItem.MenuItemID,
-			Quantity:   menuItem.Quantity,
-		}).Error; err != nil {
-			tx.Rollback()
-			return err
-		}
+	if err := saveRedemptionTx(tx, bookingID, breakdown); err != nil {
+		tx.Rollback()
+		return err
 	}
 
 	// คอมมิทการทำธุรกรรม
@@ -491,6 +797,10 @@ func (r *bookingRepository) DeleteBooking(ctx context.Context, bookingID string)
 		tx.Rollback()
 		return err
 	}
+	if err := tx.Where("booking_id = ?", bookingID).Delete(&PromotionRedemptionEntity{}).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
 
 	// Delete the Booking
 	if err := tx.Where("uuid = ?", bookingID).Delete(&CreateBooking{}).Error; err != nil {
@@ -501,3 +811,102 @@ func (r *bookingRepository) DeleteBooking(ctx context.Context, bookingID string)
 	// Commit the transaction if all operations succeed
 	return tx.Commit().Error
 }
+
+// ImportBookings บันทึกการจองหลายรายการใน transaction เดียว โดยใช้ savepoint แยกแต่ละแถว
+// แถวที่ผิดพลาดจะถูก rollback เฉพาะแถวนั้น ส่วนแถวอื่นยังบันทึกได้ตามปกติ
+// ถ้า dryRun เป็น true จะ rollback ทั้งหมดหลังตรวจสอบเสร็จ
+func (r *bookingRepository) ImportBookings(ctx context.Context, rows []ImportBookingRow, dryRun bool) ([]ImportBookingResult, error) {
+	tx := r.DB.WithContext(ctx).Begin()
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	results := make([]ImportBookingResult, 0, len(rows))
+	for _, row := range rows {
+		savepoint := fmt.Sprintf("import_row_%d", row.Row)
+		if err := tx.SavePoint(savepoint).Error; err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("failed to create savepoint: %w", err)
+		}
+
+		bookingID, err := r.importBookingRowTx(tx, row)
+		if err != nil {
+			if rbErr := tx.RollbackTo(savepoint).Error; rbErr != nil {
+				tx.Rollback()
+				return nil, fmt.Errorf("failed to rollback row %d: %w", row.Row, rbErr)
+			}
+			results = append(results, ImportBookingResult{Row: row.Row, Error: err.Error()})
+			continue
+		}
+
+		results = append(results, ImportBookingResult{Row: row.Row, BookingID: bookingID})
+	}
+
+	if dryRun {
+		return results, tx.Rollback().Error
+	}
+	return results, tx.Commit().Error
+}
+
+// importBookingRowTx แปลงหมายเลขโต๊ะและชื่อเมนูเซ็ตเป็น ID แล้วบันทึกการจองด้วย createBookingTx
+func (r *bookingRepository) importBookingRowTx(tx *gorm.DB, row ImportBookingRow) (string, error) {
+	req := row.Booking
+
+	if len(row.TableNumbers) > 0 {
+		var tables []struct {
+			UUID     string `gorm:"column:uuid"`
+			NumTable string `gorm:"column:num_table"`
+		}
+		if err := tx.Raw(`SELECT uuid, num_table FROM tables WHERE num_table IN ?`, row.TableNumbers).Scan(&tables).Error; err != nil {
+			return "", fmt.Errorf("failed to look up tables: %w", err)
+		}
+
+		tableIDs := make(map[string]string, len(tables))
+		for _, table := range tables {
+			tableIDs[table.NumTable] = table.UUID
+		}
+		for _, number := range row.TableNumbers {
+			tableID, ok := tableIDs[number]
+			if !ok {
+				return "", fmt.Errorf("table %q not found", number)
+			}
+			req.Tables = append(req.Tables, CreateBookingTable{TableID: tableID})
+		}
+		req.NumTables = int32(len(req.Tables))
+	}
+
+	if len(row.MenuSets) > 0 {
+		names := make([]string, len(row.MenuSets))
+		for i, menuSet := range row.MenuSets {
+			names[i] = strings.ToLower(menuSet.Name)
+		}
+
+		var menuSets []struct {
+			UUID string `gorm:"column:uuid"`
+			Name string `gorm:"column:name"`
+		}
+		if err := tx.Raw(`SELECT uuid, name FROM menu_sets WHERE lower(name) IN ?`, names).Scan(&menuSets).Error; err != nil {
+			return "", fmt.Errorf("failed to look up menu sets: %w", err)
+		}
+
+		// ชื่อเมนูเซ็ตไม่ได้ unique ในฐานข้อมูล ถ้าเจอชื่อซ้ำจะไม่เดาเอง
+		byName := make(map[string][]int, len(menuSets))
+		for i, menuSet := range menuSets {
+			key := strings.ToLower(menuSet.Name)
+			byName[key] = append(byName[key], i)
+		}
+		for _, menuSet := range row.MenuSets {
+			matches := byName[strings.ToLower(menuSet.Name)]
+			if len(matches) == 0 {
+				return "", fmt.Errorf("menu set %q not found", menuSet.Name)
+			}
+			if len(matches) > 1 {
+				return "", fmt.Errorf("menu set name %q matches %d menu sets", menuSet.Name, len(matches))
+			}
+			found := menuSets[matches[0]]
+			req.MenuSets = append(req.MenuSets, CreateBookingMenuSet{MenuSetID: found.UUID, Quantity: menuSet.Quantity})
+		}
+	}
+
+	return r.createBookingTx(tx, &req)
+}
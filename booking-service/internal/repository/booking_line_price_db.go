@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"gitlab.com/final_project1240930/booking_service/internal/pricing"
+	"gorm.io/gorm"
+)
+
+// storedLinePrice คือราคาที่บันทึกไว้ของรายการหนึ่งในการจองเดิม
+type storedLinePrice struct {
+	UnitPrice int64            // รวมราคาตัวเลือกแล้ว
+	Modifiers map[string]int64 // ราคาตัวเลือกแต่ละตัว key จาก modifierKey
+}
+
+// modifierKey ระบุตัวเลือกของรายการ เมนูที่เลือกในเซ็ตแยกตามช่องและจำนวนที่เลือก
+func modifierKey(kind string, modifier pricing.Modifier) string {
+	if kind == pricing.KindMenuSet {
+		return fmt.Sprintf("%s/%s*%d", modifier.GroupID, modifier.RefID, modifier.Quantity)
+	}
+	return modifier.RefID
+}
+
+// lineKey ระบุรายการจากเมนูและตัวเลือกที่เลือก (ไม่รวมจำนวนและส่วนลด)
+func lineKey(kind, refID string, modifiers []pricing.Modifier) string {
+	keys := make([]string, len(modifiers))
+	for i, modifier := range modifiers {
+		keys[i] = modifierKey(kind, modifier)
+	}
+	sort.Strings(keys)
+	return kind + ":" + refID + "[" + strings.Join(keys, ",") + "]"
+}
+
+// storedLinePricesTx อ่านราคาของรายการที่บันทึกไว้ในการจอง bookingID
+func storedLinePricesTx(tx *gorm.DB, bookingID string) (map[string]storedLinePrice, error) {
+	type storedLine struct {
+		UUID            string `gorm:"column:uuid"`
+		RefID           string `gorm:"column:ref_id"`
+		UnitPriceSatang int64  `gorm:"column:unit_price_satang"`
+	}
+	type storedModifier struct {
+		LineID      string `gorm:"column:line_id"`
+		GroupID     string `gorm:"column:group_id"`
+		RefID       string `gorm:"column:ref_id"`
+		Quantity    int32  `gorm:"column:quantity"`
+		PriceSatang int64  `gorm:"column:price_satang"`
+	}
+
+	var sets, items []storedLine
+	if err := tx.Raw(`SELECT uuid, menu_set_id AS ref_id, unit_price_satang FROM booking_menu_sets WHERE booking_id = ?`, bookingID).Scan(&sets).Error; err != nil {
+		return nil, fmt.Errorf("failed to query booked menu set prices: %w", err)
+	}
+	if err := tx.Raw(`SELECT uuid, menu_item_id AS ref_id, unit_price_satang FROM booking_menu_items WHERE booking_id = ?`, bookingID).Scan(&items).Error; err != nil {
+		return nil, fmt.Errorf("failed to query booked menu item prices: %w", err)
+	}
+
+	var choices, modifiers []storedModifier
+	if err := tx.Raw(`
+		SELECT c.booking_menu_set_id AS line_id, c.slot_id AS group_id, c.menu_item_id AS ref_id, c.quantity, c.upcharge_satang AS price_satang
+		FROM booking_menu_set_choices c
+		JOIN booking_menu_sets bms ON bms.uuid = c.booking_menu_set_id
+		WHERE bms.booking_id = ?`, bookingID).Scan(&choices).Error; err != nil {
+		return nil, fmt.Errorf("failed to query booked menu set choices: %w", err)
+	}
+	if err := tx.Raw(`
+		SELECT m.booking_menu_item_id AS line_id, m.modifier_option_id AS ref_id, 1 AS quantity, m.price_delta_satang AS price_satang
+		FROM booking_menu_item_modifiers m
+		JOIN booking_menu_items bmi ON bmi.uuid = m.booking_menu_item_id
+		WHERE bmi.booking_id = ?`, bookingID).Scan(&modifiers).Error; err != nil {
+		return nil, fmt.Errorf("failed to query booked menu item modifiers: %w", err)
+	}
+
+	prices := make(map[string]storedLinePrice, len(sets)+len(items))
+	add := func(kind string, lines []storedLine, lineModifiers []storedModifier) {
+		byLine := make(map[string][]pricing.Modifier)
+		for _, m := range lineModifiers {
+			byLine[m.LineID] = append(byLine[m.LineID], pricing.Modifier{GroupID: m.GroupID, RefID: m.RefID, Quantity: m.Quantity, Price: m.PriceSatang})
+		}
+		for _, line := range lines {
+			stored := storedLinePrice{UnitPrice: line.UnitPriceSatang, Modifiers: make(map[string]int64)}
+			for _, modifier := range byLine[line.UUID] {
+				stored.Modifiers[modifierKey(kind, modifier)] = modifier.Price
+			}
+			prices[lineKey(kind, line.RefID, byLine[line.UUID])] = stored
+		}
+	}
+	add(pricing.KindMenuSet, sets, choices)
+	add(pricing.KindMenuItem, items, modifiers)
+	return prices, nil
+}
+
+// keepStoredLinePricesTx ให้รายการที่มีอยู่แล้วในการจองเดิม (เมนูและตัวเลือกเดียวกัน) ใช้ราคาที่บันทึกไว้
+// การแก้ไขการจองจึงไม่เปลี่ยนราคาเมื่อราคาเมนูเปลี่ยนไปแล้ว เฉพาะรายการที่เพิ่มหรือเปลี่ยนตัวเลือกจะใช้ราคาปัจจุบัน
+func keepStoredLinePricesTx(tx *gorm.DB, bookingID string, lines []pricing.Line) error {
+	prices, err := storedLinePricesTx(tx, bookingID)
+	if err != nil {
+		return err
+	}
+	for i := range lines {
+		stored, ok := prices[lineKey(lines[i].Kind, lines[i].RefID, lines[i].Modifiers)]
+		if !ok {
+			continue
+		}
+		lines[i].UnitPrice = stored.UnitPrice
+		for j := range lines[i].Modifiers {
+			lines[i].Modifiers[j].Price = stored.Modifiers[modifierKey(lines[i].Kind, lines[i].Modifiers[j])]
+		}
+	}
+	return nil
+}
@@ -44,6 +44,21 @@ type Menu struct {
 	TotalQuantitySold int32  `json:"total_quantity_sold"`
 }
 
+type SalesReportRow struct {
+	Period         string  `json:"period"`
+	TotalSales     float64 `json:"total_sales"`
+	TotalBookings  int32   `json:"total_bookings"`
+	TotalCustomers int32   `json:"total_customers"`
+}
+
+type BestSellerRow struct {
+	Kind              string  `json:"kind"`
+	NameTh            string  `json:"name_th"`
+	NameEn            string  `json:"name_en"`
+	TotalQuantitySold int32   `json:"total_quantity_sold"`
+	TotalSales        float64 `json:"total_sales"`
+}
+
 type DashboardRepository interface {
 	GetDailySales(ctx context.Context) (float64, error)
 	GetDailyBookings(ctx context.Context) (int32, error)
@@ -53,4 +68,8 @@ type DashboardRepository interface {
 	GetMonthlySales(ctx context.Context) (GetMonthlySalesResponse, error)
 	GetMonthlyBookingAndCustomers(ctx context.Context) (GetMonthlyBookingAndCustomersResponse, error)
 	GetBestSellers(ctx context.Context) (GetBestSellersResponse, error)
+
+	// Export ตามช่วงวันที่ (startDate/endDate เป็น "2006-01-02" และรวมวันสิ้นสุด)
+	StreamSalesReport(ctx context.Context, startDate, endDate string, monthly bool, fn func(SalesReportRow) error) error
+	StreamBestSellers(ctx context.Context, startDate, endDate string, fn func(BestSellerRow) error) error
 }
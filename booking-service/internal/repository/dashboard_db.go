@@ -169,3 +169,103 @@ func (r *dashboardRepository) GetBestSellers(ctx context.Context) (GetBestSeller
 	logs.Info("Successfully fetched best sellers")
 	return response, nil
 }
+
+// StreamSalesReport returns sales, bookings and customers per day (or per month) in the given date range,
+// including periods without any booking
+func (r *dashboardRepository) StreamSalesReport(ctx context.Context, startDate, endDate string, monthly bool, fn func(SalesReportRow) error) error {
+	unit, format := "day", "YYYY-MM-DD"
+	if monthly {
+		unit, format = "month", "YYYY-MM"
+	}
+
+	query := fmt.Sprintf(`
+		WITH periods AS (
+			SELECT generate_series(
+				date_trunc('%[1]s', ?::timestamp),
+				?::timestamp,
+				interval '1 %[1]s'
+			) AS period_start
+		)
+		SELECT 
+			to_char(periods.period_start, '%[2]s') AS period,
+			COALESCE(SUM(b.total_price), 0) AS total_sales,
+			COUNT(b.uuid) AS total_bookings,
+			COALESCE(SUM(b.num_children + b.num_adults), 0) AS total_customers
+		FROM periods
+		LEFT JOIN bookings b
+			ON b.booking_date_time >= periods.period_start
+			AND b.booking_date_time < periods.period_start + interval '1 %[1]s'
+			AND b.booking_date_time >= ?::date
+			AND b.booking_date_time < ?::date + interval '1 day'
+		GROUP BY periods.period_start
+		ORDER BY periods.period_start
+	`, unit, format)
+
+	rows, err := r.db.WithContext(ctx).Raw(query, startDate, endDate, startDate, endDate).Rows()
+	if err != nil {
+		logs.Error("Failed to get sales report", zap.Error(err))
+		return fmt.Errorf("failed to get sales report: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row SalesReportRow
+		if err := r.db.ScanRows(rows, &row); err != nil {
+			return fmt.Errorf("failed to scan sales report row: %w", err)
+		}
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// StreamBestSellers returns every menu set and a la carte item sold in the given date range, best selling first
+func (r *dashboardRepository) StreamBestSellers(ctx context.Context, startDate, endDate string, fn func(BestSellerRow) error) error {
+	rows, err := r.db.WithContext(ctx).Raw(`
+		SELECT * FROM (
+			SELECT 
+				'MENU_SET' AS kind,
+				ms.name AS name_th,
+				ms.name AS name_en,
+				SUM(bms.quantity) AS total_quantity_sold,
+				SUM(bms.quantity * ms.price) AS total_sales
+			FROM booking_menu_sets bms
+			JOIN menu_sets ms ON bms.menu_set_id = ms.uuid
+			JOIN bookings b ON bms.booking_id = b.uuid
+			WHERE b.booking_date_time >= ?::date
+				AND b.booking_date_time < ?::date + interval '1 day'
+			GROUP BY ms.name
+			UNION ALL
+			SELECT 
+				'A_LA_CARTE' AS kind,
+				mi.name_th,
+				mi.name_en,
+				SUM(bmi.quantity) AS total_quantity_sold,
+				SUM(bmi.quantity * mi.price) AS total_sales
+			FROM booking_menu_items bmi
+			JOIN menu_items mi ON bmi.menu_item_id = mi.uuid
+			JOIN bookings b ON bmi.booking_id = b.uuid
+			WHERE b.booking_date_time >= ?::date
+				AND b.booking_date_time < ?::date + interval '1 day'
+			GROUP BY mi.name_th, mi.name_en
+		) best_sellers
+		ORDER BY kind DESC, total_quantity_sold DESC
+	`, startDate, endDate, startDate, endDate).Rows()
+	if err != nil {
+		logs.Error("Failed to get best sellers report", zap.Error(err))
+		return fmt.Errorf("failed to get best sellers report: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row BestSellerRow
+		if err := r.db.ScanRows(rows, &row); err != nil {
+			return fmt.Errorf("failed to scan best sellers row: %w", err)
+		}
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"strings"
+)
+
+// แท็กต้องตรงกับ repository.Allergens และ repository.DietaryTags ของ restaurant-service
+// (ตาราง menu_item_tags เป็นของ restaurant-service)
+var (
+	Allergens   = []string{"PEANUT", "TREE_NUT", "SHELLFISH", "FISH", "GLUTEN", "DAIRY", "EGG", "SOY", "SESAME"}
+	DietaryTags = []string{"HALAL", "VEGETARIAN", "VEGAN", "GLUTEN_FREE", "NO_PORK"}
+)
+
+// ประเภทของข้อจำกัด ใช้ค่าเดียวกับ kind ของ menu_item_tags
+const (
+	tagKindAllergen = "ALLERGEN"
+	tagKindDietary  = "DIETARY"
+)
+
+// DietaryRestrictions คือข้อจำกัดด้านอาหารที่บันทึกไว้ของลูกค้า (ระบุลูกค้าด้วยเบอร์โทร)
+type DietaryRestrictions struct {
+	PhoneNumber     string   `json:"phone_number"`
+	AvoidAllergens  []string `json:"avoid_allergens"`  // แพ้หรือไม่ทานสารเหล่านี้
+	RequiredDietary []string `json:"required_dietary"` // ต้องเป็นเมนูที่มีแท็กเหล่านี้ (เช่น HALAL)
+}
+
+type DietaryRestrictionEntity struct {
+	PhoneNumber string `gorm:"column:phone_number;primaryKey"`
+	Kind        string `gorm:"column:kind;primaryKey"`
+	Tag         string `gorm:"column:tag;primaryKey"`
+}
+
+func (DietaryRestrictionEntity) TableName() string {
+	return "customer_dietary_restrictions"
+}
+
+// NormalizePhoneNumber เก็บเฉพาะตัวเลข (และ + นำหน้า) เพื่อให้เบอร์ที่พิมพ์ต่างกันเป็นลูกค้าคนเดียวกัน
+func NormalizePhoneNumber(phone string) string {
+	phone = strings.TrimSpace(phone)
+	var b strings.Builder
+	for i, r := range phone {
+		if (r >= '0' && r <= '9') || (r == '+' && i == 0) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
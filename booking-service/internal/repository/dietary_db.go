@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"gorm.io/gorm"
+)
+
+func (r *bookingRepository) GetDietaryRestrictions(ctx context.Context, phoneNumber string) (DietaryRestrictions, error) {
+	restrictions := DietaryRestrictions{PhoneNumber: NormalizePhoneNumber(phoneNumber)}
+
+	var entities []DietaryRestrictionEntity
+	if err := r.DB.WithContext(ctx).Where("phone_number = ?", restrictions.PhoneNumber).Order("kind, tag").Find(&entities).Error; err != nil {
+		return restrictions, fmt.Errorf("failed to query dietary restrictions: %w", err)
+	}
+	for _, entity := range entities {
+		switch entity.Kind {
+		case tagKindAllergen:
+			restrictions.AvoidAllergens = append(restrictions.AvoidAllergens, entity.Tag)
+		case tagKindDietary:
+			restrictions.RequiredDietary = append(restrictions.RequiredDietary, entity.Tag)
+		}
+	}
+	return restrictions, nil
+}
+
+// SetDietaryRestrictions แทนที่ข้อจำกัดทั้งหมดของลูกค้า (ส่งว่าง = ลบทิ้ง)
+func (r *bookingRepository) SetDietaryRestrictions(ctx context.Context, restrictions DietaryRestrictions) error {
+	phoneNumber := NormalizePhoneNumber(restrictions.PhoneNumber)
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("phone_number = ?", phoneNumber).Delete(&DietaryRestrictionEntity{}).Error; err != nil {
+			return fmt.Errorf("failed to clear dietary restrictions: %w", err)
+		}
+
+		var entities []DietaryRestrictionEntity
+		for _, tag := range restrictions.AvoidAllergens {
+			entities = append(entities, DietaryRestrictionEntity{PhoneNumber: phoneNumber, Kind: tagKindAllergen, Tag: tag})
+		}
+		for _, tag := range restrictions.RequiredDietary {
+			entities = append(entities, DietaryRestrictionEntity{PhoneNumber: phoneNumber, Kind: tagKindDietary, Tag: tag})
+		}
+		if len(entities) == 0 {
+			return nil
+		}
+		if err := tx.Create(&entities).Error; err != nil {
+			return fmt.Errorf("failed to save dietary restrictions: %w", err)
+		}
+		return nil
+	})
+}
+
+// DietaryWarnings ตรวจเมนูที่สั่งแยกและเมนูในเซ็ตกับข้อจำกัดที่บันทึกไว้ของลูกค้า
+// เป็นคำเตือนให้พนักงานยืนยันกับลูกค้า ไม่ได้ห้ามจอง
+func (r *bookingRepository) DietaryWarnings(ctx context.Context, req *CreateBookingRequest) ([]string, error) {
+	restrictions, err := r.GetDietaryRestrictions(ctx, req.PhoneNumber)
+	if err != nil {
+		return nil, err
+	}
+	if len(restrictions.AvoidAllergens) == 0 && len(restrictions.RequiredDietary) == 0 {
+		return nil, nil
+	}
+
+	db := r.DB.WithContext(ctx)
+	ids := make(map[string]bool)
+	for _, menuItem := range req.MenuItems {
+		ids[menuItem.MenuItemID] = true
+	}
+	if len(req.MenuSets) > 0 {
+		setIDs := make([]string, len(req.MenuSets))
+		for i, menuSet := range req.MenuSets {
+			setIDs[i] = menuSet.MenuSetID
+			// เมนูที่เลือกในช่องเลือกของเซ็ตก็ต้องเตือนเช่นกัน
+			for _, choice := range menuSet.Choices {
+				ids[choice.MenuItemID] = true
+			}
+		}
+		var itemIDs []string
+		if err := db.Raw(`SELECT DISTINCT menu_item_id FROM menu_set_items WHERE menu_set_id IN ?`, setIDs).Scan(&itemIDs).Error; err != nil {
+			return nil, fmt.Errorf("failed to look up menu set items: %w", err)
+		}
+		for _, id := range itemIDs {
+			ids[id] = true
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	menuItemIDs := make([]string, 0, len(ids))
+	for id := range ids {
+		menuItemIDs = append(menuItemIDs, id)
+	}
+
+	var rows []struct {
+		UUID   string  `gorm:"column:uuid"`
+		NameTH string  `gorm:"column:name_th"`
+		Kind   *string `gorm:"column:kind"`
+		Tag    *string `gorm:"column:tag"`
+	}
+	if err := db.Raw(`
+		SELECT mi.uuid, mi.name_th, t.kind, t.tag
+		FROM menu_items mi
+		LEFT JOIN menu_item_tags t ON t.menu_item_id = mi.uuid
+		WHERE mi.uuid IN ?`, menuItemIDs).Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to look up menu item tags: %w", err)
+	}
+
+	names := make(map[string]string)
+	tags := make(map[string]map[string]bool)
+	for _, row := range rows {
+		names[row.UUID] = row.NameTH
+		if tags[row.UUID] == nil {
+			tags[row.UUID] = make(map[string]bool)
+		}
+		if row.Kind != nil && row.Tag != nil {
+			tags[row.UUID][*row.Kind+":"+*row.Tag] = true
+		}
+	}
+
+	var warnings []string
+	for id, name := range names {
+		for _, allergen := range restrictions.AvoidAllergens {
+			if tags[id][tagKindAllergen+":"+allergen] {
+				warnings = append(warnings, fmt.Sprintf("menu item %q contains %s", name, allergen))
+			}
+		}
+		for _, dietary := range restrictions.RequiredDietary {
+			if !tags[id][tagKindDietary+":"+dietary] {
+				warnings = append(warnings, fmt.Sprintf("menu item %q is not marked %s", name, dietary))
+			}
+		}
+	}
+	sort.Strings(warnings)
+	return warnings, nil
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"fmt"
+
+	"gitlab.com/final_project1240930/booking_service/internal/pricing"
+	"gorm.io/gorm"
+)
+
+// MenuModifierError ตัวเลือกที่เลือกไม่ตรงกับกลุ่มตัวเลือกของเมนู
+// (ไม่ใช่ตัวเลือกของเมนูนี้ เลือกซ้ำ ไม่ได้เลือกกลุ่มที่บังคับ หรือเลือกเกินจำนวน)
+type MenuModifierError struct {
+	MenuItemID string
+	Name       string
+	Reason     string
+}
+
+func (e *MenuModifierError) Error() string {
+	return fmt.Sprintf("menu item %q: %s", e.Name, e.Reason)
+}
+
+// menuModifierOption คือตัวเลือกหนึ่งแถวพร้อมกลุ่ม (restaurant-service เป็นเจ้าของตาราง)
+type menuModifierOption struct {
+	OptionID      string  `gorm:"column:option_id"`
+	GroupID       string  `gorm:"column:group_id"`
+	MenuItemID    string  `gorm:"column:menu_item_id"`
+	GroupName     string  `gorm:"column:group_name"`
+	Name          string  `gorm:"column:name"`
+	SelectionType string  `gorm:"column:selection_type"`
+	MinSelect     int32   `gorm:"column:min_select"`
+	MaxSelect     int32   `gorm:"column:max_select"`
+	PriceDelta    float64 `gorm:"column:price_delta"`
+}
+
+// selectMenuModifiersTx ตรวจตัวเลือกที่เลือกของเมนูที่สั่งแยกแต่ละรายการตามกลุ่มตัวเลือกของเมนู
+// คืนตัวเลือกเรียงตามลำดับกลุ่มและตัวเลือก (index เดียวกับ items) names คือชื่อเมนูไว้แสดงใน error
+func selectMenuModifiersTx(tx *gorm.DB, items []CreateBookingMenuItem, names map[string]string) ([][]pricing.Modifier, error) {
+	ids := make([]string, len(items))
+	for i, item := range items {
+		ids[i] = item.MenuItemID
+	}
+	var options []menuModifierOption
+	if err := tx.Raw(`
+		SELECT o.uuid AS option_id, g.uuid AS group_id, g.menu_item_id, g.name_th AS group_name, o.name_th AS name,
+			g.selection_type, g.min_select, g.max_select, o.price_delta
+		FROM menu_modifier_groups g
+		JOIN menu_modifier_options o ON o.group_id = g.uuid
+		WHERE g.menu_item_id IN ?
+		ORDER BY g.menu_item_id, g.position, o.position`, ids).Scan(&options).Error; err != nil {
+		return nil, fmt.Errorf("failed to look up menu modifiers: %w", err)
+	}
+	byItem := make(map[string][]menuModifierOption)
+	for _, option := range options {
+		byItem[option.MenuItemID] = append(byItem[option.MenuItemID], option)
+	}
+
+	selected := make([][]pricing.Modifier, len(items))
+	for i, item := range items {
+		invalid := func(format string, args ...interface{}) error {
+			return &MenuModifierError{MenuItemID: item.MenuItemID, Name: names[item.MenuItemID], Reason: fmt.Sprintf(format, args...)}
+		}
+
+		chosen := make(map[string]bool, len(item.ModifierOptionIDs))
+		for _, id := range item.ModifierOptionIDs {
+			if chosen[id] {
+				return nil, invalid("modifier option %s is selected more than once", id)
+			}
+			chosen[id] = true
+		}
+
+		itemOptions := byItem[item.MenuItemID]
+		known := make(map[string]bool, len(itemOptions))
+		counts := make(map[string]int32)
+		for _, option := range itemOptions {
+			known[option.OptionID] = true
+			if chosen[option.OptionID] {
+				counts[option.GroupID]++
+				selected[i] = append(selected[i], pricing.Modifier{
+					RefID:    option.OptionID,
+					GroupID:  option.GroupID,
+					Group:    option.GroupName,
+					Name:     option.Name,
+					Price:    pricing.FromBaht(option.PriceDelta),
+					Quantity: 1,
+				})
+			}
+		}
+		for _, id := range item.ModifierOptionIDs {
+			if !known[id] {
+				return nil, invalid("modifier option %s is not offered for this menu item", id)
+			}
+		}
+
+		// ตรวจจำนวนที่เลือกของแต่ละกลุ่ม (ตัวเลือกเรียงตามกลุ่ม ตรวจครั้งเดียวต่อกลุ่ม)
+		checked := make(map[string]bool)
+		for _, option := range itemOptions {
+			if checked[option.GroupID] {
+				continue
+			}
+			checked[option.GroupID] = true
+			count := counts[option.GroupID]
+			if count < option.MinSelect {
+				return nil, invalid("%q needs at least %d selection(s)", option.GroupName, option.MinSelect)
+			}
+			if count > option.MaxSelect {
+				return nil, invalid("%q allows at most %d selection(s)", option.GroupName, option.MaxSelect)
+			}
+		}
+	}
+	return selected, nil
+}
+
+// createBookingModifiersTx บันทึกตัวเลือกของรายการ โดยเก็บชื่อจากเมนูปัจจุบันและราคาที่ใช้คิดเงิน
+func createBookingModifiersTx(tx *gorm.DB, bookingMenuItemID string, modifiers []pricing.Modifier) error {
+	for i, modifier := range modifiers {
+		result := tx.Exec(`
+			INSERT INTO booking_menu_item_modifiers
+				(booking_menu_item_id, modifier_option_id, group_name_th, group_name_en, name_th, name_en, price_delta_satang, position)
+			SELECT ?, o.uuid, g.name_th, g.name_en, o.name_th, o.name_en, ?, ?
+			FROM menu_modifier_options o
+			JOIN menu_modifier_groups g ON g.uuid = o.group_id
+			WHERE o.uuid = ?`, bookingMenuItemID, modifier.Price, i, modifier.RefID)
+		if result.Error != nil {
+			return fmt.Errorf("failed to save menu item modifier: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("modifier option %s not found", modifier.RefID)
+		}
+	}
+	return nil
+}
+
+// attachBookingModifiers ใส่ตัวเลือกที่บันทึกไว้ให้เมนูที่สั่งแยกของการจอง
+func attachBookingModifiers(db *gorm.DB, bookings []Booking) error {
+	var lineIDs []string
+	for _, booking := range bookings {
+		for _, item := range booking.BookingMenuItems {
+			lineIDs = append(lineIDs, item.LineID)
+		}
+	}
+	if len(lineIDs) == 0 {
+		return nil
+	}
+
+	var modifiers []BookingMenuItemModifier
+	if err := db.Where("booking_menu_item_id IN ?", lineIDs).
+		Order("booking_menu_item_id, position").Find(&modifiers).Error; err != nil {
+		return fmt.Errorf("failed to query booking menu item modifiers: %w", err)
+	}
+	byLine := make(map[string][]BookingMenuItemModifier)
+	for _, modifier := range modifiers {
+		byLine[modifier.BookingMenuItemID] = append(byLine[modifier.BookingMenuItemID], modifier)
+	}
+
+	for i := range bookings {
+		for j := range bookings[i].BookingMenuItems {
+			item := &bookings[i].BookingMenuItems[j]
+			item.Modifiers = byLine[item.LineID]
+		}
+	}
+	return nil
+}
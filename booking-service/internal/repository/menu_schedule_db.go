@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// MenuScheduleError เมนูหรือเมนูเซ็ตที่สั่งไม่อยู่ในช่วงเวลาที่เปิดให้สั่งของวันเวลาที่จอง
+type MenuScheduleError struct {
+	Kind string // "menu item" หรือ "menu set"
+	ID   string
+	Name string
+}
+
+func (e *MenuScheduleError) Error() string {
+	return fmt.Sprintf("%s %q is not available at the booking time", e.Kind, e.Name)
+}
+
+// menuSchedule คือแถวของตาราง menu_schedules (restaurant-service เป็นเจ้าของตาราง)
+type menuSchedule struct {
+	OwnerID     string     `gorm:"column:owner_id"`
+	OwnerName   string     `gorm:"column:owner_name"`
+	DaysOfWeek  int16      `gorm:"column:days_of_week"` // bitmask, bit 0 = อาทิตย์, 0 = ทุกวัน
+	StartMinute int16      `gorm:"column:start_minute"`
+	EndMinute   int16      `gorm:"column:end_minute"`
+	ValidFrom   *time.Time `gorm:"column:valid_from"`
+	ValidUntil  *time.Time `gorm:"column:valid_until"`
+}
+
+// activeAt ต้องตรงกับ MenuSchedule.ActiveAt ของ restaurant-service (menu_schedule_db_test.go ทดสอบด้วยกรณีชุดเดียวกัน)
+// ช่วงที่ข้ามเที่ยงคืน (StartMinute > EndMinute) ส่วนหลังเที่ยงคืนนับเป็นของวันก่อนหน้า
+func (s menuSchedule) activeAt(t time.Time) bool {
+	minute := int16(t.Hour()*60 + t.Minute())
+	if s.StartMinute > s.EndMinute {
+		if minute >= s.StartMinute {
+			return s.onDay(t)
+		}
+		return minute < s.EndMinute && s.onDay(t.AddDate(0, 0, -1))
+	}
+	return minute >= s.StartMinute && minute < s.EndMinute && s.onDay(t)
+}
+
+func (s menuSchedule) onDay(t time.Time) bool {
+	day := t.Format("2006-01-02")
+	if s.ValidFrom != nil && day < s.ValidFrom.Format("2006-01-02") {
+		return false
+	}
+	if s.ValidUntil != nil && day > s.ValidUntil.Format("2006-01-02") {
+		return false
+	}
+	return s.DaysOfWeek == 0 || s.DaysOfWeek&(1<<t.Weekday()) != 0
+}
+
+// checkMenuScheduleTx ตรวจว่าเมนูที่สั่งแยกและเมนูเซ็ตทุกรายการสั่งได้ในวันเวลาที่จอง (ตามเวลาไทย)
+// เมนูที่ไม่มี schedule สั่งได้ตลอด ถ้ามีหลายช่วง ตรงช่วงใดช่วงหนึ่งก็สั่งได้
+func checkMenuScheduleTx(tx *gorm.DB, req *CreateBookingRequest) error {
+	at := req.BookingDateTime
+	if bangkok, err := time.LoadLocation("Asia/Bangkok"); err == nil {
+		at = at.In(bangkok)
+	}
+
+	if len(req.MenuItems) > 0 {
+		ids := make([]string, len(req.MenuItems))
+		for i, menuItem := range req.MenuItems {
+			ids[i] = menuItem.MenuItemID
+		}
+		if err := checkSchedulesTx(tx, "menu item", `
+			SELECT ms.menu_item_id AS owner_id, mi.name_th AS owner_name, ms.days_of_week, ms.start_minute, ms.end_minute, ms.valid_from, ms.valid_until
+			FROM menu_schedules ms
+			JOIN menu_items mi ON mi.uuid = ms.menu_item_id
+			WHERE ms.menu_item_id IN ?`, ids, at); err != nil {
+			return err
+		}
+	}
+
+	if len(req.MenuSets) > 0 {
+		ids := make([]string, len(req.MenuSets))
+		for i, menuSet := range req.MenuSets {
+			ids[i] = menuSet.MenuSetID
+		}
+		if err := checkSchedulesTx(tx, "menu set", `
+			SELECT ms.menu_set_id AS owner_id, s.name AS owner_name, ms.days_of_week, ms.start_minute, ms.end_minute, ms.valid_from, ms.valid_until
+			FROM menu_schedules ms
+			JOIN menu_sets s ON s.uuid = ms.menu_set_id
+			WHERE ms.menu_set_id IN ?`, ids, at); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkSchedulesTx(tx *gorm.DB, kind, query string, ids []string, at time.Time) error {
+	var schedules []menuSchedule
+	if err := tx.Raw(query, ids).Scan(&schedules).Error; err != nil {
+		return fmt.Errorf("failed to look up %s schedules: %w", kind, err)
+	}
+
+	names := make(map[string]string)
+	active := make(map[string]bool)
+	for _, schedule := range schedules {
+		names[schedule.OwnerID] = schedule.OwnerName
+		if schedule.activeAt(at) {
+			active[schedule.OwnerID] = true
+		}
+	}
+	for _, id := range ids {
+		if _, scheduled := names[id]; scheduled && !active[id] {
+			return &MenuScheduleError{Kind: kind, ID: id, Name: names[id]}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"testing"
+	"time"
+)
+
+// กรณีทดสอบชุดเดียวกับ menu_schedule_test.go ของ restaurant-service ถ้าแก้ที่หนึ่งต้องแก้อีกที่ด้วย
+func TestMenuScheduleActiveAt(t *testing.T) {
+	bangkok, err := time.LoadLocation("Asia/Bangkok")
+	if err != nil {
+		t.Fatalf("LoadLocation() error = %v", err)
+	}
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2024, 12, day, hour, minute, 0, 0, bangkok) // 19 = พฤหัส, 20 = ศุกร์, 21 = เสาร์
+	}
+	date := func(day int) *time.Time {
+		d := time.Date(2024, 12, day, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+	const friday = 1 << time.Friday
+
+	tests := []struct {
+		name     string
+		schedule menuSchedule
+		at       time.Time
+		want     bool
+	}{
+		{name: "lunch window includes its start", schedule: menuSchedule{StartMinute: 11 * 60, EndMinute: 14 * 60}, at: at(20, 11, 0), want: true},
+		{name: "lunch window excludes its end", schedule: menuSchedule{StartMinute: 11 * 60, EndMinute: 14 * 60}, at: at(20, 14, 0), want: false},
+		{name: "window ending at 24:00 includes 23:59", schedule: menuSchedule{StartMinute: 22 * 60, EndMinute: 24 * 60}, at: at(20, 23, 59), want: true},
+		{name: "overnight window before midnight", schedule: menuSchedule{StartMinute: 22 * 60, EndMinute: 2 * 60}, at: at(20, 23, 0), want: true},
+		{name: "overnight window after midnight", schedule: menuSchedule{StartMinute: 22 * 60, EndMinute: 2 * 60}, at: at(21, 1, 59), want: true},
+		{name: "overnight window excludes its end", schedule: menuSchedule{StartMinute: 22 * 60, EndMinute: 2 * 60}, at: at(21, 2, 0), want: false},
+		{name: "overnight window excludes the daytime gap", schedule: menuSchedule{StartMinute: 22 * 60, EndMinute: 2 * 60}, at: at(20, 12, 0), want: false},
+		{name: "overnight window excludes just before its start", schedule: menuSchedule{StartMinute: 22 * 60, EndMinute: 2 * 60}, at: at(20, 21, 59), want: false},
+		{name: "friday night window on friday evening", schedule: menuSchedule{DaysOfWeek: friday, StartMinute: 22 * 60, EndMinute: 2 * 60}, at: at(20, 23, 0), want: true},
+		{name: "friday night window after midnight counts on friday", schedule: menuSchedule{DaysOfWeek: friday, StartMinute: 22 * 60, EndMinute: 2 * 60}, at: at(21, 1, 0), want: true},
+		{name: "friday night window early friday belongs to thursday", schedule: menuSchedule{DaysOfWeek: friday, StartMinute: 22 * 60, EndMinute: 2 * 60}, at: at(20, 1, 0), want: false},
+		{name: "friday night window on saturday evening", schedule: menuSchedule{DaysOfWeek: friday, StartMinute: 22 * 60, EndMinute: 2 * 60}, at: at(21, 23, 0), want: false},
+		{name: "valid_until friday still covers saturday early morning", schedule: menuSchedule{StartMinute: 22 * 60, EndMinute: 2 * 60, ValidUntil: date(20)}, at: at(21, 1, 0), want: true},
+		{name: "valid_from saturday excludes saturday early morning", schedule: menuSchedule{StartMinute: 22 * 60, EndMinute: 2 * 60, ValidFrom: date(21)}, at: at(21, 1, 0), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.schedule.activeAt(tt.at); got != tt.want {
+				t.Errorf("activeAt(%s) = %v, want %v", tt.at.Format("Mon 15:04"), got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,270 @@
+package repository
+
+import (
+	"fmt"
+
+	"gitlab.com/final_project1240930/booking_service/internal/pricing"
+	"gorm.io/gorm"
+)
+
+// MenuSetChoiceError เมนูที่เลือกไม่ตรงกับช่องเลือกของเมนูเซ็ต
+// (ไม่ใช่ช่องของเซ็ตนี้ เมนูเลือกไม่ได้ในช่อง เลือกซ้ำ หรือจำนวนที่เลือกไม่ครบตามช่อง)
+type MenuSetChoiceError struct {
+	MenuSetID string
+	Name      string
+	Reason    string
+}
+
+func (e *MenuSetChoiceError) Error() string {
+	return fmt.Sprintf("menu set %q: %s", e.Name, e.Reason)
+}
+
+// menuSetSlot คือช่องเลือกของเมนูเซ็ต (restaurant-service เป็นเจ้าของตาราง)
+type menuSetSlot struct {
+	UUID       string  `gorm:"column:uuid"`
+	MenuSetID  string  `gorm:"column:menu_set_id"`
+	Name       string  `gorm:"column:name_th"`
+	CategoryID *string `gorm:"column:category_id"`
+	Picks      int32   `gorm:"column:picks"`
+}
+
+// menuSetSlotChoice คือเมนูหนึ่งรายการที่เลือกได้ในช่อง
+type menuSetSlotChoice struct {
+	SlotID     string  `gorm:"column:slot_id"`
+	MenuItemID string  `gorm:"column:menu_item_id"`
+	Name       string  `gorm:"column:name"`
+	Upcharge   float64 `gorm:"column:upcharge"`
+}
+
+// choiceQuantity จำนวนจานต่อหนึ่งเซ็ตของเมนูที่เลือก ไม่ระบุถือเป็น 1
+func choiceQuantity(choice CreateBookingMenuSetChoice) int32 {
+	if choice.Quantity == 0 {
+		return 1
+	}
+	return choice.Quantity
+}
+
+// selectMenuSetChoicesTx ตรวจเมนูที่เลือกของเมนูเซ็ตแต่ละรายการตามช่องเลือกของเซ็ต
+// คืนเมนูที่เลือกเรียงตามลำดับช่อง (index เดียวกับ sets) names คือชื่อเมนูเซ็ตไว้แสดงใน error
+func selectMenuSetChoicesTx(tx *gorm.DB, sets []CreateBookingMenuSet, names map[string]string) ([][]pricing.Modifier, error) {
+	ids := make([]string, len(sets))
+	for i, menuSet := range sets {
+		ids[i] = menuSet.MenuSetID
+	}
+	var slots []menuSetSlot
+	if err := tx.Raw(`
+		SELECT uuid, menu_set_id, name_th, category_id, picks
+		FROM menu_set_slots
+		WHERE menu_set_id IN ?
+		ORDER BY menu_set_id, position`, ids).Scan(&slots).Error; err != nil {
+		return nil, fmt.Errorf("failed to look up menu set slots: %w", err)
+	}
+	bySet := make(map[string][]menuSetSlot)
+	for _, slot := range slots {
+		bySet[slot.MenuSetID] = append(bySet[slot.MenuSetID], slot)
+	}
+
+	// เมนูที่เลือกได้ของแต่ละช่อง ช่องที่ไม่มีรายการเมนูเลือกได้ทุกเมนูในหมวดหมู่ (รวมหมวดหมู่ย่อย) โดยไม่มีราคาเพิ่ม
+	allowed := make(map[string]map[string]menuSetSlotChoice)
+	if len(slots) > 0 {
+		slotIDs := make([]string, len(slots))
+		for i, slot := range slots {
+			slotIDs[i] = slot.UUID
+		}
+		var choices []menuSetSlotChoice
+		if err := tx.Raw(`
+			SELECT ssi.slot_id, ssi.menu_item_id, mi.name_th AS name, ssi.upcharge
+			FROM menu_set_slot_items ssi
+			JOIN menu_items mi ON mi.uuid = ssi.menu_item_id
+			WHERE ssi.slot_id IN ?`, slotIDs).Scan(&choices).Error; err != nil {
+			return nil, fmt.Errorf("failed to look up menu set slot items: %w", err)
+		}
+		var categoryChoices []menuSetSlotChoice
+		if err := tx.Raw(`
+			WITH RECURSIVE slot_categories AS (
+				SELECT s.uuid AS slot_id, s.category_id
+				FROM menu_set_slots s
+				WHERE s.uuid IN ? AND s.category_id IS NOT NULL
+					AND NOT EXISTS (SELECT 1 FROM menu_set_slot_items ssi WHERE ssi.slot_id = s.uuid)
+				UNION
+				SELECT sc.slot_id, c.uuid
+				FROM menu_categories c
+				JOIN slot_categories sc ON c.parent_id = sc.category_id
+			)
+			SELECT sc.slot_id, mi.uuid AS menu_item_id, mi.name_th AS name, 0 AS upcharge
+			FROM slot_categories sc
+			JOIN menu_items mi ON mi.category_id = sc.category_id`, slotIDs).Scan(&categoryChoices).Error; err != nil {
+			return nil, fmt.Errorf("failed to look up menu set slot categories: %w", err)
+		}
+		for _, choice := range append(choices, categoryChoices...) {
+			if allowed[choice.SlotID] == nil {
+				allowed[choice.SlotID] = make(map[string]menuSetSlotChoice)
+			}
+			allowed[choice.SlotID][choice.MenuItemID] = choice
+		}
+	}
+
+	selected := make([][]pricing.Modifier, len(sets))
+	for i, menuSet := range sets {
+		invalid := func(format string, args ...interface{}) error {
+			return &MenuSetChoiceError{MenuSetID: menuSet.MenuSetID, Name: names[menuSet.MenuSetID], Reason: fmt.Sprintf(format, args...)}
+		}
+
+		setSlots := bySet[menuSet.MenuSetID]
+		if len(setSlots) == 0 {
+			if len(menuSet.Choices) > 0 {
+				return nil, invalid("this menu set has no choice slots")
+			}
+			continue
+		}
+
+		slotNames := make(map[string]string, len(setSlots))
+		for _, slot := range setSlots {
+			slotNames[slot.UUID] = slot.Name
+		}
+		chosen := make(map[[2]string]bool, len(menuSet.Choices))
+		for _, choice := range menuSet.Choices {
+			slotName, ok := slotNames[choice.SlotID]
+			if !ok {
+				return nil, invalid("slot %s is not part of this menu set", choice.SlotID)
+			}
+			if _, ok := allowed[choice.SlotID][choice.MenuItemID]; !ok {
+				return nil, invalid("menu item %s cannot be chosen for %q", choice.MenuItemID, slotName)
+			}
+			key := [2]string{choice.SlotID, choice.MenuItemID}
+			if chosen[key] {
+				return nil, invalid("menu item %s is chosen more than once for %q", choice.MenuItemID, slotName)
+			}
+			chosen[key] = true
+			if choice.Quantity < 0 {
+				return nil, invalid("quantity for %q must be at least 1", slotName)
+			}
+		}
+
+		// จำนวนที่เลือกของแต่ละช่องต้องเท่ากับจำนวนที่ช่องกำหนดพอดี
+		for _, slot := range setSlots {
+			var picked int32
+			for _, choice := range menuSet.Choices {
+				if choice.SlotID != slot.UUID {
+					continue
+				}
+				quantity := choiceQuantity(choice)
+				picked += quantity
+				option := allowed[slot.UUID][choice.MenuItemID]
+				selected[i] = append(selected[i], pricing.Modifier{
+					RefID:    choice.MenuItemID,
+					GroupID:  slot.UUID,
+					Group:    slot.Name,
+					Name:     option.Name,
+					Price:    pricing.FromBaht(option.Upcharge),
+					Quantity: quantity,
+				})
+			}
+			if picked != slot.Picks {
+				return nil, invalid("%q needs exactly %d pick(s), got %d", slot.Name, slot.Picks, picked)
+			}
+		}
+	}
+	return selected, nil
+}
+
+// createBookingSetChoicesTx บันทึกเมนูที่เลือกของเมนูเซ็ต โดยเก็บชื่อช่องปัจจุบันและราคาเพิ่มที่ใช้คิดเงิน
+func createBookingSetChoicesTx(tx *gorm.DB, bookingMenuSetID string, choices []pricing.Modifier) error {
+	for i, choice := range choices {
+		result := tx.Exec(`
+			INSERT INTO booking_menu_set_choices
+				(booking_menu_set_id, slot_id, menu_item_id, slot_name_th, slot_name_en, quantity, upcharge_satang, position)
+			SELECT ?, s.uuid, ?, s.name_th, s.name_en, ?, ?, ?
+			FROM menu_set_slots s
+			WHERE s.uuid = ?`, bookingMenuSetID, choice.RefID, choice.Quantity, choice.Price, i, choice.GroupID)
+		if result.Error != nil {
+			return fmt.Errorf("failed to save menu set choice: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("menu set slot %s not found", choice.GroupID)
+		}
+	}
+	return nil
+}
+
+// attachBookingSetChoices ใส่เมนูที่เลือกที่บันทึกไว้ให้เมนูเซ็ตของการจอง
+// เมนูที่เลือกจะต่อท้าย MenuItems ของเซ็ตด้วย Quantity เป็นจำนวนจานต่อหนึ่งเซ็ต
+func attachBookingSetChoices(db *gorm.DB, bookings []Booking) error {
+	var lineIDs []string
+	for _, booking := range bookings {
+		for _, menuSet := range booking.BookingMenuSets {
+			lineIDs = append(lineIDs, menuSet.LineID)
+		}
+	}
+	if len(lineIDs) == 0 {
+		return nil
+	}
+
+	var choices []BookingMenuSetChoice
+	if err := db.Where("booking_menu_set_id IN ?", lineIDs).
+		Order("booking_menu_set_id, position").Find(&choices).Error; err != nil {
+		return fmt.Errorf("failed to query booking menu set choices: %w", err)
+	}
+	if len(choices) == 0 {
+		return nil
+	}
+
+	itemIDs := make([]string, len(choices))
+	for i, choice := range choices {
+		itemIDs[i] = choice.MenuItemID
+	}
+	var items []struct {
+		UUID        string  `gorm:"column:uuid"`
+		NameTh      string  `gorm:"column:name_th"`
+		NameEn      string  `gorm:"column:name_en"`
+		Description string  `gorm:"column:description"`
+		Price       float64 `gorm:"column:price"`
+		Category    string  `gorm:"column:category"`
+		ImageURL    string  `gorm:"column:image_url"`
+	}
+	if err := db.Raw(`
+		SELECT mi.uuid, mi.name_th, mi.name_en, mi.description, mi.price, mc.name_en AS category, mi.image_url
+		FROM menu_items mi
+		LEFT JOIN menu_categories mc ON mc.uuid = mi.category_id
+		WHERE mi.uuid IN ?`, itemIDs).Scan(&items).Error; err != nil {
+		return fmt.Errorf("failed to query chosen menu items: %w", err)
+	}
+	menuItems := make(map[string]BookingMenuItem, len(items))
+	for _, item := range items {
+		menuItems[item.UUID] = BookingMenuItem{
+			MenuItemID:  item.UUID,
+			NameTh:      item.NameTh,
+			NameEn:      item.NameEn,
+			Description: item.Description,
+			Price:       float32(item.Price),
+			Category:    item.Category,
+			ImageURL:    item.ImageURL,
+		}
+	}
+
+	byLine := make(map[string][]BookingMenuSetChoice)
+	for _, choice := range choices {
+		if item, ok := menuItems[choice.MenuItemID]; ok {
+			choice.NameTh = item.NameTh
+			choice.NameEn = item.NameEn
+		}
+		byLine[choice.BookingMenuSetID] = append(byLine[choice.BookingMenuSetID], choice)
+	}
+
+	for i := range bookings {
+		for j := range bookings[i].BookingMenuSets {
+			menuSet := &bookings[i].BookingMenuSets[j]
+			menuSet.Choices = byLine[menuSet.LineID]
+			for _, choice := range menuSet.Choices {
+				// เมนูที่ถูกลบไปแล้วยังแสดงชื่อช่องได้จาก Choices แต่ไม่มีรายละเอียดเมนู
+				item, ok := menuItems[choice.MenuItemID]
+				if !ok {
+					continue
+				}
+				item.Quantity = choice.Quantity
+				menuSet.MenuItems = append(menuSet.MenuItems, item)
+			}
+		}
+	}
+	return nil
+}
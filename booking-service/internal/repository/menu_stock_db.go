@@ -0,0 +1,226 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// MenuStockError เมนูที่สั่งถูกปิดขายอยู่ หรือสั่งเกินจำนวนที่เหลือของวันนั้น
+type MenuStockError struct {
+	MenuItemID  string
+	Name        string
+	Requested   int32
+	Remaining   int32
+	Unavailable bool
+}
+
+func (e *MenuStockError) Error() string {
+	if e.Unavailable {
+		return fmt.Sprintf("menu item %q is not available", e.Name)
+	}
+	return fmt.Sprintf("menu item %q has only %d left for this date (requested %d)", e.Name, e.Remaining, e.Requested)
+}
+
+// MenuSetArchivedError เมนูเซ็ตที่สั่งถูกเก็บเข้าคลัง (เลิกขาย) แล้ว
+type MenuSetArchivedError struct {
+	MenuSetID string
+	Name      string
+}
+
+func (e *MenuSetArchivedError) Error() string {
+	return fmt.Sprintf("menu set %q is no longer available", e.Name)
+}
+
+// checkMenuSetArchivedTx ตรวจว่าไม่ได้สั่งเมนูเซ็ตที่เก็บเข้าคลังแล้ว
+// เซ็ตที่อยู่ในการจอง bookingID อยู่ก่อนแล้วสั่งต่อได้ เพื่อให้แก้ไขหรือยกเลิกการจองเดิมได้หลังเลิกขายเซ็ตนั้น
+func checkMenuSetArchivedTx(tx *gorm.DB, bookingID string, req *CreateBookingRequest) error {
+	if len(req.MenuSets) == 0 {
+		return nil
+	}
+	ids := make([]string, len(req.MenuSets))
+	for i, menuSet := range req.MenuSets {
+		ids[i] = menuSet.MenuSetID
+	}
+
+	query := `
+		SELECT ms.uuid, ms.name
+		FROM menu_sets ms
+		WHERE ms.uuid IN ? AND ms.archived_at IS NOT NULL`
+	args := []interface{}{ids}
+	if bookingID != "" {
+		query += ` AND NOT EXISTS (
+			SELECT 1 FROM booking_menu_sets bms
+			WHERE bms.booking_id = ? AND bms.menu_set_id = ms.uuid)`
+		args = append(args, bookingID)
+	}
+	query += ` ORDER BY ms.name`
+
+	var archived []struct {
+		UUID string `gorm:"column:uuid"`
+		Name string `gorm:"column:name"`
+	}
+	if err := tx.Raw(query, args...).Scan(&archived).Error; err != nil {
+		return fmt.Errorf("failed to check archived menu sets: %w", err)
+	}
+	if len(archived) > 0 {
+		return &MenuSetArchivedError{MenuSetID: archived[0].UUID, Name: archived[0].Name}
+	}
+	return nil
+}
+
+// bookedMenuItemsQuery แตกรายการในการจองเป็นเมนูทีละแถว (booking_id, menu_item_id, quantity)
+const bookedMenuItemsQuery = `
+		SELECT bmi.booking_id, bmi.menu_item_id, bmi.quantity
+		FROM booking_menu_items bmi
+		UNION ALL
+		SELECT bms.booking_id, msi.menu_item_id, bms.quantity
+		FROM booking_menu_sets bms
+		JOIN menu_set_items msi ON msi.menu_set_id = bms.menu_set_id
+		UNION ALL
+		SELECT bms.booking_id, c.menu_item_id, bms.quantity * c.quantity
+		FROM booking_menu_sets bms
+		JOIN booking_menu_set_choices c ON c.booking_menu_set_id = bms.uuid
+	`
+
+// menuItemUsageQuery รวมจำนวนเมนูที่ถูกสั่งในการจองที่ยืนยันแล้วของวันหนึ่ง
+// เมนูในเมนูเซ็ตนับหนึ่งที่ต่อเซ็ตที่สั่ง เมนูที่เลือกในช่องเลือกนับตามจำนวนที่เลือกต่อเซ็ต
+// รับช่วงเวลา [00:00, 24:00) ของวันนั้นตามเวลาไทยเป็น timestamp ไม่ใช้ ?::date เพราะจะได้ขอบวันตาม timezone ของ session
+// (restaurant-service ใช้ query เดียวกันคำนวณจำนวนคงเหลือ)
+const menuItemUsageQuery = `
+	SELECT used.menu_item_id, COALESCE(SUM(used.quantity), 0) AS quantity
+	FROM (` + bookedMenuItemsQuery + `) used
+	JOIN bookings b ON b.uuid = used.booking_id
+	WHERE b.status = 'CONFIRMED'
+		AND b.booking_date_time >= ?
+		AND b.booking_date_time < ?
+		AND used.menu_item_id IN ?`
+
+// checkMenuStockTx ตรวจว่าเมนูทุกรายการในการจอง (รวมเมนูในเซ็ต) เปิดขายอยู่และเหลือพอสำหรับวันที่จอง
+// ล็อกแถว menu_items ไว้จนจบ transaction กันการจองพร้อมกันตัดสต็อกเกิน
+// bookingID คือการจองที่กำลังแก้ไข (ว่างถ้าเป็นการจองใหม่) ไม่นับจำนวนเดิมของการจองนี้
+// และเมนูที่ปิดขายหรือเก็บเข้าคลังไปแล้วยังสั่งได้ไม่เกินจำนวนที่การจองนี้มีอยู่เดิม
+func checkMenuStockTx(tx *gorm.DB, bookingID string, req *CreateBookingRequest) error {
+	requested := make(map[string]int32)
+	for _, menuItem := range req.MenuItems {
+		requested[menuItem.MenuItemID] += menuItem.Quantity
+	}
+	if len(req.MenuSets) > 0 {
+		ids := make([]string, len(req.MenuSets))
+		for i, menuSet := range req.MenuSets {
+			ids[i] = menuSet.MenuSetID
+		}
+		var setItems []struct {
+			MenuSetID  string `gorm:"column:menu_set_id"`
+			MenuItemID string `gorm:"column:menu_item_id"`
+		}
+		if err := tx.Raw(`SELECT menu_set_id, menu_item_id FROM menu_set_items WHERE menu_set_id IN ?`, ids).Scan(&setItems).Error; err != nil {
+			return fmt.Errorf("failed to look up menu set items: %w", err)
+		}
+		for _, menuSet := range req.MenuSets {
+			for _, setItem := range setItems {
+				if setItem.MenuSetID == menuSet.MenuSetID {
+					requested[setItem.MenuItemID] += menuSet.Quantity
+				}
+			}
+			for _, choice := range menuSet.Choices {
+				requested[choice.MenuItemID] += menuSet.Quantity * choiceQuantity(choice)
+			}
+		}
+	}
+	if len(requested) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(requested))
+	for id := range requested {
+		ids = append(ids, id)
+	}
+
+	var items []menuItemStock
+	// เมนูที่เก็บเข้าคลังแล้ว (archived_at ไม่ว่าง) ถือว่าปิดขาย
+	if err := tx.Raw(`
+		SELECT uuid, name_th, is_available AND archived_at IS NULL AS is_available, daily_limit
+		FROM menu_items
+		WHERE uuid IN ?
+		ORDER BY uuid
+		FOR UPDATE`, ids).Scan(&items).Error; err != nil {
+		return fmt.Errorf("failed to lock menu items: %w", err)
+	}
+
+	bangkok, err := time.LoadLocation("Asia/Bangkok")
+	if err != nil {
+		return fmt.Errorf("could not load Bangkok timezone: %w", err)
+	}
+	date := req.BookingDateTime.In(bangkok)
+	dayStart := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, bangkok)
+
+	query, args := menuItemUsageQuery, []interface{}{dayStart, dayStart.AddDate(0, 0, 1), ids}
+	if bookingID != "" {
+		query += ` AND b.uuid <> ?`
+		args = append(args, bookingID)
+	}
+	query += ` GROUP BY used.menu_item_id`
+
+	var usage []struct {
+		MenuItemID string `gorm:"column:menu_item_id"`
+		Quantity   int32  `gorm:"column:quantity"`
+	}
+	if err := tx.Raw(query, args...).Scan(&usage).Error; err != nil {
+		return fmt.Errorf("failed to count menu item usage: %w", err)
+	}
+	used := make(map[string]int32, len(usage))
+	for _, u := range usage {
+		used[u.MenuItemID] = u.Quantity
+	}
+
+	held := make(map[string]int32)
+	if bookingID != "" {
+		var booked []struct {
+			MenuItemID string `gorm:"column:menu_item_id"`
+			Quantity   int32  `gorm:"column:quantity"`
+		}
+		if err := tx.Raw(`
+			SELECT held.menu_item_id, COALESCE(SUM(held.quantity), 0) AS quantity
+			FROM (`+bookedMenuItemsQuery+`) held
+			WHERE held.booking_id = ? AND held.menu_item_id IN ?
+			GROUP BY held.menu_item_id`, bookingID, ids).Scan(&booked).Error; err != nil {
+			return fmt.Errorf("failed to count booked menu items: %w", err)
+		}
+		for _, b := range booked {
+			held[b.MenuItemID] = b.Quantity
+		}
+	}
+
+	return checkMenuStock(items, requested, held, used)
+}
+
+// menuItemStock คือสถานะการขายของเมนูหนึ่งรายการ ณ ตอนตรวจ
+type menuItemStock struct {
+	UUID        string `gorm:"column:uuid"`
+	NameTH      string `gorm:"column:name_th"`
+	IsAvailable bool   `gorm:"column:is_available"`
+	DailyLimit  int32  `gorm:"column:daily_limit"`
+}
+
+// checkMenuStock ตรวจจำนวนที่สั่ง (requested) ของแต่ละเมนู
+// held คือจำนวนที่การจองที่กำลังแก้ไขมีอยู่เดิม used คือจำนวนที่การจองอื่นในวันเดียวกันใช้ไปแล้ว
+// เมนูที่ปิดขายสั่งได้ไม่เกิน held ส่วน daily limit นับจากจำนวนที่สั่งทั้งหมดเหมือนเดิม
+func checkMenuStock(items []menuItemStock, requested, held, used map[string]int32) error {
+	// เมนูที่ไม่พบปล่อยให้ priceBookingTx แจ้ง error เอง
+	for _, item := range items {
+		quantity := requested[item.UUID]
+		if !item.IsAvailable && quantity > held[item.UUID] {
+			return &MenuStockError{MenuItemID: item.UUID, Name: item.NameTH, Requested: quantity, Unavailable: true}
+		}
+		if item.DailyLimit <= 0 {
+			continue
+		}
+		remaining := max(0, item.DailyLimit-used[item.UUID])
+		if quantity > remaining {
+			return &MenuStockError{MenuItemID: item.UUID, Name: item.NameTH, Requested: quantity, Remaining: remaining}
+		}
+	}
+	return nil
+}
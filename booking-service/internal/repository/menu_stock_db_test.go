@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckMenuStock(t *testing.T) {
+	archived := menuItemStock{UUID: "archived", NameTH: "เมนูเลิกขาย", IsAvailable: false}
+	limited := menuItemStock{UUID: "limited", NameTH: "เมนูจำกัดจำนวน", IsAvailable: true, DailyLimit: 10}
+	archivedLimited := menuItemStock{UUID: "archived", NameTH: "เมนูเลิกขาย", IsAvailable: false, DailyLimit: 10}
+
+	tests := []struct {
+		name            string
+		items           []menuItemStock
+		requested       map[string]int32
+		held            map[string]int32
+		used            map[string]int32
+		wantUnavailable bool
+		wantRemaining   int32 // ไม่ใช่ 0 = ต้องได้ error เรื่องจำนวนคงเหลือ
+	}{
+		{
+			name:            "new booking with an archived item is rejected",
+			items:           []menuItemStock{archived},
+			requested:       map[string]int32{"archived": 1},
+			wantUnavailable: true,
+		},
+		{
+			name:      "editing a booking that holds an archived item keeps it",
+			items:     []menuItemStock{archived},
+			requested: map[string]int32{"archived": 2},
+			held:      map[string]int32{"archived": 2},
+		},
+		{
+			name:      "editing a booking may order fewer of an archived item",
+			items:     []menuItemStock{archived},
+			requested: map[string]int32{"archived": 1},
+			held:      map[string]int32{"archived": 2},
+		},
+		{
+			name:            "editing a booking may not order more of an archived item",
+			items:           []menuItemStock{archived},
+			requested:       map[string]int32{"archived": 3},
+			held:            map[string]int32{"archived": 2},
+			wantUnavailable: true,
+		},
+		{
+			name:      "held archived item still counts towards the daily limit",
+			items:     []menuItemStock{archivedLimited},
+			requested: map[string]int32{"archived": 2},
+			held:      map[string]int32{"archived": 2},
+			used:      map[string]int32{"archived": 9},
+			// เหลือ 1 แต่การจองนี้มี 2
+			wantRemaining: 1,
+		},
+		{
+			name:      "within the daily limit",
+			items:     []menuItemStock{limited},
+			requested: map[string]int32{"limited": 4},
+			used:      map[string]int32{"limited": 6},
+		},
+		{
+			name:          "over the daily limit",
+			items:         []menuItemStock{limited},
+			requested:     map[string]int32{"limited": 5},
+			used:          map[string]int32{"limited": 6},
+			wantRemaining: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkMenuStock(tt.items, tt.requested, tt.held, tt.used)
+			if !tt.wantUnavailable && tt.wantRemaining == 0 {
+				if err != nil {
+					t.Fatalf("checkMenuStock() error = %v, want nil", err)
+				}
+				return
+			}
+			var stockErr *MenuStockError
+			if !errors.As(err, &stockErr) {
+				t.Fatalf("checkMenuStock() error = %v, want MenuStockError", err)
+			}
+			if stockErr.Unavailable != tt.wantUnavailable || stockErr.Remaining != tt.wantRemaining {
+				t.Errorf("checkMenuStock() = %+v, want unavailable %v remaining %d", stockErr, tt.wantUnavailable, tt.wantRemaining)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrPromotionRedeemed ลบโปรโมชั่นที่มีการใช้งานแล้วไม่ได้ ให้ปิดใช้งาน (active = false) แทน
+var ErrPromotionRedeemed = errors.New("promotion has already been redeemed")
+
+// ErrPromotionNotFound ไม่พบโปรโมชั่นตาม ID หรือโค้ด
+var ErrPromotionNotFound = errors.New("promotion not found")
+
+type Promotion struct {
+	PromotionID  string         `json:"promotion_id"`
+	Code         string         `json:"code"`
+	Name         string         `json:"name"`
+	Description  string         `json:"description"`
+	DiscountType string         `json:"discount_type"` // PERCENT, AMOUNT, CHILDREN_FREE
+	Percent      float64        `json:"percent"`
+	AmountSatang int64          `json:"amount_satang"`
+	MenuSetIDs   []string       `json:"menu_set_ids"` // ว่าง = ใช้กับทั้งบิล
+	ValidFrom    *time.Time     `json:"valid_from"`
+	ValidUntil   *time.Time     `json:"valid_until"`
+	DaysOfWeek   []time.Weekday `json:"days_of_week"` // ว่าง = ทุกวัน
+	MinPartySize int32          `json:"min_party_size"`
+	MaxPartySize int32          `json:"max_party_size"` // 0 = ไม่จำกัด
+	UsageLimit   int32          `json:"usage_limit"`    // 0 = ไม่จำกัด
+	UsedCount    int32          `json:"used_count"`     // ไม่นับการจองที่ยกเลิกแล้ว
+	Active       bool           `json:"active"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+}
+
+type PromotionRedemption struct {
+	BookingID       string    `gorm:"column:booking_id" json:"booking_id"`
+	CustomerName    string    `gorm:"column:customer_name" json:"customer_name"`
+	BookingDateTime time.Time `gorm:"column:booking_date_time" json:"booking_date_time"`
+	Status          string    `gorm:"column:status" json:"status"`
+	DiscountSatang  int64     `gorm:"column:discount_satang" json:"discount_satang"`
+	RedeemedAt      time.Time `gorm:"column:redeemed_at" json:"redeemed_at"`
+}
+
+type PromotionEntity struct {
+	PromotionID  string     `gorm:"column:uuid;primaryKey"`
+	Code         string     `gorm:"column:code"`
+	Name         string     `gorm:"column:name"`
+	Description  string     `gorm:"column:description"`
+	DiscountType string     `gorm:"column:discount_type"`
+	Percent      float64    `gorm:"column:percent"`
+	AmountSatang int64      `gorm:"column:amount_satang"`
+	ValidFrom    *time.Time `gorm:"column:valid_from"`
+	ValidUntil   *time.Time `gorm:"column:valid_until"`
+	DaysOfWeek   int16      `gorm:"column:days_of_week"` // bitmask, bit 0 = อาทิตย์
+	MinPartySize int32      `gorm:"column:min_party_size"`
+	MaxPartySize int32      `gorm:"column:max_party_size"`
+	UsageLimit   int32      `gorm:"column:usage_limit"`
+	Active       bool       `gorm:"column:active"`
+	CreatedAt    time.Time  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt    time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (PromotionEntity) TableName() string {
+	return "promotions"
+}
+
+type PromotionMenuSetEntity struct {
+	PromotionID string `gorm:"column:promotion_id;primaryKey"`
+	MenuSetID   string `gorm:"column:menu_set_id;primaryKey"`
+}
+
+func (PromotionMenuSetEntity) TableName() string {
+	return "promotion_menu_sets"
+}
+
+type PromotionRedemptionEntity struct {
+	BookingID      string    `gorm:"column:booking_id;primaryKey"`
+	PromotionID    string    `gorm:"column:promotion_id"`
+	DiscountSatang int64     `gorm:"column:discount_satang"`
+	RedeemedAt     time.Time `gorm:"column:redeemed_at;autoCreateTime"`
+}
+
+func (PromotionRedemptionEntity) TableName() string {
+	return "promotion_redemptions"
+}
+
+type PromotionRepository interface {
+	CreatePromotion(ctx context.Context, promotion Promotion) (string, error)
+	UpdatePromotion(ctx context.Context, promotion Promotion) error
+	DeletePromotion(ctx context.Context, promotionID string) error
+	GetPromotions(ctx context.Context) ([]Promotion, error)
+	GetPromotionByID(ctx context.Context, promotionID string) (*Promotion, error)
+	GetPromotionRedemptions(ctx context.Context, promotionID string) ([]PromotionRedemption, error)
+}
@@ -0,0 +1,323 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gitlab.com/final_project1240930/booking_service/internal/pricing"
+	"gorm.io/gorm"
+)
+
+type promotionRepository struct {
+	DB *gorm.DB
+}
+
+func NewPromotionRepository(db *gorm.DB) PromotionRepository {
+	return &promotionRepository{DB: db}
+}
+
+// usedCountQuery นับจำนวนการใช้โค้ด ไม่นับการจองที่ยกเลิกแล้ว
+const usedCountQuery = `
+	SELECT COUNT(*)
+	FROM promotion_redemptions pr
+	JOIN bookings b ON b.uuid = pr.booking_id
+	WHERE pr.promotion_id = ? AND b.status <> 'CANCELLED'`
+
+func (r *promotionRepository) CreatePromotion(ctx context.Context, promotion Promotion) (string, error) {
+	promotion.PromotionID = uuid.New().String()
+	entity := newPromotionEntity(promotion)
+
+	err := r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&entity).Error; err != nil {
+			return fmt.Errorf("failed to create promotion: %w", err)
+		}
+		return savePromotionMenuSetsTx(tx, promotion.PromotionID, promotion.MenuSetIDs)
+	})
+	if err != nil {
+		return "", err
+	}
+	return promotion.PromotionID, nil
+}
+
+func (r *promotionRepository) UpdatePromotion(ctx context.Context, promotion Promotion) error {
+	entity := newPromotionEntity(promotion)
+
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&PromotionEntity{}).Where("uuid = ?", promotion.PromotionID).Updates(map[string]interface{}{
+			"code":           entity.Code,
+			"name":           entity.Name,
+			"description":    entity.Description,
+			"discount_type":  entity.DiscountType,
+			"percent":        entity.Percent,
+			"amount_satang":  entity.AmountSatang,
+			"valid_from":     entity.ValidFrom,
+			"valid_until":    entity.ValidUntil,
+			"days_of_week":   entity.DaysOfWeek,
+			"min_party_size": entity.MinPartySize,
+			"max_party_size": entity.MaxPartySize,
+			"usage_limit":    entity.UsageLimit,
+			"active":         entity.Active,
+			"updated_at":     time.Now(),
+		})
+		if result.Error != nil {
+			return fmt.Errorf("failed to update promotion: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return ErrPromotionNotFound
+		}
+
+		if err := tx.Where("promotion_id = ?", promotion.PromotionID).Delete(&PromotionMenuSetEntity{}).Error; err != nil {
+			return fmt.Errorf("failed to clear promotion menu sets: %w", err)
+		}
+		return savePromotionMenuSetsTx(tx, promotion.PromotionID, promotion.MenuSetIDs)
+	})
+}
+
+func (r *promotionRepository) DeletePromotion(ctx context.Context, promotionID string) error {
+	return r.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var redemptions int64
+		if err := tx.Model(&PromotionRedemptionEntity{}).Where("promotion_id = ?", promotionID).Count(&redemptions).Error; err != nil {
+			return fmt.Errorf("failed to count redemptions: %w", err)
+		}
+		if redemptions > 0 {
+			return ErrPromotionRedeemed
+		}
+
+		// promotion_menu_sets ถูกลบตาม ON DELETE CASCADE
+		result := tx.Where("uuid = ?", promotionID).Delete(&PromotionEntity{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete promotion: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return ErrPromotionNotFound
+		}
+		return nil
+	})
+}
+
+func (r *promotionRepository) GetPromotions(ctx context.Context) ([]Promotion, error) {
+	var entities []PromotionEntity
+	if err := r.DB.WithContext(ctx).Order("created_at DESC").Find(&entities).Error; err != nil {
+		return nil, fmt.Errorf("failed to query promotions: %w", err)
+	}
+
+	var menuSets []PromotionMenuSetEntity
+	if err := r.DB.WithContext(ctx).Find(&menuSets).Error; err != nil {
+		return nil, fmt.Errorf("failed to query promotion menu sets: %w", err)
+	}
+	menuSetIDs := make(map[string][]string)
+	for _, menuSet := range menuSets {
+		menuSetIDs[menuSet.PromotionID] = append(menuSetIDs[menuSet.PromotionID], menuSet.MenuSetID)
+	}
+
+	var counts []struct {
+		PromotionID string `gorm:"column:promotion_id"`
+		UsedCount   int32  `gorm:"column:used_count"`
+	}
+	if err := r.DB.WithContext(ctx).Raw(`
+		SELECT pr.promotion_id, COUNT(*) AS used_count
+		FROM promotion_redemptions pr
+		JOIN bookings b ON b.uuid = pr.booking_id
+		WHERE b.status <> 'CANCELLED'
+		GROUP BY pr.promotion_id`).Scan(&counts).Error; err != nil {
+		return nil, fmt.Errorf("failed to count redemptions: %w", err)
+	}
+	usedCounts := make(map[string]int32, len(counts))
+	for _, count := range counts {
+		usedCounts[count.PromotionID] = count.UsedCount
+	}
+
+	promotions := make([]Promotion, 0, len(entities))
+	for _, entity := range entities {
+		promotions = append(promotions, entity.toPromotion(menuSetIDs[entity.PromotionID], usedCounts[entity.PromotionID]))
+	}
+	return promotions, nil
+}
+
+func (r *promotionRepository) GetPromotionByID(ctx context.Context, promotionID string) (*Promotion, error) {
+	promotion, err := findPromotionTx(r.DB.WithContext(ctx), "uuid = ?", promotionID)
+	if err != nil {
+		return nil, err
+	}
+	return &promotion, nil
+}
+
+func (r *promotionRepository) GetPromotionRedemptions(ctx context.Context, promotionID string) ([]PromotionRedemption, error) {
+	var redemptions []PromotionRedemption
+	err := r.DB.WithContext(ctx).Raw(`
+		SELECT pr.booking_id, b.customer_name, b.booking_date_time, b.status, pr.discount_satang, pr.redeemed_at
+		FROM promotion_redemptions pr
+		JOIN bookings b ON b.uuid = pr.booking_id
+		WHERE pr.promotion_id = ?
+		ORDER BY pr.redeemed_at DESC`, promotionID).Scan(&redemptions).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to query redemptions: %w", err)
+	}
+	return redemptions, nil
+}
+
+// findPromotionTx ค้นหาโปรโมชั่นหนึ่งรายการพร้อมเมนูเซ็ตและจำนวนการใช้งาน
+func findPromotionTx(tx *gorm.DB, query string, args ...interface{}) (Promotion, error) {
+	var entity PromotionEntity
+	if err := tx.Where(query, args...).First(&entity).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return Promotion{}, ErrPromotionNotFound
+		}
+		return Promotion{}, fmt.Errorf("failed to query promotion: %w", err)
+	}
+
+	var menuSetIDs []string
+	if err := tx.Model(&PromotionMenuSetEntity{}).Where("promotion_id = ?", entity.PromotionID).Pluck("menu_set_id", &menuSetIDs).Error; err != nil {
+		return Promotion{}, fmt.Errorf("failed to query promotion menu sets: %w", err)
+	}
+
+	var usedCount int32
+	if err := tx.Raw(usedCountQuery, entity.PromotionID).Scan(&usedCount).Error; err != nil {
+		return Promotion{}, fmt.Errorf("failed to count redemptions: %w", err)
+	}
+
+	return entity.toPromotion(menuSetIDs, usedCount), nil
+}
+
+// loadPromotionTx ล็อกแถวโปรโมชั่นตามโค้ด (ไม่สนตัวพิมพ์เล็กใหญ่) เพื่อไม่ให้การจองพร้อมกันใช้เกิน usage_limit
+// การใช้โค้ดของ bookingID เองไม่ถูกนับ และถ้า bookingID ใช้โค้ดนี้อยู่แล้วจะไม่ตรวจเงื่อนไขซ้ำ เพื่อให้แก้ไขการจองเดิมได้
+func loadPromotionTx(tx *gorm.DB, code, bookingID string) (*pricing.Promotion, error) {
+	var entity PromotionEntity
+	err := tx.Raw(`SELECT * FROM promotions WHERE UPPER(code) = UPPER(?) FOR UPDATE`, strings.TrimSpace(code)).Scan(&entity).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to query promotion: %w", err)
+	}
+	if entity.PromotionID == "" {
+		return nil, &pricing.PromotionError{Code: code, Reason: "was not found"}
+	}
+
+	var menuSetIDs []string
+	if err := tx.Model(&PromotionMenuSetEntity{}).Where("promotion_id = ?", entity.PromotionID).Pluck("menu_set_id", &menuSetIDs).Error; err != nil {
+		return nil, fmt.Errorf("failed to query promotion menu sets: %w", err)
+	}
+
+	query, args := usedCountQuery, []interface{}{entity.PromotionID}
+	if bookingID != "" {
+		query += ` AND pr.booking_id <> ?`
+		args = append(args, bookingID)
+	}
+	var usedCount int32
+	if err := tx.Raw(query, args...).Scan(&usedCount).Error; err != nil {
+		return nil, fmt.Errorf("failed to count redemptions: %w", err)
+	}
+
+	promotion := entity.toPromotion(menuSetIDs, usedCount).ToPricing()
+	if bookingID != "" {
+		var redeemed int64
+		if err := tx.Model(&PromotionRedemptionEntity{}).
+			Where("booking_id = ? AND promotion_id = ?", bookingID, entity.PromotionID).
+			Count(&redeemed).Error; err != nil {
+			return nil, fmt.Errorf("failed to query promotion redemption: %w", err)
+		}
+		promotion.Redeemed = redeemed > 0
+	}
+	return &promotion, nil
+}
+
+// saveRedemptionTx บันทึกการใช้โค้ดของการจอง (แทนที่ของเดิมถ้ามี)
+func saveRedemptionTx(tx *gorm.DB, bookingID string, breakdown pricing.Breakdown) error {
+	if err := tx.Where("booking_id = ?", bookingID).Delete(&PromotionRedemptionEntity{}).Error; err != nil {
+		return fmt.Errorf("failed to clear promotion redemption: %w", err)
+	}
+	if breakdown.PromotionID == "" {
+		return nil
+	}
+	if err := tx.Create(&PromotionRedemptionEntity{
+		BookingID:      bookingID,
+		PromotionID:    breakdown.PromotionID,
+		DiscountSatang: breakdown.PromotionDiscount,
+	}).Error; err != nil {
+		return fmt.Errorf("failed to record promotion redemption: %w", err)
+	}
+	return nil
+}
+
+func savePromotionMenuSetsTx(tx *gorm.DB, promotionID string, menuSetIDs []string) error {
+	for _, menuSetID := range menuSetIDs {
+		if err := tx.Create(&PromotionMenuSetEntity{PromotionID: promotionID, MenuSetID: menuSetID}).Error; err != nil {
+			return fmt.Errorf("failed to add menu set %s to promotion: %w", menuSetID, err)
+		}
+	}
+	return nil
+}
+
+// ToPricing แปลงเป็นเงื่อนไขที่ใช้คำนวณราคา
+func (p Promotion) ToPricing() pricing.Promotion {
+	return pricing.Promotion{
+		ID:           p.PromotionID,
+		Code:         p.Code,
+		DiscountType: p.DiscountType,
+		Percent:      p.Percent,
+		Amount:       p.AmountSatang,
+		MenuSetIDs:   p.MenuSetIDs,
+		ValidFrom:    p.ValidFrom,
+		ValidUntil:   p.ValidUntil,
+		DaysOfWeek:   p.DaysOfWeek,
+		MinPartySize: p.MinPartySize,
+		MaxPartySize: p.MaxPartySize,
+		UsageLimit:   p.UsageLimit,
+		UsedCount:    p.UsedCount,
+		Active:       p.Active,
+	}
+}
+
+func newPromotionEntity(p Promotion) PromotionEntity {
+	var days int16
+	for _, day := range p.DaysOfWeek {
+		days |= 1 << day
+	}
+	return PromotionEntity{
+		PromotionID:  p.PromotionID,
+		Code:         strings.ToUpper(strings.TrimSpace(p.Code)),
+		Name:         p.Name,
+		Description:  p.Description,
+		DiscountType: p.DiscountType,
+		Percent:      p.Percent,
+		AmountSatang: p.AmountSatang,
+		ValidFrom:    p.ValidFrom,
+		ValidUntil:   p.ValidUntil,
+		DaysOfWeek:   days,
+		MinPartySize: p.MinPartySize,
+		MaxPartySize: p.MaxPartySize,
+		UsageLimit:   p.UsageLimit,
+		Active:       p.Active,
+	}
+}
+
+func (e PromotionEntity) toPromotion(menuSetIDs []string, usedCount int32) Promotion {
+	var days []time.Weekday
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if e.DaysOfWeek&(1<<day) != 0 {
+			days = append(days, day)
+		}
+	}
+	return Promotion{
+		PromotionID:  e.PromotionID,
+		Code:         e.Code,
+		Name:         e.Name,
+		Description:  e.Description,
+		DiscountType: e.DiscountType,
+		Percent:      e.Percent,
+		AmountSatang: e.AmountSatang,
+		MenuSetIDs:   menuSetIDs,
+		ValidFrom:    e.ValidFrom,
+		ValidUntil:   e.ValidUntil,
+		DaysOfWeek:   days,
+		MinPartySize: e.MinPartySize,
+		MaxPartySize: e.MaxPartySize,
+		UsageLimit:   e.UsageLimit,
+		UsedCount:    usedCount,
+		Active:       e.Active,
+		CreatedAt:    e.CreatedAt,
+		UpdatedAt:    e.UpdatedAt,
+	}
+}
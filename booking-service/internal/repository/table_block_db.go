@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// TableBlockedError โต๊ะที่เลือกถูก block (ชำรุด/กันไว้ให้พนักงาน) ในชั่วโมงที่จอง
+type TableBlockedError struct {
+	NumTable int32
+	Reason   string
+}
+
+func (e *TableBlockedError) Error() string {
+	return fmt.Sprintf("table %d is blocked at the booking time: %s", e.NumTable, e.Reason)
+}
+
+// checkTableBlocksTx ตรวจว่าโต๊ะที่เลือกไม่มี block คาบเกี่ยวกับชั่วโมงที่จอง (table_blocks เป็นของ restaurant-service)
+// ใช้ช่วงเวลาเดียวกับ GetAvailableTables
+func checkTableBlocksTx(tx *gorm.DB, req *CreateBookingRequest) error {
+	if len(req.Tables) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(req.Tables))
+	for i, table := range req.Tables {
+		ids[i] = table.TableID
+	}
+	hourStart := req.BookingDateTime.Truncate(time.Hour)
+
+	var blocked []struct {
+		NumTable int32  `gorm:"column:num_table"`
+		Reason   string `gorm:"column:reason"`
+	}
+	if err := tx.Raw(`
+		SELECT t.num_table, tb.reason
+		FROM table_blocks tb
+		JOIN tables t ON t.uuid = tb.table_id
+		WHERE tb.table_id IN ?
+			AND tb.start_time < ? AND tb.end_time > ?
+		ORDER BY t.num_table, tb.start_time`, ids, hourStart.Add(time.Hour), hourStart).Scan(&blocked).Error; err != nil {
+		return fmt.Errorf("failed to check table blocks: %w", err)
+	}
+	if len(blocked) > 0 {
+		return &TableBlockedError{NumTable: blocked[0].NumTable, Reason: blocked[0].Reason}
+	}
+	return nil
+}
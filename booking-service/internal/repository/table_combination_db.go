@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// TableUnavailableError โต๊ะที่เลือกถูกจองไว้แล้วในชั่วโมงเดียวกัน หรือชุดโต๊ะต่อที่ระบุไม่มีอยู่
+type TableUnavailableError struct {
+	NumTable      int32
+	CombinationID string // ไม่ว่างเมื่อไม่พบชุดโต๊ะต่อ
+}
+
+func (e *TableUnavailableError) Error() string {
+	if e.CombinationID != "" {
+		return fmt.Sprintf("table combination %q not found", e.CombinationID)
+	}
+	return fmt.Sprintf("table %d is already booked at the booking time", e.NumTable)
+}
+
+// expandTableCombinationTx เพิ่มโต๊ะทุกตัวในชุดโต๊ะต่อเข้าไปใน req.Tables (table_combination_members เป็นของ restaurant-service)
+// เพื่อให้โต๊ะทุกตัวในชุดไม่ว่างในช่วงเวลาที่จอง
+func expandTableCombinationTx(tx *gorm.DB, req *CreateBookingRequest) error {
+	if req.CombinationID == "" {
+		return nil
+	}
+
+	var combinations []string
+	if err := tx.Raw(`SELECT uuid FROM table_combinations WHERE uuid = ?`, req.CombinationID).Scan(&combinations).Error; err != nil {
+		return fmt.Errorf("failed to look up table combination: %w", err)
+	}
+	if len(combinations) == 0 {
+		return &TableUnavailableError{CombinationID: req.CombinationID}
+	}
+
+	var members []string
+	if err := tx.Raw(`
+		SELECT m.table_id
+		FROM table_combination_members m
+		JOIN tables t ON t.uuid = m.table_id
+		WHERE m.combination_id = ?
+		ORDER BY t.num_table`, req.CombinationID).Scan(&members).Error; err != nil {
+		return fmt.Errorf("failed to look up table combination members: %w", err)
+	}
+
+	selected := make(map[string]bool, len(req.Tables))
+	for _, table := range req.Tables {
+		selected[table.TableID] = true
+	}
+	for _, tableID := range members {
+		if !selected[tableID] {
+			req.Tables = append(req.Tables, CreateBookingTable{TableID: tableID})
+			selected[tableID] = true
+		}
+	}
+	if req.NumTables < int32(len(req.Tables)) {
+		req.NumTables = int32(len(req.Tables))
+	}
+	return nil
+}
+
+// checkTableConflictsTx ตรวจว่าโต๊ะที่เลือกไม่ถูกจองไว้แล้วในชั่วโมงเดียวกัน (ช่วงเวลาเดียวกับ GetAvailableTables)
+// ล็อกแถว tables ที่เลือกไว้จนจบ transaction กันการจองพร้อมกันได้โต๊ะเดียวกัน
+// (และกัน CreateTableBlock ของ restaurant-service ที่ล็อกแถวเดียวกัน)
+// bookingID คือการจองที่กำลังแก้ไข (ว่างถ้าเป็นการจองใหม่)
+func checkTableConflictsTx(tx *gorm.DB, bookingID string, req *CreateBookingRequest) error {
+	if len(req.Tables) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(req.Tables))
+	for i, table := range req.Tables {
+		ids[i] = table.TableID
+	}
+	var locked []string
+	if err := tx.Raw(`SELECT uuid FROM tables WHERE uuid IN ? ORDER BY uuid FOR UPDATE`, ids).Scan(&locked).Error; err != nil {
+		return fmt.Errorf("failed to lock tables: %w", err)
+	}
+	hourStart := req.BookingDateTime.Truncate(time.Hour)
+
+	query := `
+		SELECT DISTINCT t.num_table
+		FROM booking_tables bt
+		JOIN bookings b ON b.uuid = bt.booking_id
+		JOIN tables t ON t.uuid = bt.table_id
+		WHERE bt.table_id IN ?
+			AND b.status NOT IN ('CANCELLED', 'COMPLETED')
+			AND b.booking_date_time >= ? AND b.booking_date_time < ?`
+	args := []interface{}{ids, hourStart, hourStart.Add(time.Hour)}
+	if bookingID != "" {
+		query += ` AND b.uuid <> ?`
+		args = append(args, bookingID)
+	}
+	query += ` ORDER BY t.num_table`
+
+	var booked []int32
+	if err := tx.Raw(query, args...).Scan(&booked).Error; err != nil {
+		return fmt.Errorf("failed to check booked tables: %w", err)
+	}
+	if len(booked) > 0 {
+		return &TableUnavailableError{NumTable: booked[0]}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// TableZoneError โต๊ะที่เลือกไม่อยู่ในโซนที่ลูกค้าต้องการ NumTable เป็น 0 เมื่อไม่พบโซน
+type TableZoneError struct {
+	Zone     string
+	NumTable int32
+}
+
+func (e *TableZoneError) Error() string {
+	if e.NumTable == 0 {
+		return fmt.Sprintf("table zone %q not found", e.Zone)
+	}
+	return fmt.Sprintf("table %d is not in zone %q", e.NumTable, e.Zone)
+}
+
+// checkTableZoneTx ตรวจว่าโต๊ะที่เลือกทุกตัวอยู่ในโซนที่ลูกค้าต้องการ (table_zones เป็นของ restaurant-service)
+func checkTableZoneTx(tx *gorm.DB, req *CreateBookingRequest) error {
+	if req.ZoneID == "" {
+		return nil
+	}
+
+	var zones []string
+	if err := tx.Raw(`SELECT name_en FROM table_zones WHERE uuid = ?`, req.ZoneID).Scan(&zones).Error; err != nil {
+		return fmt.Errorf("failed to look up table zone: %w", err)
+	}
+	if len(zones) == 0 {
+		return &TableZoneError{Zone: req.ZoneID}
+	}
+	if len(req.Tables) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(req.Tables))
+	for i, table := range req.Tables {
+		ids[i] = table.TableID
+	}
+	var outside []int32
+	if err := tx.Raw(`
+		SELECT num_table FROM tables
+		WHERE uuid IN ? AND zone_id IS DISTINCT FROM ?
+		ORDER BY num_table`, ids, req.ZoneID).Scan(&outside).Error; err != nil {
+		return fmt.Errorf("failed to check table zones: %w", err)
+	}
+	if len(outside) > 0 {
+		return &TableZoneError{Zone: zones[0], NumTable: outside[0]}
+	}
+	return nil
+}
@@ -38,7 +38,8 @@ type BookingDetail struct {
 	MenuSets        []*BookingMenuSet  `protobuf:"bytes,10,rep,name=menu_sets,json=menuSets,proto3" json:"menu_sets,omitempty"`                       // รายการเมนูเซ็ต
 	MenuItems       []*BookingMenuItem `protobuf:"bytes,11,rep,name=menu_items,json=menuItems,proto3" json:"menu_items,omitempty"`                    // รายการเมนูจานเดี่ยว
 	Status          string             `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
-	TotalPrice      float64            `protobuf:"fixed64,13,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"` // ราคาทั้งหมด
+	TotalPrice      float64            `protobuf:"fixed64,13,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"` // ราคาทั้งหมด (เท่ากับ pricing.total_satang / 100)
+	Pricing         *PriceBreakdown    `protobuf:"bytes,14,opt,name=pricing,proto3" json:"pricing,omitempty"`                           // รายละเอียดยอดเงิน
 }
 
 func (x *BookingDetail) Reset() {
@@ -162,20 +163,30 @@ func (x *BookingDetail) GetTotalPrice() float64 {
 	return 0
 }
 
+func (x *BookingDetail) GetPricing() *PriceBreakdown {
+	if x != nil {
+		return x.Pricing
+	}
+	return nil
+}
+
 // BookingMenuItem message
 type BookingMenuItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MenuItemId  string  `protobuf:"bytes,1,opt,name=menu_item_id,json=menuItemId,proto3" json:"menu_item_id,omitempty"` // รหัส ID ของเมนู
-	NameTh      string  `protobuf:"bytes,2,opt,name=name_th,json=nameTh,proto3" json:"name_th,omitempty"`               // ชื่อเมนูภาษาไทย
-	NameEn      string  `protobuf:"bytes,3,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`               // ชื่อเมนูภาษาอังกฤษ
-	Description string  `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                   // คำอธิบาย
-	Price       float32 `protobuf:"fixed32,5,opt,name=price,proto3" json:"price,omitempty"`                             // ราคา
-	Category    string  `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`                         // หมวดหมู่
-	ImageUrl    string  `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`         // URL รูปภาพ
-	Quantity    int32   `protobuf:"varint,8,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	MenuItemId        string                     `protobuf:"bytes,1,opt,name=menu_item_id,json=menuItemId,proto3" json:"menu_item_id,omitempty"` // รหัส ID ของเมนู
+	NameTh            string                     `protobuf:"bytes,2,opt,name=name_th,json=nameTh,proto3" json:"name_th,omitempty"`               // ชื่อเมนูภาษาไทย
+	NameEn            string                     `protobuf:"bytes,3,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`               // ชื่อเมนูภาษาอังกฤษ
+	Description       string                     `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`                   // คำอธิบาย
+	Price             float32                    `protobuf:"fixed32,5,opt,name=price,proto3" json:"price,omitempty"`                             // ราคา
+	Category          string                     `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`                         // หมวดหมู่
+	ImageUrl          string                     `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`         // URL รูปภาพ
+	Quantity          int32                      `protobuf:"varint,8,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Discount          *Discount                  `protobuf:"bytes,9,opt,name=discount,proto3" json:"discount,omitempty"`                                               // ส่วนลดของรายการ (ใช้ตอนสร้าง/แก้ไขการจอง)
+	ModifierOptionIds []string                   `protobuf:"bytes,10,rep,name=modifier_option_ids,json=modifierOptionIds,proto3" json:"modifier_option_ids,omitempty"` // ตัวเลือกที่เลือก (ใช้ตอนสร้าง/แก้ไขการจอง)
+	Modifiers         []*BookingMenuItemModifier `protobuf:"bytes,11,rep,name=modifiers,proto3" json:"modifiers,omitempty"`                                            // ตัวเลือกที่เลือก ณ เวลาที่จอง
 }
 
 func (x *BookingMenuItem) Reset() {
@@ -264,22 +275,131 @@ func (x *BookingMenuItem) GetQuantity() int32 {
 	return 0
 }
 
+func (x *BookingMenuItem) GetDiscount() *Discount {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *BookingMenuItem) GetModifierOptionIds() []string {
+	if x != nil {
+		return x.ModifierOptionIds
+	}
+	return nil
+}
+
+func (x *BookingMenuItem) GetModifiers() []*BookingMenuItemModifier {
+	if x != nil {
+		return x.Modifiers
+	}
+	return nil
+}
+
+// ตัวเลือกของเมนูที่สั่ง (เช่น ระดับความเผ็ด) ชื่อและราคา ณ เวลาที่จอง
+type BookingMenuItemModifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModifierOptionId string `protobuf:"bytes,1,opt,name=modifier_option_id,json=modifierOptionId,proto3" json:"modifier_option_id,omitempty"`
+	GroupNameTh      string `protobuf:"bytes,2,opt,name=group_name_th,json=groupNameTh,proto3" json:"group_name_th,omitempty"`
+	GroupNameEn      string `protobuf:"bytes,3,opt,name=group_name_en,json=groupNameEn,proto3" json:"group_name_en,omitempty"`
+	NameTh           string `protobuf:"bytes,4,opt,name=name_th,json=nameTh,proto3" json:"name_th,omitempty"`
+	NameEn           string `protobuf:"bytes,5,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`
+	PriceDeltaSatang int64  `protobuf:"varint,6,opt,name=price_delta_satang,json=priceDeltaSatang,proto3" json:"price_delta_satang,omitempty"` // ราคาที่บวกเพิ่มต่อหน่วย (รวมอยู่ใน unit_price_satang แล้ว)
+}
+
+func (x *BookingMenuItemModifier) Reset() {
+	*x = BookingMenuItemModifier{}
+	mi := &file_booking_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingMenuItemModifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingMenuItemModifier) ProtoMessage() {}
+
+func (x *BookingMenuItemModifier) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingMenuItemModifier.ProtoReflect.Descriptor instead.
+func (*BookingMenuItemModifier) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BookingMenuItemModifier) GetModifierOptionId() string {
+	if x != nil {
+		return x.ModifierOptionId
+	}
+	return ""
+}
+
+func (x *BookingMenuItemModifier) GetGroupNameTh() string {
+	if x != nil {
+		return x.GroupNameTh
+	}
+	return ""
+}
+
+func (x *BookingMenuItemModifier) GetGroupNameEn() string {
+	if x != nil {
+		return x.GroupNameEn
+	}
+	return ""
+}
+
+func (x *BookingMenuItemModifier) GetNameTh() string {
+	if x != nil {
+		return x.NameTh
+	}
+	return ""
+}
+
+func (x *BookingMenuItemModifier) GetNameEn() string {
+	if x != nil {
+		return x.NameEn
+	}
+	return ""
+}
+
+func (x *BookingMenuItemModifier) GetPriceDeltaSatang() int64 {
+	if x != nil {
+		return x.PriceDeltaSatang
+	}
+	return 0
+}
+
 // BookingMenuSet message
 type BookingMenuSet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MenuSetId    string             `protobuf:"bytes,1,opt,name=menu_set_id,json=menuSetId,proto3" json:"menu_set_id,omitempty"`
-	MenuSetName  string             `protobuf:"bytes,2,opt,name=menu_set_name,json=menuSetName,proto3" json:"menu_set_name,omitempty"`      // ชื่อเมนูเซ็ต
-	MenuSetPrice float32            `protobuf:"fixed32,3,opt,name=menu_set_price,json=menuSetPrice,proto3" json:"menu_set_price,omitempty"` // ราคาของเมนูเซ็ต
-	Quantity     int32              `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	MenuItems    []*BookingMenuItem `protobuf:"bytes,5,rep,name=menu_items,json=menuItems,proto3" json:"menu_items,omitempty"` // เมนูภายในเซ็ต
+	MenuSetId    string                  `protobuf:"bytes,1,opt,name=menu_set_id,json=menuSetId,proto3" json:"menu_set_id,omitempty"`
+	MenuSetName  string                  `protobuf:"bytes,2,opt,name=menu_set_name,json=menuSetName,proto3" json:"menu_set_name,omitempty"`      // ชื่อเมนูเซ็ต
+	MenuSetPrice float32                 `protobuf:"fixed32,3,opt,name=menu_set_price,json=menuSetPrice,proto3" json:"menu_set_price,omitempty"` // ราคาของเมนูเซ็ต
+	Quantity     int32                   `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	MenuItems    []*BookingMenuItem      `protobuf:"bytes,5,rep,name=menu_items,json=menuItems,proto3" json:"menu_items,omitempty"` // เมนูภายในเซ็ต (เมนูที่เลือกมี quantity เป็นจำนวนจานต่อเซ็ต)
+	Discount     *Discount               `protobuf:"bytes,6,opt,name=discount,proto3" json:"discount,omitempty"`                    // ส่วนลดของรายการ (ใช้ตอนสร้าง/แก้ไขการจอง)
+	Choices      []*BookingMenuSetChoice `protobuf:"bytes,7,rep,name=choices,proto3" json:"choices,omitempty"`                      // เมนูที่เลือกในช่องเลือกของเซ็ต (ต่อหนึ่งเซ็ต)
 }
 
 func (x *BookingMenuSet) Reset() {
 	*x = BookingMenuSet{}
-	mi := &file_booking_proto_msgTypes[2]
+	mi := &file_booking_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -291,7 +411,7 @@ func (x *BookingMenuSet) String() string {
 func (*BookingMenuSet) ProtoMessage() {}
 
 func (x *BookingMenuSet) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[2]
+	mi := &file_booking_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -304,7 +424,7 @@ func (x *BookingMenuSet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BookingMenuSet.ProtoReflect.Descriptor instead.
 func (*BookingMenuSet) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{2}
+	return file_booking_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *BookingMenuSet) GetMenuSetId() string {
@@ -342,6 +462,122 @@ func (x *BookingMenuSet) GetMenuItems() []*BookingMenuItem {
 	return nil
 }
 
+func (x *BookingMenuSet) GetDiscount() *Discount {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *BookingMenuSet) GetChoices() []*BookingMenuSetChoice {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+// เมนูที่เลือกในช่องเลือกของเมนูเซ็ต ตอนสร้าง/แก้ไขการจองใช้แค่ slot_id, menu_item_id และ quantity
+type BookingMenuSetChoice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SlotId         string `protobuf:"bytes,1,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	MenuItemId     string `protobuf:"bytes,2,opt,name=menu_item_id,json=menuItemId,proto3" json:"menu_item_id,omitempty"`
+	Quantity       int32  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"` // จำนวนจานต่อหนึ่งเซ็ต (0 ถือเป็น 1)
+	SlotNameTh     string `protobuf:"bytes,4,opt,name=slot_name_th,json=slotNameTh,proto3" json:"slot_name_th,omitempty"`
+	SlotNameEn     string `protobuf:"bytes,5,opt,name=slot_name_en,json=slotNameEn,proto3" json:"slot_name_en,omitempty"`
+	NameTh         string `protobuf:"bytes,6,opt,name=name_th,json=nameTh,proto3" json:"name_th,omitempty"`
+	NameEn         string `protobuf:"bytes,7,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`
+	UpchargeSatang int64  `protobuf:"varint,8,opt,name=upcharge_satang,json=upchargeSatang,proto3" json:"upcharge_satang,omitempty"` // ราคาเพิ่มต่อจาน (รวมใน unit_price_satang ของเซ็ตแล้ว)
+}
+
+func (x *BookingMenuSetChoice) Reset() {
+	*x = BookingMenuSetChoice{}
+	mi := &file_booking_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookingMenuSetChoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingMenuSetChoice) ProtoMessage() {}
+
+func (x *BookingMenuSetChoice) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingMenuSetChoice.ProtoReflect.Descriptor instead.
+func (*BookingMenuSetChoice) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BookingMenuSetChoice) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+func (x *BookingMenuSetChoice) GetMenuItemId() string {
+	if x != nil {
+		return x.MenuItemId
+	}
+	return ""
+}
+
+func (x *BookingMenuSetChoice) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *BookingMenuSetChoice) GetSlotNameTh() string {
+	if x != nil {
+		return x.SlotNameTh
+	}
+	return ""
+}
+
+func (x *BookingMenuSetChoice) GetSlotNameEn() string {
+	if x != nil {
+		return x.SlotNameEn
+	}
+	return ""
+}
+
+func (x *BookingMenuSetChoice) GetNameTh() string {
+	if x != nil {
+		return x.NameTh
+	}
+	return ""
+}
+
+func (x *BookingMenuSetChoice) GetNameEn() string {
+	if x != nil {
+		return x.NameEn
+	}
+	return ""
+}
+
+func (x *BookingMenuSetChoice) GetUpchargeSatang() int64 {
+	if x != nil {
+		return x.UpchargeSatang
+	}
+	return 0
+}
+
 // BookingTable message
 type BookingTable struct {
 	state         protoimpl.MessageState
@@ -356,7 +592,7 @@ type BookingTable struct {
 
 func (x *BookingTable) Reset() {
 	*x = BookingTable{}
-	mi := &file_booking_proto_msgTypes[3]
+	mi := &file_booking_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -368,7 +604,7 @@ func (x *BookingTable) String() string {
 func (*BookingTable) ProtoMessage() {}
 
 func (x *BookingTable) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[3]
+	mi := &file_booking_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -381,7 +617,7 @@ func (x *BookingTable) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BookingTable.ProtoReflect.Descriptor instead.
 func (*BookingTable) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{3}
+	return file_booking_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *BookingTable) GetTableId() string {
@@ -429,12 +665,16 @@ type CreateBookingRequest struct {
 	MenuSets        []*BookingMenuSet  `protobuf:"bytes,10,rep,name=menu_sets,json=menuSets,proto3" json:"menu_sets,omitempty"`
 	MenuItems       []*BookingMenuItem `protobuf:"bytes,11,rep,name=menu_items,json=menuItems,proto3" json:"menu_items,omitempty"`
 	Status          string             `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
-	TotalPrice      float64            `protobuf:"fixed64,13,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	TotalPrice      float64            `protobuf:"fixed64,13,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`        // ไม่ใช้แล้ว ยอดเงินคำนวณจากราคาเมนูเสมอ
+	OrderDiscount   *Discount          `protobuf:"bytes,14,opt,name=order_discount,json=orderDiscount,proto3" json:"order_discount,omitempty"` // ส่วนลดท้ายบิล
+	PromotionCode   string             `protobuf:"bytes,15,opt,name=promotion_code,json=promotionCode,proto3" json:"promotion_code,omitempty"` // โค้ดโปรโมชั่น (ถ้ามี)
+	ZoneId          string             `protobuf:"bytes,16,opt,name=zone_id,json=zoneId,proto3" json:"zone_id,omitempty"`                      // โซนที่ลูกค้าต้องการ เช่น ระเบียงเท่านั้น (ว่าง = ไม่ระบุ) โต๊ะที่เลือกต้องอยู่ในโซนนี้
+	CombinationId   string             `protobuf:"bytes,17,opt,name=combination_id,json=combinationId,proto3" json:"combination_id,omitempty"` // ชุดโต๊ะต่อที่จอง (ถ้ามี) โต๊ะทุกตัวในชุดจะถูกจองด้วย
 }
 
 func (x *CreateBookingRequest) Reset() {
 	*x = CreateBookingRequest{}
-	mi := &file_booking_proto_msgTypes[4]
+	mi := &file_booking_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -446,7 +686,7 @@ func (x *CreateBookingRequest) String() string {
 func (*CreateBookingRequest) ProtoMessage() {}
 
 func (x *CreateBookingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[4]
+	mi := &file_booking_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -459,7 +699,7 @@ func (x *CreateBookingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBookingRequest.ProtoReflect.Descriptor instead.
 func (*CreateBookingRequest) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{4}
+	return file_booking_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateBookingRequest) GetBookingId() string {
@@ -553,29 +793,59 @@ func (x *CreateBookingRequest) GetTotalPrice() float64 {
 	return 0
 }
 
-type CreateBookingResponse struct {
+func (x *CreateBookingRequest) GetOrderDiscount() *Discount {
+	if x != nil {
+		return x.OrderDiscount
+	}
+	return nil
+}
+
+func (x *CreateBookingRequest) GetPromotionCode() string {
+	if x != nil {
+		return x.PromotionCode
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetZoneId() string {
+	if x != nil {
+		return x.ZoneId
+	}
+	return ""
+}
+
+func (x *CreateBookingRequest) GetCombinationId() string {
+	if x != nil {
+		return x.CombinationId
+	}
+	return ""
+}
+
+// ส่วนลด ถ้ากำหนดทั้งสองแบบจะหักเปอร์เซ็นต์ก่อนแล้วหักจำนวนเงิน
+type Discount struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BookingId string `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Percent      float64 `protobuf:"fixed64,1,opt,name=percent,proto3" json:"percent,omitempty"`                              // 0-100
+	AmountSatang int64   `protobuf:"varint,2,opt,name=amount_satang,json=amountSatang,proto3" json:"amount_satang,omitempty"` // จำนวนเงิน (สตางค์)
 }
 
-func (x *CreateBookingResponse) Reset() {
-	*x = CreateBookingResponse{}
-	mi := &file_booking_proto_msgTypes[5]
+func (x *Discount) Reset() {
+	*x = Discount{}
+	mi := &file_booking_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateBookingResponse) String() string {
+func (x *Discount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBookingResponse) ProtoMessage() {}
+func (*Discount) ProtoMessage() {}
 
-func (x *CreateBookingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[5]
+func (x *Discount) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,41 +856,61 @@ func (x *CreateBookingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBookingResponse.ProtoReflect.Descriptor instead.
-func (*CreateBookingResponse) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use Discount.ProtoReflect.Descriptor instead.
+func (*Discount) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CreateBookingResponse) GetBookingId() string {
+func (x *Discount) GetPercent() float64 {
 	if x != nil {
-		return x.BookingId
+		return x.Percent
 	}
-	return ""
+	return 0
 }
 
-type UpdateBookingResponse struct {
+func (x *Discount) GetAmountSatang() int64 {
+	if x != nil {
+		return x.AmountSatang
+	}
+	return 0
+}
+
+// จำนวนเงินทั้งหมดเป็นหน่วยสตางค์ (100 สตางค์ = 1 บาท)
+type PriceBreakdown struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-}
-
-func (x *UpdateBookingResponse) Reset() {
-	*x = UpdateBookingResponse{}
-	mi := &file_booking_proto_msgTypes[6]
+	Lines                   []*PriceLine `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	SubtotalSatang          int64        `protobuf:"varint,2,opt,name=subtotal_satang,json=subtotalSatang,proto3" json:"subtotal_satang,omitempty"`                  // ผลรวมก่อนหักส่วนลด
+	LineDiscountSatang      int64        `protobuf:"varint,3,opt,name=line_discount_satang,json=lineDiscountSatang,proto3" json:"line_discount_satang,omitempty"`    // ส่วนลดรายการรวม
+	OrderDiscountSatang     int64        `protobuf:"varint,4,opt,name=order_discount_satang,json=orderDiscountSatang,proto3" json:"order_discount_satang,omitempty"` // ส่วนลดท้ายบิล
+	ServiceChargeSatang     int64        `protobuf:"varint,5,opt,name=service_charge_satang,json=serviceChargeSatang,proto3" json:"service_charge_satang,omitempty"`
+	ServiceChargePercent    float64      `protobuf:"fixed64,6,opt,name=service_charge_percent,json=serviceChargePercent,proto3" json:"service_charge_percent,omitempty"`
+	VatSatang               int64        `protobuf:"varint,7,opt,name=vat_satang,json=vatSatang,proto3" json:"vat_satang,omitempty"` // ถ้า vat_inclusive เป็นยอด VAT ที่รวมอยู่ในราคาแล้ว
+	VatPercent              float64      `protobuf:"fixed64,8,opt,name=vat_percent,json=vatPercent,proto3" json:"vat_percent,omitempty"`
+	VatInclusive            bool         `protobuf:"varint,9,opt,name=vat_inclusive,json=vatInclusive,proto3" json:"vat_inclusive,omitempty"`
+	RoundingSatang          int64        `protobuf:"varint,10,opt,name=rounding_satang,json=roundingSatang,proto3" json:"rounding_satang,omitempty"` // ส่วนต่างจากการปัดเศษ
+	TotalSatang             int64        `protobuf:"varint,11,opt,name=total_satang,json=totalSatang,proto3" json:"total_satang,omitempty"`          // ยอดสุทธิ
+	PromotionCode           string       `protobuf:"bytes,12,opt,name=promotion_code,json=promotionCode,proto3" json:"promotion_code,omitempty"`
+	PromotionDiscountSatang int64        `protobuf:"varint,13,opt,name=promotion_discount_satang,json=promotionDiscountSatang,proto3" json:"promotion_discount_satang,omitempty"` // ส่วนลดจากโปรโมชั่น หักหลังส่วนลดท้ายบิล
+}
+
+func (x *PriceBreakdown) Reset() {
+	*x = PriceBreakdown{}
+	mi := &file_booking_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBookingResponse) String() string {
+func (x *PriceBreakdown) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBookingResponse) ProtoMessage() {}
+func (*PriceBreakdown) ProtoMessage() {}
 
-func (x *UpdateBookingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[6]
+func (x *PriceBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -631,41 +921,891 @@ func (x *UpdateBookingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBookingResponse.ProtoReflect.Descriptor instead.
-func (*UpdateBookingResponse) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use PriceBreakdown.ProtoReflect.Descriptor instead.
+func (*PriceBreakdown) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UpdateBookingResponse) GetSuccess() bool {
+func (x *PriceBreakdown) GetLines() []*PriceLine {
 	if x != nil {
-		return x.Success
+		return x.Lines
 	}
-	return false
+	return nil
 }
 
-type DeleteBookingRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	BookingId string `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+func (x *PriceBreakdown) GetSubtotalSatang() int64 {
+	if x != nil {
+		return x.SubtotalSatang
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetLineDiscountSatang() int64 {
+	if x != nil {
+		return x.LineDiscountSatang
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetOrderDiscountSatang() int64 {
+	if x != nil {
+		return x.OrderDiscountSatang
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetServiceChargeSatang() int64 {
+	if x != nil {
+		return x.ServiceChargeSatang
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetServiceChargePercent() float64 {
+	if x != nil {
+		return x.ServiceChargePercent
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetVatSatang() int64 {
+	if x != nil {
+		return x.VatSatang
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetVatPercent() float64 {
+	if x != nil {
+		return x.VatPercent
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetVatInclusive() bool {
+	if x != nil {
+		return x.VatInclusive
+	}
+	return false
+}
+
+func (x *PriceBreakdown) GetRoundingSatang() int64 {
+	if x != nil {
+		return x.RoundingSatang
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetTotalSatang() int64 {
+	if x != nil {
+		return x.TotalSatang
+	}
+	return 0
+}
+
+func (x *PriceBreakdown) GetPromotionCode() string {
+	if x != nil {
+		return x.PromotionCode
+	}
+	return ""
+}
+
+func (x *PriceBreakdown) GetPromotionDiscountSatang() int64 {
+	if x != nil {
+		return x.PromotionDiscountSatang
+	}
+	return 0
+}
+
+type PriceLine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind            string   `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`                // "MENU_SET" หรือ "MENU_ITEM"
+	RefId           string   `protobuf:"bytes,2,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"` // menu_set_id หรือ menu_item_id
+	Name            string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity        int32    `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPriceSatang int64    `protobuf:"varint,5,opt,name=unit_price_satang,json=unitPriceSatang,proto3" json:"unit_price_satang,omitempty"` // ราคาต่อหน่วย ณ เวลาที่จอง
+	GrossSatang     int64    `protobuf:"varint,6,opt,name=gross_satang,json=grossSatang,proto3" json:"gross_satang,omitempty"`
+	DiscountSatang  int64    `protobuf:"varint,7,opt,name=discount_satang,json=discountSatang,proto3" json:"discount_satang,omitempty"`
+	NetSatang       int64    `protobuf:"varint,8,opt,name=net_satang,json=netSatang,proto3" json:"net_satang,omitempty"`
+	Modifiers       []string `protobuf:"bytes,9,rep,name=modifiers,proto3" json:"modifiers,omitempty"` // ชื่อตัวเลือกหรือเมนูที่เลือกในเซ็ต (ราคารวมใน unit_price_satang แล้ว)
+}
+
+func (x *PriceLine) Reset() {
+	*x = PriceLine{}
+	mi := &file_booking_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceLine) ProtoMessage() {}
+
+func (x *PriceLine) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceLine.ProtoReflect.Descriptor instead.
+func (*PriceLine) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PriceLine) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *PriceLine) GetRefId() string {
+	if x != nil {
+		return x.RefId
+	}
+	return ""
+}
+
+func (x *PriceLine) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PriceLine) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PriceLine) GetUnitPriceSatang() int64 {
+	if x != nil {
+		return x.UnitPriceSatang
+	}
+	return 0
+}
+
+func (x *PriceLine) GetGrossSatang() int64 {
+	if x != nil {
+		return x.GrossSatang
+	}
+	return 0
+}
+
+func (x *PriceLine) GetDiscountSatang() int64 {
+	if x != nil {
+		return x.DiscountSatang
+	}
+	return 0
+}
+
+func (x *PriceLine) GetNetSatang() int64 {
+	if x != nil {
+		return x.NetSatang
+	}
+	return 0
+}
+
+func (x *PriceLine) GetModifiers() []string {
+	if x != nil {
+		return x.Modifiers
+	}
+	return nil
+}
+
+type CreateBookingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId string   `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Warnings  []string `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"` // เมนูที่ขัดกับข้อจำกัดด้านอาหารของลูกค้า (เตือนเท่านั้น ไม่ได้ห้ามจอง)
+}
+
+func (x *CreateBookingResponse) Reset() {
+	*x = CreateBookingResponse{}
+	mi := &file_booking_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBookingResponse) ProtoMessage() {}
+
+func (x *CreateBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBookingResponse.ProtoReflect.Descriptor instead.
+func (*CreateBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateBookingResponse) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *CreateBookingResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type UpdateBookingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success  bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Warnings []string `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"` // เหมือน CreateBookingResponse.warnings
+}
+
+func (x *UpdateBookingResponse) Reset() {
+	*x = UpdateBookingResponse{}
+	mi := &file_booking_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBookingResponse) ProtoMessage() {}
+
+func (x *UpdateBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBookingResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateBookingResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateBookingResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type GetCustomerDietaryRestrictionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PhoneNumber string `protobuf:"bytes,1,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+}
+
+func (x *GetCustomerDietaryRestrictionsRequest) Reset() {
+	*x = GetCustomerDietaryRestrictionsRequest{}
+	mi := &file_booking_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomerDietaryRestrictionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerDietaryRestrictionsRequest) ProtoMessage() {}
+
+func (x *GetCustomerDietaryRestrictionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerDietaryRestrictionsRequest.ProtoReflect.Descriptor instead.
+func (*GetCustomerDietaryRestrictionsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetCustomerDietaryRestrictionsRequest) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+type CustomerDietaryRestrictions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PhoneNumber     string   `protobuf:"bytes,1,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	AvoidAllergens  []string `protobuf:"bytes,2,rep,name=avoid_allergens,json=avoidAllergens,proto3" json:"avoid_allergens,omitempty"`    // PEANUT, TREE_NUT, SHELLFISH, FISH, GLUTEN, DAIRY, EGG, SOY, SESAME
+	RequiredDietary []string `protobuf:"bytes,3,rep,name=required_dietary,json=requiredDietary,proto3" json:"required_dietary,omitempty"` // HALAL, VEGETARIAN, VEGAN, GLUTEN_FREE, NO_PORK
+}
+
+func (x *CustomerDietaryRestrictions) Reset() {
+	*x = CustomerDietaryRestrictions{}
+	mi := &file_booking_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomerDietaryRestrictions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerDietaryRestrictions) ProtoMessage() {}
+
+func (x *CustomerDietaryRestrictions) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerDietaryRestrictions.ProtoReflect.Descriptor instead.
+func (*CustomerDietaryRestrictions) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CustomerDietaryRestrictions) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *CustomerDietaryRestrictions) GetAvoidAllergens() []string {
+	if x != nil {
+		return x.AvoidAllergens
+	}
+	return nil
+}
+
+func (x *CustomerDietaryRestrictions) GetRequiredDietary() []string {
+	if x != nil {
+		return x.RequiredDietary
+	}
+	return nil
+}
+
+type DeleteBookingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId string `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
 }
 
 func (x *DeleteBookingRequest) Reset() {
 	*x = DeleteBookingRequest{}
-	mi := &file_booking_proto_msgTypes[7]
+	mi := &file_booking_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBookingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBookingRequest) ProtoMessage() {}
+
+func (x *DeleteBookingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBookingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBookingRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteBookingRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type DeleteBookingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *DeleteBookingResponse) Reset() {
+	*x = DeleteBookingResponse{}
+	mi := &file_booking_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBookingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBookingResponse) ProtoMessage() {}
+
+func (x *DeleteBookingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBookingResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBookingResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteBookingResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetBookingDetailsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetBookingDetailsRequest) Reset() {
+	*x = GetBookingDetailsRequest{}
+	mi := &file_booking_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingDetailsRequest) ProtoMessage() {}
+
+func (x *GetBookingDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingDetailsRequest.ProtoReflect.Descriptor instead.
+func (*GetBookingDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{16}
+}
+
+type GetBookingDetailsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingDetails []*BookingDetail `protobuf:"bytes,1,rep,name=booking_details,json=bookingDetails,proto3" json:"booking_details,omitempty"`
+}
+
+func (x *GetBookingDetailsResponse) Reset() {
+	*x = GetBookingDetailsResponse{}
+	mi := &file_booking_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingDetailsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingDetailsResponse) ProtoMessage() {}
+
+func (x *GetBookingDetailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingDetailsResponse.ProtoReflect.Descriptor instead.
+func (*GetBookingDetailsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetBookingDetailsResponse) GetBookingDetails() []*BookingDetail {
+	if x != nil {
+		return x.BookingDetails
+	}
+	return nil
+}
+
+type GetBookingDetailsByIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingId string `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+}
+
+func (x *GetBookingDetailsByIDRequest) Reset() {
+	*x = GetBookingDetailsByIDRequest{}
+	mi := &file_booking_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingDetailsByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingDetailsByIDRequest) ProtoMessage() {}
+
+func (x *GetBookingDetailsByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingDetailsByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetBookingDetailsByIDRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetBookingDetailsByIDRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type GetBookingDetailsByIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookingDetail *BookingDetail `protobuf:"bytes,1,opt,name=booking_detail,json=bookingDetail,proto3" json:"booking_detail,omitempty"`
+}
+
+func (x *GetBookingDetailsByIDResponse) Reset() {
+	*x = GetBookingDetailsByIDResponse{}
+	mi := &file_booking_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBookingDetailsByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBookingDetailsByIDResponse) ProtoMessage() {}
+
+func (x *GetBookingDetailsByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBookingDetailsByIDResponse.ProtoReflect.Descriptor instead.
+func (*GetBookingDetailsByIDResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetBookingDetailsByIDResponse) GetBookingDetail() *BookingDetail {
+	if x != nil {
+		return x.BookingDetail
+	}
+	return nil
+}
+
+type ExportBookingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartDate string `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // วันที่เริ่มต้น (เช่น "2024-12-01")
+	EndDate   string `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // วันที่สิ้นสุด (รวมวันนี้ด้วย)
+}
+
+func (x *ExportBookingsRequest) Reset() {
+	*x = ExportBookingsRequest{}
+	mi := &file_booking_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportBookingsRequest) ProtoMessage() {}
+
+func (x *ExportBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportBookingsRequest.ProtoReflect.Descriptor instead.
+func (*ExportBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ExportBookingsRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ExportBookingsRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+// คอลัมน์ของ CSV (แถวแรกเป็นหัวคอลัมน์ ลำดับคอลัมน์สลับกันได้):
+// customer_name, company_name, phone_number, booking_date_time, num_adults, num_children, table_numbers, menu_sets
+// - booking_date_time: RFC3339 หรือ "2006-01-02 15:04" (เวลากรุงเทพ)
+// - table_numbers: หมายเลขโต๊ะคั่นด้วย "," หรือ ";" เช่น "1;2"
+// - menu_sets: ชื่อเมนูเซ็ตคั่นด้วย ";" ระบุจำนวนด้วย "x" เช่น "Set A x2; Set B"
+type ImportBookingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Csv    []byte `protobuf:"bytes,1,opt,name=csv,proto3" json:"csv,omitempty"`
+	DryRun bool   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *ImportBookingsRequest) Reset() {
+	*x = ImportBookingsRequest{}
+	mi := &file_booking_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportBookingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportBookingsRequest) ProtoMessage() {}
+
+func (x *ImportBookingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportBookingsRequest.ProtoReflect.Descriptor instead.
+func (*ImportBookingsRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ImportBookingsRequest) GetCsv() []byte {
+	if x != nil {
+		return x.Csv
+	}
+	return nil
+}
+
+func (x *ImportBookingsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportBookingRowResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Row       int32  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"` // หมายเลขบรรทัดในไฟล์ (หัวคอลัมน์คือบรรทัดที่ 1)
+	Success   bool   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	BookingId string `protobuf:"bytes,3,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"` // ว่างเมื่อเป็น dry run หรือเกิดข้อผิดพลาด
+	Error     string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ImportBookingRowResult) Reset() {
+	*x = ImportBookingRowResult{}
+	mi := &file_booking_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportBookingRowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportBookingRowResult) ProtoMessage() {}
+
+func (x *ImportBookingRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportBookingRowResult.ProtoReflect.Descriptor instead.
+func (*ImportBookingRowResult) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ImportBookingRowResult) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportBookingRowResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ImportBookingRowResult) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *ImportBookingRowResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ImportBookingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DryRun       bool                      `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	TotalRows    int32                     `protobuf:"varint,2,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	SuccessCount int32                     `protobuf:"varint,3,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	ErrorCount   int32                     `protobuf:"varint,4,opt,name=error_count,json=errorCount,proto3" json:"error_count,omitempty"`
+	Results      []*ImportBookingRowResult `protobuf:"bytes,5,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *ImportBookingsResponse) Reset() {
+	*x = ImportBookingsResponse{}
+	mi := &file_booking_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteBookingRequest) String() string {
+func (x *ImportBookingsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBookingRequest) ProtoMessage() {}
+func (*ImportBookingsResponse) ProtoMessage() {}
 
-func (x *DeleteBookingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[7]
+func (x *ImportBookingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -676,41 +1816,69 @@ func (x *DeleteBookingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBookingRequest.ProtoReflect.Descriptor instead.
-func (*DeleteBookingRequest) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ImportBookingsResponse.ProtoReflect.Descriptor instead.
+func (*ImportBookingsResponse) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *DeleteBookingRequest) GetBookingId() string {
+func (x *ImportBookingsResponse) GetDryRun() bool {
 	if x != nil {
-		return x.BookingId
+		return x.DryRun
 	}
-	return ""
+	return false
 }
 
-type DeleteBookingResponse struct {
+func (x *ImportBookingsResponse) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *ImportBookingsResponse) GetSuccessCount() int32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *ImportBookingsResponse) GetErrorCount() int32 {
+	if x != nil {
+		return x.ErrorCount
+	}
+	return 0
+}
+
+func (x *ImportBookingsResponse) GetResults() []*ImportBookingRowResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetPrepSheetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // วันที่ (เช่น "2024-12-01")
 }
 
-func (x *DeleteBookingResponse) Reset() {
-	*x = DeleteBookingResponse{}
-	mi := &file_booking_proto_msgTypes[8]
+func (x *GetPrepSheetRequest) Reset() {
+	*x = GetPrepSheetRequest{}
+	mi := &file_booking_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteBookingResponse) String() string {
+func (x *GetPrepSheetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBookingResponse) ProtoMessage() {}
+func (*GetPrepSheetRequest) ProtoMessage() {}
 
-func (x *DeleteBookingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[8]
+func (x *GetPrepSheetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -721,39 +1889,46 @@ func (x *DeleteBookingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBookingResponse.ProtoReflect.Descriptor instead.
-func (*DeleteBookingResponse) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetPrepSheetRequest.ProtoReflect.Descriptor instead.
+func (*GetPrepSheetRequest) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *DeleteBookingResponse) GetSuccess() bool {
+func (x *GetPrepSheetRequest) GetDate() string {
 	if x != nil {
-		return x.Success
+		return x.Date
 	}
-	return false
+	return ""
 }
 
-type GetBookingDetailsRequest struct {
+type PrepSheet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Date          string              `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	TotalAdults   int32               `protobuf:"varint,2,opt,name=total_adults,json=totalAdults,proto3" json:"total_adults,omitempty"`
+	TotalChildren int32               `protobuf:"varint,3,opt,name=total_children,json=totalChildren,proto3" json:"total_children,omitempty"`
+	Bookings      []*PrepSheetBooking `protobuf:"bytes,4,rep,name=bookings,proto3" json:"bookings,omitempty"`                 // การจองเรียงตามเวลา
+	MenuSets      []*BookingMenuSet   `protobuf:"bytes,5,rep,name=menu_sets,json=menuSets,proto3" json:"menu_sets,omitempty"` // เมนูเซ็ตรวมทั้งวัน (quantity = จำนวนเซ็ตทั้งหมด)
+	Items         []*PrepSheetItem    `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`                       // จำนวนจานที่ต้องเตรียมแยกตามเมนู
 }
 
-func (x *GetBookingDetailsRequest) Reset() {
-	*x = GetBookingDetailsRequest{}
-	mi := &file_booking_proto_msgTypes[9]
+func (x *PrepSheet) Reset() {
+	*x = PrepSheet{}
+	mi := &file_booking_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBookingDetailsRequest) String() string {
+func (x *PrepSheet) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBookingDetailsRequest) ProtoMessage() {}
+func (*PrepSheet) ProtoMessage() {}
 
-func (x *GetBookingDetailsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[9]
+func (x *PrepSheet) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -764,34 +1939,82 @@ func (x *GetBookingDetailsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBookingDetailsRequest.ProtoReflect.Descriptor instead.
-func (*GetBookingDetailsRequest) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use PrepSheet.ProtoReflect.Descriptor instead.
+func (*PrepSheet) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{25}
 }
 
-type GetBookingDetailsResponse struct {
+func (x *PrepSheet) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *PrepSheet) GetTotalAdults() int32 {
+	if x != nil {
+		return x.TotalAdults
+	}
+	return 0
+}
+
+func (x *PrepSheet) GetTotalChildren() int32 {
+	if x != nil {
+		return x.TotalChildren
+	}
+	return 0
+}
+
+func (x *PrepSheet) GetBookings() []*PrepSheetBooking {
+	if x != nil {
+		return x.Bookings
+	}
+	return nil
+}
+
+func (x *PrepSheet) GetMenuSets() []*BookingMenuSet {
+	if x != nil {
+		return x.MenuSets
+	}
+	return nil
+}
+
+func (x *PrepSheet) GetItems() []*PrepSheetItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type PrepSheetBooking struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BookingDetails []*BookingDetail `protobuf:"bytes,1,rep,name=booking_details,json=bookingDetails,proto3" json:"booking_details,omitempty"`
+	BookingId       string   `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	BookingDateTime string   `protobuf:"bytes,2,opt,name=booking_date_time,json=bookingDateTime,proto3" json:"booking_date_time,omitempty"`
+	CustomerName    string   `protobuf:"bytes,3,opt,name=customer_name,json=customerName,proto3" json:"customer_name,omitempty"`
+	CompanyName     string   `protobuf:"bytes,4,opt,name=company_name,json=companyName,proto3" json:"company_name,omitempty"`
+	NumAdults       int32    `protobuf:"varint,5,opt,name=num_adults,json=numAdults,proto3" json:"num_adults,omitempty"`
+	NumChildren     int32    `protobuf:"varint,6,opt,name=num_children,json=numChildren,proto3" json:"num_children,omitempty"`
+	TableNumbers    []string `protobuf:"bytes,7,rep,name=table_numbers,json=tableNumbers,proto3" json:"table_numbers,omitempty"`
 }
 
-func (x *GetBookingDetailsResponse) Reset() {
-	*x = GetBookingDetailsResponse{}
-	mi := &file_booking_proto_msgTypes[10]
+func (x *PrepSheetBooking) Reset() {
+	*x = PrepSheetBooking{}
+	mi := &file_booking_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBookingDetailsResponse) String() string {
+func (x *PrepSheetBooking) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBookingDetailsResponse) ProtoMessage() {}
+func (*PrepSheetBooking) ProtoMessage() {}
 
-func (x *GetBookingDetailsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[10]
+func (x *PrepSheetBooking) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -802,41 +2025,90 @@ func (x *GetBookingDetailsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBookingDetailsResponse.ProtoReflect.Descriptor instead.
-func (*GetBookingDetailsResponse) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use PrepSheetBooking.ProtoReflect.Descriptor instead.
+func (*PrepSheetBooking) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetBookingDetailsResponse) GetBookingDetails() []*BookingDetail {
+func (x *PrepSheetBooking) GetBookingId() string {
 	if x != nil {
-		return x.BookingDetails
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *PrepSheetBooking) GetBookingDateTime() string {
+	if x != nil {
+		return x.BookingDateTime
+	}
+	return ""
+}
+
+func (x *PrepSheetBooking) GetCustomerName() string {
+	if x != nil {
+		return x.CustomerName
+	}
+	return ""
+}
+
+func (x *PrepSheetBooking) GetCompanyName() string {
+	if x != nil {
+		return x.CompanyName
+	}
+	return ""
+}
+
+func (x *PrepSheetBooking) GetNumAdults() int32 {
+	if x != nil {
+		return x.NumAdults
+	}
+	return 0
+}
+
+func (x *PrepSheetBooking) GetNumChildren() int32 {
+	if x != nil {
+		return x.NumChildren
+	}
+	return 0
+}
+
+func (x *PrepSheetBooking) GetTableNumbers() []string {
+	if x != nil {
+		return x.TableNumbers
 	}
 	return nil
 }
 
-type GetBookingDetailsByIDRequest struct {
+type PrepSheetItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BookingId string `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	MenuItemId string               `protobuf:"bytes,1,opt,name=menu_item_id,json=menuItemId,proto3" json:"menu_item_id,omitempty"`
+	NameTh     string               `protobuf:"bytes,2,opt,name=name_th,json=nameTh,proto3" json:"name_th,omitempty"`
+	NameEn     string               `protobuf:"bytes,3,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`
+	Category   string               `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	FromSets   int32                `protobuf:"varint,5,opt,name=from_sets,json=fromSets,proto3" json:"from_sets,omitempty"`   // จำนวนจากเมนูเซ็ต
+	ALaCarte   int32                `protobuf:"varint,6,opt,name=a_la_carte,json=aLaCarte,proto3" json:"a_la_carte,omitempty"` // จำนวนที่สั่งแยก
+	Total      int32                `protobuf:"varint,7,opt,name=total,proto3" json:"total,omitempty"`
+	Modifiers  []*PrepSheetModifier `protobuf:"bytes,8,rep,name=modifiers,proto3" json:"modifiers,omitempty"` // ตัวเลือกของจานที่สั่งแยก
 }
 
-func (x *GetBookingDetailsByIDRequest) Reset() {
-	*x = GetBookingDetailsByIDRequest{}
-	mi := &file_booking_proto_msgTypes[11]
+func (x *PrepSheetItem) Reset() {
+	*x = PrepSheetItem{}
+	mi := &file_booking_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBookingDetailsByIDRequest) String() string {
+func (x *PrepSheetItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBookingDetailsByIDRequest) ProtoMessage() {}
+func (*PrepSheetItem) ProtoMessage() {}
 
-func (x *GetBookingDetailsByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[11]
+func (x *PrepSheetItem) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -847,41 +2119,94 @@ func (x *GetBookingDetailsByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBookingDetailsByIDRequest.ProtoReflect.Descriptor instead.
-func (*GetBookingDetailsByIDRequest) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use PrepSheetItem.ProtoReflect.Descriptor instead.
+func (*PrepSheetItem) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetBookingDetailsByIDRequest) GetBookingId() string {
+func (x *PrepSheetItem) GetMenuItemId() string {
 	if x != nil {
-		return x.BookingId
+		return x.MenuItemId
 	}
 	return ""
 }
 
-type GetBookingDetailsByIDResponse struct {
+func (x *PrepSheetItem) GetNameTh() string {
+	if x != nil {
+		return x.NameTh
+	}
+	return ""
+}
+
+func (x *PrepSheetItem) GetNameEn() string {
+	if x != nil {
+		return x.NameEn
+	}
+	return ""
+}
+
+func (x *PrepSheetItem) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *PrepSheetItem) GetFromSets() int32 {
+	if x != nil {
+		return x.FromSets
+	}
+	return 0
+}
+
+func (x *PrepSheetItem) GetALaCarte() int32 {
+	if x != nil {
+		return x.ALaCarte
+	}
+	return 0
+}
+
+func (x *PrepSheetItem) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PrepSheetItem) GetModifiers() []*PrepSheetModifier {
+	if x != nil {
+		return x.Modifiers
+	}
+	return nil
+}
+
+// จำนวนจานที่สั่งแยกซึ่งเลือกตัวเลือกนี้
+type PrepSheetModifier struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BookingDetail *BookingDetail `protobuf:"bytes,1,opt,name=booking_detail,json=bookingDetail,proto3" json:"booking_detail,omitempty"`
+	GroupNameTh string `protobuf:"bytes,1,opt,name=group_name_th,json=groupNameTh,proto3" json:"group_name_th,omitempty"`
+	NameTh      string `protobuf:"bytes,2,opt,name=name_th,json=nameTh,proto3" json:"name_th,omitempty"`
+	NameEn      string `protobuf:"bytes,3,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`
+	Quantity    int32  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
 }
 
-func (x *GetBookingDetailsByIDResponse) Reset() {
-	*x = GetBookingDetailsByIDResponse{}
-	mi := &file_booking_proto_msgTypes[12]
+func (x *PrepSheetModifier) Reset() {
+	*x = PrepSheetModifier{}
+	mi := &file_booking_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBookingDetailsByIDResponse) String() string {
+func (x *PrepSheetModifier) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBookingDetailsByIDResponse) ProtoMessage() {}
+func (*PrepSheetModifier) ProtoMessage() {}
 
-func (x *GetBookingDetailsByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[12]
+func (x *PrepSheetModifier) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -892,23 +2217,44 @@ func (x *GetBookingDetailsByIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBookingDetailsByIDResponse.ProtoReflect.Descriptor instead.
-func (*GetBookingDetailsByIDResponse) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use PrepSheetModifier.ProtoReflect.Descriptor instead.
+func (*PrepSheetModifier) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetBookingDetailsByIDResponse) GetBookingDetail() *BookingDetail {
+func (x *PrepSheetModifier) GetGroupNameTh() string {
 	if x != nil {
-		return x.BookingDetail
+		return x.GroupNameTh
 	}
-	return nil
+	return ""
+}
+
+func (x *PrepSheetModifier) GetNameTh() string {
+	if x != nil {
+		return x.NameTh
+	}
+	return ""
+}
+
+func (x *PrepSheetModifier) GetNameEn() string {
+	if x != nil {
+		return x.NameEn
+	}
+	return ""
+}
+
+func (x *PrepSheetModifier) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
 }
 
 var File_booking_proto protoreflect.FileDescriptor
 
 var file_booking_proto_rawDesc = []byte{
 	0x0a, 0x0d, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x80, 0x04, 0x0a, 0x0d, 0x42, 0x6f,
+	0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0xb4, 0x04, 0x0a, 0x0d, 0x42, 0x6f,
 	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
 	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75,
@@ -940,36 +2286,88 @@ var file_booking_proto_rawDesc = []byte{
 	0x65, 0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0c, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
 	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0xf2, 0x01, 0x0a,
-	0x0f, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d,
-	0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d,
-	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x68, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x6e,
-	0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x61,
-	0x6d, 0x65, 0x45, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67,
-	0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61,
-	0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x22, 0xd0, 0x01, 0x0a, 0x0e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x6e,
-	0x75, 0x53, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x73, 0x65, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x6e, 0x75, 0x53,
-	0x65, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x73, 0x65, 0x74,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x6e,
-	0x75, 0x53, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x65, 0x6e, 0x75,
-	0x5f, 0x73, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02,
-	0x52, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x38, 0x0a, 0x0a, 0x6d, 0x65,
-	0x6e, 0x75, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
-	0x67, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x09, 0x6d, 0x65, 0x6e, 0x75, 0x49,
-	0x74, 0x65, 0x6d, 0x73, 0x22, 0x7f, 0x0a, 0x0c, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x54,
+	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x07,
+	0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x72,
+	0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x07, 0x70, 0x72, 0x69, 0x63, 0x69, 0x6e, 0x67,
+	0x22, 0x93, 0x03, 0x0a, 0x0f, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x6e, 0x75,
+	0x49, 0x74, 0x65, 0x6d, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x69, 0x74, 0x65,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x6e, 0x75,
+	0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x68, 0x12,
+	0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x64, 0x69, 0x73,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x11, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x3f, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x6e, 0x75, 0x49,
+	0x74, 0x65, 0x6d, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x09, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x22, 0xef, 0x01, 0x0a, 0x17, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x5f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x22, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61,
+	0x6d, 0x65, 0x54, 0x68, 0x12, 0x22, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65,
+	0x5f, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x54,
+	0x68, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x73, 0x61, 0x74, 0x61, 0x6e, 0x67,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x70, 0x72, 0x69, 0x63, 0x65, 0x44, 0x65, 0x6c,
+	0x74, 0x61, 0x53, 0x61, 0x74, 0x61, 0x6e, 0x67, 0x22, 0xba, 0x02, 0x0a, 0x0e, 0x42, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x6d,
+	0x65, 0x6e, 0x75, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x6d,
+	0x65, 0x6e, 0x75, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x24, 0x0a, 0x0e, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x73, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x38, 0x0a, 0x0a, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x09, 0x6d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x64,
+	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x07, 0x63,
+	0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x4d,
+	0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x07, 0x63, 0x68,
+	0x6f, 0x69, 0x63, 0x65, 0x73, 0x22, 0x8c, 0x02, 0x0a, 0x14, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x5f,
+	0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d,
+	0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x5f, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6c, 0x6f,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x54, 0x68, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x6c, 0x6f, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73,
+	0x6c, 0x6f, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d,
+	0x65, 0x5f, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65,
+	0x54, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x75,
+	0x70, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x61, 0x6e, 0x67, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x75, 0x70, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x53, 0x61,
+	0x74, 0x61, 0x6e, 0x67, 0x22, 0x7f, 0x0a, 0x0c, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x54,
 	0x61, 0x62, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x12,
 	0x21, 0x0a, 0x0c, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
@@ -977,7 +2375,7 @@ var file_booking_proto_rawDesc = []byte{
 	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x63,
 	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x65, 0x61, 0x74,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf4, 0x03, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x96, 0x05, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
 	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
 	0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x23, 0x0a,
@@ -1008,69 +2406,281 @@ var file_booking_proto_rawDesc = []byte{
 	0x65, 0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0c, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
 	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x36, 0x0a, 0x15,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
-	0x6e, 0x67, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f,
-	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x35, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x31,
-	0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5d, 0x0a,
-	0x19, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0f, 0x62, 0x6f,
-	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x42,
-	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x0e, 0x62, 0x6f,
-	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x3d, 0x0a, 0x1c,
-	0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x73, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x5f, 0x0a, 0x1d, 0x47,
-	0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0e,
-	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x0d, 0x62,
-	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x32, 0xce, 0x03, 0x0a,
-	0x0e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x5c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0e,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x44,
+	0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x6d, 0x6f,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x7a, 0x6f, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x62, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x63, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x49,
+	0x0a, 0x08, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73,
+	0x61, 0x74, 0x61, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x53, 0x61, 0x74, 0x61, 0x6e, 0x67, 0x22, 0xc8, 0x04, 0x0a, 0x0e, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x29, 0x0a, 0x05,
+	0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x65,
+	0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x73, 0x61, 0x74, 0x61, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x73, 0x75, 0x62, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x61, 0x74, 0x61, 0x6e, 0x67,
+	0x12, 0x30, 0x0a, 0x14, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x73, 0x61, 0x74, 0x61, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12,
+	0x6c, 0x69, 0x6e, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x61, 0x74, 0x61,
+	0x6e, 0x67, 0x12, 0x32, 0x0a, 0x15, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x64, 0x69, 0x73, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x61, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x13, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x53, 0x61, 0x74, 0x61, 0x6e, 0x67, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x61, 0x6e, 0x67, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x68,
+	0x61, 0x72, 0x67, 0x65, 0x53, 0x61, 0x74, 0x61, 0x6e, 0x67, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x61, 0x6e, 0x67, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x76, 0x61, 0x74, 0x53, 0x61, 0x74, 0x61, 0x6e, 0x67, 0x12,
+	0x1f, 0x0a, 0x0b, 0x76, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x76, 0x61, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x76, 0x61, 0x74, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x76, 0x61, 0x74, 0x49, 0x6e, 0x63, 0x6c,
+	0x75, 0x73, 0x69, 0x76, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x73, 0x61, 0x74, 0x61, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x61, 0x74, 0x61, 0x6e, 0x67, 0x12, 0x21,
+	0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x61, 0x74, 0x61, 0x6e, 0x67, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x61, 0x74, 0x61, 0x6e,
+	0x67, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x6d, 0x6f,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x70, 0x72, 0x6f, 0x6d,
+	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73,
+	0x61, 0x74, 0x61, 0x6e, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x70, 0x72, 0x6f,
+	0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x61,
+	0x74, 0x61, 0x6e, 0x67, 0x22, 0x9b, 0x02, 0x0a, 0x09, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4c, 0x69,
+	0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x66, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2a, 0x0a,
+	0x11, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x61,
+	0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x75, 0x6e, 0x69, 0x74, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x53, 0x61, 0x74, 0x61, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x72, 0x6f,
+	0x73, 0x73, 0x5f, 0x73, 0x61, 0x74, 0x61, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x67, 0x72, 0x6f, 0x73, 0x73, 0x53, 0x61, 0x74, 0x61, 0x6e, 0x67, 0x12, 0x27, 0x0a, 0x0f,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x61, 0x6e, 0x67, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53,
+	0x61, 0x74, 0x61, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x74, 0x5f, 0x73, 0x61, 0x74,
+	0x61, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x53, 0x61,
+	0x74, 0x61, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x73, 0x22, 0x52, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x4d, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x4a, 0x0a, 0x25, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x65, 0x72, 0x44, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x22, 0x94, 0x01, 0x0a, 0x1b, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x44, 0x69,
+	0x65, 0x74, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x76, 0x6f, 0x69, 0x64, 0x5f, 0x61, 0x6c,
+	0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61,
+	0x76, 0x6f, 0x69, 0x64, 0x41, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x73, 0x12, 0x29, 0x0a,
+	0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x64, 0x69, 0x65, 0x74, 0x61, 0x72,
+	0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x44, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x22, 0x35, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22,
+	0x31, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5d,
+	0x0a, 0x19, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0f, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x0e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x3d, 0x0a,
+	0x1c, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x5f, 0x0a, 0x1d,
 	0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x73, 0x42, 0x79, 0x49, 0x44, 0x12, 0x26, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a,
+	0x0e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x0d,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x51, 0x0a,
+	0x15, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65,
+	0x22, 0x42, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x73, 0x76,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x73, 0x76, 0x12, 0x17, 0x0a, 0x07, 0x64,
+	0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72,
+	0x79, 0x52, 0x75, 0x6e, 0x22, 0x79, 0x0a, 0x16, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x72, 0x6f, 0x77,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0xd2, 0x01, 0x0a, 0x16, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72,
+	0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x6f, 0x77,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x6f,
+	0x77, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x22, 0x29, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x70, 0x53,
+	0x68, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22,
+	0x87, 0x02, 0x0a, 0x09, 0x50, 0x72, 0x65, 0x70, 0x53, 0x68, 0x65, 0x65, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x64,
+	0x75, 0x6c, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x68,
+	0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x36, 0x0a, 0x08, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x53, 0x68, 0x65,
+	0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x73, 0x65, 0x74, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74,
+	0x52, 0x08, 0x6d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x53, 0x68, 0x65, 0x65, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x8c, 0x02, 0x0a, 0x10, 0x50, 0x72,
+	0x65, 0x70, 0x53, 0x68, 0x65, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1d,
+	0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x2a, 0x0a,
+	0x11, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x44, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x61, 0x64, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x41, 0x64, 0x75, 0x6c, 0x74, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x43, 0x68, 0x69, 0x6c, 0x64,
+	0x72, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x8b, 0x02, 0x0a, 0x0d, 0x50, 0x72, 0x65,
+	0x70, 0x53, 0x68, 0x65, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x65,
+	0x6e, 0x75, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6d, 0x65, 0x6e, 0x75, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e,
+	0x61, 0x6d, 0x65, 0x54, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72,
+	0x6f, 0x6d, 0x5f, 0x73, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x66,
+	0x72, 0x6f, 0x6d, 0x53, 0x65, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x0a, 0x61, 0x5f, 0x6c, 0x61, 0x5f,
+	0x63, 0x61, 0x72, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x4c, 0x61,
+	0x43, 0x61, 0x72, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x39, 0x0a, 0x09, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x53, 0x68,
+	0x65, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x09, 0x6d, 0x6f, 0x64,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x65, 0x70, 0x53,
+	0x68, 0x65, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0d,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x54, 0x68,
+	0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d,
+	0x65, 0x5f, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65,
+	0x45, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x32, 0xe9,
+	0x07, 0x0a, 0x0e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x5c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
 	0x73, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x73, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f,
-	0x6b, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x42, 0x79, 0x49, 0x44, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0d, 0x55, 0x70, 0x64,
+	0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x68, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x42, 0x79, 0x49, 0x44, 0x12, 0x26, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x42,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x42, 0x79, 0x49,
+	0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0d, 0x43, 0x72, 0x65,
 	0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72,
 	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b,
 	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0d, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0d, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f,
 	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f,
-	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0c, 0x5a,
-	0x0a, 0x2e, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a,
+	0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1e,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4c, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x30, 0x01, 0x12, 0x53, 0x0a,
+	0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x42, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x70, 0x53, 0x68, 0x65,
+	0x65, 0x74, 0x12, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x65, 0x70, 0x53, 0x68, 0x65, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x65,
+	0x70, 0x53, 0x68, 0x65, 0x65, 0x74, 0x12, 0x48, 0x0a, 0x0c, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x42,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e,
+	0x12, 0x78, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x44,
+	0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x2f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x44, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x44, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x6e, 0x0a, 0x1e, 0x53, 0x65,
+	0x74, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x44, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x44, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x1a, 0x25, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x44, 0x69, 0x65, 0x74, 0x61, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0c, 0x5a, 0x0a, 0x2e, 0x2f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1085,46 +2695,86 @@ func file_booking_proto_rawDescGZIP() []byte {
 	return file_booking_proto_rawDescData
 }
 
-var file_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_booking_proto_goTypes = []any{
-	(*BookingDetail)(nil),                 // 0: services.BookingDetail
-	(*BookingMenuItem)(nil),               // 1: services.BookingMenuItem
-	(*BookingMenuSet)(nil),                // 2: services.BookingMenuSet
-	(*BookingTable)(nil),                  // 3: services.BookingTable
-	(*CreateBookingRequest)(nil),          // 4: services.CreateBookingRequest
-	(*CreateBookingResponse)(nil),         // 5: services.CreateBookingResponse
-	(*UpdateBookingResponse)(nil),         // 6: services.UpdateBookingResponse
-	(*DeleteBookingRequest)(nil),          // 7: services.DeleteBookingRequest
-	(*DeleteBookingResponse)(nil),         // 8: services.DeleteBookingResponse
-	(*GetBookingDetailsRequest)(nil),      // 9: services.GetBookingDetailsRequest
-	(*GetBookingDetailsResponse)(nil),     // 10: services.GetBookingDetailsResponse
-	(*GetBookingDetailsByIDRequest)(nil),  // 11: services.GetBookingDetailsByIDRequest
-	(*GetBookingDetailsByIDResponse)(nil), // 12: services.GetBookingDetailsByIDResponse
+	(*BookingDetail)(nil),                         // 0: services.BookingDetail
+	(*BookingMenuItem)(nil),                       // 1: services.BookingMenuItem
+	(*BookingMenuItemModifier)(nil),               // 2: services.BookingMenuItemModifier
+	(*BookingMenuSet)(nil),                        // 3: services.BookingMenuSet
+	(*BookingMenuSetChoice)(nil),                  // 4: services.BookingMenuSetChoice
+	(*BookingTable)(nil),                          // 5: services.BookingTable
+	(*CreateBookingRequest)(nil),                  // 6: services.CreateBookingRequest
+	(*Discount)(nil),                              // 7: services.Discount
+	(*PriceBreakdown)(nil),                        // 8: services.PriceBreakdown
+	(*PriceLine)(nil),                             // 9: services.PriceLine
+	(*CreateBookingResponse)(nil),                 // 10: services.CreateBookingResponse
+	(*UpdateBookingResponse)(nil),                 // 11: services.UpdateBookingResponse
+	(*GetCustomerDietaryRestrictionsRequest)(nil), // 12: services.GetCustomerDietaryRestrictionsRequest
+	(*CustomerDietaryRestrictions)(nil),           // 13: services.CustomerDietaryRestrictions
+	(*DeleteBookingRequest)(nil),                  // 14: services.DeleteBookingRequest
+	(*DeleteBookingResponse)(nil),                 // 15: services.DeleteBookingResponse
+	(*GetBookingDetailsRequest)(nil),              // 16: services.GetBookingDetailsRequest
+	(*GetBookingDetailsResponse)(nil),             // 17: services.GetBookingDetailsResponse
+	(*GetBookingDetailsByIDRequest)(nil),          // 18: services.GetBookingDetailsByIDRequest
+	(*GetBookingDetailsByIDResponse)(nil),         // 19: services.GetBookingDetailsByIDResponse
+	(*ExportBookingsRequest)(nil),                 // 20: services.ExportBookingsRequest
+	(*ImportBookingsRequest)(nil),                 // 21: services.ImportBookingsRequest
+	(*ImportBookingRowResult)(nil),                // 22: services.ImportBookingRowResult
+	(*ImportBookingsResponse)(nil),                // 23: services.ImportBookingsResponse
+	(*GetPrepSheetRequest)(nil),                   // 24: services.GetPrepSheetRequest
+	(*PrepSheet)(nil),                             // 25: services.PrepSheet
+	(*PrepSheetBooking)(nil),                      // 26: services.PrepSheetBooking
+	(*PrepSheetItem)(nil),                         // 27: services.PrepSheetItem
+	(*PrepSheetModifier)(nil),                     // 28: services.PrepSheetModifier
 }
 var file_booking_proto_depIdxs = []int32{
-	3,  // 0: services.BookingDetail.tables:type_name -> services.BookingTable
-	2,  // 1: services.BookingDetail.menu_sets:type_name -> services.BookingMenuSet
+	5,  // 0: services.BookingDetail.tables:type_name -> services.BookingTable
+	3,  // 1: services.BookingDetail.menu_sets:type_name -> services.BookingMenuSet
 	1,  // 2: services.BookingDetail.menu_items:type_name -> services.BookingMenuItem
-	1,  // 3: services.BookingMenuSet.menu_items:type_name -> services.BookingMenuItem
-	2,  // 4: services.CreateBookingRequest.menu_sets:type_name -> services.BookingMenuSet
-	1,  // 5: services.CreateBookingRequest.menu_items:type_name -> services.BookingMenuItem
-	0,  // 6: services.GetBookingDetailsResponse.booking_details:type_name -> services.BookingDetail
-	0,  // 7: services.GetBookingDetailsByIDResponse.booking_detail:type_name -> services.BookingDetail
-	9,  // 8: services.BookingService.GetBookingDetails:input_type -> services.GetBookingDetailsRequest
-	11, // 9: services.BookingService.GetBookingDetailsByID:input_type -> services.GetBookingDetailsByIDRequest
-	4,  // 10: services.BookingService.CreateBooking:input_type -> services.CreateBookingRequest
-	4,  // 11: services.BookingService.UpdateBooking:input_type -> services.CreateBookingRequest
-	7,  // 12: services.BookingService.DeleteBooking:input_type -> services.DeleteBookingRequest
-	10, // 13: services.BookingService.GetBookingDetails:output_type -> services.GetBookingDetailsResponse
-	12, // 14: services.BookingService.GetBookingDetailsByID:output_type -> services.GetBookingDetailsByIDResponse
-	5,  // 15: services.BookingService.CreateBooking:output_type -> services.CreateBookingResponse
-	6,  // 16: services.BookingService.UpdateBooking:output_type -> services.UpdateBookingResponse
-	8,  // 17: services.BookingService.DeleteBooking:output_type -> services.DeleteBookingResponse
-	13, // [13:18] is the sub-list for method output_type
-	8,  // [8:13] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	8,  // 3: services.BookingDetail.pricing:type_name -> services.PriceBreakdown
+	7,  // 4: services.BookingMenuItem.discount:type_name -> services.Discount
+	2,  // 5: services.BookingMenuItem.modifiers:type_name -> services.BookingMenuItemModifier
+	1,  // 6: services.BookingMenuSet.menu_items:type_name -> services.BookingMenuItem
+	7,  // 7: services.BookingMenuSet.discount:type_name -> services.Discount
+	4,  // 8: services.BookingMenuSet.choices:type_name -> services.BookingMenuSetChoice
+	3,  // 9: services.CreateBookingRequest.menu_sets:type_name -> services.BookingMenuSet
+	1,  // 10: services.CreateBookingRequest.menu_items:type_name -> services.BookingMenuItem
+	7,  // 11: services.CreateBookingRequest.order_discount:type_name -> services.Discount
+	9,  // 12: services.PriceBreakdown.lines:type_name -> services.PriceLine
+	0,  // 13: services.GetBookingDetailsResponse.booking_details:type_name -> services.BookingDetail
+	0,  // 14: services.GetBookingDetailsByIDResponse.booking_detail:type_name -> services.BookingDetail
+	22, // 15: services.ImportBookingsResponse.results:type_name -> services.ImportBookingRowResult
+	26, // 16: services.PrepSheet.bookings:type_name -> services.PrepSheetBooking
+	3,  // 17: services.PrepSheet.menu_sets:type_name -> services.BookingMenuSet
+	27, // 18: services.PrepSheet.items:type_name -> services.PrepSheetItem
+	28, // 19: services.PrepSheetItem.modifiers:type_name -> services.PrepSheetModifier
+	16, // 20: services.BookingService.GetBookingDetails:input_type -> services.GetBookingDetailsRequest
+	18, // 21: services.BookingService.GetBookingDetailsByID:input_type -> services.GetBookingDetailsByIDRequest
+	6,  // 22: services.BookingService.CreateBooking:input_type -> services.CreateBookingRequest
+	6,  // 23: services.BookingService.UpdateBooking:input_type -> services.CreateBookingRequest
+	14, // 24: services.BookingService.DeleteBooking:input_type -> services.DeleteBookingRequest
+	20, // 25: services.BookingService.ExportBookings:input_type -> services.ExportBookingsRequest
+	21, // 26: services.BookingService.ImportBookings:input_type -> services.ImportBookingsRequest
+	24, // 27: services.BookingService.GetPrepSheet:input_type -> services.GetPrepSheetRequest
+	6,  // 28: services.BookingService.QuoteBooking:input_type -> services.CreateBookingRequest
+	12, // 29: services.BookingService.GetCustomerDietaryRestrictions:input_type -> services.GetCustomerDietaryRestrictionsRequest
+	13, // 30: services.BookingService.SetCustomerDietaryRestrictions:input_type -> services.CustomerDietaryRestrictions
+	17, // 31: services.BookingService.GetBookingDetails:output_type -> services.GetBookingDetailsResponse
+	19, // 32: services.BookingService.GetBookingDetailsByID:output_type -> services.GetBookingDetailsByIDResponse
+	10, // 33: services.BookingService.CreateBooking:output_type -> services.CreateBookingResponse
+	11, // 34: services.BookingService.UpdateBooking:output_type -> services.UpdateBookingResponse
+	15, // 35: services.BookingService.DeleteBooking:output_type -> services.DeleteBookingResponse
+	0,  // 36: services.BookingService.ExportBookings:output_type -> services.BookingDetail
+	23, // 37: services.BookingService.ImportBookings:output_type -> services.ImportBookingsResponse
+	25, // 38: services.BookingService.GetPrepSheet:output_type -> services.PrepSheet
+	8,  // 39: services.BookingService.QuoteBooking:output_type -> services.PriceBreakdown
+	13, // 40: services.BookingService.GetCustomerDietaryRestrictions:output_type -> services.CustomerDietaryRestrictions
+	13, // 41: services.BookingService.SetCustomerDietaryRestrictions:output_type -> services.CustomerDietaryRestrictions
+	31, // [31:42] is the sub-list for method output_type
+	20, // [20:31] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_booking_proto_init() }
@@ -1138,7 +2788,7 @@ func file_booking_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_booking_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
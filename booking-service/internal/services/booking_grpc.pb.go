@@ -19,11 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BookingService_GetBookingDetails_FullMethodName     = "/services.BookingService/GetBookingDetails"
-	BookingService_GetBookingDetailsByID_FullMethodName = "/services.BookingService/GetBookingDetailsByID"
-	BookingService_CreateBooking_FullMethodName         = "/services.BookingService/CreateBooking"
-	BookingService_UpdateBooking_FullMethodName         = "/services.BookingService/UpdateBooking"
-	BookingService_DeleteBooking_FullMethodName         = "/services.BookingService/DeleteBooking"
+	BookingService_GetBookingDetails_FullMethodName              = "/services.BookingService/GetBookingDetails"
+	BookingService_GetBookingDetailsByID_FullMethodName          = "/services.BookingService/GetBookingDetailsByID"
+	BookingService_CreateBooking_FullMethodName                  = "/services.BookingService/CreateBooking"
+	BookingService_UpdateBooking_FullMethodName                  = "/services.BookingService/UpdateBooking"
+	BookingService_DeleteBooking_FullMethodName                  = "/services.BookingService/DeleteBooking"
+	BookingService_ExportBookings_FullMethodName                 = "/services.BookingService/ExportBookings"
+	BookingService_ImportBookings_FullMethodName                 = "/services.BookingService/ImportBookings"
+	BookingService_GetPrepSheet_FullMethodName                   = "/services.BookingService/GetPrepSheet"
+	BookingService_QuoteBooking_FullMethodName                   = "/services.BookingService/QuoteBooking"
+	BookingService_GetCustomerDietaryRestrictions_FullMethodName = "/services.BookingService/GetCustomerDietaryRestrictions"
+	BookingService_SetCustomerDietaryRestrictions_FullMethodName = "/services.BookingService/SetCustomerDietaryRestrictions"
 )
 
 // BookingServiceClient is the client API for BookingService service.
@@ -37,6 +43,17 @@ type BookingServiceClient interface {
 	CreateBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*CreateBookingResponse, error)
 	UpdateBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*UpdateBookingResponse, error)
 	DeleteBooking(ctx context.Context, in *DeleteBookingRequest, opts ...grpc.CallOption) (*DeleteBookingResponse, error)
+	// Export การจองตามช่วงวันที่ (stream ทีละรายการ)
+	ExportBookings(ctx context.Context, in *ExportBookingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BookingDetail], error)
+	// นำเข้าการจองจากไฟล์ CSV (dry_run = ตรวจสอบอย่างเดียว ไม่บันทึก)
+	ImportBookings(ctx context.Context, in *ImportBookingsRequest, opts ...grpc.CallOption) (*ImportBookingsResponse, error)
+	// ใบเตรียมอาหารของครัวประจำวัน (รวมทุกการจองที่ยืนยันแล้ว)
+	GetPrepSheet(ctx context.Context, in *GetPrepSheetRequest, opts ...grpc.CallOption) (*PrepSheet, error)
+	// คำนวณยอดเงินของการจองจากราคาเมนูปัจจุบันโดยไม่บันทึก
+	QuoteBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*PriceBreakdown, error)
+	// ข้อจำกัดด้านอาหารของลูกค้า (ระบุด้วยเบอร์โทร) ใช้เตือนตอนสร้าง/แก้ไขการจอง
+	GetCustomerDietaryRestrictions(ctx context.Context, in *GetCustomerDietaryRestrictionsRequest, opts ...grpc.CallOption) (*CustomerDietaryRestrictions, error)
+	SetCustomerDietaryRestrictions(ctx context.Context, in *CustomerDietaryRestrictions, opts ...grpc.CallOption) (*CustomerDietaryRestrictions, error)
 }
 
 type bookingServiceClient struct {
@@ -97,6 +114,75 @@ func (c *bookingServiceClient) DeleteBooking(ctx context.Context, in *DeleteBook
 	return out, nil
 }
 
+func (c *bookingServiceClient) ExportBookings(ctx context.Context, in *ExportBookingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BookingDetail], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &BookingService_ServiceDesc.Streams[0], BookingService_ExportBookings_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportBookingsRequest, BookingDetail]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BookingService_ExportBookingsClient = grpc.ServerStreamingClient[BookingDetail]
+
+func (c *bookingServiceClient) ImportBookings(ctx context.Context, in *ImportBookingsRequest, opts ...grpc.CallOption) (*ImportBookingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportBookingsResponse)
+	err := c.cc.Invoke(ctx, BookingService_ImportBookings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetPrepSheet(ctx context.Context, in *GetPrepSheetRequest, opts ...grpc.CallOption) (*PrepSheet, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrepSheet)
+	err := c.cc.Invoke(ctx, BookingService_GetPrepSheet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) QuoteBooking(ctx context.Context, in *CreateBookingRequest, opts ...grpc.CallOption) (*PriceBreakdown, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceBreakdown)
+	err := c.cc.Invoke(ctx, BookingService_QuoteBooking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetCustomerDietaryRestrictions(ctx context.Context, in *GetCustomerDietaryRestrictionsRequest, opts ...grpc.CallOption) (*CustomerDietaryRestrictions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CustomerDietaryRestrictions)
+	err := c.cc.Invoke(ctx, BookingService_GetCustomerDietaryRestrictions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) SetCustomerDietaryRestrictions(ctx context.Context, in *CustomerDietaryRestrictions, opts ...grpc.CallOption) (*CustomerDietaryRestrictions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CustomerDietaryRestrictions)
+	err := c.cc.Invoke(ctx, BookingService_SetCustomerDietaryRestrictions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BookingServiceServer is the server API for BookingService service.
 // All implementations must embed UnimplementedBookingServiceServer
 // for forward compatibility.
@@ -108,6 +194,17 @@ type BookingServiceServer interface {
 	CreateBooking(context.Context, *CreateBookingRequest) (*CreateBookingResponse, error)
 	UpdateBooking(context.Context, *CreateBookingRequest) (*UpdateBookingResponse, error)
 	DeleteBooking(context.Context, *DeleteBookingRequest) (*DeleteBookingResponse, error)
+	// Export การจองตามช่วงวันที่ (stream ทีละรายการ)
+	ExportBookings(*ExportBookingsRequest, grpc.ServerStreamingServer[BookingDetail]) error
+	// นำเข้าการจองจากไฟล์ CSV (dry_run = ตรวจสอบอย่างเดียว ไม่บันทึก)
+	ImportBookings(context.Context, *ImportBookingsRequest) (*ImportBookingsResponse, error)
+	// ใบเตรียมอาหารของครัวประจำวัน (รวมทุกการจองที่ยืนยันแล้ว)
+	GetPrepSheet(context.Context, *GetPrepSheetRequest) (*PrepSheet, error)
+	// คำนวณยอดเงินของการจองจากราคาเมนูปัจจุบันโดยไม่บันทึก
+	QuoteBooking(context.Context, *CreateBookingRequest) (*PriceBreakdown, error)
+	// ข้อจำกัดด้านอาหารของลูกค้า (ระบุด้วยเบอร์โทร) ใช้เตือนตอนสร้าง/แก้ไขการจอง
+	GetCustomerDietaryRestrictions(context.Context, *GetCustomerDietaryRestrictionsRequest) (*CustomerDietaryRestrictions, error)
+	SetCustomerDietaryRestrictions(context.Context, *CustomerDietaryRestrictions) (*CustomerDietaryRestrictions, error)
 	mustEmbedUnimplementedBookingServiceServer()
 }
 
@@ -133,6 +230,24 @@ func (UnimplementedBookingServiceServer) UpdateBooking(context.Context, *CreateB
 func (UnimplementedBookingServiceServer) DeleteBooking(context.Context, *DeleteBookingRequest) (*DeleteBookingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteBooking not implemented")
 }
+func (UnimplementedBookingServiceServer) ExportBookings(*ExportBookingsRequest, grpc.ServerStreamingServer[BookingDetail]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportBookings not implemented")
+}
+func (UnimplementedBookingServiceServer) ImportBookings(context.Context, *ImportBookingsRequest) (*ImportBookingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportBookings not implemented")
+}
+func (UnimplementedBookingServiceServer) GetPrepSheet(context.Context, *GetPrepSheetRequest) (*PrepSheet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrepSheet not implemented")
+}
+func (UnimplementedBookingServiceServer) QuoteBooking(context.Context, *CreateBookingRequest) (*PriceBreakdown, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) GetCustomerDietaryRestrictions(context.Context, *GetCustomerDietaryRestrictionsRequest) (*CustomerDietaryRestrictions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCustomerDietaryRestrictions not implemented")
+}
+func (UnimplementedBookingServiceServer) SetCustomerDietaryRestrictions(context.Context, *CustomerDietaryRestrictions) (*CustomerDietaryRestrictions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCustomerDietaryRestrictions not implemented")
+}
 func (UnimplementedBookingServiceServer) mustEmbedUnimplementedBookingServiceServer() {}
 func (UnimplementedBookingServiceServer) testEmbeddedByValue()                        {}
 
@@ -244,6 +359,107 @@ func _BookingService_DeleteBooking_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookingService_ExportBookings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportBookingsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BookingServiceServer).ExportBookings(m, &grpc.GenericServerStream[ExportBookingsRequest, BookingDetail]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type BookingService_ExportBookingsServer = grpc.ServerStreamingServer[BookingDetail]
+
+func _BookingService_ImportBookings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportBookingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ImportBookings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_ImportBookings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ImportBookings(ctx, req.(*ImportBookingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetPrepSheet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrepSheetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetPrepSheet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetPrepSheet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetPrepSheet(ctx, req.(*GetPrepSheetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_QuoteBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).QuoteBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_QuoteBooking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).QuoteBooking(ctx, req.(*CreateBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetCustomerDietaryRestrictions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCustomerDietaryRestrictionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetCustomerDietaryRestrictions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_GetCustomerDietaryRestrictions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetCustomerDietaryRestrictions(ctx, req.(*GetCustomerDietaryRestrictionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_SetCustomerDietaryRestrictions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CustomerDietaryRestrictions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).SetCustomerDietaryRestrictions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BookingService_SetCustomerDietaryRestrictions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).SetCustomerDietaryRestrictions(ctx, req.(*CustomerDietaryRestrictions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BookingService_ServiceDesc is the grpc.ServiceDesc for BookingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -271,7 +487,33 @@ var BookingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteBooking",
 			Handler:    _BookingService_DeleteBooking_Handler,
 		},
+		{
+			MethodName: "ImportBookings",
+			Handler:    _BookingService_ImportBookings_Handler,
+		},
+		{
+			MethodName: "GetPrepSheet",
+			Handler:    _BookingService_GetPrepSheet_Handler,
+		},
+		{
+			MethodName: "QuoteBooking",
+			Handler:    _BookingService_QuoteBooking_Handler,
+		},
+		{
+			MethodName: "GetCustomerDietaryRestrictions",
+			Handler:    _BookingService_GetCustomerDietaryRestrictions_Handler,
+		},
+		{
+			MethodName: "SetCustomerDietaryRestrictions",
+			Handler:    _BookingService_SetCustomerDietaryRestrictions_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportBookings",
+			Handler:       _BookingService_ExportBookings_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "booking.proto",
 }
@@ -0,0 +1,264 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"gitlab.com/final_project1240930/booking_service/internal/repository"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// จำนวนแถวสูงสุดที่นำเข้าได้ต่อหนึ่งไฟล์
+const maxImportRows = 5000
+
+// คอลัมน์ที่ต้องมีในไฟล์ import
+var requiredImportColumns = []string{"customer_name", "phone_number", "booking_date_time", "num_adults"}
+
+// "Set A x2" หรือ "Set A ×2" หรือ "Set A * 2"
+var menuSetQuantityPattern = regexp.MustCompile(`^(.*?)\s*[xX×*]\s*(\d+)$`)
+
+func (s *bookingServer) ImportBookings(ctx context.Context, req *ImportBookingsRequest) (*ImportBookingsResponse, error) {
+	records, columns, err := readImportCSV(req.Csv)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp := &ImportBookingsResponse{DryRun: req.DryRun, TotalRows: int32(len(records))}
+
+	// ตรวจสอบรูปแบบแต่ละแถวก่อน แถวที่ผ่านจะถูกส่งไปบันทึกใน repository
+	var rows []repository.ImportBookingRow
+	rowErrors := make(map[int]string)
+	for _, record := range records {
+		row, err := parseImportRow(record.line, record.fields, columns)
+		if err != nil {
+			rowErrors[record.line] = err.Error()
+			continue
+		}
+		rows = append(rows, row)
+	}
+
+	saved := make(map[int]repository.ImportBookingResult, len(rows))
+	if len(rows) > 0 {
+		results, err := s.bookingRepo.ImportBookings(ctx, rows, req.DryRun)
+		if err != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("could not import bookings: %v", err))
+		}
+		for _, result := range results {
+			saved[result.Row] = result
+		}
+	}
+
+	// รวมผลลัพธ์ตามลำดับบรรทัดในไฟล์
+	for _, record := range records {
+		result := &ImportBookingRowResult{Row: int32(record.line)}
+		if msg, ok := rowErrors[record.line]; ok {
+			result.Error = msg
+		} else if r := saved[record.line]; r.Error != "" {
+			result.Error = r.Error
+		} else {
+			result.Success = true
+			if !req.DryRun {
+				result.BookingId = r.BookingID
+			}
+		}
+
+		if result.Success {
+			resp.SuccessCount++
+		} else {
+			resp.ErrorCount++
+		}
+		resp.Results = append(resp.Results, result)
+	}
+
+	return resp, nil
+}
+
+type importRecord struct {
+	line   int
+	fields []string
+}
+
+// readImportCSV อ่านไฟล์ CSV และคืนค่าแถวข้อมูลพร้อมตำแหน่งคอลัมน์จากแถวหัวคอลัมน์
+func readImportCSV(data []byte) ([]importRecord, map[string]int, error) {
+	// ไฟล์ที่ save จาก Excel มักมี UTF-8 BOM นำหน้า
+	data = bytes.TrimPrefix(data, []byte("\ufeff"))
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil, errors.New("csv file is empty")
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid csv header: %v", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range requiredImportColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, nil, fmt.Errorf("missing required column: %s", name)
+		}
+	}
+
+	var records []importRecord
+	for {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid csv: %v", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		if isBlankRecord(fields) {
+			continue
+		}
+		if len(records) == maxImportRows {
+			return nil, nil, fmt.Errorf("csv file has more than %d rows", maxImportRows)
+		}
+		records = append(records, importRecord{line: line, fields: fields})
+	}
+
+	return records, columns, nil
+}
+
+func isBlankRecord(fields []string) bool {
+	for _, field := range fields {
+		if strings.TrimSpace(field) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// parseImportRow แปลงหนึ่งแถวของ CSV และตรวจสอบด้วยกฎเดียวกับ CreateBooking
+func parseImportRow(line int, fields []string, columns map[string]int) (repository.ImportBookingRow, error) {
+	get := func(name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(fields) {
+			return ""
+		}
+		return strings.TrimSpace(fields[i])
+	}
+
+	numAdults, err := parseImportInt(get("num_adults"), "num_adults")
+	if err != nil {
+		return repository.ImportBookingRow{}, err
+	}
+	numChildren, err := parseImportInt(get("num_children"), "num_children")
+	if err != nil {
+		return repository.ImportBookingRow{}, err
+	}
+
+	req := &CreateBookingRequest{
+		CustomerName:    get("customer_name"),
+		CompanyName:     get("company_name"),
+		PhoneNumber:     get("phone_number"),
+		BookingDateTime: normalizeImportDateTime(get("booking_date_time")),
+		NumAdults:       numAdults,
+		NumChildren:     numChildren,
+	}
+
+	bookingDateTime, err := validateImportBookingRequest(req)
+	if err != nil {
+		return repository.ImportBookingRow{}, errors.New(status.Convert(err).Message())
+	}
+
+	menuSets, err := parseImportMenuSets(get("menu_sets"))
+	if err != nil {
+		return repository.ImportBookingRow{}, err
+	}
+
+	return repository.ImportBookingRow{
+		Row:          line,
+		Booking:      *ConvertCreateBookingRequestToRepositoryRequest(req, bookingDateTime),
+		TableNumbers: splitImportList(get("table_numbers"), ",;"),
+		MenuSets:     menuSets,
+	}, nil
+}
+
+// validateImportBookingRequest ไฟล์ import ไม่ผ่านหน้าจอที่บังคับกรอกข้อมูล จึงตรวจข้อมูลลูกค้าและจำนวนคนเพิ่ม
+// ก่อนตรวจตามกฎเดียวกับ CreateBooking
+func validateImportBookingRequest(req *CreateBookingRequest) (time.Time, error) {
+	if strings.TrimSpace(req.CustomerName) == "" {
+		return time.Time{}, status.Error(codes.InvalidArgument, "customer_name is required")
+	}
+	if strings.TrimSpace(req.PhoneNumber) == "" {
+		return time.Time{}, status.Error(codes.InvalidArgument, "phone_number is required")
+	}
+	if req.NumAdults <= 0 {
+		return time.Time{}, status.Error(codes.InvalidArgument, "num_adults must be at least 1")
+	}
+	if req.NumChildren < 0 {
+		return time.Time{}, status.Error(codes.InvalidArgument, "num_children must not be negative")
+	}
+	return validateCreateBookingRequest(req)
+}
+
+func parseImportInt(value, name string) (int32, error) {
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %q", name, value)
+	}
+	return int32(n), nil
+}
+
+// normalizeImportDateTime แปลงรูปแบบ "2006-01-02 15:04" (เวลากรุงเทพ) เป็น RFC3339
+// ค่าอื่นจะส่งต่อไปให้ validateCreateBookingRequest ตรวจสอบตามเดิม
+func normalizeImportDateTime(value string) string {
+	bangkok, err := time.LoadLocation("Asia/Bangkok")
+	if err != nil {
+		return value
+	}
+	for _, layout := range []string{"2006-01-02 15:04", "2006-01-02 15:04:05"} {
+		if t, err := time.ParseInLocation(layout, value, bangkok); err == nil {
+			return t.Format(time.RFC3339)
+		}
+	}
+	return value
+}
+
+func splitImportList(value, separators string) []string {
+	var items []string
+	for _, item := range strings.FieldsFunc(value, func(r rune) bool { return strings.ContainsRune(separators, r) }) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func parseImportMenuSets(value string) ([]repository.ImportBookingMenuSet, error) {
+	var menuSets []repository.ImportBookingMenuSet
+	for _, item := range splitImportList(value, ";") {
+		menuSet := repository.ImportBookingMenuSet{Name: item, Quantity: 1}
+		if m := menuSetQuantityPattern.FindStringSubmatch(item); m != nil {
+			quantity, err := strconv.Atoi(m[2])
+			if err != nil || quantity <= 0 {
+				return nil, fmt.Errorf("invalid menu set quantity: %q", item)
+			}
+			menuSet.Name = m[1]
+			menuSet.Quantity = int32(quantity)
+		}
+		menuSets = append(menuSets, menuSet)
+	}
+	return menuSets, nil
+}
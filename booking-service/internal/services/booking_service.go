@@ -2,11 +2,18 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"gitlab.com/final_project1240930/booking_service/internal/logs"
+	"gitlab.com/final_project1240930/booking_service/internal/pricing"
 	"gitlab.com/final_project1240930/booking_service/internal/repository"
+	"go.uber.org/zap"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 )
@@ -43,6 +50,7 @@ func convertToProto(bookings []repository.Booking) []*BookingDetail {
 			Tables:    convertTablesToProto(booking.Tables),
 			MenuSets:  convertMenuSetsToProto(booking.BookingMenuSets),
 			MenuItems: convertMenuItemsToProto(booking.BookingMenuItems),
+			Pricing:   convertPricingToProto(booking),
 		}
 
 		// เพิ่ม BookingDetail ลงใน slice
@@ -51,6 +59,110 @@ func convertToProto(bookings []repository.Booking) []*BookingDetail {
 
 	return protoBookings
 }
+
+// convertPricingToProto สร้างรายละเอียดยอดเงินจากราคาที่บันทึกไว้ตอนจอง
+func convertPricingToProto(booking repository.Booking) *PriceBreakdown {
+	p := booking.Pricing
+	breakdown := &PriceBreakdown{
+		SubtotalSatang:          p.SubtotalSatang,
+		LineDiscountSatang:      p.LineDiscountSatang,
+		OrderDiscountSatang:     p.OrderDiscountSatang,
+		PromotionCode:           p.PromotionCode,
+		PromotionDiscountSatang: p.PromotionDiscountSatang,
+		ServiceChargeSatang:     p.ServiceChargeSatang,
+		ServiceChargePercent:    p.ServiceChargePercent,
+		VatSatang:               p.VATSatang,
+		VatPercent:              p.VATPercent,
+		VatInclusive:            p.VATInclusive,
+		RoundingSatang:          p.RoundingSatang,
+		TotalSatang:             p.TotalSatang,
+	}
+
+	addLine := func(kind, refID, name string, quantity int32, unitPrice, discount int64) *PriceLine {
+		gross := unitPrice * int64(quantity)
+		line := &PriceLine{
+			Kind:            kind,
+			RefId:           refID,
+			Name:            name,
+			Quantity:        quantity,
+			UnitPriceSatang: unitPrice,
+			GrossSatang:     gross,
+			DiscountSatang:  discount,
+			NetSatang:       gross - discount,
+		}
+		breakdown.Lines = append(breakdown.Lines, line)
+		return line
+	}
+	for _, menuSet := range booking.BookingMenuSets {
+		line := addLine(pricing.KindMenuSet, menuSet.MenuSetID, menuSet.MenuSetName, menuSet.Quantity, menuSet.UnitPriceSatang, menuSet.DiscountSatang)
+		for _, choice := range menuSet.Choices {
+			line.Modifiers = append(line.Modifiers, quantityName(choice.NameTh, choice.Quantity))
+		}
+	}
+	for _, item := range booking.BookingMenuItems {
+		line := addLine(pricing.KindMenuItem, item.MenuItemID, item.NameTh, item.Quantity, item.UnitPriceSatang, item.DiscountSatang)
+		for _, modifier := range item.Modifiers {
+			line.Modifiers = append(line.Modifiers, modifier.NameTh)
+		}
+	}
+
+	return breakdown
+}
+
+func convertBreakdownToProto(b pricing.Breakdown) *PriceBreakdown {
+	breakdown := &PriceBreakdown{
+		SubtotalSatang:          b.Subtotal,
+		LineDiscountSatang:      b.LineDiscount,
+		OrderDiscountSatang:     b.OrderDiscount,
+		PromotionCode:           b.PromotionCode,
+		PromotionDiscountSatang: b.PromotionDiscount,
+		ServiceChargeSatang:     b.ServiceCharge,
+		ServiceChargePercent:    b.ServiceChargePercent,
+		VatSatang:               b.VAT,
+		VatPercent:              b.VATPercent,
+		VatInclusive:            b.VATInclusive,
+		RoundingSatang:          b.Rounding,
+		TotalSatang:             b.Total,
+	}
+	for _, line := range b.Lines {
+		breakdown.Lines = append(breakdown.Lines, &PriceLine{
+			Kind:            line.Kind,
+			RefId:           line.RefID,
+			Name:            line.Name,
+			Quantity:        line.Quantity,
+			UnitPriceSatang: line.UnitPrice,
+			GrossSatang:     line.Gross,
+			DiscountSatang:  line.DiscountAmount,
+			NetSatang:       line.Net,
+			Modifiers:       modifierNames(line.Modifiers),
+		})
+	}
+	return breakdown
+}
+
+func modifierNames(modifiers []pricing.Modifier) []string {
+	var names []string
+	for _, modifier := range modifiers {
+		names = append(names, quantityName(modifier.Name, modifier.Quantity))
+	}
+	return names
+}
+
+// quantityName ต่อท้ายจำนวนเมื่อเลือกเมนูเดียวกันมากกว่าหนึ่งจาน เช่น "ต้มยำกุ้ง x2"
+func quantityName(name string, quantity int32) string {
+	if quantity > 1 {
+		return fmt.Sprintf("%s x%d", name, quantity)
+	}
+	return name
+}
+
+func convertDiscount(discount *Discount) pricing.Discount {
+	if discount == nil {
+		return pricing.Discount{}
+	}
+	return pricing.Discount{Percent: discount.Percent, Amount: discount.AmountSatang}
+}
+
 func convertTablesToProto(tables []repository.BookingTable) []*BookingTable {
 	var protoTables []*BookingTable
 	for _, table := range tables {
@@ -74,6 +186,18 @@ func convertMenuSetsToProto(menuSets []repository.BookingMenuSet) []*BookingMenu
 			Quantity:     menuSet.Quantity,
 			MenuItems:    convertMenuItemsToProto(menuSet.MenuItems),
 		}
+		for _, choice := range menuSet.Choices {
+			protoMenuSet.Choices = append(protoMenuSet.Choices, &BookingMenuSetChoice{
+				SlotId:         choice.SlotID,
+				MenuItemId:     choice.MenuItemID,
+				Quantity:       choice.Quantity,
+				SlotNameTh:     choice.SlotNameTh,
+				SlotNameEn:     choice.SlotNameEn,
+				NameTh:         choice.NameTh,
+				NameEn:         choice.NameEn,
+				UpchargeSatang: choice.UpchargeSatang,
+			})
+		}
 		protoMenuSets = append(protoMenuSets, protoMenuSet)
 	}
 	return protoMenuSets
@@ -92,6 +216,16 @@ func convertMenuItemsToProto(menuItems []repository.BookingMenuItem) []*BookingM
 			ImageUrl:    menuItem.ImageURL,
 			Quantity:    menuItem.Quantity,
 		}
+		for _, modifier := range menuItem.Modifiers {
+			protoMenuItem.Modifiers = append(protoMenuItem.Modifiers, &BookingMenuItemModifier{
+				ModifierOptionId: modifier.ModifierOptionID,
+				GroupNameTh:      modifier.GroupNameTh,
+				GroupNameEn:      modifier.GroupNameEn,
+				NameTh:           modifier.NameTh,
+				NameEn:           modifier.NameEn,
+				PriceDeltaSatang: modifier.PriceDeltaSatang,
+			})
+		}
 		protoMenuItems = append(protoMenuItems, protoMenuItem)
 	}
 	return protoMenuItems
@@ -147,14 +281,24 @@ func ConvertCreateBookingRequestToRepositoryRequest(req *CreateBookingRequest, b
 		menuSets[i] = repository.CreateBookingMenuSet{
 			MenuSetID: menuSet.MenuSetId,
 			Quantity:  menuSet.Quantity,
+			Discount:  convertDiscount(menuSet.Discount),
+		}
+		for _, choice := range menuSet.Choices {
+			menuSets[i].Choices = append(menuSets[i].Choices, repository.CreateBookingMenuSetChoice{
+				SlotID:     choice.SlotId,
+				MenuItemID: choice.MenuItemId,
+				Quantity:   choice.Quantity,
+			})
 		}
 	}
 
 	menuItems := make([]repository.CreateBookingMenuItem, len(req.MenuItems))
 	for i, menuItem := range req.MenuItems {
 		menuItems[i] = repository.CreateBookingMenuItem{
-			MenuItemID: menuItem.MenuItemId,
-			Quantity:   menuItem.Quantity,
+			MenuItemID:        menuItem.MenuItemId,
+			Quantity:          menuItem.Quantity,
+			Discount:          convertDiscount(menuItem.Discount),
+			ModifierOptionIDs: menuItem.ModifierOptionIds,
 		}
 	}
 
@@ -172,23 +316,90 @@ func ConvertCreateBookingRequestToRepositoryRequest(req *CreateBookingRequest, b
 		MenuItems:       menuItems,
 		Status:          req.Status,
 		TotalPrice:      req.TotalPrice,
+		OrderDiscount:   convertDiscount(req.OrderDiscount),
+		PromotionCode:   strings.TrimSpace(req.PromotionCode),
+		ZoneID:          req.ZoneId,
+		CombinationID:   req.CombinationId,
 	}
 }
 
-func (s *bookingServer) CreateBooking(ctx context.Context, req *CreateBookingRequest) (*CreateBookingResponse, error) {
+// bookingErrorCode ใช้ FailedPrecondition เมื่อโค้ดโปรโมชั่นใช้กับการจองนี้ไม่ได้
+// หรือเมนูที่สั่งปิดขาย/เหลือไม่พอ/ไม่อยู่ในช่วงเวลาที่สั่งได้/เลือกตัวเลือกหรือเมนูในเซ็ตไม่ถูกต้อง
+// หรือโต๊ะที่เลือกไม่อยู่ในโซนที่ลูกค้าต้องการ/ไม่ว่าง/ถูก block/ชุดโต๊ะต่อไม่ถูกต้อง นอกนั้นเป็น Internal
+func bookingErrorCode(err error) codes.Code {
+	var promotionErr *pricing.PromotionError
+	if errors.As(err, &promotionErr) {
+		return codes.FailedPrecondition
+	}
+	var stockErr *repository.MenuStockError
+	if errors.As(err, &stockErr) {
+		return codes.FailedPrecondition
+	}
+	var scheduleErr *repository.MenuScheduleError
+	if errors.As(err, &scheduleErr) {
+		return codes.FailedPrecondition
+	}
+	var modifierErr *repository.MenuModifierError
+	if errors.As(err, &modifierErr) {
+		return codes.FailedPrecondition
+	}
+	var choiceErr *repository.MenuSetChoiceError
+	if errors.As(err, &choiceErr) {
+		return codes.FailedPrecondition
+	}
+	var archivedErr *repository.MenuSetArchivedError
+	if errors.As(err, &archivedErr) {
+		return codes.FailedPrecondition
+	}
+	var zoneErr *repository.TableZoneError
+	if errors.As(err, &zoneErr) {
+		return codes.FailedPrecondition
+	}
+	var tableErr *repository.TableUnavailableError
+	if errors.As(err, &tableErr) {
+		return codes.FailedPrecondition
+	}
+	var blockedErr *repository.TableBlockedError
+	if errors.As(err, &blockedErr) {
+		return codes.FailedPrecondition
+	}
+	return codes.Internal
+}
+
+// validateCreateBookingRequest ตรวจสอบข้อมูลการจองใหม่ และคืนค่าเวลาจองในเขตเวลากรุงเทพ
+// ใช้ร่วมกันระหว่าง CreateBooking และ ImportBookings (การ import ตรวจเพิ่มใน validateImportBookingRequest)
+func validateCreateBookingRequest(req *CreateBookingRequest) (time.Time, error) {
+	if req.ZoneId != "" {
+		if _, err := uuid.Parse(req.ZoneId); err != nil {
+			return time.Time{}, status.Error(codes.InvalidArgument, "invalid zone_id format")
+		}
+	}
+	if req.CombinationId != "" {
+		if _, err := uuid.Parse(req.CombinationId); err != nil {
+			return time.Time{}, status.Error(codes.InvalidArgument, "invalid combination_id format")
+		}
+	}
+
 	// Load Bangkok timezone
 	bangkok, err := time.LoadLocation("Asia/Bangkok")
 	if err != nil {
-		return nil, status.Error(codes.Internal, fmt.Sprintf("could not load Bangkok timezone: %v", err))
+		return time.Time{}, status.Error(codes.Internal, fmt.Sprintf("could not load Bangkok timezone: %v", err))
 	}
 
 	bookingDateTime, err := time.Parse(time.RFC3339, req.BookingDateTime)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid booking_date_time format: %v", err))
+		return time.Time{}, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid booking_date_time format: %v", err))
 	}
 
 	// Convert to Bangkok timezone
-	bookingDateTimeInBangkok := bookingDateTime.In(bangkok)
+	return bookingDateTime.In(bangkok), nil
+}
+
+func (s *bookingServer) CreateBooking(ctx context.Context, req *CreateBookingRequest) (*CreateBookingResponse, error) {
+	bookingDateTimeInBangkok, err := validateCreateBookingRequest(req)
+	if err != nil {
+		return nil, err
+	}
 
 	if req.BookingId == "" {
 		req.BookingId = uuid.New().String()
@@ -198,10 +409,31 @@ func (s *bookingServer) CreateBooking(ctx context.Context, req *CreateBookingReq
 
 	err = s.bookingRepo.CreateBooking(ctx, repositoryReq)
 	if err != nil {
-		return nil, status.Error(codes.Internal, fmt.Sprintf("could not create booking: %v", err))
+		return nil, status.Error(bookingErrorCode(err), fmt.Sprintf("could not create booking: %v", err))
+	}
+
+	return &CreateBookingResponse{BookingId: req.BookingId, Warnings: s.dietaryWarnings(ctx, repositoryReq)}, nil
+}
+
+func (s *bookingServer) QuoteBooking(ctx context.Context, req *CreateBookingRequest) (*PriceBreakdown, error) {
+	// เงื่อนไขโปรโมชั่นดูจากวันเวลาที่จอง ถ้าไม่ระบุใช้เวลาปัจจุบัน
+	bookingDateTime := time.Now()
+	if req.BookingDateTime != "" {
+		parsed, err := time.Parse(time.RFC3339, req.BookingDateTime)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid booking_date_time format: %v", err))
+		}
+		bookingDateTime = parsed
+	}
+
+	repositoryReq := ConvertCreateBookingRequestToRepositoryRequest(req, bookingDateTime)
+
+	breakdown, err := s.bookingRepo.QuoteBooking(ctx, repositoryReq)
+	if err != nil {
+		return nil, status.Error(bookingErrorCode(err), fmt.Sprintf("could not quote booking: %v", err))
 	}
 
-	return &CreateBookingResponse{BookingId: req.BookingId}, nil
+	return convertBreakdownToProto(breakdown), nil
 }
 
 func (s *bookingServer) UpdateBooking(ctx context.Context, req *CreateBookingRequest) (*UpdateBookingResponse, error) {
@@ -226,10 +458,82 @@ func (s *bookingServer) UpdateBooking(ctx context.Context, req *CreateBookingReq
 
 	err = s.bookingRepo.UpdateBooking(ctx, req.BookingId, repositoryReq)
 	if err != nil {
-		return nil, status.Error(codes.Internal, fmt.Sprintf("could not update booking: %v", err))
+		return nil, status.Error(bookingErrorCode(err), fmt.Sprintf("could not update booking: %v", err))
 	}
 
-	return &UpdateBookingResponse{Success: true}, nil
+	return &UpdateBookingResponse{Success: true, Warnings: s.dietaryWarnings(ctx, repositoryReq)}, nil
+}
+
+// dietaryWarnings การจองบันทึกแล้ว ถ้าตรวจคำเตือนไม่ได้ให้ log ไว้แทนการคืน error
+func (s *bookingServer) dietaryWarnings(ctx context.Context, req *repository.CreateBookingRequest) []string {
+	warnings, err := s.bookingRepo.DietaryWarnings(ctx, req)
+	if err != nil {
+		logs.Error("Failed to check dietary restrictions", zap.Error(err))
+		return nil
+	}
+	return warnings
+}
+
+func (s *bookingServer) GetCustomerDietaryRestrictions(ctx context.Context, req *GetCustomerDietaryRestrictionsRequest) (*CustomerDietaryRestrictions, error) {
+	if repository.NormalizePhoneNumber(req.PhoneNumber) == "" {
+		return nil, status.Error(codes.InvalidArgument, "phone_number is required")
+	}
+
+	restrictions, err := s.bookingRepo.GetDietaryRestrictions(ctx, req.PhoneNumber)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("could not get dietary restrictions: %v", err))
+	}
+	return convertDietaryRestrictionsToProto(restrictions), nil
+}
+
+func (s *bookingServer) SetCustomerDietaryRestrictions(ctx context.Context, req *CustomerDietaryRestrictions) (*CustomerDietaryRestrictions, error) {
+	if repository.NormalizePhoneNumber(req.PhoneNumber) == "" {
+		return nil, status.Error(codes.InvalidArgument, "phone_number is required")
+	}
+	allergens, err := normalizeDietaryTags("avoid_allergens", req.AvoidAllergens, repository.Allergens)
+	if err != nil {
+		return nil, err
+	}
+	dietary, err := normalizeDietaryTags("required_dietary", req.RequiredDietary, repository.DietaryTags)
+	if err != nil {
+		return nil, err
+	}
+
+	restrictions := repository.DietaryRestrictions{
+		PhoneNumber:     req.PhoneNumber,
+		AvoidAllergens:  allergens,
+		RequiredDietary: dietary,
+	}
+	if err := s.bookingRepo.SetDietaryRestrictions(ctx, restrictions); err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("could not save dietary restrictions: %v", err))
+	}
+	return s.GetCustomerDietaryRestrictions(ctx, &GetCustomerDietaryRestrictionsRequest{PhoneNumber: req.PhoneNumber})
+}
+
+// normalizeDietaryTags แปลงเป็นตัวพิมพ์ใหญ่ ตัดตัวซ้ำ และตรวจว่าอยู่ใน allowed
+func normalizeDietaryTags(field string, values []string, allowed []string) ([]string, error) {
+	var tags []string
+	seen := make(map[string]bool)
+	for _, value := range values {
+		tag := strings.ToUpper(strings.TrimSpace(value))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		if !slices.Contains(allowed, tag) {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("%s: unknown tag %q (allowed: %s)", field, value, strings.Join(allowed, ", ")))
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
+
+func convertDietaryRestrictionsToProto(restrictions repository.DietaryRestrictions) *CustomerDietaryRestrictions {
+	return &CustomerDietaryRestrictions{
+		PhoneNumber:     restrictions.PhoneNumber,
+		AvoidAllergens:  restrictions.AvoidAllergens,
+		RequiredDietary: restrictions.RequiredDietary,
+	}
 }
 
 func (s *bookingServer) DeleteBooking(ctx context.Context, req *DeleteBookingRequest) (*DeleteBookingResponse, error) {
@@ -241,3 +545,156 @@ func (s *bookingServer) DeleteBooking(ctx context.Context, req *DeleteBookingReq
 
 	return &DeleteBookingResponse{Success: true}, nil
 }
+
+// ตรวจสอบช่วงวันที่สำหรับ export (รูปแบบ "2006-01-02")
+func validateDateRange(startDate, endDate string) error {
+	start, err := time.Parse("2006-01-02", startDate)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("invalid start_date format: %v", err))
+	}
+	end, err := time.Parse("2006-01-02", endDate)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, fmt.Sprintf("invalid end_date format: %v", err))
+	}
+	if end.Before(start) {
+		return status.Error(codes.InvalidArgument, "end_date must not be before start_date")
+	}
+	return nil
+}
+
+func (s *bookingServer) ExportBookings(req *ExportBookingsRequest, stream BookingService_ExportBookingsServer) error {
+	if err := validateDateRange(req.StartDate, req.EndDate); err != nil {
+		return err
+	}
+
+	err := s.bookingRepo.StreamBookingDetails(stream.Context(), req.StartDate, req.EndDate, func(booking repository.Booking) error {
+		return stream.Send(convertToProto([]repository.Booking{booking})[0])
+	})
+	if err != nil {
+		return status.Error(codes.Internal, fmt.Sprintf("could not export bookings: %v", err))
+	}
+
+	return nil
+}
+
+// สถานะการจองที่ครัวต้องเตรียมอาหาร
+const confirmedStatus = "CONFIRMED"
+
+func (s *bookingServer) GetPrepSheet(ctx context.Context, req *GetPrepSheetRequest) (*PrepSheet, error) {
+	if err := validateDateRange(req.Date, req.Date); err != nil {
+		return nil, err
+	}
+
+	sheet := &PrepSheet{Date: req.Date}
+	menuSets := make(map[string]*BookingMenuSet)
+	setItems := make(map[string]*BookingMenuItem)
+	items := make(map[string]*PrepSheetItem)
+	modifiers := make(map[string]*PrepSheetModifier)
+
+	// รวมจำนวนของเมนูเดียวกันที่มาจากหลายการจอง
+	addItem := func(item repository.BookingMenuItem, fromSets, aLaCarte int32) {
+		prep, ok := items[item.MenuItemID]
+		if !ok {
+			prep = &PrepSheetItem{
+				MenuItemId: item.MenuItemID,
+				NameTh:     item.NameTh,
+				NameEn:     item.NameEn,
+				Category:   item.Category,
+			}
+			items[item.MenuItemID] = prep
+			sheet.Items = append(sheet.Items, prep)
+		}
+		prep.FromSets += fromSets
+		prep.ALaCarte += aLaCarte
+		prep.Total += fromSets + aLaCarte
+
+		// ตัวเลือกมีเฉพาะจานที่สั่งแยก นับจำนวนจานที่เลือกแต่ละตัวเลือก
+		for _, modifier := range item.Modifiers {
+			key := item.MenuItemID + "/" + modifier.ModifierOptionID
+			total, ok := modifiers[key]
+			if !ok {
+				total = &PrepSheetModifier{
+					GroupNameTh: modifier.GroupNameTh,
+					NameTh:      modifier.NameTh,
+					NameEn:      modifier.NameEn,
+				}
+				modifiers[key] = total
+				prep.Modifiers = append(prep.Modifiers, total)
+			}
+			total.Quantity += aLaCarte
+		}
+	}
+
+	err := s.bookingRepo.StreamBookingDetails(ctx, req.Date, req.Date, func(booking repository.Booking) error {
+		if booking.Status != confirmedStatus {
+			return nil
+		}
+
+		tableNumbers := make([]string, 0, len(booking.Tables))
+		for _, table := range booking.Tables {
+			tableNumbers = append(tableNumbers, table.TableNumber)
+		}
+		sheet.Bookings = append(sheet.Bookings, &PrepSheetBooking{
+			BookingId:       booking.BookingID,
+			BookingDateTime: booking.BookingDateTime.Format(time.RFC3339),
+			CustomerName:    booking.CustomerName,
+			CompanyName:     booking.CompanyName,
+			NumAdults:       booking.NumAdults,
+			NumChildren:     booking.NumChildren,
+			TableNumbers:    tableNumbers,
+		})
+		sheet.TotalAdults += booking.NumAdults
+		sheet.TotalChildren += booking.NumChildren
+
+		// เมนูตายตัวของเซ็ต (quantity 0) มีหนึ่งจานต่อเซ็ต เมนูที่เลือกมีตามจำนวนที่เลือกต่อเซ็ต
+		for _, menuSet := range booking.BookingMenuSets {
+			total, ok := menuSets[menuSet.MenuSetID]
+			if !ok {
+				total = &BookingMenuSet{
+					MenuSetId:    menuSet.MenuSetID,
+					MenuSetName:  menuSet.MenuSetName,
+					MenuSetPrice: menuSet.MenuSetPrice,
+				}
+				menuSets[menuSet.MenuSetID] = total
+				sheet.MenuSets = append(sheet.MenuSets, total)
+			}
+			total.Quantity += menuSet.Quantity
+
+			for _, item := range menuSet.MenuItems {
+				dishes := menuSet.Quantity * max(item.Quantity, 1)
+				addItem(item, dishes, 0)
+
+				// สรุปเซ็ตแสดงเมนูตายตัวครั้งเดียว ส่วนเมนูที่เลือกรวมจำนวนจานทั้งวัน
+				key := fmt.Sprintf("%s/%s/%t", menuSet.MenuSetID, item.MenuItemID, item.Quantity > 0)
+				setItem, ok := setItems[key]
+				if !ok {
+					setItem = convertMenuItemsToProto([]repository.BookingMenuItem{item})[0]
+					setItem.Quantity = 0
+					setItems[key] = setItem
+					total.MenuItems = append(total.MenuItems, setItem)
+				}
+				if item.Quantity > 0 {
+					setItem.Quantity += dishes
+				}
+			}
+		}
+
+		for _, item := range booking.BookingMenuItems {
+			addItem(item, 0, item.Quantity)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("could not build prep sheet: %v", err))
+	}
+
+	// รวมเมนูหมวดเดียวกันไว้ด้วยกันเพื่อให้ครัวอ่านง่าย
+	sort.SliceStable(sheet.Items, func(i, j int) bool {
+		if sheet.Items[i].Category != sheet.Items[j].Category {
+			return sheet.Items[i].Category < sheet.Items[j].Category
+		}
+		return sheet.Items[i].NameTh < sheet.Items[j].NameTh
+	})
+
+	return sheet, nil
+}
@@ -136,3 +136,50 @@ func (s *dashboardServer) GetBestSellers(ctx context.Context, req *emptypb.Empty
 		TopALaCarte: topAlaCarteList,
 	}, nil
 }
+
+func (s *dashboardServer) ExportSalesReport(req *ExportReportRequest, stream DashboardService_ExportSalesReportServer) error {
+	logs.Info("Received ExportSalesReportRequest", zap.String("StartDate", req.StartDate), zap.String("EndDate", req.EndDate), zap.String("Period", req.Period.String()))
+
+	if err := validateDateRange(req.StartDate, req.EndDate); err != nil {
+		return err
+	}
+
+	err := s.repo.StreamSalesReport(stream.Context(), req.StartDate, req.EndDate, req.Period == ReportPeriod_MONTHLY, func(row repository.SalesReportRow) error {
+		return stream.Send(&SalesReportRow{
+			Period:         row.Period,
+			TotalSales:     row.TotalSales,
+			TotalBookings:  row.TotalBookings,
+			TotalCustomers: row.TotalCustomers,
+		})
+	})
+	if err != nil {
+		logs.Error("Failed to export sales report", zap.Error(err))
+		return status.Errorf(codes.Internal, "Failed to export sales report: %v", err)
+	}
+
+	return nil
+}
+
+func (s *dashboardServer) ExportBestSellers(req *ExportReportRequest, stream DashboardService_ExportBestSellersServer) error {
+	logs.Info("Received ExportBestSellersRequest", zap.String("StartDate", req.StartDate), zap.String("EndDate", req.EndDate))
+
+	if err := validateDateRange(req.StartDate, req.EndDate); err != nil {
+		return err
+	}
+
+	err := s.repo.StreamBestSellers(stream.Context(), req.StartDate, req.EndDate, func(row repository.BestSellerRow) error {
+		return stream.Send(&BestSellerRow{
+			Kind:              row.Kind,
+			NameTh:            row.NameTh,
+			NameEn:            row.NameEn,
+			TotalQuantitySold: row.TotalQuantitySold,
+			TotalSales:        row.TotalSales,
+		})
+	})
+	if err != nil {
+		logs.Error("Failed to export best sellers", zap.Error(err))
+		return status.Errorf(codes.Internal, "Failed to export best sellers: %v", err)
+	}
+
+	return nil
+}
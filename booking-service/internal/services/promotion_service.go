@@ -0,0 +1,220 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gitlab.com/final_project1240930/booking_service/internal/logs"
+	"gitlab.com/final_project1240930/booking_service/internal/pricing"
+	"gitlab.com/final_project1240930/booking_service/internal/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+type promotionServer struct {
+	repo repository.PromotionRepository
+}
+
+func NewPromotionServer(repo repository.PromotionRepository) PromotionServiceServer {
+	return &promotionServer{repo: repo}
+}
+
+func (s *promotionServer) mustEmbedUnimplementedPromotionServiceServer() {}
+
+func (s *promotionServer) CreatePromotion(ctx context.Context, req *Promotion) (*CreatePromotionResponse, error) {
+	promotion, err := convertPromotionFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+
+	promotionID, err := s.repo.CreatePromotion(ctx, promotion)
+	if err != nil {
+		logs.Error("Failed to create promotion", zap.Error(err))
+		return nil, status.Error(codes.Internal, fmt.Sprintf("could not create promotion: %v", err))
+	}
+
+	return &CreatePromotionResponse{PromotionId: promotionID}, nil
+}
+
+func (s *promotionServer) UpdatePromotion(ctx context.Context, req *Promotion) (*UpdatePromotionResponse, error) {
+	if req.PromotionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "promotion_id is required for update")
+	}
+
+	promotion, err := convertPromotionFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdatePromotion(ctx, promotion); err != nil {
+		return nil, promotionError("update", err)
+	}
+
+	return &UpdatePromotionResponse{Success: true}, nil
+}
+
+func (s *promotionServer) DeletePromotion(ctx context.Context, req *PromotionIDRequest) (*DeletePromotionResponse, error) {
+	if req.PromotionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "promotion_id is required")
+	}
+
+	if err := s.repo.DeletePromotion(ctx, req.PromotionId); err != nil {
+		return nil, promotionError("delete", err)
+	}
+
+	return &DeletePromotionResponse{Success: true}, nil
+}
+
+func (s *promotionServer) GetPromotions(ctx context.Context, req *emptypb.Empty) (*GetPromotionsResponse, error) {
+	promotions, err := s.repo.GetPromotions(ctx)
+	if err != nil {
+		logs.Error("Failed to get promotions", zap.Error(err))
+		return nil, status.Error(codes.Internal, fmt.Sprintf("could not get promotions: %v", err))
+	}
+
+	response := &GetPromotionsResponse{Promotions: make([]*Promotion, 0, len(promotions))}
+	for _, promotion := range promotions {
+		response.Promotions = append(response.Promotions, convertPromotionToProto(promotion))
+	}
+	return response, nil
+}
+
+func (s *promotionServer) GetPromotionByID(ctx context.Context, req *PromotionIDRequest) (*Promotion, error) {
+	if req.PromotionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "promotion_id is required")
+	}
+
+	promotion, err := s.repo.GetPromotionByID(ctx, req.PromotionId)
+	if err != nil {
+		return nil, promotionError("get", err)
+	}
+
+	return convertPromotionToProto(*promotion), nil
+}
+
+func (s *promotionServer) GetPromotionRedemptions(ctx context.Context, req *PromotionIDRequest) (*GetPromotionRedemptionsResponse, error) {
+	if req.PromotionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "promotion_id is required")
+	}
+
+	redemptions, err := s.repo.GetPromotionRedemptions(ctx, req.PromotionId)
+	if err != nil {
+		logs.Error("Failed to get promotion redemptions", zap.Error(err))
+		return nil, status.Error(codes.Internal, fmt.Sprintf("could not get promotion redemptions: %v", err))
+	}
+
+	response := &GetPromotionRedemptionsResponse{Redemptions: make([]*PromotionRedemption, 0, len(redemptions))}
+	for _, redemption := range redemptions {
+		response.Redemptions = append(response.Redemptions, &PromotionRedemption{
+			BookingId:       redemption.BookingID,
+			CustomerName:    redemption.CustomerName,
+			BookingDateTime: redemption.BookingDateTime.Format(time.RFC3339),
+			Status:          redemption.Status,
+			DiscountSatang:  redemption.DiscountSatang,
+			RedeemedAt:      redemption.RedeemedAt.Format(time.RFC3339),
+		})
+	}
+	return response, nil
+}
+
+// promotionError แปลง error จาก repository เป็น gRPC status
+func promotionError(action string, err error) error {
+	switch {
+	case errors.Is(err, repository.ErrPromotionNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, repository.ErrPromotionRedeemed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	logs.Error("Failed to "+action+" promotion", zap.Error(err))
+	return status.Error(codes.Internal, fmt.Sprintf("could not %s promotion: %v", action, err))
+}
+
+func convertPromotionFromProto(req *Promotion) (repository.Promotion, error) {
+	promotion := repository.Promotion{
+		PromotionID:  req.PromotionId,
+		Code:         strings.TrimSpace(req.Code),
+		Name:         strings.TrimSpace(req.Name),
+		Description:  req.Description,
+		DiscountType: strings.ToUpper(req.DiscountType),
+		Percent:      req.Percent,
+		AmountSatang: req.AmountSatang,
+		MenuSetIDs:   req.MenuSetIds,
+		MinPartySize: req.MinPartySize,
+		MaxPartySize: req.MaxPartySize,
+		UsageLimit:   req.UsageLimit,
+		Active:       req.Active,
+	}
+	if promotion.Name == "" {
+		return repository.Promotion{}, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	var err error
+	if promotion.ValidFrom, err = parseOptionalTime(req.ValidFrom); err != nil {
+		return repository.Promotion{}, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid valid_from format: %v", err))
+	}
+	if promotion.ValidUntil, err = parseOptionalTime(req.ValidUntil); err != nil {
+		return repository.Promotion{}, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid valid_until format: %v", err))
+	}
+
+	for _, day := range req.DaysOfWeek {
+		if day < int32(time.Sunday) || day > int32(time.Saturday) {
+			return repository.Promotion{}, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid day of week %d (0 = Sunday ... 6 = Saturday)", day))
+		}
+		promotion.DaysOfWeek = append(promotion.DaysOfWeek, time.Weekday(day))
+	}
+
+	if err := pricing.ValidatePromotion(promotion.ToPricing()); err != nil {
+		return repository.Promotion{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return promotion, nil
+}
+
+func convertPromotionToProto(promotion repository.Promotion) *Promotion {
+	days := make([]int32, 0, len(promotion.DaysOfWeek))
+	for _, day := range promotion.DaysOfWeek {
+		days = append(days, int32(day))
+	}
+	return &Promotion{
+		PromotionId:  promotion.PromotionID,
+		Code:         promotion.Code,
+		Name:         promotion.Name,
+		Description:  promotion.Description,
+		DiscountType: promotion.DiscountType,
+		Percent:      promotion.Percent,
+		AmountSatang: promotion.AmountSatang,
+		MenuSetIds:   promotion.MenuSetIDs,
+		ValidFrom:    formatOptionalTime(promotion.ValidFrom),
+		ValidUntil:   formatOptionalTime(promotion.ValidUntil),
+		DaysOfWeek:   days,
+		MinPartySize: promotion.MinPartySize,
+		MaxPartySize: promotion.MaxPartySize,
+		UsageLimit:   promotion.UsageLimit,
+		UsedCount:    promotion.UsedCount,
+		Active:       promotion.Active,
+		CreatedAt:    promotion.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:    promotion.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func parseOptionalTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,19 @@
+ALTER TABLE booking_menu_items
+    DROP COLUMN unit_price_satang,
+    DROP COLUMN discount_satang;
+
+ALTER TABLE booking_menu_sets
+    DROP COLUMN unit_price_satang,
+    DROP COLUMN discount_satang;
+
+ALTER TABLE bookings
+    DROP COLUMN subtotal_satang,
+    DROP COLUMN line_discount_satang,
+    DROP COLUMN order_discount_satang,
+    DROP COLUMN service_charge_satang,
+    DROP COLUMN service_charge_percent,
+    DROP COLUMN vat_satang,
+    DROP COLUMN vat_percent,
+    DROP COLUMN vat_inclusive,
+    DROP COLUMN rounding_satang,
+    DROP COLUMN total_satang;
@@ -0,0 +1,36 @@
+-- เก็บยอดเงินของการจองเป็นสตางค์ (BIGINT) แทน total_price ที่เป็น double
+ALTER TABLE bookings
+    ADD COLUMN subtotal_satang BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN line_discount_satang BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN order_discount_satang BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN service_charge_satang BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN service_charge_percent NUMERIC(5, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN vat_satang BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN vat_percent NUMERIC(5, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN vat_inclusive BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN rounding_satang BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN total_satang BIGINT NOT NULL DEFAULT 0;
+
+-- ราคาต่อหน่วย ณ เวลาที่จอง เพื่อไม่ให้ยอดเดิมเปลี่ยนเมื่อแก้ราคาเมนู
+ALTER TABLE booking_menu_sets
+    ADD COLUMN unit_price_satang BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN discount_satang BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE booking_menu_items
+    ADD COLUMN unit_price_satang BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN discount_satang BIGINT NOT NULL DEFAULT 0;
+
+-- การจองเดิมไม่มีรายละเอียด ถือว่า total_price เป็นยอดสุทธิทั้งหมด
+UPDATE bookings
+SET subtotal_satang = ROUND(total_price * 100),
+    total_satang = ROUND(total_price * 100);
+
+UPDATE booking_menu_sets bms
+SET unit_price_satang = ROUND(ms.price * 100)
+FROM menu_sets ms
+WHERE ms.uuid = bms.menu_set_id;
+
+UPDATE booking_menu_items bmi
+SET unit_price_satang = ROUND(mi.price * 100)
+FROM menu_items mi
+WHERE mi.uuid = bmi.menu_item_id;
@@ -0,0 +1,7 @@
+ALTER TABLE bookings
+    DROP COLUMN promotion_code,
+    DROP COLUMN promotion_discount_satang;
+
+DROP TABLE promotion_redemptions;
+DROP TABLE promotion_menu_sets;
+DROP TABLE promotions;
@@ -0,0 +1,43 @@
+-- โค้ดโปรโมชั่น (เช่น เด็กทานฟรีวันเสาร์-อาทิตย์, ลด 10% เซ็ต A)
+CREATE TABLE promotions (
+    uuid UUID PRIMARY KEY,
+    code VARCHAR(50) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    discount_type VARCHAR(20) NOT NULL CHECK (discount_type IN ('PERCENT', 'AMOUNT', 'CHILDREN_FREE')),
+    percent NUMERIC(5, 2) NOT NULL DEFAULT 0,
+    amount_satang BIGINT NOT NULL DEFAULT 0,
+    valid_from TIMESTAMPTZ,
+    valid_until TIMESTAMPTZ,
+    -- bitmask ของวันในสัปดาห์ (bit 0 = อาทิตย์ ... bit 6 = เสาร์), 0 = ทุกวัน
+    days_of_week SMALLINT NOT NULL DEFAULT 0,
+    min_party_size INT NOT NULL DEFAULT 0,
+    max_party_size INT NOT NULL DEFAULT 0,
+    usage_limit INT NOT NULL DEFAULT 0,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX promotions_code_key ON promotions (UPPER(code));
+
+-- เมนูเซ็ตที่ร่วมโปรโมชั่น ไม่มีแถว = ใช้กับทั้งบิล
+CREATE TABLE promotion_menu_sets (
+    promotion_id UUID NOT NULL REFERENCES promotions (uuid) ON DELETE CASCADE,
+    menu_set_id UUID NOT NULL,
+    PRIMARY KEY (promotion_id, menu_set_id)
+);
+
+-- การใช้โค้ด หนึ่งการจองใช้ได้หนึ่งโค้ด
+CREATE TABLE promotion_redemptions (
+    booking_id UUID PRIMARY KEY,
+    promotion_id UUID NOT NULL REFERENCES promotions (uuid),
+    discount_satang BIGINT NOT NULL,
+    redeemed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX promotion_redemptions_promotion_id_idx ON promotion_redemptions (promotion_id);
+
+ALTER TABLE bookings
+    ADD COLUMN promotion_code VARCHAR(50) NOT NULL DEFAULT '',
+    ADD COLUMN promotion_discount_satang BIGINT NOT NULL DEFAULT 0;
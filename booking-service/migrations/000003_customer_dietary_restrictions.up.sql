@@ -0,0 +1,8 @@
+-- ข้อจำกัดด้านอาหารของลูกค้า ระบุลูกค้าด้วยเบอร์โทร (เก็บเฉพาะตัวเลข)
+-- ALLERGEN = แพ้/ไม่ทานสารนี้, DIETARY = ต้องเป็นเมนูที่มีแท็กนี้ (ค่าเดียวกับ menu_item_tags)
+CREATE TABLE customer_dietary_restrictions (
+    phone_number VARCHAR(20) NOT NULL,
+    kind VARCHAR(10) NOT NULL CHECK (kind IN ('ALLERGEN', 'DIETARY')),
+    tag VARCHAR(30) NOT NULL,
+    PRIMARY KEY (phone_number, kind, tag)
+);
@@ -0,0 +1,4 @@
+DROP TABLE booking_menu_item_modifiers;
+
+-- ไม่คืน primary key เดิม เพราะการจองหลังจากนี้อาจมีเมนูเดียวกันหลายรายการ
+ALTER TABLE booking_menu_items DROP COLUMN uuid;
@@ -0,0 +1,17 @@
+-- เมนูเดียวกันสั่งได้หลายรายการด้วยตัวเลือกต่างกัน (เช่น ไม่เผ็ดหนึ่งจาน เผ็ดมากหนึ่งจาน)
+-- จึงให้แต่ละรายการมี uuid ของตัวเอง
+ALTER TABLE booking_menu_items DROP CONSTRAINT IF EXISTS booking_menu_items_pkey;
+ALTER TABLE booking_menu_items ADD COLUMN uuid UUID NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY;
+
+-- ตัวเลือกที่เลือกของแต่ละรายการ เก็บชื่อและราคา ณ เวลาที่จองไว้ ไม่เปลี่ยนตามเมนูที่แก้ภายหลัง
+CREATE TABLE booking_menu_item_modifiers (
+    booking_menu_item_id UUID NOT NULL REFERENCES booking_menu_items (uuid) ON DELETE CASCADE,
+    modifier_option_id UUID NOT NULL,
+    group_name_th VARCHAR(255) NOT NULL,
+    group_name_en VARCHAR(255) NOT NULL,
+    name_th VARCHAR(255) NOT NULL,
+    name_en VARCHAR(255) NOT NULL,
+    price_delta_satang BIGINT NOT NULL DEFAULT 0,
+    position INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (booking_menu_item_id, modifier_option_id)
+);
@@ -0,0 +1,4 @@
+DROP TABLE booking_menu_set_choices;
+
+-- ไม่คืน primary key เดิม เพราะการจองหลังจากนี้อาจมีเมนูเซ็ตเดียวกันหลายรายการ
+ALTER TABLE booking_menu_sets DROP COLUMN uuid;
@@ -0,0 +1,19 @@
+-- เมนูเซ็ตเดียวกันสั่งได้หลายรายการด้วยเมนูที่เลือกต่างกัน จึงให้แต่ละรายการมี uuid ของตัวเอง
+ALTER TABLE booking_menu_sets DROP CONSTRAINT IF EXISTS booking_menu_sets_pkey;
+ALTER TABLE booking_menu_sets ADD COLUMN uuid UUID NOT NULL DEFAULT gen_random_uuid() PRIMARY KEY;
+
+-- เมนูที่เลือกในช่องเลือกของเซ็ต quantity คือจำนวนจานต่อหนึ่งเซ็ต
+-- เก็บชื่อช่องและราคาเพิ่ม ณ เวลาที่จองไว้ ไม่เปลี่ยนตามเซ็ตที่แก้ภายหลัง
+CREATE TABLE booking_menu_set_choices (
+    booking_menu_set_id UUID NOT NULL REFERENCES booking_menu_sets (uuid) ON DELETE CASCADE,
+    slot_id UUID NOT NULL,
+    menu_item_id UUID NOT NULL,
+    slot_name_th VARCHAR(255) NOT NULL,
+    slot_name_en VARCHAR(255) NOT NULL,
+    quantity INTEGER NOT NULL CHECK (quantity >= 1),
+    upcharge_satang BIGINT NOT NULL DEFAULT 0,
+    position INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (booking_menu_set_id, slot_id, menu_item_id)
+);
+
+CREATE INDEX booking_menu_set_choices_menu_item_id_idx ON booking_menu_set_choices (menu_item_id);
@@ -1,12 +1,15 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/joho/godotenv"
+	"gitlab.com/final_project1240930/booking_service/internal/imagestore"
 	"gitlab.com/final_project1240930/booking_service/internal/logs"
 	"gitlab.com/final_project1240930/booking_service/internal/repository"
 	"gitlab.com/final_project1240930/booking_service/internal/services"
@@ -62,15 +65,33 @@ func main() {
 	s := grpc.NewServer()
 
 	// --------------------------- Menu -------------------------------
-	cloudinaryURL := os.Getenv("CLOUDINARY_URL")
-	menuRepositoryDB := repository.NewMenuRepository(db, cloudinaryURL)
+	imageStoreConfig, err := imagestore.ConfigFromEnv()
+	if err != nil {
+		logs.Fatal("Invalid image store configuration", zap.Error(err))
+	}
+	imageStore, err := imagestore.New(context.Background(), imageStoreConfig)
+	if err != nil {
+		logs.Fatal("Failed to initialize image store", zap.Error(err))
+	}
+	logs.Info("Using image store", zap.String("driver", imageStoreConfig.Driver))
+
+	menuRepositoryDB := repository.NewMenuRepository(db, imageStore)
 	services.RegisterMenuServiceServer(s, services.NewMenuServer(menuRepositoryDB))
 
+	// ราคาที่ตั้งล่วงหน้ามีผลเองเมื่อถึงเวลา ตรวจทุกนาที
+	go applyScheduledPrices(menuRepositoryDB, time.Minute)
+
 	// --------------------------- Table -------------------------------
 
 	tableRepositoryDB := repository.NewTableRepository(db)
 	services.RegisterTableServiceServer(s, services.NewTableServer(tableRepositoryDB))
 
+	// --------------------------- Inventory -------------------------------
+
+	inventoryRepositoryDB := repository.NewInventoryRepository(db)
+	purchasingRepositoryDB := repository.NewPurchasingRepository(db)
+	services.RegisterInventoryServiceServer(s, services.NewInventoryServer(inventoryRepositoryDB, purchasingRepositoryDB))
+
 	// -----------------------------------------------------------------
 
 	logs.Info("Server running on port " + appPort)
@@ -80,3 +101,18 @@ func main() {
 	}
 
 }
+
+// applyScheduledPrices ปรับราคาเมนูตามราคาที่ตั้งล่วงหน้าทันทีที่เริ่ม แล้วตรวจซ้ำทุก interval
+func applyScheduledPrices(menuRepo repository.MenuRepository, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		changed, err := menuRepo.ApplyScheduledPrices(context.Background(), time.Now())
+		if err != nil {
+			logs.Error("Failed to apply scheduled menu prices", zap.Error(err))
+		} else if changed > 0 {
+			logs.Info("Applied scheduled menu prices", zap.Int("Changed", changed))
+		}
+		<-ticker.C
+	}
+}
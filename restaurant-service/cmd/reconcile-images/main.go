@@ -0,0 +1,85 @@
+// reconcile-images หาไฟล์รูปเมนูในที่เก็บรูปภาพที่ไม่มีตารางใดในฐานข้อมูลอ้างถึงแล้ว (ทุกตารางที่มีคอลัมน์ image_url)
+// ค่าเริ่มต้นแค่แสดงรายการ ใส่ -delete เพื่อลบจริง
+//
+//	./reconcile-images                      # แสดงรายการไฟล์ที่ไม่ได้ใช้
+//	./reconcile-images -delete -min-age 72h # ลบไฟล์ที่ไม่ได้ใช้และอัปโหลดมานานกว่า 3 วัน
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/joho/godotenv"
+	"gitlab.com/final_project1240930/booking_service/internal/imagestore"
+	"gitlab.com/final_project1240930/booking_service/internal/logs"
+	"gitlab.com/final_project1240930/booking_service/internal/repository"
+	"go.uber.org/zap"
+)
+
+func main() {
+	envFile := flag.String("env", "/app/.env", "path of the .env file")
+	minAge := flag.Duration("min-age", 24*time.Hour, "only consider images uploaded at least this long ago")
+	deleteOrphans := flag.Bool("delete", false, "delete orphan images instead of only listing them")
+	flag.Parse()
+
+	// ใน container ค่าอาจมาจาก environment โดยตรง ไม่มีไฟล์ .env ก็ทำงานต่อได้
+	if err := godotenv.Load(*envFile); err != nil {
+		logs.Info("No .env file loaded, using environment variables", zap.String("env", *envFile))
+	}
+
+	dbPort, err := strconv.Atoi(os.Getenv("DB_PORT"))
+	if err != nil {
+		logs.Fatal("Error converting DB_PORT to int", zap.Error(err))
+	}
+	db, err := repository.NewDatabase(os.Getenv("DB_HOST"), dbPort, os.Getenv("DB_USER"), os.Getenv("DB_PASSWORD"), os.Getenv("DB_NAME"))
+	if err != nil {
+		logs.Fatal("Failed to connect to database", zap.Error(err))
+	}
+	defer func() {
+		if err := repository.CloseDatabase(db); err != nil {
+			logs.Error("Failed to close database", zap.Error(err))
+		}
+	}()
+
+	ctx := context.Background()
+	imageStoreConfig, err := imagestore.ConfigFromEnv()
+	if err != nil {
+		logs.Fatal("Invalid image store configuration", zap.Error(err))
+	}
+	imageStore, err := imagestore.New(ctx, imageStoreConfig)
+	if err != nil {
+		logs.Fatal("Failed to initialize image store", zap.Error(err))
+	}
+
+	menuRepositoryDB := repository.NewMenuRepository(db, imageStore)
+	orphans, err := menuRepositoryDB.FindOrphanImages(ctx, time.Now().Add(-*minAge))
+	if err != nil {
+		logs.Fatal("Failed to find orphan images", zap.Error(err))
+	}
+
+	deleted := 0
+	for _, orphan := range orphans {
+		fmt.Printf("%s\t%s\n", orphan.UploadedAt.Format(time.RFC3339), orphan.Key)
+		if !*deleteOrphans {
+			continue
+		}
+		if err := imageStore.Delete(ctx, orphan.Key); err != nil {
+			logs.Error("Failed to delete orphan image", zap.String("key", orphan.Key), zap.Error(err))
+			continue
+		}
+		deleted++
+	}
+
+	if *deleteOrphans {
+		fmt.Printf("deleted %d of %d orphan images (%s)\n", deleted, len(orphans), imageStoreConfig.Driver)
+		if deleted < len(orphans) {
+			os.Exit(1)
+		}
+		return
+	}
+	fmt.Printf("found %d orphan images (%s), run with -delete to remove them\n", len(orphans), imageStoreConfig.Driver)
+}
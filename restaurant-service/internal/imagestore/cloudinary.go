@@ -0,0 +1,104 @@
+package imagestore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/cloudinary/cloudinary-go/v2"
+	"github.com/cloudinary/cloudinary-go/v2/api"
+	"github.com/cloudinary/cloudinary-go/v2/api/admin"
+	"github.com/cloudinary/cloudinary-go/v2/api/uploader"
+)
+
+type cloudinaryStore struct {
+	cld *cloudinary.Cloudinary
+}
+
+func NewCloudinaryStore(cloudinaryURL string) (ImageStore, error) {
+	if cloudinaryURL == "" {
+		return nil, fmt.Errorf("CLOUDINARY_URL is required for the cloudinary image store")
+	}
+
+	cld, err := cloudinary.NewFromURL(cloudinaryURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize Cloudinary: %w", err)
+	}
+	// URL ไม่ต้องมีเลข version และ query สำหรับ analytics เพื่อให้ URL ของ key เดิมคงที่
+	cld.Config.URL.ForceVersion = false
+	cld.Config.URL.Analytics = false
+	return &cloudinaryStore{cld: cld}, nil
+}
+
+// Put ใช้ key ที่ตัดนามสกุลไฟล์ออกเป็น public ID (Cloudinary เก็บนามสกุลแยกไว้เอง)
+func (s *cloudinaryStore) Put(ctx context.Context, key string, data []byte, contentType string) (string, error) {
+	resp, err := s.cld.Upload.Upload(ctx, bytes.NewReader(data), uploader.UploadParams{
+		PublicID: strings.TrimSuffix(key, path.Ext(key)),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to upload image to Cloudinary: %w", err)
+	}
+	if resp == nil {
+		return "", fmt.Errorf("received empty response from Cloudinary")
+	}
+	if resp.Error.Message != "" {
+		return "", fmt.Errorf("cloudinary upload failed: %s", resp.Error.Message)
+	}
+	if resp.PublicID == "" {
+		return "", fmt.Errorf("received empty public ID from Cloudinary")
+	}
+	return resp.PublicID, nil
+}
+
+func (s *cloudinaryStore) Delete(ctx context.Context, key string) error {
+	resp, err := s.cld.Upload.Destroy(ctx, uploader.DestroyParams{PublicID: key})
+	if err != nil {
+		return fmt.Errorf("failed to delete image from Cloudinary: %w", err)
+	}
+	// "not found" แปลว่าไม่มีไฟล์อยู่แล้ว
+	if resp != nil && resp.Result != "ok" && resp.Result != "not found" {
+		return fmt.Errorf("cloudinary delete failed: %s", resp.Result)
+	}
+	return nil
+}
+
+// List ใช้ Admin API ซึ่งคืนค่าได้ครั้งละไม่เกิน 500 รายการ จึงต้องวนตาม next_cursor
+func (s *cloudinaryStore) List(ctx context.Context, prefix string) ([]StoredImage, error) {
+	var images []StoredImage
+	params := admin.AssetsParams{
+		AssetType:    api.Image,
+		DeliveryType: string(api.Upload),
+		Prefix:       prefix,
+		MaxResults:   500,
+	}
+	for {
+		resp, err := s.cld.Admin.Assets(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list images in Cloudinary: %w", err)
+		}
+		if resp.Error.Message != "" {
+			return nil, fmt.Errorf("cloudinary list failed: %s", resp.Error.Message)
+		}
+		for _, asset := range resp.Assets {
+			images = append(images, StoredImage{Key: asset.PublicID, UploadedAt: asset.CreatedAt})
+		}
+		if resp.NextCursor == "" {
+			return images, nil
+		}
+		params.NextCursor = resp.NextCursor
+	}
+}
+
+func (s *cloudinaryStore) URL(key string) string {
+	image, err := s.cld.Image(key)
+	if err != nil {
+		return ""
+	}
+	url, err := image.String()
+	if err != nil {
+		return ""
+	}
+	return url
+}
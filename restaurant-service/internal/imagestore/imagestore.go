@@ -0,0 +1,115 @@
+// Package imagestore เก็บไฟล์รูปภาพของเมนู รองรับ Cloudinary, โฟลเดอร์ในเครื่อง (gateway เป็นคน serve)
+// และ S3 / MinIO โดยเลือกจากค่า IMAGE_STORE
+package imagestore
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ImageStore คือที่เก็บไฟล์รูปภาพ ไฟล์อ้างอิงด้วย key เช่น "menu_images/menu_image_xxx.jpg"
+type ImageStore interface {
+	// Put บันทึกไฟล์แล้วคืนค่า key ที่ใช้อ้างอิงไฟล์จริง (บาง driver อาจไม่ตรงกับ key ที่ส่งไป)
+	Put(ctx context.Context, key string, data []byte, contentType string) (string, error)
+	// Delete ลบไฟล์ ถ้าไม่มีไฟล์อยู่แล้วไม่ถือว่าผิดพลาด
+	Delete(ctx context.Context, key string) error
+	// URL คืนค่า URL สาธารณะของไฟล์
+	URL(key string) string
+	// List คืนค่าไฟล์ทั้งหมดที่ key ขึ้นต้นด้วย prefix (ใช้หาไฟล์ที่ไม่มีเมนูอ้างถึง)
+	List(ctx context.Context, prefix string) ([]StoredImage, error)
+}
+
+// StoredImage คือไฟล์หนึ่งไฟล์ในที่เก็บรูปภาพ key อยู่ในรูปแบบเดียวกับที่ Put คืนค่า
+type StoredImage struct {
+	Key        string
+	UploadedAt time.Time
+}
+
+const (
+	DriverCloudinary = "cloudinary"
+	DriverLocal      = "local"
+	DriverS3         = "s3"
+)
+
+// Config คือค่าตั้งค่าของที่เก็บรูปภาพ ใช้เฉพาะส่วนของ driver ที่เลือก
+type Config struct {
+	Driver string
+
+	CloudinaryURL string
+
+	LocalDir     string // โฟลเดอร์ที่เก็บไฟล์ (ต้อง mount ให้ gateway อ่านได้)
+	LocalBaseURL string // URL ที่ gateway serve โฟลเดอร์นี้ เช่น http://localhost:8080/images
+
+	S3 S3Config
+}
+
+type S3Config struct {
+	Endpoint  string // host:port ไม่ต้องมี scheme เช่น minio:9000
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	UseSSL    bool
+	PublicURL string // URL สาธารณะของ bucket ถ้าไม่กำหนดจะใช้ endpoint/bucket
+}
+
+// ConfigFromEnv อ่านค่าจาก IMAGE_STORE (cloudinary, local หรือ s3)
+// ถ้าไม่กำหนด IMAGE_STORE จะใช้ cloudinary เมื่อมี CLOUDINARY_URL นอกนั้นใช้ local
+func ConfigFromEnv() (Config, error) {
+	cfg := Config{
+		Driver:        strings.ToLower(os.Getenv("IMAGE_STORE")),
+		CloudinaryURL: os.Getenv("CLOUDINARY_URL"),
+		LocalDir:      envOrDefault("IMAGE_LOCAL_DIR", "./media"),
+		LocalBaseURL:  envOrDefault("IMAGE_LOCAL_BASE_URL", "http://localhost:8080/images"),
+		S3: S3Config{
+			Endpoint:  os.Getenv("S3_ENDPOINT"),
+			Region:    os.Getenv("S3_REGION"),
+			Bucket:    os.Getenv("S3_BUCKET"),
+			AccessKey: os.Getenv("S3_ACCESS_KEY"),
+			SecretKey: os.Getenv("S3_SECRET_KEY"),
+			PublicURL: os.Getenv("S3_PUBLIC_URL"),
+		},
+	}
+
+	if cfg.Driver == "" {
+		cfg.Driver = DriverLocal
+		if cfg.CloudinaryURL != "" {
+			cfg.Driver = DriverCloudinary
+		}
+	}
+
+	if value := os.Getenv("S3_USE_SSL"); value != "" {
+		useSSL, err := strconv.ParseBool(value)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid S3_USE_SSL: %w", err)
+		}
+		cfg.S3.UseSSL = useSSL
+	}
+
+	return cfg, nil
+}
+
+func envOrDefault(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// New สร้าง ImageStore ตาม driver ที่กำหนด
+func New(ctx context.Context, cfg Config) (ImageStore, error) {
+	switch cfg.Driver {
+	case DriverCloudinary:
+		return NewCloudinaryStore(cfg.CloudinaryURL)
+	case DriverLocal:
+		return NewLocalStore(cfg.LocalDir, cfg.LocalBaseURL)
+	case DriverS3:
+		return NewS3Store(ctx, cfg.S3)
+	default:
+		return nil, fmt.Errorf("unknown IMAGE_STORE driver: %q", cfg.Driver)
+	}
+}
@@ -0,0 +1,126 @@
+package imagestore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// localStore เก็บไฟล์ในโฟลเดอร์ของเครื่อง ใช้ตอน dev หรือเมื่อไม่มีบัญชี cloud
+// gateway ต้อง mount โฟลเดอร์เดียวกันแล้ว serve ที่ LocalBaseURL
+type localStore struct {
+	dir     string
+	baseURL string
+}
+
+func NewLocalStore(dir, baseURL string) (ImageStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create image directory: %w", err)
+	}
+	return &localStore{dir: dir, baseURL: strings.TrimRight(baseURL, "/")}, nil
+}
+
+// path แปลง key เป็น path ในโฟลเดอร์ และไม่ยอมให้ key ชี้ออกนอกโฟลเดอร์
+func (s *localStore) path(key string) (string, error) {
+	if !fs.ValidPath(key) || key == "." {
+		return "", fmt.Errorf("invalid image key: %q", key)
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
+
+func (s *localStore) Put(ctx context.Context, key string, data []byte, contentType string) (string, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", fmt.Errorf("failed to create image directory: %w", err)
+	}
+
+	// เขียนไฟล์ชั่วคราวก่อนแล้วค่อย rename เพื่อไม่ให้ gateway อ่านไฟล์ที่เขียนไม่เสร็จ
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create image file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return "", fmt.Errorf("failed to write image file: %w", err)
+	}
+	if err := tmp.Chmod(0o644); err != nil {
+		tmp.Close()
+		return "", fmt.Errorf("failed to write image file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("failed to write image file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", fmt.Errorf("failed to save image file: %w", err)
+	}
+	return key, nil
+}
+
+func (s *localStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete image file: %w", err)
+	}
+	return nil
+}
+
+func (s *localStore) List(ctx context.Context, prefix string) ([]StoredImage, error) {
+	root, err := s.path(strings.TrimSuffix(prefix, "/"))
+	if err != nil {
+		return nil, err
+	}
+
+	var images []StoredImage
+	err = filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		// ข้ามไฟล์ชั่วคราวของ Put ที่ยังเขียนไม่เสร็จ
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".upload-") {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(s.dir, path)
+		if err != nil {
+			return err
+		}
+		images = append(images, StoredImage{Key: filepath.ToSlash(rel), UploadedAt: info.ModTime()})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list image files: %w", err)
+	}
+	return images, nil
+}
+
+func (s *localStore) URL(key string) string {
+	return s.baseURL + "/" + escapeKey(key)
+}
+
+// escapeKey escape แต่ละส่วนของ key แต่คง "/" ไว้
+func escapeKey(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,196 @@
+// Package imaging ตรวจสอบและแปลงรูปเมนูก่อนเก็บ: ตรวจชนิดไฟล์จาก magic bytes,
+// ตัด EXIF ทิ้ง (หมุนรูปตาม orientation ก่อน), ย่อเป็นหลายขนาด และแปลงเป็น WebP
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+
+	"github.com/HugoSmits86/nativewebp"
+	"golang.org/x/image/draw"
+	"golang.org/x/image/webp"
+)
+
+// รูปแบบไฟล์ที่รับ
+const (
+	FormatJPEG = "jpeg"
+	FormatPNG  = "png"
+	FormatWebP = "webp"
+)
+
+// ContentType ของไฟล์ที่ได้จาก Process ทุกขนาด
+const (
+	ContentType = "image/webp"
+	Extension   = ".webp"
+)
+
+// ขนาดรูปต้นฉบับสูงสุดที่ยอมถอดรหัส กันไฟล์เล็กที่ขยายเป็นภาพใหญ่มาก (decompression bomb)
+const maxSourcePixels = 40_000_000
+
+// ErrUnsupportedFormat ไฟล์ไม่ใช่ JPEG, PNG หรือ WebP
+var ErrUnsupportedFormat = errors.New("unsupported image format: only JPEG, PNG and WebP are accepted")
+
+// ErrInvalidImage ไฟล์เสียหรือใหญ่เกินกว่าที่รับได้
+var ErrInvalidImage = errors.New("invalid image")
+
+// Variant คือขนาดของรูปที่สร้าง Crop = ตัดให้เต็มกรอบพอดี, ไม่ Crop = ย่อให้อยู่ในกรอบโดยคงสัดส่วน
+// รูปที่เล็กกว่ากรอบจะไม่ถูกขยาย
+type Variant struct {
+	Name   string
+	Width  int
+	Height int
+	Crop   bool
+}
+
+// ชื่อของแต่ละขนาด
+const (
+	VariantThumbnail = "thumbnail"
+	VariantCard      = "card"
+	VariantFull      = "full"
+)
+
+// Variants คือขนาดที่สร้างทุกครั้งที่อัปโหลด (full ต้องอยู่ก่อน ขนาดอื่นย่อต่อจาก full)
+// nativewebp เข้ารหัสแบบ lossless เท่านั้น จึงจำกัด full ไว้ที่ 1280px เพื่อไม่ให้ไฟล์ใหญ่เกินไป
+var Variants = []Variant{
+	{Name: VariantFull, Width: 1280, Height: 1280},
+	{Name: VariantCard, Width: 640, Height: 480, Crop: true},
+	{Name: VariantThumbnail, Width: 200, Height: 200, Crop: true},
+}
+
+// Result คือรูปหนึ่งขนาดที่เข้ารหัสเป็น WebP แล้ว
+type Result struct {
+	Variant string
+	Data    []byte
+	Width   int
+	Height  int
+}
+
+// DetectFormat ดูชนิดไฟล์จาก magic bytes ไม่เชื่อนามสกุลหรือ content type ที่ส่งมา
+func DetectFormat(data []byte) (string, error) {
+	switch {
+	case len(data) >= 3 && bytes.Equal(data[:3], []byte{0xFF, 0xD8, 0xFF}):
+		return FormatJPEG, nil
+	case len(data) >= 8 && bytes.Equal(data[:8], []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1A, '\n'}):
+		return FormatPNG, nil
+	case len(data) >= 12 && string(data[:4]) == "RIFF" && string(data[8:12]) == "WEBP":
+		return FormatWebP, nil
+	}
+	return "", ErrUnsupportedFormat
+}
+
+// Process ตรวจสอบไฟล์แล้วสร้างรูปทุกขนาดใน Variants ตามลำดับ
+// รูปที่ได้ถูกถอดรหัสและเข้ารหัสใหม่ทั้งหมด จึงไม่มี EXIF หรือ metadata อื่นติดไปด้วย
+func Process(data []byte) ([]Result, error) {
+	format, err := DetectFormat(data)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := decodeConfig(format, data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImage, err)
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > maxSourcePixels {
+		return nil, fmt.Errorf("%w: %dx%d exceeds %d pixels", ErrInvalidImage, config.Width, config.Height, maxSourcePixels)
+	}
+
+	src, err := decode(format, data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImage, err)
+	}
+
+	orientation := 1
+	if format == FormatJPEG {
+		orientation = jpegOrientation(data)
+	}
+
+	results := make([]Result, 0, len(Variants))
+	var full image.Image
+	for _, variant := range Variants {
+		var img image.Image
+		if full == nil {
+			// ย่อก่อนแล้วค่อยหมุน จะได้หมุนรูปที่เล็กลงแล้ว
+			width, height := variant.Width, variant.Height
+			if swapsAxes(orientation) {
+				width, height = height, width
+			}
+			img = applyOrientation(fit(src, width, height), orientation)
+			full = img
+		} else if variant.Crop {
+			img = fill(full, variant.Width, variant.Height)
+		} else {
+			img = fit(full, variant.Width, variant.Height)
+		}
+
+		var buf bytes.Buffer
+		if err := nativewebp.Encode(&buf, img, nil); err != nil {
+			return nil, fmt.Errorf("failed to encode %s variant: %w", variant.Name, err)
+		}
+		results = append(results, Result{
+			Variant: variant.Name,
+			Data:    buf.Bytes(),
+			Width:   img.Bounds().Dx(),
+			Height:  img.Bounds().Dy(),
+		})
+	}
+	return results, nil
+}
+
+func decodeConfig(format string, data []byte) (image.Config, error) {
+	switch format {
+	case FormatJPEG:
+		return jpeg.DecodeConfig(bytes.NewReader(data))
+	case FormatPNG:
+		return png.DecodeConfig(bytes.NewReader(data))
+	default:
+		return webp.DecodeConfig(bytes.NewReader(data))
+	}
+}
+
+func decode(format string, data []byte) (image.Image, error) {
+	switch format {
+	case FormatJPEG:
+		return jpeg.Decode(bytes.NewReader(data))
+	case FormatPNG:
+		return png.Decode(bytes.NewReader(data))
+	default:
+		return webp.Decode(bytes.NewReader(data))
+	}
+}
+
+// fit ย่อรูปให้อยู่ในกรอบ width x height โดยคงสัดส่วน
+func fit(src image.Image, width, height int) *image.NRGBA {
+	bounds := src.Bounds()
+	scale := min(1, float64(width)/float64(bounds.Dx()), float64(height)/float64(bounds.Dy()))
+	dstWidth := max(1, int(float64(bounds.Dx())*scale+0.5))
+	dstHeight := max(1, int(float64(bounds.Dy())*scale+0.5))
+	return scaleRect(src, bounds, dstWidth, dstHeight)
+}
+
+// fill ตัดกึ่งกลางรูปให้ได้สัดส่วน width:height แล้วย่อให้เต็มกรอบ
+// ถ้ารูปเล็กกว่ากรอบจะคงสัดส่วนของกรอบไว้แต่ไม่ขยาย
+func fill(src image.Image, width, height int) *image.NRGBA {
+	bounds := src.Bounds()
+	scale := max(float64(width)/float64(bounds.Dx()), float64(height)/float64(bounds.Dy()))
+	if scale > 1 {
+		width = max(1, int(float64(width)/scale+0.5))
+		height = max(1, int(float64(height)/scale+0.5))
+		scale = 1
+	}
+
+	cropWidth := min(bounds.Dx(), int(float64(width)/scale+0.5))
+	cropHeight := min(bounds.Dy(), int(float64(height)/scale+0.5))
+	x := bounds.Min.X + (bounds.Dx()-cropWidth)/2
+	y := bounds.Min.Y + (bounds.Dy()-cropHeight)/2
+	return scaleRect(src, image.Rect(x, y, x+cropWidth, y+cropHeight), width, height)
+}
+
+func scaleRect(src image.Image, rect image.Rectangle, width, height int) *image.NRGBA {
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, rect, draw.Src, nil)
+	return dst
+}
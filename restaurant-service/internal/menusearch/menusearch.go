@@ -0,0 +1,220 @@
+// Package menusearch ให้คะแนนความตรงของเมนูกับคำค้นภาษาไทยและอังกฤษ
+//
+// ภาษาไทยไม่มีช่องว่างระหว่างคำ จึงไม่ตัดคำภาษาไทยด้วยพจนานุกรม แต่เทียบคำค้นกับข้อความทั้งช่วง
+// แบบ substring และ bigram ของตัวอักษร ส่วนภาษาอังกฤษตัดเป็นคำแล้วเทียบแบบ prefix และ trigram
+// (แบบเดียวกับ pg_trgm) เพื่อให้พิมพ์ผิดเล็กน้อยยังค้นเจอ
+package menusearch
+
+import (
+	"strings"
+	"unicode"
+)
+
+// เกณฑ์ขั้นต่ำที่ถือว่าคำค้นหนึ่งคำตรงกับข้อความ
+const (
+	latinThreshold = 0.3 // trigram similarity ของคำภาษาอังกฤษ
+	thaiThreshold  = 0.5 // สัดส่วน bigram ของคำค้นภาษาไทยที่พบในข้อความ
+	fuzzyWeight    = 0.7 // ตรงแบบ fuzzy ได้คะแนนน้อยกว่าตรงทั้งคำเสมอ
+	infixWeight    = 0.8 // คำภาษาอังกฤษที่เจออยู่กลางคำ เช่น "ice" ใน "rice"
+	phraseWeight   = 0.2 // สัดส่วนคะแนนของการเจอคำค้นทั้งวลีติดกัน
+)
+
+// MinScore คะแนนต่ำสุดที่ถือว่าเป็นผลการค้นหา
+const MinScore = 0.25
+
+// Field คือข้อความหนึ่งช่องของเมนู (เช่น ชื่อไทย ชื่ออังกฤษ รายละเอียด) พร้อมน้ำหนัก 0-1
+type Field struct {
+	Text   string
+	Weight float64
+}
+
+// Query คือคำค้นที่ normalize และตัดคำแล้ว
+type Query struct {
+	phrase string
+	tokens []string
+}
+
+// NewQuery เตรียมคำค้นสำหรับให้คะแนน
+func NewQuery(q string) Query {
+	tokens := tokenize(normalize(q))
+	return Query{phrase: strings.Join(tokens, " "), tokens: tokens}
+}
+
+// Empty คืน true ถ้าคำค้นไม่มีตัวอักษรที่ใช้ค้นได้
+func (q Query) Empty() bool {
+	return len(q.tokens) == 0
+}
+
+// Score คืนคะแนนความตรง 0-1 ของข้อความทุกช่องกับคำค้น
+// คะแนนหลักคือค่าเฉลี่ยของคำค้นแต่ละคำ (ใช้ช่องที่ได้คะแนนสูงสุดของคำนั้น) บวกคะแนนถ้าเจอทั้งวลี
+func (q Query) Score(fields ...Field) float64 {
+	if q.Empty() {
+		return 0
+	}
+	docs := make([]document, 0, len(fields))
+	for _, field := range fields {
+		text := normalize(field.Text)
+		docs = append(docs, document{text: text, words: tokenize(text), weight: field.Weight})
+	}
+
+	var total float64
+	for _, token := range q.tokens {
+		var best float64
+		for _, doc := range docs {
+			if score := doc.weight * doc.match(token); score > best {
+				best = score
+			}
+		}
+		total += best
+	}
+
+	var phrase float64
+	for _, doc := range docs {
+		if doc.weight > phrase && strings.Contains(strings.Join(doc.words, " "), q.phrase) {
+			phrase = doc.weight
+		}
+	}
+	return (1-phraseWeight)*total/float64(len(q.tokens)) + phraseWeight*phrase
+}
+
+type document struct {
+	text   string
+	words  []string
+	weight float64
+}
+
+// match คืนคะแนน 0-1 ของคำค้นหนึ่งคำกับข้อความช่องนี้
+func (d document) match(token string) float64 {
+	if isThai(token) {
+		// คำภาษาไทยเจอที่ไหนในข้อความก็ได้ เพราะไม่รู้ขอบเขตคำ
+		if strings.Contains(d.text, token) {
+			return 1
+		}
+		var best float64
+		for _, word := range d.words {
+			if !isThai(word) {
+				continue
+			}
+			if sim := containment(bigrams(token), bigrams(word)); sim > best {
+				best = sim
+			}
+		}
+		if best < thaiThreshold {
+			return 0
+		}
+		return fuzzyWeight * best
+	}
+
+	var best float64
+	for _, word := range d.words {
+		switch {
+		case strings.HasPrefix(word, token):
+			return 1
+		case strings.Contains(word, token):
+			best = max(best, infixWeight)
+		default:
+			if sim := similarity(trigrams(token), trigrams(word)); sim >= latinThreshold {
+				best = max(best, fuzzyWeight*sim)
+			}
+		}
+	}
+	return best
+}
+
+// normalize ทำให้ข้อความเทียบกันได้: ตัวพิมพ์เล็ก ตัดอักขระความกว้างศูนย์
+// ตัดวรรณยุกต์ไทยทิ้งเพราะมักพิมพ์ผิดหรือไม่ใส่ แล้วรวมนิคหิต+สระอาเป็นสระอำ
+// (ตัดวรรณยุกต์ก่อน เพราะมักพิมพ์วรรณยุกต์คั่นกลาง เช่น น + ํ + ้ + า)
+func normalize(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		switch {
+		case r == '\u200b' || r == '\u200c' || r == '\u200d' || r == '\ufeff':
+			continue
+		case r >= '\u0e48' && r <= '\u0e4b': // ไม้เอก ไม้โท ไม้ตรี ไม้จัตวา
+			continue
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return strings.ReplaceAll(b.String(), "\u0e4d\u0e32", "\u0e33")
+}
+
+// tokenize ตัดข้อความเป็นคำตามช่องว่างและเครื่องหมาย และแยกช่วงที่เปลี่ยนระหว่างอักษรไทยกับอักษรอื่น
+// ช่วงภาษาไทยที่ติดกันนับเป็นคำเดียว
+func tokenize(s string) []string {
+	var tokens []string
+	var current []rune
+	flush := func() {
+		if len(current) > 0 {
+			tokens = append(tokens, string(current))
+			current = current[:0]
+		}
+	}
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsNumber(r) && !unicode.IsMark(r) {
+			flush()
+			continue
+		}
+		if len(current) > 0 && isThaiRune(current[len(current)-1]) != isThaiRune(r) {
+			flush()
+		}
+		current = append(current, r)
+	}
+	flush()
+	return tokens
+}
+
+func isThaiRune(r rune) bool {
+	return r >= '\u0e00' && r <= '\u0e7f'
+}
+
+func isThai(s string) bool {
+	for _, r := range s {
+		return isThaiRune(r)
+	}
+	return false
+}
+
+// trigrams แบบ pg_trgm: เติมช่องว่างหน้าคำสองตัวและท้ายคำหนึ่งตัว
+func trigrams(word string) map[string]bool {
+	return ngrams([]rune("  "+word+" "), 3)
+}
+
+func bigrams(word string) map[string]bool {
+	return ngrams([]rune(word), 2)
+}
+
+func ngrams(runes []rune, n int) map[string]bool {
+	grams := make(map[string]bool)
+	for i := 0; i+n <= len(runes); i++ {
+		grams[string(runes[i:i+n])] = true
+	}
+	return grams
+}
+
+// similarity คือ Jaccard ของสองเซต (จำนวนที่ซ้ำกัน / จำนวนทั้งหมด)
+func similarity(a, b map[string]bool) float64 {
+	common := countCommon(a, b)
+	if union := len(a) + len(b) - common; union > 0 {
+		return float64(common) / float64(union)
+	}
+	return 0
+}
+
+// containment คือสัดส่วนของ a ที่อยู่ใน b ใช้กับคำค้นที่สั้นกว่าข้อความ
+func containment(a, b map[string]bool) float64 {
+	if len(a) == 0 {
+		return 0
+	}
+	return float64(countCommon(a, b)) / float64(len(a))
+}
+
+func countCommon(a, b map[string]bool) int {
+	var common int
+	for gram := range a {
+		if b[gram] {
+			common++
+		}
+	}
+	return common
+}
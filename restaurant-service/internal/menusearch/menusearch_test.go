@@ -0,0 +1,161 @@
+package menusearch
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "tone marks are dropped", in: "ต้มยำกุ้ง", want: "ตมยำกุง"},
+		{name: "all four tone marks", in: "ก่ก้ก๊ก๋", want: "กกกก"},
+		{name: "vowels above and below are kept", in: "กิ๊ฟ ปู", want: "กิฟ ปู"},
+		{name: "nikhahit and sara aa become sara am", in: "นํา", want: "นำ"},
+		{name: "tone typed between nikhahit and sara aa", in: "นํ้า", want: "นำ"},
+		{name: "latin is lower-cased", in: "Pad THAI", want: "pad thai"},
+		{name: "zero-width characters are removed", in: "ผัด\u200bไทย\ufeff", want: "ผัดไทย"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalize(tt.in); got != tt.want {
+				t.Errorf("normalize(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want []string
+	}{
+		{name: "thai run is one token", in: "ขาวผัดกุง", want: []string{"ขาวผัดกุง"}},
+		{name: "combining vowels stay in the token", in: "กิฟ ปู", want: []string{"กิฟ", "ปู"}},
+		{name: "latin words split on spaces and punctuation", in: "fried-rice (large)", want: []string{"fried", "rice", "large"}},
+		{name: "script change splits tokens", in: "ชาเยนxl", want: []string{"ชาเยน", "xl"}},
+		{name: "numbers are kept", in: "set 2 คน", want: []string{"set", "2", "คน"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tokenize(tt.in); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("tokenize(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScoreMatches(t *testing.T) {
+	tests := []struct {
+		name   string
+		query  string
+		fields []Field
+		want   bool
+	}{
+		{name: "thai without tone marks", query: "ตมยำ", fields: []Field{{Text: "ต้มยำกุ้ง", Weight: 1}}, want: true},
+		{name: "thai with the wrong tone mark", query: "ต๊มยำ", fields: []Field{{Text: "ต้มยำกุ้ง", Weight: 1}}, want: true},
+		{name: "thai sara am typed as nikhahit, tone, sara aa", query: "นํ้าแข็ง", fields: []Field{{Text: "น้ำแข็งใส", Weight: 1}}, want: true},
+		{name: "thai infix", query: "กุ้ง", fields: []Field{{Text: "ต้มยำกุ้ง", Weight: 1}}, want: true},
+		{name: "thai with a missing consonant", query: "ผัดกะเพา", fields: []Field{{Text: "ผัดกะเพราไก่", Weight: 1}}, want: true},
+		{name: "unrelated thai", query: "ส้มตำ", fields: []Field{{Text: "ข้าวผัด", Weight: 1}}, want: false},
+		{name: "single thai rune inside the text", query: "ผ", fields: []Field{{Text: "ผัดไทย", Weight: 1}}, want: true},
+		{name: "single thai rune not in the text", query: "ฮ", fields: []Field{{Text: "ผัดไทย", Weight: 1}}, want: false},
+		{name: "english prefix", query: "fri", fields: []Field{{Text: "Fried Rice", Weight: 1}}, want: true},
+		{name: "english infix", query: "ice", fields: []Field{{Text: "Fried Rice", Weight: 1}}, want: true},
+		{name: "english typo with a missing letter", query: "chiken", fields: []Field{{Text: "Chicken Rice", Weight: 1}}, want: true},
+		{name: "english typo in a longer name", query: "nodle", fields: []Field{{Text: "Beef Noodle Soup", Weight: 1}}, want: true},
+		{name: "unrelated english", query: "pizza", fields: []Field{{Text: "Fried Rice", Weight: 1}}, want: false},
+		{name: "english query matches the english name of a thai dish", query: "kung", fields: []Field{{Text: "ต้มยำกุ้ง", Weight: 1}, {Text: "Tom Yum Kung", Weight: 1}}, want: true},
+		{name: "thai query matches the thai name of a dish", query: "กุ้ง", fields: []Field{{Text: "ต้มยำกุ้ง", Weight: 1}, {Text: "Tom Yum Kung", Weight: 1}}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score := NewQuery(tt.query).Score(tt.fields...)
+			if got := score >= MinScore; got != tt.want {
+				t.Errorf("Score(%q) = %.3f, want match: %v", tt.query, score, tt.want)
+			}
+		})
+	}
+}
+
+func TestScoreEmptyQuery(t *testing.T) {
+	q := NewQuery(" ,- ")
+	if !q.Empty() {
+		t.Fatal("Empty() = false, want true")
+	}
+	if score := q.Score(Field{Text: "Fried Rice", Weight: 1}); score != 0 {
+		t.Errorf("Score() = %v, want 0", score)
+	}
+}
+
+func TestScoreRanking(t *testing.T) {
+	type menu struct {
+		name        string
+		description string
+	}
+	tests := []struct {
+		name  string
+		query string
+		menus []menu
+		want  []string // เรียงคะแนนจากมากไปน้อย ไม่รวมเมนูที่ต่ำกว่า MinScore
+	}{
+		{
+			name:  "whole phrase in the name beats words spread across fields, infix and unrelated",
+			query: "fried rice",
+			menus: []menu{
+				{name: "Licorice Tea"},
+				{name: "Pizza"},
+				{name: "Fried Noodle"},
+				{name: "Rice", description: "Steamed jasmine rice with a fried egg"},
+				{name: "Fried Rice"},
+			},
+			want: []string{"Fried Rice", "Rice", "Fried Noodle", "Licorice Tea"},
+		},
+		{
+			name:  "exact thai beats fuzzy thai",
+			query: "กะเพรา",
+			menus: []menu{
+				{name: "ผัดกะเพาหมู"},
+				{name: "ต้มยำกุ้ง"},
+				{name: "ผัดกะเพราไก่"},
+			},
+			want: []string{"ผัดกะเพราไก่", "ผัดกะเพาหมู"},
+		},
+		{
+			name:  "name match beats description match",
+			query: "egg",
+			menus: []menu{
+				{name: "Fried Rice", description: "with egg"},
+				{name: "Egg Fried Rice"},
+			},
+			want: []string{"Egg Fried Rice", "Fried Rice"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := NewQuery(tt.query)
+			scores := make(map[string]float64)
+			var got []string
+			for _, m := range tt.menus {
+				score := q.Score(Field{Text: m.name, Weight: 1}, Field{Text: m.description, Weight: 0.5})
+				if score >= MinScore {
+					scores[m.name] = score
+					got = append(got, m.name)
+				}
+			}
+			sort.SliceStable(got, func(i, j int) bool { return scores[got[i]] > scores[got[j]] })
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ranking = %q (scores %v), want %q", got, scores, tt.want)
+			}
+		})
+	}
+}
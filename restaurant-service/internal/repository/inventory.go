@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// IngredientUnits หน่วยของวัตถุดิบที่รับ
+var IngredientUnits = []string{"g", "kg", "ml", "l", "piece", "pack"}
+
+// ชนิดของรายการเคลื่อนไหวสต็อก
+const (
+	MovementReceive = "RECEIVE" // รับของเข้า
+	MovementConsume = "CONSUME" // ใช้ไป
+	MovementWaste   = "WASTE"   // เสียหรือทิ้ง
+	MovementCount   = "COUNT"   // ตรวจนับ ตั้งคงเหลือเป็นจำนวนที่นับได้
+	MovementAdjust  = "ADJUST"  // ปรับแก้ บวกหรือลบก็ได้
+)
+
+// Ingredient คือวัตถุดิบ Stock เปลี่ยนได้ผ่าน AdjustStock เท่านั้น
+type Ingredient struct {
+	UUID         uuid.UUID  `gorm:"column:uuid;type:uuid;default:gen_random_uuid();primaryKey" json:"ingredient_id"`
+	NameTH       string     `gorm:"column:name_th;type:varchar(255);not null;unique" json:"name_th"`
+	NameEN       string     `gorm:"column:name_en;type:varchar(255);not null;unique" json:"name_en"`
+	Unit         string     `gorm:"column:unit;type:varchar(16);not null" json:"unit"`
+	Stock        float64    `gorm:"column:stock;type:numeric(14,3);not null" json:"stock"`
+	ReorderLevel float64    `gorm:"column:reorder_level;type:numeric(14,3);not null" json:"reorder_level"` // 0 = ไม่เตือน
+	SupplierID   *uuid.UUID `gorm:"column:supplier_id;type:uuid" json:"supplier_id"`                       // ผู้ขายหลัก nil = ยังไม่กำหนด
+	UnitCost     float64    `gorm:"column:unit_cost;type:numeric(10,2);not null" json:"unit_cost"`         // ราคาต่อหน่วย (บาท)
+	PackSize     float64    `gorm:"column:pack_size;type:numeric(14,3);not null" json:"pack_size"`         // สั่งเป็นจำนวนเต็มของขนาดบรรจุ 0 = สั่งเศษได้
+}
+
+// RecipeLine คือปริมาณวัตถุดิบหนึ่งอย่างที่ใช้ต่อเมนูหนึ่งจาน
+type RecipeLine struct {
+	MenuItemID   uuid.UUID `gorm:"column:menu_item_id;type:uuid;primaryKey" json:"menu_item_id"`
+	IngredientID uuid.UUID `gorm:"column:ingredient_id;type:uuid;primaryKey" json:"ingredient_id"`
+	Quantity     float64   `gorm:"column:quantity;type:numeric(14,3);not null" json:"quantity"`
+	NameTH       string    `gorm:"column:name_th;->" json:"name_th"` // ชื่อวัตถุดิบ อ่านอย่างเดียว
+	NameEN       string    `gorm:"column:name_en;->" json:"name_en"`
+	Unit         string    `gorm:"column:unit;->" json:"unit"`
+}
+
+func (RecipeLine) TableName() string {
+	return "menu_item_ingredients"
+}
+
+// StockMovement คือรายการหนึ่งในสมุดบันทึกสต็อก Quantity เป็นค่าที่เปลี่ยน (ลบ = ลดลง)
+type StockMovement struct {
+	UUID         uuid.UUID `gorm:"column:uuid;type:uuid;default:gen_random_uuid();primaryKey" json:"movement_id"`
+	IngredientID uuid.UUID `gorm:"column:ingredient_id;type:uuid;not null" json:"ingredient_id"`
+	Kind         string    `gorm:"column:kind;type:varchar(16);not null" json:"kind"`
+	Quantity     float64   `gorm:"column:quantity;type:numeric(14,3);not null" json:"quantity"`
+	StockAfter   float64   `gorm:"column:stock_after;type:numeric(14,3);not null" json:"stock_after"`
+	Note         string    `gorm:"column:note;type:text;not null" json:"note"`
+	CreatedAt    time.Time `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+	NameTH       string    `gorm:"column:name_th;->" json:"name_th"` // ชื่อวัตถุดิบ อ่านอย่างเดียว
+}
+
+func (StockMovement) TableName() string {
+	return "ingredient_movements"
+}
+
+// IngredientRequirement ปริมาณวัตถุดิบที่การจองของวันหนึ่งต้องใช้
+type IngredientRequirement struct {
+	IngredientID uuid.UUID
+	Date         string // YYYY-MM-DD ตามเวลาไทย
+	Quantity     float64
+}
+
+var (
+	ErrIngredientNotFound  = errors.New("ingredient not found")
+	ErrIngredientNameTaken = errors.New("ingredient name is already in use")
+	// ErrIngredientInUse ลบวัตถุดิบที่ยังอยู่ในสูตรของเมนูไม่ได้
+	ErrIngredientInUse = errors.New("ingredient is still used in menu item recipes")
+	// ErrInsufficientStock รายการที่ทำให้คงเหลือติดลบ
+	ErrInsufficientStock = errors.New("stock cannot go below zero")
+	// ErrIngredientOrdered ลบวัตถุดิบที่อยู่ในใบสั่งซื้อไม่ได้ เพื่อเก็บประวัติการสั่งซื้อไว้
+	ErrIngredientOrdered = errors.New("ingredient appears on purchase orders")
+)
+
+type InventoryRepository interface {
+	// Ingredient Methods
+	// CreateIngredient ถ้า Stock มากกว่า 0 บันทึกเป็นรายการตรวจนับแรกด้วย
+	CreateIngredient(ctx context.Context, ingredient Ingredient) (Ingredient, error)
+	// UpdateIngredient แก้ชื่อ หน่วย และจุดสั่งซื้อ ไม่เปลี่ยน Stock
+	UpdateIngredient(ctx context.Context, ingredient Ingredient) (Ingredient, error)
+	DeleteIngredient(ctx context.Context, id uuid.UUID) error
+	// GetIngredients เรียงตามชื่อ
+	GetIngredients(ctx context.Context) ([]Ingredient, error)
+	GetIngredientByID(ctx context.Context, id uuid.UUID) (Ingredient, error)
+
+	// Recipe Methods (แทนที่สูตรทั้งหมดของเมนู)
+	SetMenuItemRecipe(ctx context.Context, menuItemID uuid.UUID, lines []RecipeLine) ([]RecipeLine, error)
+	GetMenuItemRecipe(ctx context.Context, menuItemID uuid.UUID) ([]RecipeLine, error)
+
+	// Stock Methods
+	// AdjustStock ปรับคงเหลือและบันทึกรายการใน transaction เดียวกัน
+	// kind COUNT: quantity คือจำนวนที่นับได้ ชนิดอื่น: quantity คือค่าที่เปลี่ยน (ลบ = ลดลง)
+	AdjustStock(ctx context.Context, ingredientID uuid.UUID, kind string, quantity float64, note string) (StockMovement, error)
+	// GetStockMovements ใหม่สุดก่อน ingredientID nil = ทุกวัตถุดิบ
+	GetStockMovements(ctx context.Context, ingredientID *uuid.UUID, limit int) ([]StockMovement, error)
+
+	// GetIngredientRequirements ปริมาณวัตถุดิบที่การจองที่ยืนยันแล้วในช่วง [from, to) ต้องใช้ตามสูตร แยกตามวันที่จอง
+	GetIngredientRequirements(ctx context.Context, from, to time.Time) ([]IngredientRequirement, error)
+}
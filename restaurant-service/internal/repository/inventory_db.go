@@ -0,0 +1,372 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"gitlab.com/final_project1240930/booking_service/internal/logs"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type inventoryRepositoryDB struct {
+	db *gorm.DB
+}
+
+func NewInventoryRepository(db *gorm.DB) InventoryRepository {
+	return &inventoryRepositoryDB{db: db}
+}
+
+// roundQuantity ปัดให้ละเอียดเท่า numeric(14,3) กันเศษทศนิยมสะสมจาก float
+func roundQuantity(quantity float64) float64 {
+	return math.Round(quantity*1000) / 1000
+}
+
+// ---------------- Ingredient ------------------------
+
+// CreateIngredient
+func (r *inventoryRepositoryDB) CreateIngredient(ctx context.Context, ingredient Ingredient) (Ingredient, error) {
+	newID, err := uuid.NewV4()
+	if err != nil {
+		logs.Error("Failed to generate UUID", zap.Error(err))
+		return Ingredient{}, err
+	}
+	ingredient.UUID = newID
+	ingredient.Stock = roundQuantity(ingredient.Stock)
+
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkIngredientTx(tx, ingredient); err != nil {
+			return err
+		}
+		if err := tx.Create(&ingredient).Error; err != nil {
+			return err
+		}
+		if ingredient.Stock == 0 {
+			return nil
+		}
+		return tx.Create(&StockMovement{
+			IngredientID: ingredient.UUID,
+			Kind:         MovementCount,
+			Quantity:     ingredient.Stock,
+			StockAfter:   ingredient.Stock,
+			Note:         "initial stock",
+		}).Error
+	})
+	if err != nil {
+		logs.Error("Failed to create ingredient", zap.Error(err), zap.Any("Ingredient", ingredient))
+		return Ingredient{}, fmt.Errorf("failed to create ingredient: %w", err)
+	}
+
+	logs.Info("Ingredient created successfully", zap.String("ID", ingredient.UUID.String()), zap.String("NameTH", ingredient.NameTH))
+	return ingredient, nil
+}
+
+// UpdateIngredient
+func (r *inventoryRepositoryDB) UpdateIngredient(ctx context.Context, ingredient Ingredient) (Ingredient, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var current Ingredient
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&current, "uuid = ?", ingredient.UUID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrIngredientNotFound
+			}
+			return err
+		}
+		if err := checkIngredientTx(tx, ingredient); err != nil {
+			return err
+		}
+		// คงเหลือเปลี่ยนผ่าน AdjustStock เท่านั้น
+		ingredient.Stock = current.Stock
+		return tx.Save(&ingredient).Error
+	})
+	if err != nil {
+		logs.Error("Failed to update ingredient", zap.Error(err), zap.Any("Ingredient", ingredient))
+		return Ingredient{}, fmt.Errorf("failed to update ingredient: %w", err)
+	}
+	return ingredient, nil
+}
+
+// checkIngredientTx ชื่อต้องไม่ซ้ำกับวัตถุดิบอื่น และผู้ขายต้องมีอยู่จริง
+func checkIngredientTx(tx *gorm.DB, ingredient Ingredient) error {
+	var count int64
+	if err := tx.Model(&Ingredient{}).
+		Where("(name_th = ? OR name_en = ?) AND uuid <> ?", ingredient.NameTH, ingredient.NameEN, ingredient.UUID).
+		Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrIngredientNameTaken
+	}
+
+	if ingredient.SupplierID != nil {
+		if err := tx.Model(&Supplier{}).Where("uuid = ?", *ingredient.SupplierID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count == 0 {
+			return ErrSupplierNotFound
+		}
+	}
+	return nil
+}
+
+// DeleteIngredient ลบได้เฉพาะวัตถุดิบที่ไม่อยู่ในสูตรของเมนูและใบสั่งซื้อ สมุดบันทึกของวัตถุดิบนั้นถูกลบตาม
+func (r *inventoryRepositoryDB) DeleteIngredient(ctx context.Context, id uuid.UUID) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var ingredient Ingredient
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&ingredient, "uuid = ?", id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrIngredientNotFound
+			}
+			return err
+		}
+
+		var count int64
+		if err := tx.Model(&RecipeLine{}).Where("ingredient_id = ?", id).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrIngredientInUse
+		}
+		if err := tx.Model(&PurchaseOrderLine{}).Where("ingredient_id = ?", id).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrIngredientOrdered
+		}
+		return tx.Delete(&ingredient).Error
+	})
+	if err != nil {
+		logs.Error("Failed to delete ingredient", zap.String("ID", id.String()), zap.Error(err))
+		return fmt.Errorf("failed to delete ingredient: %w", err)
+	}
+	return nil
+}
+
+// GetIngredients
+func (r *inventoryRepositoryDB) GetIngredients(ctx context.Context) ([]Ingredient, error) {
+	var ingredients []Ingredient
+	if err := r.db.WithContext(ctx).Order("name_th").Find(&ingredients).Error; err != nil {
+		logs.Error("Failed to get ingredients", zap.Error(err))
+		return nil, fmt.Errorf("failed to get ingredients: %w", err)
+	}
+	return ingredients, nil
+}
+
+// GetIngredientByID
+func (r *inventoryRepositoryDB) GetIngredientByID(ctx context.Context, id uuid.UUID) (Ingredient, error) {
+	var ingredient Ingredient
+	if err := r.db.WithContext(ctx).First(&ingredient, "uuid = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return Ingredient{}, ErrIngredientNotFound
+		}
+		return Ingredient{}, fmt.Errorf("failed to get ingredient: %w", err)
+	}
+	return ingredient, nil
+}
+
+// ---------------- Recipe ------------------------
+
+// SetMenuItemRecipe ลบสูตรเดิมของเมนูแล้วเพิ่มตาม lines
+func (r *inventoryRepositoryDB) SetMenuItemRecipe(ctx context.Context, menuItemID uuid.UUID, lines []RecipeLine) ([]RecipeLine, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var item MenuItem
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&item, "uuid = ?", menuItemID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrMenuItemNotFound
+			}
+			return err
+		}
+
+		if len(lines) > 0 {
+			ids := make([]uuid.UUID, len(lines))
+			for i, line := range lines {
+				ids[i] = line.IngredientID
+			}
+			var count int64
+			if err := tx.Model(&Ingredient{}).Where("uuid IN ?", ids).Count(&count).Error; err != nil {
+				return err
+			}
+			if int(count) != len(lines) {
+				return ErrIngredientNotFound
+			}
+		}
+
+		if err := tx.Where("menu_item_id = ?", menuItemID).Delete(&RecipeLine{}).Error; err != nil {
+			return err
+		}
+		for _, line := range lines {
+			line.MenuItemID = menuItemID
+			line.Quantity = roundQuantity(line.Quantity)
+			if err := tx.Create(&line).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		logs.Error("Failed to set menu item recipe", zap.String("MenuItemID", menuItemID.String()), zap.Error(err))
+		return nil, fmt.Errorf("failed to set menu item recipe: %w", err)
+	}
+	return r.GetMenuItemRecipe(ctx, menuItemID)
+}
+
+// GetMenuItemRecipe เรียงตามชื่อวัตถุดิบ
+func (r *inventoryRepositoryDB) GetMenuItemRecipe(ctx context.Context, menuItemID uuid.UUID) ([]RecipeLine, error) {
+	var item MenuItem
+	if err := r.db.WithContext(ctx).Select("uuid").First(&item, "uuid = ?", menuItemID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrMenuItemNotFound
+		}
+		return nil, fmt.Errorf("failed to get menu item: %w", err)
+	}
+
+	var lines []RecipeLine
+	if err := r.db.WithContext(ctx).
+		Select("menu_item_ingredients.*, i.name_th, i.name_en, i.unit").
+		Joins("JOIN ingredients i ON i.uuid = menu_item_ingredients.ingredient_id").
+		Where("menu_item_ingredients.menu_item_id = ?", menuItemID).
+		Order("i.name_th").
+		Find(&lines).Error; err != nil {
+		logs.Error("Failed to get menu item recipe", zap.Error(err))
+		return nil, fmt.Errorf("failed to get menu item recipe: %w", err)
+	}
+	return lines, nil
+}
+
+// ---------------- Stock ------------------------
+
+// AdjustStock
+func (r *inventoryRepositoryDB) AdjustStock(ctx context.Context, ingredientID uuid.UUID, kind string, quantity float64, note string) (StockMovement, error) {
+	var movement StockMovement
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) (err error) {
+		movement, err = adjustStockTx(tx, ingredientID, kind, quantity, note)
+		return err
+	})
+	if err != nil {
+		logs.Error("Failed to adjust stock", zap.String("IngredientID", ingredientID.String()), zap.String("Kind", kind), zap.Error(err))
+		return StockMovement{}, fmt.Errorf("failed to adjust stock: %w", err)
+	}
+
+	logs.Info("Stock adjusted",
+		zap.String("IngredientID", ingredientID.String()),
+		zap.String("Kind", kind),
+		zap.Float64("Quantity", movement.Quantity),
+		zap.Float64("StockAfter", movement.StockAfter),
+	)
+	return movement, nil
+}
+
+// adjustStockTx ล็อกแถววัตถุดิบไว้จนจบ transaction กันการปรับพร้อมกันทับกัน
+// ใช้ร่วมกับการรับของตามใบสั่งซื้อ
+func adjustStockTx(tx *gorm.DB, ingredientID uuid.UUID, kind string, quantity float64, note string) (StockMovement, error) {
+	var ingredient Ingredient
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&ingredient, "uuid = ?", ingredientID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return StockMovement{}, ErrIngredientNotFound
+		}
+		return StockMovement{}, err
+	}
+
+	stockAfter := roundQuantity(ingredient.Stock + quantity)
+	if kind == MovementCount {
+		stockAfter = roundQuantity(quantity)
+	}
+	if stockAfter < 0 {
+		return StockMovement{}, ErrInsufficientStock
+	}
+
+	movement := StockMovement{
+		IngredientID: ingredientID,
+		Kind:         kind,
+		Quantity:     roundQuantity(stockAfter - ingredient.Stock),
+		StockAfter:   stockAfter,
+		Note:         note,
+		NameTH:       ingredient.NameTH,
+	}
+	if err := tx.Create(&movement).Error; err != nil {
+		return StockMovement{}, err
+	}
+	if err := tx.Model(&ingredient).Update("stock", stockAfter).Error; err != nil {
+		return StockMovement{}, err
+	}
+	return movement, nil
+}
+
+// GetStockMovements
+func (r *inventoryRepositoryDB) GetStockMovements(ctx context.Context, ingredientID *uuid.UUID, limit int) ([]StockMovement, error) {
+	query := r.db.WithContext(ctx).
+		Select("ingredient_movements.*, i.name_th").
+		Joins("JOIN ingredients i ON i.uuid = ingredient_movements.ingredient_id")
+	if ingredientID != nil {
+		query = query.Where("ingredient_movements.ingredient_id = ?", *ingredientID)
+	}
+
+	var movements []StockMovement
+	if err := query.Order("ingredient_movements.created_at DESC").Limit(limit).Find(&movements).Error; err != nil {
+		logs.Error("Failed to get stock movements", zap.Error(err))
+		return nil, fmt.Errorf("failed to get stock movements: %w", err)
+	}
+	return movements, nil
+}
+
+// ---------------- Projection ------------------------
+
+// ingredientRequirementQuery ปริมาณวัตถุดิบตามสูตรของแต่ละการจองที่ยืนยันแล้ว
+const ingredientRequirementQuery = `
+	SELECT b.booking_date_time, r.ingredient_id, SUM(used.quantity * r.quantity) AS quantity
+	FROM (` + bookedMenuItemsQuery + `
+	) used
+	JOIN bookings b ON b.uuid = used.booking_id
+	JOIN menu_item_ingredients r ON r.menu_item_id = used.menu_item_id
+	WHERE b.status = 'CONFIRMED'
+		AND b.booking_date_time >= ?
+		AND b.booking_date_time < ?
+	GROUP BY b.uuid, b.booking_date_time, r.ingredient_id`
+
+// GetIngredientRequirements แบ่งวันตามเวลาไทย เรียงตามวันที่
+func (r *inventoryRepositoryDB) GetIngredientRequirements(ctx context.Context, from, to time.Time) ([]IngredientRequirement, error) {
+	var rows []struct {
+		BookingDateTime time.Time `gorm:"column:booking_date_time"`
+		IngredientID    uuid.UUID `gorm:"column:ingredient_id"`
+		Quantity        float64   `gorm:"column:quantity"`
+	}
+	if err := r.db.WithContext(ctx).Raw(ingredientRequirementQuery, from, to).Scan(&rows).Error; err != nil {
+		logs.Error("Failed to get ingredient requirements", zap.Error(err))
+		return nil, fmt.Errorf("failed to get ingredient requirements: %w", err)
+	}
+
+	bangkok, err := time.LoadLocation("Asia/Bangkok")
+	if err != nil {
+		return nil, fmt.Errorf("could not load Bangkok timezone: %w", err)
+	}
+	type key struct {
+		ingredientID uuid.UUID
+		date         string
+	}
+	totals := make(map[key]float64)
+	for _, row := range rows {
+		k := key{ingredientID: row.IngredientID, date: row.BookingDateTime.In(bangkok).Format("2006-01-02")}
+		totals[k] += row.Quantity
+	}
+
+	requirements := make([]IngredientRequirement, 0, len(totals))
+	for k, quantity := range totals {
+		requirements = append(requirements, IngredientRequirement{
+			IngredientID: k.ingredientID,
+			Date:         k.date,
+			Quantity:     roundQuantity(quantity),
+		})
+	}
+	sort.Slice(requirements, func(i, j int) bool {
+		if requirements[i].Date != requirements[j].Date {
+			return requirements[i].Date < requirements[j].Date
+		}
+		return requirements[i].IngredientID.String() < requirements[j].IngredientID.String()
+	})
+	return requirements, nil
+}
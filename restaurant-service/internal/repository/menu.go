@@ -2,32 +2,131 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/gofrs/uuid"
+	"gitlab.com/final_project1240930/booking_service/internal/imagestore"
+	"gitlab.com/final_project1240930/booking_service/internal/imaging"
 )
 
-type MenuCategory string
+// สารก่อภูมิแพ้ที่มีในเมนู
+const (
+	AllergenPeanut    = "PEANUT"
+	AllergenTreeNut   = "TREE_NUT"
+	AllergenShellfish = "SHELLFISH"
+	AllergenFish      = "FISH"
+	AllergenGluten    = "GLUTEN"
+	AllergenDairy     = "DAIRY"
+	AllergenEgg       = "EGG"
+	AllergenSoy       = "SOY"
+	AllergenSesame    = "SESAME"
+)
+
+// ข้อมูลด้านอาหารที่เมนูเหมาะสม
+const (
+	DietaryHalal      = "HALAL"
+	DietaryVegetarian = "VEGETARIAN"
+	DietaryVegan      = "VEGAN"
+	DietaryGlutenFree = "GLUTEN_FREE"
+	DietaryNoPork     = "NO_PORK"
+)
+
+var (
+	Allergens   = []string{AllergenPeanut, AllergenTreeNut, AllergenShellfish, AllergenFish, AllergenGluten, AllergenDairy, AllergenEgg, AllergenSoy, AllergenSesame}
+	DietaryTags = []string{DietaryHalal, DietaryVegetarian, DietaryVegan, DietaryGlutenFree, DietaryNoPork}
+)
 
+// ประเภทของแท็กในตาราง menu_item_tags
 const (
-	MainCourse MenuCategory = "MAIN_COURSE"
-	Beverage   MenuCategory = "BEVERAGE"
-	Dessert    MenuCategory = "DESSERT"
+	TagKindAllergen = "ALLERGEN"
+	TagKindDietary  = "DIETARY"
 )
 
 type MenuItem struct {
-	UUID        uuid.UUID    `gorm:"column:uuid;type:uuid;default:gen_random_uuid();primaryKey" json:"menu_item_id"`
-	NameTH      string       `gorm:"type:varchar(255);not null;unique" json:"name_th"`
-	NameEN      string       `gorm:"type:varchar(255);not null;unique" json:"name_en"`
-	Description string       `gorm:"type:text" json:"description"`
-	Price       float64      `gorm:"type:decimal(10,2);not null" json:"price"`
-	Category    MenuCategory `gorm:"type:menu_category;not null" json:"category"`
-	ImageURL    string       `gorm:"type:varchar(255)" json:"image_url"`
+	UUID        uuid.UUID `gorm:"column:uuid;type:uuid;default:gen_random_uuid();primaryKey" json:"menu_item_id"`
+	NameTH      string    `gorm:"type:varchar(255);not null;unique" json:"name_th"`
+	NameEN      string    `gorm:"type:varchar(255);not null;unique" json:"name_en"`
+	Description string    `gorm:"type:text" json:"description"`
+	Price       float64   `gorm:"type:decimal(10,2);not null" json:"price"`
+	CategoryID  uuid.UUID `gorm:"column:category_id;type:uuid;not null" json:"category_id"`
+	ImageURL    string    `gorm:"type:varchar(255)" json:"image_url"`                  // รูปขนาด full
+	ImageKey    string    `gorm:"column:image_key;type:varchar(255)" json:"image_key"` // key ของรูป full ในที่เก็บรูปภาพ (Cloudinary public ID)
+
+	// รูปขนาดย่อ ว่างสำหรับรูปที่อัปโหลดก่อนมีการย่อรูป
+	ImageThumbnailURL string `gorm:"column:image_thumbnail_url;type:varchar(255)" json:"image_thumbnail_url"`
+	ImageCardURL      string `gorm:"column:image_card_url;type:varchar(255)" json:"image_card_url"`
+
+	IsAvailable bool  `gorm:"column:is_available;not null" json:"is_available"` // false = ปิดขายชั่วคราว
+	DailyLimit  int32 `gorm:"column:daily_limit;not null" json:"daily_limit"`   // จำนวนที่ขายได้ต่อวัน 0 = ไม่จำกัด
+
+	ArchivedAt *time.Time `gorm:"column:archived_at" json:"archived_at"` // nil = ยังขายอยู่ ไม่ nil = เลิกขายแล้ว (ไม่แสดงในรายการเมนู)
+}
+
+// MenuCategory คือหมวดหมู่ของเมนู หมวดหมู่ย่อยมี ParentID ชี้ไปที่หมวดหมู่แม่
+type MenuCategory struct {
+	UUID      uuid.UUID  `gorm:"column:uuid;type:uuid;default:gen_random_uuid();primaryKey" json:"category_id"`
+	NameTH    string     `gorm:"column:name_th;type:varchar(255);not null;unique" json:"name_th"`
+	NameEN    string     `gorm:"column:name_en;type:varchar(255);not null;unique" json:"name_en"`
+	SortOrder int32      `gorm:"column:sort_order;not null" json:"sort_order"` // เรียงน้อยไปมาก
+	ParentID  *uuid.UUID `gorm:"column:parent_id;type:uuid" json:"parent_id"`  // nil = หมวดหมู่ระดับบนสุด
+}
+
+func (MenuCategory) TableName() string {
+	return "menu_categories"
+}
+
+// CategoryDescendants คืน ID ของหมวดหมู่ id และหมวดหมู่ย่อยทุกชั้นของมัน
+func CategoryDescendants(categories []MenuCategory, id uuid.UUID) map[uuid.UUID]bool {
+	ids := map[uuid.UUID]bool{id: true}
+	for changed := true; changed; {
+		changed = false
+		for _, category := range categories {
+			if category.ParentID != nil && ids[*category.ParentID] && !ids[category.UUID] {
+				ids[category.UUID] = true
+				changed = true
+			}
+		}
+	}
+	return ids
 }
 
 type MenuSet struct {
-	UUID  uuid.UUID `gorm:"column:uuid;type:uuid;default:gen_random_uuid();primaryKey"`
-	Name  string    `gorm:"type:varchar(255);not null;unique"`
-	Price float64   `gorm:"type:double precision;not null"`
+	UUID       uuid.UUID  `gorm:"column:uuid;type:uuid;default:gen_random_uuid();primaryKey"`
+	Name       string     `gorm:"type:varchar(255);not null;unique"`
+	Price      float64    `gorm:"type:double precision;not null"`
+	ArchivedAt *time.Time `gorm:"column:archived_at"` // nil = ยังขายอยู่
+}
+
+// MenuSetSlot คือช่องเลือกเมนูของเมนูเซ็ต ลูกค้าเลือกเมนูในช่องนี้ Picks จานต่อหนึ่งเซ็ต
+// ถ้ามี Items ต้องเลือกจาก Items เท่านั้น ถ้าไม่มี เลือกเมนูใดก็ได้ใน CategoryID (รวมหมวดหมู่ย่อย)
+type MenuSetSlot struct {
+	UUID       uuid.UUID         `gorm:"column:uuid;type:uuid;default:gen_random_uuid();primaryKey" json:"slot_id"`
+	MenuSetID  uuid.UUID         `gorm:"column:menu_set_id;type:uuid;not null" json:"menu_set_id"`
+	NameTH     string            `gorm:"column:name_th;type:varchar(255);not null" json:"name_th"`
+	NameEN     string            `gorm:"column:name_en;type:varchar(255);not null" json:"name_en"`
+	CategoryID *uuid.UUID        `gorm:"column:category_id;type:uuid" json:"category_id"` // nil = ไม่จำกัดหมวดหมู่
+	Picks      int32             `gorm:"column:picks;not null" json:"picks"`
+	Position   int32             `gorm:"column:position;not null" json:"position"`
+	Items      []MenuSetSlotItem `gorm:"-" json:"items"`
+}
+
+func (MenuSetSlot) TableName() string {
+	return "menu_set_slots"
+}
+
+// MenuSetSlotItem คือเมนูที่เลือกได้ในช่อง Upcharge (บาท) บวกเพิ่มจากราคาเซ็ตต่อหนึ่งจานที่เลือก
+type MenuSetSlotItem struct {
+	SlotID     uuid.UUID `gorm:"column:slot_id;type:uuid;primaryKey" json:"slot_id"`
+	MenuItemID uuid.UUID `gorm:"column:menu_item_id;type:uuid;primaryKey" json:"menu_item_id"`
+	Upcharge   float64   `gorm:"column:upcharge;type:decimal(10,2);not null" json:"upcharge"`
+	Position   int32     `gorm:"column:position;not null" json:"position"`
+	NameTH     string    `gorm:"column:name_th;->" json:"name_th"` // ชื่อเมนู อ่านอย่างเดียว
+	NameEN     string    `gorm:"column:name_en;->" json:"name_en"`
+}
+
+func (MenuSetSlotItem) TableName() string {
+	return "menu_set_slot_items"
 }
 
 type MenuSetItem struct {
@@ -42,6 +141,239 @@ type MenuSetItem struct {
 type Image struct {
 	UUID     uuid.UUID `gorm:"column:uuid;type:uuid;default:gen_random_uuid();primaryKey"`
 	ImageURL string    `gorm:"type:varchar(255);not null"`
+	ImageKey string    `gorm:"-"` // ว่าง = หา key จาก ImageURL
+}
+
+// MaxMenuItemImages จำนวนรูปในแกลเลอรีสูงสุดต่อหนึ่งเมนู
+const MaxMenuItemImages = 10
+
+var (
+	ErrMenuItemNotFound      = errors.New("menu item not found")
+	ErrMenuSetNotFound       = errors.New("menu set not found")
+	ErrMenuItemImageNotFound = errors.New("menu item image not found")
+	ErrTooManyMenuItemImages = errors.New("menu item already has the maximum number of images")
+	// ErrImageOrderMismatch รายการ ID ที่ส่งมาเรียงลำดับต้องเป็นรูปทั้งหมดของเมนูนั้นพอดี
+	ErrImageOrderMismatch = errors.New("image IDs must list every image of the menu item exactly once")
+)
+
+var (
+	ErrMenuCategoryNotFound  = errors.New("menu category not found")
+	ErrMenuCategoryNameTaken = errors.New("menu category name is already in use")
+	// ErrParentCategoryNotFound หมวดหมู่แม่ที่ระบุไม่มีอยู่
+	ErrParentCategoryNotFound = errors.New("parent menu category not found")
+	// ErrMenuCategoryCycle ตั้งหมวดหมู่แม่เป็นตัวเองหรือหมวดหมู่ย่อยของตัวเอง
+	ErrMenuCategoryCycle = errors.New("menu category cannot be placed under itself or its subcategories")
+	// ErrMenuCategoryInUse ลบหมวดหมู่ที่ยังมีเมนู หมวดหมู่ย่อย หรือช่องเลือกของเมนูเซ็ตใช้อยู่ไม่ได้
+	ErrMenuCategoryInUse = errors.New("menu category still has menu items, subcategories or menu set slots")
+)
+
+var (
+	// ErrMenuItemInUse ลบเมนูที่การจองหรือเมนูเซ็ตยังอ้างถึงไม่ได้ ให้เก็บเข้าคลังแทน
+	ErrMenuItemInUse = errors.New("menu item is still referenced by bookings or menu sets; archive it instead")
+	// ErrMenuSetInUse ลบเมนูเซ็ตที่การจองยังอ้างถึงไม่ได้ ให้เก็บเข้าคลังแทน
+	ErrMenuSetInUse = errors.New("menu set is still referenced by bookings; archive it instead")
+)
+
+// ErrSlotItemNotInCategory เมนูในช่องเลือกต้องอยู่ในหมวดหมู่ของช่อง (รวมหมวดหมู่ย่อย)
+var ErrSlotItemNotInCategory = errors.New("menu set slot item is not in the slot's category")
+
+// ErrPriceChangeNotInFuture ราคาที่ตั้งล่วงหน้าต้องมีผลหลังเวลาปัจจุบัน
+var ErrPriceChangeNotInFuture = errors.New("scheduled price change must take effect in the future")
+
+// MenuItemVersion คือข้อมูลเมนูในช่วง [EffectiveFrom, EffectiveTo) EffectiveTo nil = ยังใช้อยู่
+type MenuItemVersion struct {
+	UUID          uuid.UUID  `gorm:"column:uuid;type:uuid;default:gen_random_uuid();primaryKey" json:"version_id"`
+	MenuItemID    uuid.UUID  `gorm:"column:menu_item_id;type:uuid;not null" json:"menu_item_id"`
+	NameTH        string     `gorm:"column:name_th;type:varchar(255);not null" json:"name_th"`
+	NameEN        string     `gorm:"column:name_en;type:varchar(255);not null" json:"name_en"`
+	Description   string     `gorm:"column:description;type:text;not null" json:"description"`
+	Price         float64    `gorm:"column:price;type:decimal(10,2);not null" json:"price"`
+	CategoryID    uuid.UUID  `gorm:"column:category_id;type:uuid" json:"category_id"`
+	EffectiveFrom time.Time  `gorm:"column:effective_from;not null" json:"effective_from"`
+	EffectiveTo   *time.Time `gorm:"column:effective_to" json:"effective_to"`
+}
+
+func (MenuItemVersion) TableName() string {
+	return "menu_item_versions"
+}
+
+// MenuSetVersion คือข้อมูลเมนูเซ็ตในช่วง [EffectiveFrom, EffectiveTo) EffectiveTo nil = ยังใช้อยู่
+type MenuSetVersion struct {
+	UUID          uuid.UUID  `gorm:"column:uuid;type:uuid;default:gen_random_uuid();primaryKey" json:"version_id"`
+	MenuSetID     uuid.UUID  `gorm:"column:menu_set_id;type:uuid;not null" json:"menu_set_id"`
+	Name          string     `gorm:"column:name;type:varchar(255);not null" json:"name"`
+	Price         float64    `gorm:"column:price;type:decimal(10,2);not null" json:"price"`
+	EffectiveFrom time.Time  `gorm:"column:effective_from;not null" json:"effective_from"`
+	EffectiveTo   *time.Time `gorm:"column:effective_to" json:"effective_to"`
+}
+
+func (MenuSetVersion) TableName() string {
+	return "menu_set_versions"
+}
+
+// MenuPrice คือราคาของเมนูหรือเมนูเซ็ตในช่วง [EffectiveFrom, EffectiveTo)
+// ช่วงที่ EffectiveFrom ยังไม่ถึงคือราคาที่ตั้งล่วงหน้า
+type MenuPrice struct {
+	UUID          uuid.UUID  `gorm:"column:uuid;type:uuid;default:gen_random_uuid();primaryKey" json:"price_id"`
+	MenuItemID    *uuid.UUID `gorm:"column:menu_item_id;type:uuid" json:"menu_item_id"`
+	MenuSetID     *uuid.UUID `gorm:"column:menu_set_id;type:uuid" json:"menu_set_id"`
+	Price         float64    `gorm:"column:price;type:decimal(10,2);not null" json:"price"`
+	EffectiveFrom time.Time  `gorm:"column:effective_from;not null" json:"effective_from"`
+	EffectiveTo   *time.Time `gorm:"column:effective_to" json:"effective_to"`
+}
+
+func (MenuPrice) TableName() string {
+	return "menu_price_history"
+}
+
+// MenuItemHistory คือประวัติของเมนู เรียงตาม EffectiveFrom
+type MenuItemHistory struct {
+	Versions []MenuItemVersion
+	Prices   []MenuPrice
+}
+
+// MenuSetHistory คือประวัติของเมนูเซ็ต เรียงตาม EffectiveFrom
+type MenuSetHistory struct {
+	Versions []MenuSetVersion
+	Prices   []MenuPrice
+}
+
+// MenuItemImage คือรูปในแกลเลอรีของเมนู เรียงตาม Position (เริ่มที่ 0)
+type MenuItemImage struct {
+	UUID              uuid.UUID `gorm:"column:uuid;type:uuid;default:gen_random_uuid();primaryKey" json:"image_id"`
+	MenuItemID        uuid.UUID `gorm:"column:menu_item_id;type:uuid;not null" json:"menu_item_id"`
+	Position          int32     `gorm:"column:position;not null" json:"position"`
+	AltTH             string    `gorm:"column:alt_th;type:varchar(255)" json:"alt_th"`
+	AltEN             string    `gorm:"column:alt_en;type:varchar(255)" json:"alt_en"`
+	ImageURL          string    `gorm:"column:image_url;type:varchar(255);not null" json:"image_url"`
+	ImageKey          string    `gorm:"column:image_key;type:varchar(255)" json:"image_key"`
+	ImageThumbnailURL string    `gorm:"column:image_thumbnail_url;type:varchar(255)" json:"image_thumbnail_url"`
+	ImageCardURL      string    `gorm:"column:image_card_url;type:varchar(255)" json:"image_card_url"`
+	CreatedAt         time.Time `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+}
+
+func (MenuItemImage) TableName() string {
+	return "menu_item_images"
+}
+
+// MenuItemTag คือแท็กสารก่อภูมิแพ้หรือข้อมูลด้านอาหารหนึ่งรายการของเมนู
+type MenuItemTag struct {
+	MenuItemID uuid.UUID `gorm:"column:menu_item_id;type:uuid;primaryKey"`
+	Kind       string    `gorm:"column:kind;type:varchar(10);primaryKey"`
+	Tag        string    `gorm:"column:tag;type:varchar(30);primaryKey"`
+}
+
+func (MenuItemTag) TableName() string {
+	return "menu_item_tags"
+}
+
+// MenuTags คือแท็กของเมนูหรือเมนูเซ็ต เรียงตามลำดับใน Allergens/DietaryTags
+type MenuTags struct {
+	Allergens   []string `json:"allergens"`
+	DietaryTags []string `json:"dietary_tags"`
+}
+
+// MenuSchedule คือช่วงเวลาที่สั่งเมนูหรือเมนูเซ็ตได้ (เป็นของเมนูหรือเมนูเซ็ตอย่างใดอย่างหนึ่ง)
+// เมนูที่ไม่มี schedule สั่งได้ตลอด ถ้ามีหลายอัน ตรงอันใดอันหนึ่งก็สั่งได้
+// เวลาทั้งหมดดูตามเวลาไทย
+type MenuSchedule struct {
+	UUID        uuid.UUID  `gorm:"column:uuid;type:uuid;default:gen_random_uuid();primaryKey" json:"schedule_id"`
+	MenuItemID  *uuid.UUID `gorm:"column:menu_item_id;type:uuid" json:"menu_item_id"`
+	MenuSetID   *uuid.UUID `gorm:"column:menu_set_id;type:uuid" json:"menu_set_id"`
+	DaysOfWeek  int16      `gorm:"column:days_of_week;not null" json:"days_of_week"` // bitmask, bit 0 = อาทิตย์ ... bit 6 = เสาร์, 0 = ทุกวัน
+	StartMinute int16      `gorm:"column:start_minute;not null" json:"start_minute"` // นาทีนับจากเที่ยงคืน (รวม)
+	EndMinute   int16      `gorm:"column:end_minute;not null" json:"end_minute"`     // นาทีนับจากเที่ยงคืน (ไม่รวม) สูงสุด 1440, น้อยกว่า StartMinute = ข้ามเที่ยงคืน
+	ValidFrom   *time.Time `gorm:"column:valid_from;type:date" json:"valid_from"`    // nil = ไม่จำกัด
+	ValidUntil  *time.Time `gorm:"column:valid_until;type:date" json:"valid_until"`  // nil = ไม่จำกัด (รวมวันสุดท้าย)
+}
+
+func (MenuSchedule) TableName() string {
+	return "menu_schedules"
+}
+
+// ActiveAt บอกว่า t (เวลาไทย) อยู่ในช่วงของ schedule นี้หรือไม่
+// ช่วงที่ข้ามเที่ยงคืน (เช่น 22:00-02:00) ส่วนหลังเที่ยงคืนนับเป็นของวันก่อนหน้า
+func (s MenuSchedule) ActiveAt(t time.Time) bool {
+	minute := int16(t.Hour()*60 + t.Minute())
+	if s.StartMinute > s.EndMinute {
+		if minute >= s.StartMinute {
+			return s.onDay(t)
+		}
+		return minute < s.EndMinute && s.onDay(t.AddDate(0, 0, -1))
+	}
+	return minute >= s.StartMinute && minute < s.EndMinute && s.onDay(t)
+}
+
+// onDay บอกว่า schedule นี้ใช้กับวันของ t หรือไม่ (ช่วงวันที่และวันในสัปดาห์)
+func (s MenuSchedule) onDay(t time.Time) bool {
+	day := t.Format("2006-01-02")
+	if s.ValidFrom != nil && day < s.ValidFrom.Format("2006-01-02") {
+		return false
+	}
+	if s.ValidUntil != nil && day > s.ValidUntil.Format("2006-01-02") {
+		return false
+	}
+	return s.DaysOfWeek == 0 || s.DaysOfWeek&(1<<t.Weekday()) != 0
+}
+
+// ScheduledAt บอกว่าเมนูที่มี schedules เหล่านี้สั่งได้ในเวลา t หรือไม่
+func ScheduledAt(schedules []MenuSchedule, t time.Time) bool {
+	if len(schedules) == 0 {
+		return true
+	}
+	for _, schedule := range schedules {
+		if schedule.ActiveAt(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// ประเภทการเลือกของกลุ่มตัวเลือก
+const (
+	ModifierSelectionSingle = "SINGLE"
+	ModifierSelectionMulti  = "MULTI"
+)
+
+// MenuModifierGroup คือกลุ่มตัวเลือกของเมนู เช่น ระดับความเผ็ด หรือท็อปปิ้งเพิ่ม
+// SINGLE เลือกได้หนึ่งอย่าง (MinSelect 1 = บังคับเลือก) ส่วน MULTI เลือกได้ MinSelect ถึง MaxSelect อย่าง
+type MenuModifierGroup struct {
+	UUID          uuid.UUID            `gorm:"column:uuid;type:uuid;default:gen_random_uuid();primaryKey" json:"modifier_group_id"`
+	MenuItemID    uuid.UUID            `gorm:"column:menu_item_id;type:uuid;not null" json:"menu_item_id"`
+	NameTH        string               `gorm:"column:name_th;type:varchar(255);not null" json:"name_th"`
+	NameEN        string               `gorm:"column:name_en;type:varchar(255);not null" json:"name_en"`
+	SelectionType string               `gorm:"column:selection_type;type:varchar(10);not null" json:"selection_type"`
+	MinSelect     int32                `gorm:"column:min_select;not null" json:"min_select"`
+	MaxSelect     int32                `gorm:"column:max_select;not null" json:"max_select"`
+	Position      int32                `gorm:"column:position;not null" json:"position"`
+	Options       []MenuModifierOption `gorm:"-" json:"options"`
+}
+
+func (MenuModifierGroup) TableName() string {
+	return "menu_modifier_groups"
+}
+
+// MenuModifierOption คือตัวเลือกหนึ่งอย่างในกลุ่ม PriceDelta (บาท) บวกเพิ่มจากราคาเมนูต่อจาน ติดลบได้
+type MenuModifierOption struct {
+	UUID       uuid.UUID `gorm:"column:uuid;type:uuid;default:gen_random_uuid();primaryKey" json:"modifier_option_id"`
+	GroupID    uuid.UUID `gorm:"column:group_id;type:uuid;not null" json:"group_id"`
+	NameTH     string    `gorm:"column:name_th;type:varchar(255);not null" json:"name_th"`
+	NameEN     string    `gorm:"column:name_en;type:varchar(255);not null" json:"name_en"`
+	PriceDelta float64   `gorm:"column:price_delta;type:decimal(10,2);not null" json:"price_delta"`
+	Position   int32     `gorm:"column:position;not null" json:"position"`
+}
+
+func (MenuModifierOption) TableName() string {
+	return "menu_modifier_options"
+}
+
+// ImageVariants คือ URL ของรูปแต่ละขนาดที่ได้จากการอัปโหลดหนึ่งครั้ง
+// Key คือ key ของรูป full ในที่เก็บรูปภาพ ใช้ลบรูปทุกขนาดภายหลัง
+type ImageVariants struct {
+	ThumbnailURL string `json:"thumbnail_url"`
+	CardURL      string `json:"card_url"`
+	FullURL      string `json:"full_url"`
+	Key          string `json:"key"`
 }
 
 type MenuSetItemDetails struct {
@@ -58,23 +390,90 @@ type MenuSetItemDetails struct {
 
 type MenuRepository interface {
 	// Image Methods
-	UploadImage(ctx context.Context, imageData []byte, fileName string) (string, error)
+	UploadImage(ctx context.Context, images []imaging.Result) (ImageVariants, error)
 	DeleteImage(ctx context.Context, image Image) error
+	FindOrphanImages(ctx context.Context, uploadedBefore time.Time) ([]imagestore.StoredImage, error)
 
 	// Menu Item Methods
 	CreateMenuItem(ctx context.Context, item MenuItem) (uuid.UUID, error)
 	UpdateMenuItem(ctx context.Context, item MenuItem) error
+	// DeleteMenuItem ลบถาวร คืน ErrMenuItemInUse ถ้าการจองหรือเมนูเซ็ตยังอ้างถึง
 	DeleteMenuItem(ctx context.Context, id uuid.UUID) error
+	// ArchiveMenuItem / RestoreMenuItem เก็บเมนูเข้าคลังหรือนำกลับมาขาย
+	ArchiveMenuItem(ctx context.Context, id uuid.UUID) error
+	RestoreMenuItem(ctx context.Context, id uuid.UUID) error
+	// GetMenuItems คืนทุกเมนูรวมที่เก็บเข้าคลังแล้ว
 	GetMenuItems(ctx context.Context) ([]MenuItem, error)
 	GetMenuItemByID(ctx context.Context, id uuid.UUID) (MenuItem, error)
 
+	// Menu Category Methods
+	CreateMenuCategory(ctx context.Context, category MenuCategory) (MenuCategory, error)
+	UpdateMenuCategory(ctx context.Context, category MenuCategory) (MenuCategory, error)
+	DeleteMenuCategory(ctx context.Context, id uuid.UUID) error
+	// GetMenuCategories เรียงตาม sort_order แล้วตามชื่อ
+	GetMenuCategories(ctx context.Context) ([]MenuCategory, error)
+	GetMenuCategoryByID(ctx context.Context, id uuid.UUID) (MenuCategory, error)
+
+	// Menu Item Image Methods (แกลเลอรี)
+	AddMenuItemImage(ctx context.Context, image MenuItemImage) (MenuItemImage, error)
+	GetMenuItemImages(ctx context.Context, menuItemIDs []uuid.UUID) ([]MenuItemImage, error)
+	ReorderMenuItemImages(ctx context.Context, menuItemID uuid.UUID, imageIDs []uuid.UUID) ([]MenuItemImage, error)
+	RemoveMenuItemImage(ctx context.Context, menuItemID, imageID uuid.UUID) (MenuItemImage, error)
+
+	// Menu Item Availability Methods
+	SetMenuItemAvailability(ctx context.Context, id uuid.UUID, isAvailable bool, dailyLimit int32) error
+	// GetMenuItemUsage คืนจำนวนที่ถูกสั่งในการจองที่ยืนยันแล้วของวันที่ date (YYYY-MM-DD) แยกตามเมนู
+	GetMenuItemUsage(ctx context.Context, date string, menuItemIDs []uuid.UUID) (map[uuid.UUID]int32, error)
+
+	// Menu Tag Methods
+	SetMenuItemTags(ctx context.Context, menuItemID uuid.UUID, tags MenuTags) error
+	GetMenuItemTags(ctx context.Context, menuItemIDs []uuid.UUID) (map[uuid.UUID]MenuTags, error)
+	// GetMenuSetTags สารก่อภูมิแพ้ของเซ็ตคือทุกอย่างที่มีในเมนูของเซ็ต (รวมเมนูที่ระบุไว้ในช่องเลือก)
+	// ส่วนข้อมูลด้านอาหารต้องเป็นของทุกเมนูในเซ็ต (เช่น เซ็ตฮาลาลเมื่อทุกเมนูฮาลาล)
+	GetMenuSetTags(ctx context.Context, menuSetIDs []uuid.UUID) (map[uuid.UUID]MenuTags, error)
+
+	// Menu Schedule Methods (แทนที่ schedule ทั้งหมดของเมนู/เมนูเซ็ต)
+	SetMenuItemSchedules(ctx context.Context, menuItemID uuid.UUID, schedules []MenuSchedule) ([]MenuSchedule, error)
+	SetMenuSetSchedules(ctx context.Context, menuSetID uuid.UUID, schedules []MenuSchedule) ([]MenuSchedule, error)
+	GetMenuItemSchedules(ctx context.Context, menuItemIDs []uuid.UUID) ([]MenuSchedule, error)
+	GetMenuSetSchedules(ctx context.Context, menuSetIDs []uuid.UUID) ([]MenuSchedule, error)
+
+	// Menu Modifier Methods (แทนที่กลุ่มตัวเลือกทั้งหมดของเมนู)
+	SetMenuItemModifiers(ctx context.Context, menuItemID uuid.UUID, groups []MenuModifierGroup) ([]MenuModifierGroup, error)
+	// GetMenuItemModifiers เรียงกลุ่มและตัวเลือกตาม position
+	GetMenuItemModifiers(ctx context.Context, menuItemIDs []uuid.UUID) (map[uuid.UUID][]MenuModifierGroup, error)
+
+	// Menu History Methods
+	GetMenuItemHistory(ctx context.Context, menuItemID uuid.UUID) (MenuItemHistory, error)
+	GetMenuSetHistory(ctx context.Context, menuSetID uuid.UUID) (MenuSetHistory, error)
+	// ScheduleMenuItemPrice ตั้งราคาที่มีผลตั้งแต่ effectiveFrom (ต้องเป็นเวลาในอนาคต) ถ้ามีราคาที่ตั้งไว้เวลาเดียวกันจะแทนที่
+	ScheduleMenuItemPrice(ctx context.Context, menuItemID uuid.UUID, price float64, effectiveFrom time.Time) error
+	ScheduleMenuSetPrice(ctx context.Context, menuSetID uuid.UUID, price float64, effectiveFrom time.Time) error
+	// ApplyScheduledPrices ปรับราคาของเมนูและเมนูเซ็ตให้ตรงกับราคาที่มีผลอยู่ ณ เวลา now คืนจำนวนรายการที่เปลี่ยน
+	ApplyScheduledPrices(ctx context.Context, now time.Time) (int, error)
+
 	// Menu Set Methods
 	CreateMenuSet(ctx context.Context, menuSet MenuSet) (uuid.UUID, error)
 	UpdateMenuSet(ctx context.Context, set MenuSet) error
+	// DeleteMenuSet ลบถาวร คืน ErrMenuSetInUse ถ้าการจองยังอ้างถึง
 	DeleteMenuSet(ctx context.Context, id uuid.UUID) error
+	ArchiveMenuSet(ctx context.Context, id uuid.UUID) error
+	RestoreMenuSet(ctx context.Context, id uuid.UUID) error
+	// GetMenuSets คืนทุกเซ็ตรวมที่เก็บเข้าคลังแล้ว
 	GetMenuSets(ctx context.Context) ([]MenuSet, error)
 	GetMenuSetByID(ctx context.Context, id uuid.UUID) (MenuSet, error)
 
+	// Menu Set Slot Methods (แทนที่ช่องเลือกทั้งหมดของเมนูเซ็ต)
+	SetMenuSetSlots(ctx context.Context, menuSetID uuid.UUID, slots []MenuSetSlot) ([]MenuSetSlot, error)
+	// GetMenuSetSlots เรียงช่องและเมนูในช่องตาม position
+	GetMenuSetSlots(ctx context.Context, menuSetIDs []uuid.UUID) (map[uuid.UUID][]MenuSetSlot, error)
+
+	// Menu Catalog Methods (นำออก/นำเข้าเมนูทั้งร้าน ดู MenuCatalog)
+	ExportMenuCatalog(ctx context.Context) (MenuCatalog, error)
+	// ImportMenuCatalog เพิ่มหรือแก้ตามชื่อในหนึ่ง transaction คืน ErrInvalidMenuCatalog ถ้าข้อมูลอ้างถึงกันไม่ครบ
+	// dryRun = คำนวณผลแล้ว rollback ไม่บันทึกอะไร
+	ImportMenuCatalog(ctx context.Context, catalog MenuCatalog, dryRun bool) ([]MenuImportChange, error)
+
 	// // Menu Set Item Methods
 	CreateMenuSetItems(ctx context.Context, menuSetID uuid.UUID, menuItemIDs []uuid.UUID) error
 	UpdateMenuSetItems(ctx context.Context, menuSetID uuid.UUID, menuItemIDs []uuid.UUID) error
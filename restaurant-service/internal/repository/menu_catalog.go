@@ -0,0 +1,67 @@
+package repository
+
+import "errors"
+
+// MenuCatalog คือเมนูทั้งร้านสำหรับนำออกและนำเข้า อ้างถึงกันด้วยชื่อแทน ID เพื่อย้ายข้ามระบบได้
+// หมวดหมู่และเมนูใช้ name_en ส่วนเมนูเซ็ตใช้ name
+type MenuCatalog struct {
+	Categories []CatalogCategory `json:"categories"`
+	Items      []CatalogItem     `json:"items"`
+	Sets       []CatalogSet      `json:"sets"`
+}
+
+type CatalogCategory struct {
+	NameTH       string `json:"name_th"`
+	NameEN       string `json:"name_en"`
+	SortOrder    int32  `json:"sort_order"`
+	ParentNameEN string `json:"parent_name_en"` // ว่าง = หมวดหมู่ระดับบนสุด
+}
+
+type CatalogItem struct {
+	NameTH         string   `json:"name_th"`
+	NameEN         string   `json:"name_en"`
+	Description    string   `json:"description"`
+	Price          float64  `json:"price"`
+	CategoryNameEN string   `json:"category_name_en"`
+	IsAvailable    bool     `json:"is_available"`
+	DailyLimit     int32    `json:"daily_limit"`
+	Tags           MenuTags `json:"tags"`
+	Archived       bool     `json:"archived"`
+
+	// Image.FullURL ว่าง = ไม่เปลี่ยนรูปเดิม (เมนูใหม่ไม่มีรูป) ต้องเป็นรูปในที่เก็บรูปภาพของร้านเท่านั้น
+	Image ImageVariants `json:"image"`
+	// ImageFile ชื่อไฟล์รูปในไฟล์ zip ที่นำเข้า ถ้ามีแต่ Image ว่าง (ทดลองนำเข้า) ถือว่ารูปจะเปลี่ยน
+	ImageFile string `json:"image_file"`
+}
+
+type CatalogSet struct {
+	Name        string   `json:"name"`
+	Price       float64  `json:"price"`
+	ItemNamesEN []string `json:"item_names_en"` // เมนูในเซ็ต (menu_set_items)
+	Archived    bool     `json:"archived"`
+}
+
+// ประเภทของรายการใน MenuImportChange
+const (
+	CatalogKindCategory = "CATEGORY"
+	CatalogKindItem     = "ITEM"
+	CatalogKindSet      = "SET"
+)
+
+// ผลของการนำเข้าแต่ละรายการ
+const (
+	ImportActionCreate    = "CREATE"
+	ImportActionUpdate    = "UPDATE"
+	ImportActionUnchanged = "UNCHANGED"
+)
+
+// MenuImportChange คือผลการนำเข้าหนึ่งรายการ Fields คือชื่อฟิลด์ที่เปลี่ยน (เฉพาะ UPDATE)
+type MenuImportChange struct {
+	Kind   string   `json:"kind"`
+	Name   string   `json:"name"`
+	Action string   `json:"action"`
+	Fields []string `json:"fields"`
+}
+
+// ErrInvalidMenuCatalog ข้อมูลที่นำเข้าอ้างถึงสิ่งที่ไม่มี ชื่อซ้ำ หรือหมวดหมู่วนกัน
+var ErrInvalidMenuCatalog = errors.New("invalid menu catalog")
@@ -0,0 +1,529 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"gitlab.com/final_project1240930/booking_service/internal/logs"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// errMenuImportDryRun ใช้ rollback transaction ของการทดลองนำเข้าหลังคำนวณผลเสร็จแล้ว
+var errMenuImportDryRun = errors.New("menu import dry run")
+
+// ---------------- Menu Catalog ------------------------
+
+// ExportMenuCatalog คืนหมวดหมู่ เมนู และเมนูเซ็ตทั้งหมด รวมที่เก็บเข้าคลังแล้ว
+func (r *menuRepositoryDB) ExportMenuCatalog(ctx context.Context) (MenuCatalog, error) {
+	db := r.db.WithContext(ctx)
+	catalog := MenuCatalog{Categories: []CatalogCategory{}, Items: []CatalogItem{}, Sets: []CatalogSet{}}
+
+	var categories []MenuCategory
+	if err := db.Order("sort_order, name_en").Find(&categories).Error; err != nil {
+		logs.Error("Failed to export menu categories", zap.Error(err))
+		return MenuCatalog{}, fmt.Errorf("failed to export menu categories: %w", err)
+	}
+	categoryNames := make(map[uuid.UUID]string, len(categories))
+	for _, category := range categories {
+		categoryNames[category.UUID] = category.NameEN
+	}
+	for _, category := range categories {
+		entry := CatalogCategory{NameTH: category.NameTH, NameEN: category.NameEN, SortOrder: category.SortOrder}
+		if category.ParentID != nil {
+			entry.ParentNameEN = categoryNames[*category.ParentID]
+		}
+		catalog.Categories = append(catalog.Categories, entry)
+	}
+
+	var items []MenuItem
+	if err := db.Order("name_en").Find(&items).Error; err != nil {
+		logs.Error("Failed to export menu items", zap.Error(err))
+		return MenuCatalog{}, fmt.Errorf("failed to export menu items: %w", err)
+	}
+	itemIDs := make([]uuid.UUID, len(items))
+	itemNames := make(map[uuid.UUID]string, len(items))
+	for i, item := range items {
+		itemIDs[i] = item.UUID
+		itemNames[item.UUID] = item.NameEN
+	}
+	tags, err := getMenuItemTags(db, itemIDs)
+	if err != nil {
+		logs.Error("Failed to export menu item tags", zap.Error(err))
+		return MenuCatalog{}, fmt.Errorf("failed to export menu item tags: %w", err)
+	}
+	for _, item := range items {
+		entry := CatalogItem{
+			NameTH:         item.NameTH,
+			NameEN:         item.NameEN,
+			Description:    item.Description,
+			Price:          item.Price,
+			CategoryNameEN: categoryNames[item.CategoryID],
+			IsAvailable:    item.IsAvailable,
+			DailyLimit:     item.DailyLimit,
+			Tags:           tags[item.UUID],
+			Archived:       item.ArchivedAt != nil,
+		}
+		if item.ImageURL != "" && item.ImageURL != "no image" {
+			entry.Image = ImageVariants{
+				FullURL:      item.ImageURL,
+				ThumbnailURL: item.ImageThumbnailURL,
+				CardURL:      item.ImageCardURL,
+			}
+		}
+		catalog.Items = append(catalog.Items, entry)
+	}
+
+	var sets []MenuSet
+	if err := db.Order("name").Find(&sets).Error; err != nil {
+		logs.Error("Failed to export menu sets", zap.Error(err))
+		return MenuCatalog{}, fmt.Errorf("failed to export menu sets: %w", err)
+	}
+	setItems, err := getMenuSetItemIDs(db)
+	if err != nil {
+		logs.Error("Failed to export menu set items", zap.Error(err))
+		return MenuCatalog{}, fmt.Errorf("failed to export menu set items: %w", err)
+	}
+	for _, menuSet := range sets {
+		names := make([]string, 0, len(setItems[menuSet.UUID]))
+		for _, id := range setItems[menuSet.UUID] {
+			names = append(names, itemNames[id])
+		}
+		slices.Sort(names)
+		catalog.Sets = append(catalog.Sets, CatalogSet{
+			Name:        menuSet.Name,
+			Price:       menuSet.Price,
+			ItemNamesEN: names,
+			Archived:    menuSet.ArchivedAt != nil,
+		})
+	}
+
+	return catalog, nil
+}
+
+// getMenuSetItemIDs คืน ID ของเมนูใน menu_set_items แยกตามเซ็ต
+func getMenuSetItemIDs(db *gorm.DB) (map[uuid.UUID][]uuid.UUID, error) {
+	var rows []struct {
+		MenuSetID  uuid.UUID `gorm:"column:menu_set_id"`
+		MenuItemID uuid.UUID `gorm:"column:menu_item_id"`
+	}
+	if err := db.Table("menu_set_items").Select("menu_set_id, menu_item_id").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	result := make(map[uuid.UUID][]uuid.UUID)
+	for _, row := range rows {
+		result[row.MenuSetID] = append(result[row.MenuSetID], row.MenuItemID)
+	}
+	return result, nil
+}
+
+// ImportMenuCatalog เพิ่มหรือแก้หมวดหมู่ เมนู และเมนูเซ็ตตามชื่อในหนึ่ง transaction
+// รายการที่ไม่อยู่ใน catalog ไม่ถูกแตะ ถ้ารายการใดผิดจะไม่มีอะไรถูกบันทึกเลย
+// dryRun ทำทุกขั้นตอนแล้ว rollback เพื่อให้เห็นผลก่อนนำเข้าจริง
+func (r *menuRepositoryDB) ImportMenuCatalog(ctx context.Context, catalog MenuCatalog, dryRun bool) ([]MenuImportChange, error) {
+	var changes []MenuImportChange
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := historyTime(time.Now())
+
+		categoryIDs, categoryChanges, err := importCategoriesTx(tx, catalog.Categories)
+		if err != nil {
+			return err
+		}
+		itemIDs, itemChanges, err := r.importItemsTx(tx, catalog.Items, categoryIDs, now)
+		if err != nil {
+			return err
+		}
+		setChanges, err := importSetsTx(tx, catalog.Sets, itemIDs, now)
+		if err != nil {
+			return err
+		}
+
+		changes = slices.Concat(categoryChanges, itemChanges, setChanges)
+		if dryRun {
+			return errMenuImportDryRun
+		}
+		return nil
+	})
+	if errors.Is(err, errMenuImportDryRun) {
+		return changes, nil
+	}
+	if err != nil {
+		logs.Error("Failed to import menu catalog", zap.Error(err))
+		return nil, fmt.Errorf("failed to import menu catalog: %w", err)
+	}
+
+	logs.Info("Menu catalog imported",
+		zap.Int("Categories", len(catalog.Categories)),
+		zap.Int("Items", len(catalog.Items)),
+		zap.Int("Sets", len(catalog.Sets)),
+	)
+	return changes, nil
+}
+
+// importChange สร้างผลการนำเข้าของรายการที่มีอยู่แล้ว (exists) หรือรายการใหม่
+func importChange(kind, name string, exists bool, fields fieldChanges) MenuImportChange {
+	change := MenuImportChange{Kind: kind, Name: name, Action: ImportActionCreate}
+	if exists {
+		change.Action = ImportActionUnchanged
+		if len(fields) > 0 {
+			change.Action = ImportActionUpdate
+			change.Fields = fields
+		}
+	}
+	return change
+}
+
+// fieldChanges เก็บชื่อฟิลด์ที่เปลี่ยนตามลำดับที่ตรวจ
+type fieldChanges []string
+
+func (f fieldChanges) add(field string, changed bool) fieldChanges {
+	if changed {
+		return append(f, field)
+	}
+	return f
+}
+
+// importCategoriesTx คืน ID ของทุกหมวดหมู่ (รวมที่ไม่อยู่ใน entries) ตาม name_en
+func importCategoriesTx(tx *gorm.DB, entries []CatalogCategory) (map[string]uuid.UUID, []MenuImportChange, error) {
+	var categories []MenuCategory
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Find(&categories).Error; err != nil {
+		return nil, nil, err
+	}
+	current := make(map[string]MenuCategory, len(categories))
+	final := make(map[string]MenuCategory, len(categories)+len(entries))
+	for _, category := range categories {
+		current[category.NameEN] = category
+		final[category.NameEN] = category
+	}
+
+	// สร้าง ID ให้หมวดหมู่ใหม่ก่อน เพื่อให้อ้างเป็นหมวดหมู่แม่ได้ไม่ว่าจะอยู่ลำดับไหนในไฟล์
+	for _, entry := range entries {
+		if _, ok := final[entry.NameEN]; ok {
+			continue
+		}
+		newID, err := uuid.NewV4()
+		if err != nil {
+			return nil, nil, err
+		}
+		final[entry.NameEN] = MenuCategory{UUID: newID}
+	}
+
+	seen := make(map[string]bool, len(entries))
+	changes := make([]MenuImportChange, 0, len(entries))
+	for _, entry := range entries {
+		if seen[entry.NameEN] {
+			return nil, nil, fmt.Errorf("%w: category %q is listed more than once", ErrInvalidMenuCatalog, entry.NameEN)
+		}
+		seen[entry.NameEN] = true
+
+		category := final[entry.NameEN]
+		category.NameTH = entry.NameTH
+		category.NameEN = entry.NameEN
+		category.SortOrder = entry.SortOrder
+		category.ParentID = nil
+		if entry.ParentNameEN != "" {
+			parent, ok := final[entry.ParentNameEN]
+			if !ok {
+				return nil, nil, fmt.Errorf("%w: category %q: parent %q not found", ErrInvalidMenuCatalog, entry.NameEN, entry.ParentNameEN)
+			}
+			category.ParentID = &parent.UUID
+		}
+		final[entry.NameEN] = category
+
+		old, exists := current[entry.NameEN]
+		changes = append(changes, importChange(CatalogKindCategory, entry.NameEN, exists, fieldChanges{}.
+			add("name_th", category.NameTH != old.NameTH).
+			add("sort_order", category.SortOrder != old.SortOrder).
+			add("parent", !sameParent(category.ParentID, old.ParentID))))
+	}
+
+	// ตรวจจากผลลัพธ์สุดท้าย: ชื่อไทยต้องไม่ซ้ำ และหมวดหมู่ต้องไม่วนกัน
+	byID := make(map[uuid.UUID]MenuCategory, len(final))
+	nameTH := make(map[string]string, len(final))
+	for _, category := range final {
+		byID[category.UUID] = category
+		if other, ok := nameTH[category.NameTH]; ok {
+			return nil, nil, fmt.Errorf("%w: categories %q and %q have the same name_th %q", ErrInvalidMenuCatalog, other, category.NameEN, category.NameTH)
+		}
+		nameTH[category.NameTH] = category.NameEN
+	}
+	for _, category := range final {
+		parentID := category.ParentID
+		for steps := 0; parentID != nil && steps < len(byID); steps++ {
+			if *parentID == category.UUID {
+				return nil, nil, fmt.Errorf("%w: category %q: %v", ErrInvalidMenuCatalog, category.NameEN, ErrMenuCategoryCycle)
+			}
+			parentID = byID[*parentID].ParentID
+		}
+	}
+
+	// สร้างหมวดหมู่ใหม่โดยยังไม่มีหมวดหมู่แม่ก่อน แล้วค่อยตั้งหมวดหมู่แม่ตามลำดับ (parent_id อ้างถึงหมวดหมู่ที่ต้องมีอยู่แล้ว)
+	var updates []MenuCategory
+	for i, entry := range entries {
+		category := final[entry.NameEN]
+		if _, exists := current[entry.NameEN]; !exists {
+			created := category
+			created.ParentID = nil
+			if err := tx.Create(&created).Error; err != nil {
+				return nil, nil, err
+			}
+			if category.ParentID != nil {
+				updates = append(updates, category)
+			}
+		} else if changes[i].Action == ImportActionUpdate {
+			updates = append(updates, category)
+		}
+	}
+	for _, category := range updates {
+		if err := tx.Save(&category).Error; err != nil {
+			return nil, nil, err
+		}
+	}
+
+	ids := make(map[string]uuid.UUID, len(final))
+	for name, category := range final {
+		ids[name] = category.UUID
+	}
+	return ids, changes, nil
+}
+
+func sameParent(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// importItemsTx คืน ID ของทุกเมนู (รวมที่ไม่อยู่ใน entries) ตาม name_en
+func (r *menuRepositoryDB) importItemsTx(tx *gorm.DB, entries []CatalogItem, categoryIDs map[string]uuid.UUID, now time.Time) (map[string]uuid.UUID, []MenuImportChange, error) {
+	var items []MenuItem
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Find(&items).Error; err != nil {
+		return nil, nil, err
+	}
+	current := make(map[string]MenuItem, len(items))
+	ids := make(map[string]uuid.UUID, len(items)+len(entries))
+	nameTH := make(map[string]string, len(items))
+	itemIDs := make([]uuid.UUID, len(items))
+	for i, item := range items {
+		current[item.NameEN] = item
+		ids[item.NameEN] = item.UUID
+		nameTH[item.NameTH] = item.NameEN
+		itemIDs[i] = item.UUID
+	}
+	currentTags, err := getMenuItemTags(tx, itemIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	seen := make(map[string]bool, len(entries))
+	changes := make([]MenuImportChange, 0, len(entries))
+	for _, entry := range entries {
+		if seen[entry.NameEN] {
+			return nil, nil, fmt.Errorf("%w: menu item %q is listed more than once", ErrInvalidMenuCatalog, entry.NameEN)
+		}
+		seen[entry.NameEN] = true
+
+		categoryID, ok := categoryIDs[entry.CategoryNameEN]
+		if !ok {
+			return nil, nil, fmt.Errorf("%w: menu item %q: category %q not found", ErrInvalidMenuCatalog, entry.NameEN, entry.CategoryNameEN)
+		}
+
+		old, exists := current[entry.NameEN]
+		item := old
+		if !exists {
+			newID, err := uuid.NewV4()
+			if err != nil {
+				return nil, nil, err
+			}
+			item = MenuItem{UUID: newID, ImageURL: "no image"}
+		}
+
+		// ชื่อไทยต้องไม่ซ้ำกับเมนูอื่น (ชื่อที่เมนูนี้เคยใช้ปล่อยให้เมนูอื่นใช้ต่อได้)
+		if other, ok := nameTH[entry.NameTH]; ok && other != entry.NameEN {
+			return nil, nil, fmt.Errorf("%w: menu items %q and %q have the same name_th %q", ErrInvalidMenuCatalog, other, entry.NameEN, entry.NameTH)
+		}
+		if exists && nameTH[old.NameTH] == entry.NameEN {
+			delete(nameTH, old.NameTH)
+		}
+		nameTH[entry.NameTH] = entry.NameEN
+
+		item.NameTH = entry.NameTH
+		item.NameEN = entry.NameEN
+		item.Description = entry.Description
+		item.Price = entry.Price
+		item.CategoryID = categoryID
+		item.IsAvailable = entry.IsAvailable
+		item.DailyLimit = entry.DailyLimit
+
+		imageChanged := entry.ImageFile != "" && entry.Image.FullURL == ""
+		if entry.Image.FullURL != "" {
+			key := entry.Image.Key
+			if key == "" {
+				key = r.imageKey(entry.Image.FullURL)
+			}
+			if key == "" {
+				return nil, nil, fmt.Errorf("%w: menu item %q: image %s is not in the menu image store", ErrInvalidMenuCatalog, entry.NameEN, entry.Image.FullURL)
+			}
+			imageChanged = entry.Image.FullURL != old.ImageURL
+			item.ImageURL = entry.Image.FullURL
+			item.ImageKey = key
+			item.ImageThumbnailURL = entry.Image.ThumbnailURL
+			item.ImageCardURL = entry.Image.CardURL
+		}
+
+		if !entry.Archived {
+			item.ArchivedAt = nil
+		} else if item.ArchivedAt == nil {
+			archivedAt := now
+			item.ArchivedAt = &archivedAt
+		}
+
+		tags := collectTags(func(kind, tag string) bool {
+			if kind == TagKindAllergen {
+				return slices.Contains(entry.Tags.Allergens, tag)
+			}
+			return slices.Contains(entry.Tags.DietaryTags, tag)
+		})
+		oldTags := currentTags[old.UUID]
+		allergensChanged := !slices.Equal(tags.Allergens, oldTags.Allergens)
+		dietaryChanged := !slices.Equal(tags.DietaryTags, oldTags.DietaryTags)
+
+		change := importChange(CatalogKindItem, entry.NameEN, exists, fieldChanges{}.
+			add("name_th", item.NameTH != old.NameTH).
+			add("description", item.Description != old.Description).
+			add("price", item.Price != old.Price).
+			add("category", item.CategoryID != old.CategoryID).
+			add("is_available", item.IsAvailable != old.IsAvailable).
+			add("daily_limit", item.DailyLimit != old.DailyLimit).
+			add("image", imageChanged).
+			add("archived", (item.ArchivedAt != nil) != (old.ArchivedAt != nil)).
+			add("allergens", allergensChanged).
+			add("dietary_tags", dietaryChanged))
+		changes = append(changes, change)
+		ids[entry.NameEN] = item.UUID
+
+		switch {
+		case !exists:
+			if err := tx.Create(&item).Error; err != nil {
+				return nil, nil, err
+			}
+			if err := startMenuItemHistoryTx(tx, item, now); err != nil {
+				return nil, nil, err
+			}
+		case change.Action == ImportActionUpdate:
+			if err := saveMenuItemTx(tx, item, old, now); err != nil {
+				return nil, nil, err
+			}
+		}
+		if allergensChanged || dietaryChanged {
+			if err := replaceMenuItemTagsTx(tx, item.UUID, tags); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	return ids, changes, nil
+}
+
+// importSetsTx แทนที่เมนูในเซ็ต (menu_set_items) ด้วยรายการใน entry ช่องเลือกของเซ็ตไม่ถูกแตะ
+func importSetsTx(tx *gorm.DB, entries []CatalogSet, itemIDs map[string]uuid.UUID, now time.Time) ([]MenuImportChange, error) {
+	var sets []MenuSet
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Find(&sets).Error; err != nil {
+		return nil, err
+	}
+	current := make(map[string]MenuSet, len(sets))
+	for _, menuSet := range sets {
+		current[menuSet.Name] = menuSet
+	}
+	currentItems, err := getMenuSetItemIDs(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(entries))
+	changes := make([]MenuImportChange, 0, len(entries))
+	for _, entry := range entries {
+		if seen[entry.Name] {
+			return nil, fmt.Errorf("%w: menu set %q is listed more than once", ErrInvalidMenuCatalog, entry.Name)
+		}
+		seen[entry.Name] = true
+
+		menuItemIDs := make([]uuid.UUID, 0, len(entry.ItemNamesEN))
+		for _, name := range entry.ItemNamesEN {
+			id, ok := itemIDs[name]
+			if !ok {
+				return nil, fmt.Errorf("%w: menu set %q: menu item %q not found", ErrInvalidMenuCatalog, entry.Name, name)
+			}
+			if !slices.Contains(menuItemIDs, id) {
+				menuItemIDs = append(menuItemIDs, id)
+			}
+		}
+
+		old, exists := current[entry.Name]
+		menuSet := old
+		if !exists {
+			newID, err := uuid.NewV4()
+			if err != nil {
+				return nil, err
+			}
+			menuSet = MenuSet{UUID: newID, Name: entry.Name}
+		}
+		menuSet.Price = entry.Price
+		if !entry.Archived {
+			menuSet.ArchivedAt = nil
+		} else if menuSet.ArchivedAt == nil {
+			archivedAt := now
+			menuSet.ArchivedAt = &archivedAt
+		}
+
+		itemsChanged := !sameMenuItemIDs(menuItemIDs, currentItems[old.UUID])
+		priceChanged := menuSet.Price != old.Price
+		archivedChanged := (menuSet.ArchivedAt != nil) != (old.ArchivedAt != nil)
+		changes = append(changes, importChange(CatalogKindSet, entry.Name, exists, fieldChanges{}.
+			add("price", priceChanged).
+			add("items", itemsChanged).
+			add("archived", archivedChanged)))
+
+		if !exists {
+			if err := tx.Create(&menuSet).Error; err != nil {
+				return nil, err
+			}
+			if err := startMenuSetHistoryTx(tx, menuSet, now); err != nil {
+				return nil, err
+			}
+		} else if priceChanged || archivedChanged {
+			if err := saveMenuSetTx(tx, menuSet, old, now); err != nil {
+				return nil, err
+			}
+		}
+		if !itemsChanged {
+			continue
+		}
+		if err := tx.Where("menu_set_id = ?", menuSet.UUID).Delete(&MenuSetItem{}).Error; err != nil {
+			return nil, err
+		}
+		for _, menuItemID := range menuItemIDs {
+			if err := tx.Create(&MenuSetItem{MenuSetID: menuSet.UUID, MenuItemID: menuItemID}).Error; err != nil {
+				return nil, err
+			}
+		}
+	}
+	return changes, nil
+}
+
+// sameMenuItemIDs เทียบเมนูในเซ็ตโดยไม่สนลำดับ
+func sameMenuItemIDs(a, b []uuid.UUID) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, id := range a {
+		if !slices.Contains(b, id) {
+			return false
+		}
+	}
+	return true
+}
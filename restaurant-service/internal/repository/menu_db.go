@@ -1,111 +1,265 @@
 package repository
 
 import (
-	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"net/url"
+	"path"
+	"regexp"
 	"strings"
+	"time"
 
-	"github.com/cloudinary/cloudinary-go/v2"
-	"github.com/cloudinary/cloudinary-go/v2/api/uploader"
 	"github.com/gofrs/uuid"
+	"gitlab.com/final_project1240930/booking_service/internal/imagestore"
+	"gitlab.com/final_project1240930/booking_service/internal/imaging"
 	"gitlab.com/final_project1240930/booking_service/internal/logs"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type menuRepositoryDB struct {
-	db         *gorm.DB
-	cloudinary *cloudinary.Cloudinary
+	db     *gorm.DB
+	images imagestore.ImageStore
 }
 
-func NewMenuRepository(db *gorm.DB, cloudinaryURL string) MenuRepository {
-	// ตรวจสอบว่า URL เป็นค่าว่างหรือไม่
-	if cloudinaryURL == "" {
-		logs.Fatal("Cloudinary URL is missing")
-	}
-	logs.Info("Using Cloudinary URL", zap.String("cloudinaryURL", cloudinaryURL))
-
-	// สร้าง Cloudinary instance จาก URL
-	cloudinaryInstance, err := cloudinary.NewFromURL(cloudinaryURL)
-	if err != nil {
-		logs.Fatal("Failed to initialize Cloudinary", zap.Error(err))
-	}
-
-	// ทดสอบการอัปโหลดไฟล์โดยใช้ไฟล์ทดสอบ
-	_, err = cloudinaryInstance.Upload.Upload(context.Background(), bytes.NewReader([]byte("test")), uploader.UploadParams{})
-	if err != nil {
-		logs.Error("Cloudinary upload test failed", zap.Error(err))
-	} else {
-		logs.Info("Cloudinary upload test successful")
-	}
-
-	// สร้างและคืนค่า menuRepositoryDB
+func NewMenuRepository(db *gorm.DB, images imagestore.ImageStore) MenuRepository {
 	return &menuRepositoryDB{
-		db:         db,
-		cloudinary: cloudinaryInstance,
+		db:     db,
+		images: images,
 	}
 }
 
 // ---------------- Images Upload & Delete ------------------------
 
-// UploadImage method
-func (r *menuRepositoryDB) UploadImage(ctx context.Context, imageData []byte, fileName string) (string, error) {
-	var resp *uploader.UploadResult
-	var err error
-
-	// ล็อกข้อมูลก่อนอัปโหลด
-	logs.Info("Uploading image to Cloudinary", zap.String("file_name", fileName))
-
-	resp, err = r.cloudinary.Upload.Upload(ctx, bytes.NewReader(imageData), uploader.UploadParams{
-		Folder:   "menu_images",
-		PublicID: fileName,
-	})
+// โฟลเดอร์ของรูปเมนูในที่เก็บไฟล์
+const menuImageFolder = "menu_images"
 
+// UploadImage บันทึกรูปทุกขนาดที่ย่อแล้วไว้ในโฟลเดอร์เดียวกัน เช่น menu_images/<uuid>/thumbnail.webp
+func (r *menuRepositoryDB) UploadImage(ctx context.Context, images []imaging.Result) (ImageVariants, error) {
+	id, err := uuid.NewV4()
 	if err != nil {
-		logs.Error("Failed to upload image to Cloudinary", zap.Error(err))
-		return "", fmt.Errorf("failed to upload image: %v", err)
+		logs.Error("Failed to generate UUID", zap.Error(err))
+		return ImageVariants{}, err
 	}
+	dir := path.Join(menuImageFolder, id.String())
+	logs.Info("Uploading image", zap.String("folder", dir), zap.Int("variants", len(images)))
 
-	// ล็อกการตอบกลับจาก Cloudinary
-	logs.Info("Cloudinary upload response", zap.Any("response", resp))
+	var variants ImageVariants
+	var keys []string
+	for _, img := range images {
+		key, err := r.images.Put(ctx, path.Join(dir, img.Variant+imaging.Extension), img.Data, imaging.ContentType)
+		if err != nil {
+			logs.Error("Failed to upload image", zap.String("variant", img.Variant), zap.Error(err))
+			// ไม่ทิ้งไฟล์ที่อัปโหลดไปแล้วไว้ครึ่ง ๆ กลาง ๆ
+			for _, uploaded := range keys {
+				if err := r.images.Delete(ctx, uploaded); err != nil {
+					logs.Error("Failed to clean up image", zap.String("key", uploaded), zap.Error(err))
+				}
+			}
+			return ImageVariants{}, fmt.Errorf("failed to upload image: %v", err)
+		}
+		keys = append(keys, key)
 
-	if resp == nil || resp.SecureURL == "" {
-		logs.Error("Cloudinary upload response is empty", zap.String("file_name", fileName))
-		return "", fmt.Errorf("received empty response from Cloudinary")
+		switch img.Variant {
+		case imaging.VariantThumbnail:
+			variants.ThumbnailURL = r.images.URL(key)
+		case imaging.VariantCard:
+			variants.CardURL = r.images.URL(key)
+		case imaging.VariantFull:
+			variants.FullURL = r.images.URL(key)
+			variants.Key = key
+		}
 	}
 
-	logs.Info("Image uploaded successfully", zap.String("image_url", resp.SecureURL))
-	return resp.SecureURL, nil
+	logs.Info("Image uploaded successfully", zap.String("image_url", variants.FullURL))
+	return variants, nil
 }
 
-// DeleteImage method
+// DeleteImage ลบรูปตาม ImageKey ที่บันทึกไว้ตอนอัปโหลด (ถ้าไม่มีจะหา key จาก URL)
+// ถ้าเป็นรูปที่ย่อไว้หลายขนาดจะลบทุกขนาดในโฟลเดอร์เดียวกัน
 func (r *menuRepositoryDB) DeleteImage(ctx context.Context, image Image) error {
-	publicID := extractPublicID(image.ImageURL)
-	if publicID == "" {
-		logs.Error("Invalid Cloudinary image URL", zap.String("image_url", image.ImageURL))
+	key := image.ImageKey
+	if key == "" {
+		key = r.imageKey(image.ImageURL)
+	}
+	if key == "" {
+		logs.Error("Invalid image URL", zap.String("image_url", image.ImageURL))
 		return fmt.Errorf("invalid image URL")
 	}
 
-	// ลบภาพจาก Cloudinary
-	_, err := r.cloudinary.Upload.Destroy(ctx, uploader.DestroyParams{
-		PublicID: publicID,
-	})
-	if err != nil {
-		logs.Error("Failed to delete image from Cloudinary", zap.Error(err))
-		return fmt.Errorf("failed to delete image: %v", err)
+	for _, key := range variantKeys(key) {
+		if err := r.images.Delete(ctx, key); err != nil {
+			logs.Error("Failed to delete image", zap.String("key", key), zap.Error(err))
+			return fmt.Errorf("failed to delete image: %v", err)
+		}
 	}
 
 	logs.Info("Image deleted successfully", zap.String("image_url", image.ImageURL))
 	return nil
 }
 
+// variantKeys คืนค่า key ของรูปทุกขนาดที่อัปโหลดพร้อมกับ key นี้
+// รูปเดิมที่ไม่ได้ย่อ (ไม่ได้อยู่ในโฟลเดอร์ของตัวเอง) คืนค่าเฉพาะ key นั้น
+// Cloudinary คืนค่า key ที่ไม่มีนามสกุล จึงใช้นามสกุลตาม key ที่ได้มา
+func variantKeys(key string) []string {
+	dir, file := path.Split(key)
+	ext := path.Ext(file)
+	if path.Dir(path.Clean(dir)) != menuImageFolder {
+		return []string{key}
+	}
+
+	isVariant := false
+	for _, variant := range imaging.Variants {
+		if strings.TrimSuffix(file, ext) == variant.Name {
+			isVariant = true
+			break
+		}
+	}
+	if !isVariant {
+		return []string{key}
+	}
+
+	keys := make([]string, 0, len(imaging.Variants))
+	for _, variant := range imaging.Variants {
+		keys = append(keys, dir+variant.Name+ext)
+	}
+	return keys
+}
+
+// imageKey หา key ของไฟล์จาก URL ที่สร้างด้วย r.images.URL
+// ใช้กับข้อมูลเก่าที่ยังไม่ได้บันทึก image_key ไว้ URL ของ Cloudinary ใช้ extractPublicID
+func (r *menuRepositoryDB) imageKey(imageURL string) string {
+	if prefix := strings.TrimSuffix(r.images.URL(""), "/") + "/"; prefix != "/" && strings.HasPrefix(imageURL, prefix) {
+		key, err := url.PathUnescape(strings.TrimPrefix(imageURL, prefix))
+		if err != nil {
+			return ""
+		}
+		return key
+	}
+	return extractPublicID(imageURL)
+}
+
+// cloudinaryTransformation ตรงกับส่วนของ URL ที่เป็น transformation เช่น "c_fill,w_200"
+var cloudinaryTransformation = regexp.MustCompile(`^[a-z]{1,3}_[^,]*(,[a-z]{1,3}_[^,]*)*$`)
+
+// cloudinaryVersion ตรงกับเลข version ใน URL เช่น "v1700000000"
+var cloudinaryVersion = regexp.MustCompile(`^v[0-9]+$`)
+
+// extractPublicID หา public ID จาก URL ของ Cloudinary เช่น
+// https://res.cloudinary.com/<cloud>/image/upload/v1700000000/menu_images/menu_image_xxx.jpg
+// ได้ "menu_images/menu_image_xxx" (ต้องมีชื่อโฟลเดอร์ด้วย และตัดนามสกุลที่เป็น format ออก)
 func extractPublicID(imageURL string) string {
-	parts := strings.Split(imageURL, "/")
-	if len(parts) < 8 {
+	u, err := url.Parse(imageURL)
+	if err != nil {
 		return ""
 	}
-	return parts[len(parts)-1]
+	_, rest, ok := strings.Cut(u.EscapedPath(), "/upload/")
+	if !ok {
+		return ""
+	}
+
+	segments := strings.Split(rest, "/")
+	// transformation อยู่ก่อน version และ public ID อยู่หลัง version เสมอ
+	for i, segment := range segments {
+		if cloudinaryVersion.MatchString(segment) {
+			segments = segments[i+1:]
+			break
+		}
+	}
+	for len(segments) > 1 && cloudinaryTransformation.MatchString(segments[0]) {
+		segments = segments[1:]
+	}
+
+	publicID, err := url.PathUnescape(strings.Join(segments, "/"))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSuffix(publicID, path.Ext(publicID))
+}
+
+// imageReferenceQuery สร้าง query ที่อ่าน image_url และ image_key จากทุกตารางที่มีคอลัมน์ image_url
+// ตารางที่เก็บรูปเพิ่มในภายหลังจะถูกนับด้วยโดยไม่ต้องแก้ FindOrphanImages (ตารางที่ไม่มี image_key หา key จาก URL)
+func (r *menuRepositoryDB) imageReferenceQuery(ctx context.Context) (string, error) {
+	var tables []struct {
+		TableName   string
+		HasImageKey bool
+	}
+	if err := r.db.WithContext(ctx).Raw(`
+		SELECT table_name, bool_or(column_name = 'image_key') AS has_image_key
+		FROM information_schema.columns
+		WHERE table_schema = current_schema() AND column_name IN ('image_url', 'image_key')
+		GROUP BY table_name
+		HAVING bool_or(column_name = 'image_url')
+		ORDER BY table_name
+	`).Scan(&tables).Error; err != nil {
+		return "", err
+	}
+	if len(tables) == 0 {
+		return "", errors.New("no table with an image_url column")
+	}
+
+	selects := make([]string, len(tables))
+	for i, table := range tables {
+		key := "''"
+		if table.HasImageKey {
+			key = "image_key"
+		}
+		selects[i] = fmt.Sprintf(`SELECT image_url, %s AS image_key FROM "%s"`, key, strings.ReplaceAll(table.TableName, `"`, `""`))
+	}
+	return strings.Join(selects, " UNION ALL "), nil
+}
+
+// FindOrphanImages คืนค่าไฟล์ในโฟลเดอร์รูปเมนูที่ไม่มีตารางใดอ้างถึง (ดู imageReferenceQuery)
+// ไม่นับไฟล์ที่อัปโหลดหลัง uploadedBefore เพราะอาจเป็นรูปที่กำลังบันทึกเมนูอยู่
+func (r *menuRepositoryDB) FindOrphanImages(ctx context.Context, uploadedBefore time.Time) ([]imagestore.StoredImage, error) {
+	query, err := r.imageReferenceQuery(ctx)
+	if err != nil {
+		logs.Error("Failed to find image-bearing tables", zap.Error(err))
+		return nil, fmt.Errorf("failed to find image-bearing tables: %v", err)
+	}
+
+	var references []struct {
+		ImageURL string
+		ImageKey string
+	}
+	if err := r.db.WithContext(ctx).Raw(query).Scan(&references).Error; err != nil {
+		logs.Error("Failed to get image references", zap.Error(err))
+		return nil, fmt.Errorf("failed to get image references: %v", err)
+	}
+
+	referenced := make(map[string]bool)
+	for _, ref := range references {
+		if ref.ImageURL == "" || ref.ImageURL == "no image" {
+			continue
+		}
+		key := ref.ImageKey
+		if key == "" {
+			key = r.imageKey(ref.ImageURL)
+		}
+		if key == "" {
+			// หา key ไม่ได้ ไม่รู้ว่าไฟล์ไหนถูกใช้อยู่ ลบต่อไม่ปลอดภัย
+			return nil, fmt.Errorf("cannot resolve image key of %s", ref.ImageURL)
+		}
+		for _, key := range variantKeys(key) {
+			referenced[key] = true
+		}
+	}
+
+	stored, err := r.images.List(ctx, menuImageFolder+"/")
+	if err != nil {
+		logs.Error("Failed to list stored images", zap.Error(err))
+		return nil, fmt.Errorf("failed to list stored images: %v", err)
+	}
+
+	var orphans []imagestore.StoredImage
+	for _, image := range stored {
+		if !referenced[image.Key] && image.UploadedAt.Before(uploadedBefore) {
+			orphans = append(orphans, image)
+		}
+	}
+	return orphans, nil
 }
 
 // ---------------- Menu ------------------------
@@ -122,8 +276,14 @@ func (r *menuRepositoryDB) CreateMenuItem(ctx context.Context, item MenuItem) (u
 	// กำหนดค่า ID
 	item.UUID = newID
 
-	// บันทึกข้อมูลลงฐานข้อมูล
-	if err := r.db.WithContext(ctx).Create(&item).Error; err != nil {
+	// บันทึกข้อมูลลงฐานข้อมูล พร้อมเริ่มประวัติของเมนู
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&item).Error; err != nil {
+			return err
+		}
+		return startMenuItemHistoryTx(tx, item, historyTime(time.Now()))
+	})
+	if err != nil {
 		logs.Error("Failed to create menu item", zap.Error(err), zap.Any("MenuItem", item))
 		return uuid.Nil, err
 	}
@@ -134,28 +294,104 @@ func (r *menuRepositoryDB) CreateMenuItem(ctx context.Context, item MenuItem) (u
 		zap.String("NameTH", item.NameTH),
 		zap.String("NameEN", item.NameEN),
 		zap.Float64("Price", item.Price),
-		zap.String("Category", string(item.Category)),
+		zap.String("CategoryID", item.CategoryID.String()),
 		zap.String("Images", string(item.ImageURL)),
 	)
 
 	return item.UUID, nil
 }
 
-// UpdateMenuItem
+// UpdateMenuItem บันทึกเวอร์ชันใหม่เมื่อชื่อ คำอธิบาย ราคา หรือหมวดหมู่เปลี่ยน ราคาใหม่มีผลทันที
 func (r *menuRepositoryDB) UpdateMenuItem(ctx context.Context, item MenuItem) error {
-	if err := r.db.WithContext(ctx).Save(&item).Error; err != nil {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var current MenuItem
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&current, "uuid = ?", item.UUID).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrMenuItemNotFound
+		}
+		if err != nil {
+			return err
+		}
+		// การแก้ไขไม่เปลี่ยนสถานะเก็บเข้าคลัง
+		item.ArchivedAt = current.ArchivedAt
+		return saveMenuItemTx(tx, item, current, historyTime(time.Now()))
+	})
+	if err != nil {
 		logs.Error("Failed to update menu item", zap.Error(err), zap.Any("MenuItem", item))
 		return err
 	}
 	return nil
 }
 
-// DeleteMenuItem
-func (r *menuRepositoryDB) DeleteMenuItem(ctx context.Context, id uuid.UUID) error {
-	if err := r.db.WithContext(ctx).Delete(&MenuItem{}, "uuid = ?", id).Error; err != nil {
-		logs.Error("Failed to delete menu item", zap.Error(err))
+// saveMenuItemTx บันทึกเมนูที่แก้จาก current แล้ว ตั้งราคาใหม่และบันทึกเวอร์ชันเมื่อข้อมูลที่เก็บประวัติเปลี่ยน
+func saveMenuItemTx(tx *gorm.DB, item, current MenuItem, now time.Time) error {
+	if err := tx.Save(&item).Error; err != nil {
 		return err
 	}
+	if item.Price != current.Price {
+		if err := setMenuPriceTx(tx, MenuPrice{MenuItemID: &item.UUID, Price: item.Price}, now); err != nil {
+			return err
+		}
+	}
+	if item.NameTH != current.NameTH || item.NameEN != current.NameEN || item.Description != current.Description ||
+		item.Price != current.Price || item.CategoryID != current.CategoryID {
+		return recordMenuItemVersionTx(tx, item, now)
+	}
+	return nil
+}
+
+// DeleteMenuItem ลบได้เฉพาะเมนูที่ไม่มีการจองและเมนูเซ็ตอ้างถึงแล้ว
+// (menu_set_items ลบตาม ON DELETE CASCADE จึงต้องตรวจก่อน ไม่อย่างนั้นเมนูจะหายจากเซ็ตโดยไม่รู้ตัว)
+func (r *menuRepositoryDB) DeleteMenuItem(ctx context.Context, id uuid.UUID) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var item MenuItem
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&item, "uuid = ?", id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrMenuItemNotFound
+			}
+			return err
+		}
+
+		var count int64
+		if err := tx.Raw(`
+			SELECT (SELECT COUNT(*) FROM booking_menu_items WHERE menu_item_id = ?)
+				+ (SELECT COUNT(*) FROM booking_menu_set_choices WHERE menu_item_id = ?)
+				+ (SELECT COUNT(*) FROM menu_set_items WHERE menu_item_id = ?)
+				+ (SELECT COUNT(*) FROM menu_set_slot_items WHERE menu_item_id = ?)`, id, id, id, id).Scan(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrMenuItemInUse
+		}
+		return tx.Delete(&item).Error
+	})
+	if err != nil {
+		logs.Error("Failed to delete menu item", zap.String("ID", id.String()), zap.Error(err))
+		return fmt.Errorf("failed to delete menu item: %w", err)
+	}
+	return nil
+}
+
+// ArchiveMenuItem เก็บเมนูเข้าคลัง เมนูที่เก็บแล้วอยู่แล้วไม่เปลี่ยนเวลาเดิม
+func (r *menuRepositoryDB) ArchiveMenuItem(ctx context.Context, id uuid.UUID) error {
+	return setArchivedAt(ctx, r.db, &MenuItem{}, id, gorm.Expr("COALESCE(archived_at, now())"), ErrMenuItemNotFound)
+}
+
+// RestoreMenuItem นำเมนูที่เก็บเข้าคลังกลับมาขาย
+func (r *menuRepositoryDB) RestoreMenuItem(ctx context.Context, id uuid.UUID) error {
+	return setArchivedAt(ctx, r.db, &MenuItem{}, id, nil, ErrMenuItemNotFound)
+}
+
+// setArchivedAt ตั้ง archived_at ของเมนูหรือเมนูเซ็ต notFound คือ error ที่คืนเมื่อไม่พบแถว
+func setArchivedAt(ctx context.Context, db *gorm.DB, model interface{}, id uuid.UUID, value interface{}, notFound error) error {
+	result := db.WithContext(ctx).Model(model).Where("uuid = ?", id).Update("archived_at", value)
+	if result.Error != nil {
+		logs.Error("Failed to update archived_at", zap.String("ID", id.String()), zap.Error(result.Error))
+		return fmt.Errorf("failed to update archived_at: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return notFound
+	}
 	return nil
 }
 
@@ -184,6 +420,681 @@ func (r *menuRepositoryDB) GetMenuItemByID(ctx context.Context, id uuid.UUID) (M
 	return menuItem, nil
 }
 
+// ---------------- Menu Category ------------------------
+
+// CreateMenuCategory
+func (r *menuRepositoryDB) CreateMenuCategory(ctx context.Context, category MenuCategory) (MenuCategory, error) {
+	newID, err := uuid.NewV4()
+	if err != nil {
+		logs.Error("Failed to generate UUID", zap.Error(err))
+		return MenuCategory{}, err
+	}
+	category.UUID = newID
+
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkMenuCategoryTx(tx, category); err != nil {
+			return err
+		}
+		return tx.Create(&category).Error
+	})
+	if err != nil {
+		logs.Error("Failed to create menu category", zap.Error(err), zap.Any("MenuCategory", category))
+		return MenuCategory{}, fmt.Errorf("failed to create menu category: %w", err)
+	}
+
+	logs.Info("Menu category created successfully", zap.String("ID", category.UUID.String()), zap.String("NameTH", category.NameTH))
+	return category, nil
+}
+
+// UpdateMenuCategory
+func (r *menuRepositoryDB) UpdateMenuCategory(ctx context.Context, category MenuCategory) (MenuCategory, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing MenuCategory
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&existing, "uuid = ?", category.UUID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrMenuCategoryNotFound
+			}
+			return err
+		}
+		if err := checkMenuCategoryTx(tx, category); err != nil {
+			return err
+		}
+		return tx.Save(&category).Error
+	})
+	if err != nil {
+		logs.Error("Failed to update menu category", zap.Error(err), zap.Any("MenuCategory", category))
+		return MenuCategory{}, fmt.Errorf("failed to update menu category: %w", err)
+	}
+	return category, nil
+}
+
+// checkMenuCategoryTx ตรวจว่าชื่อไม่ซ้ำ หมวดหมู่แม่มีอยู่จริง และไม่ได้ย้ายไปอยู่ใต้ตัวเอง
+func checkMenuCategoryTx(tx *gorm.DB, category MenuCategory) error {
+	var count int64
+	if err := tx.Model(&MenuCategory{}).
+		Where("(name_th = ? OR name_en = ?) AND uuid <> ?", category.NameTH, category.NameEN, category.UUID).
+		Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrMenuCategoryNameTaken
+	}
+
+	if category.ParentID == nil {
+		return nil
+	}
+	if *category.ParentID == category.UUID {
+		return ErrMenuCategoryCycle
+	}
+
+	// ไล่จากหมวดหมู่แม่ขึ้นไปจนถึงระดับบนสุด ถ้าเจอตัวเองแปลว่าหมวดหมู่แม่เป็นหมวดหมู่ย่อยของตัวเอง
+	var ancestors []uuid.UUID
+	if err := tx.Raw(`
+		WITH RECURSIVE ancestors AS (
+			SELECT uuid, parent_id FROM menu_categories WHERE uuid = ?
+			UNION
+			SELECT mc.uuid, mc.parent_id FROM menu_categories mc JOIN ancestors a ON mc.uuid = a.parent_id
+		)
+		SELECT uuid FROM ancestors`, *category.ParentID).Scan(&ancestors).Error; err != nil {
+		return err
+	}
+	if len(ancestors) == 0 {
+		return ErrParentCategoryNotFound
+	}
+	for _, id := range ancestors {
+		if id == category.UUID {
+			return ErrMenuCategoryCycle
+		}
+	}
+	return nil
+}
+
+// DeleteMenuCategory ลบได้เฉพาะหมวดหมู่ที่ไม่มีเมนูและหมวดหมู่ย่อยแล้ว
+func (r *menuRepositoryDB) DeleteMenuCategory(ctx context.Context, id uuid.UUID) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var category MenuCategory
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&category, "uuid = ?", id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrMenuCategoryNotFound
+			}
+			return err
+		}
+
+		var count int64
+		if err := tx.Raw(`
+			SELECT (SELECT COUNT(*) FROM menu_items WHERE category_id = ?)
+				+ (SELECT COUNT(*) FROM menu_categories WHERE parent_id = ?)
+				+ (SELECT COUNT(*) FROM menu_set_slots WHERE category_id = ?)`, id, id, id).Scan(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrMenuCategoryInUse
+		}
+		return tx.Delete(&category).Error
+	})
+	if err != nil {
+		logs.Error("Failed to delete menu category", zap.String("ID", id.String()), zap.Error(err))
+		return fmt.Errorf("failed to delete menu category: %w", err)
+	}
+	return nil
+}
+
+// GetMenuCategories
+func (r *menuRepositoryDB) GetMenuCategories(ctx context.Context) ([]MenuCategory, error) {
+	var categories []MenuCategory
+	if err := r.db.WithContext(ctx).Order("sort_order, name_th").Find(&categories).Error; err != nil {
+		logs.Error("Failed to get menu categories", zap.Error(err))
+		return nil, fmt.Errorf("failed to get menu categories: %w", err)
+	}
+	return categories, nil
+}
+
+// GetMenuCategoryByID
+func (r *menuRepositoryDB) GetMenuCategoryByID(ctx context.Context, id uuid.UUID) (MenuCategory, error) {
+	var category MenuCategory
+	if err := r.db.WithContext(ctx).First(&category, "uuid = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return MenuCategory{}, ErrMenuCategoryNotFound
+		}
+		return MenuCategory{}, fmt.Errorf("failed to get menu category: %w", err)
+	}
+	return category, nil
+}
+
+// ---------------- Menu Item Availability ------------------------
+
+// bookedMenuItemsQuery คือจำนวนเมนูที่สั่งในแต่ละการจอง (booking_id, menu_item_id, quantity)
+// เมนูในเมนูเซ็ตนับหนึ่งที่ต่อเซ็ตที่สั่ง เมนูที่เลือกในช่องเลือกนับตามจำนวนที่เลือกต่อเซ็ต
+const bookedMenuItemsQuery = `
+		SELECT bmi.booking_id, bmi.menu_item_id, bmi.quantity
+		FROM booking_menu_items bmi
+		UNION ALL
+		SELECT bms.booking_id, msi.menu_item_id, bms.quantity
+		FROM booking_menu_sets bms
+		JOIN menu_set_items msi ON msi.menu_set_id = bms.menu_set_id
+		UNION ALL
+		SELECT bms.booking_id, c.menu_item_id, bms.quantity * c.quantity
+		FROM booking_menu_sets bms
+		JOIN booking_menu_set_choices c ON c.booking_menu_set_id = bms.uuid`
+
+// menuItemUsageQuery รวมจำนวนเมนูที่ถูกสั่งในการจองที่ยืนยันแล้วของวันหนึ่ง
+// รับช่วงเวลา [00:00, 24:00) ของวันนั้นตามเวลาไทยเป็น timestamp ไม่ใช้ ?::date เพราะจะได้ขอบวันตาม timezone ของ session
+// (booking-service ใช้ query เดียวกันตอนตัดสต็อก)
+const menuItemUsageQuery = `
+	SELECT used.menu_item_id, COALESCE(SUM(used.quantity), 0) AS quantity
+	FROM (` + bookedMenuItemsQuery + `
+	) used
+	JOIN bookings b ON b.uuid = used.booking_id
+	WHERE b.status = 'CONFIRMED'
+		AND b.booking_date_time >= ?
+		AND b.booking_date_time < ?
+		AND used.menu_item_id IN ?
+	GROUP BY used.menu_item_id`
+
+// SetMenuItemAvailability เปิด/ปิดการขายและตั้งจำนวนที่ขายได้ต่อวัน
+func (r *menuRepositoryDB) SetMenuItemAvailability(ctx context.Context, id uuid.UUID, isAvailable bool, dailyLimit int32) error {
+	result := r.db.WithContext(ctx).Model(&MenuItem{}).Where("uuid = ?", id).Updates(map[string]interface{}{
+		"is_available": isAvailable,
+		"daily_limit":  dailyLimit,
+	})
+	if result.Error != nil {
+		logs.Error("Failed to update menu item availability", zap.Error(result.Error))
+		return fmt.Errorf("failed to update menu item availability: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrMenuItemNotFound
+	}
+	return nil
+}
+
+// GetMenuItemUsage
+func (r *menuRepositoryDB) GetMenuItemUsage(ctx context.Context, date string, menuItemIDs []uuid.UUID) (map[uuid.UUID]int32, error) {
+	usage := make(map[uuid.UUID]int32)
+	if len(menuItemIDs) == 0 {
+		return usage, nil
+	}
+
+	bangkok, err := time.LoadLocation("Asia/Bangkok")
+	if err != nil {
+		return nil, fmt.Errorf("could not load Bangkok timezone: %w", err)
+	}
+	dayStart, err := time.ParseInLocation("2006-01-02", date, bangkok)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date format: %w", err)
+	}
+
+	var rows []struct {
+		MenuItemID uuid.UUID `gorm:"column:menu_item_id"`
+		Quantity   int32     `gorm:"column:quantity"`
+	}
+	if err := r.db.WithContext(ctx).Raw(menuItemUsageQuery, dayStart, dayStart.AddDate(0, 0, 1), menuItemIDs).Scan(&rows).Error; err != nil {
+		logs.Error("Failed to get menu item usage", zap.Error(err))
+		return nil, fmt.Errorf("failed to get menu item usage: %w", err)
+	}
+	for _, row := range rows {
+		usage[row.MenuItemID] = row.Quantity
+	}
+	return usage, nil
+}
+
+// ---------------- Menu Tags ------------------------
+
+// SetMenuItemTags แทนที่แท็กทั้งหมดของเมนู
+func (r *menuRepositoryDB) SetMenuItemTags(ctx context.Context, menuItemID uuid.UUID, tags MenuTags) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockMenuItemTx(tx, menuItemID); err != nil {
+			return err
+		}
+		return replaceMenuItemTagsTx(tx, menuItemID, tags)
+	})
+	if err != nil {
+		logs.Error("Failed to set menu item tags", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func replaceMenuItemTagsTx(tx *gorm.DB, menuItemID uuid.UUID, tags MenuTags) error {
+	if err := tx.Where("menu_item_id = ?", menuItemID).Delete(&MenuItemTag{}).Error; err != nil {
+		return fmt.Errorf("failed to clear menu item tags: %w", err)
+	}
+
+	var rows []MenuItemTag
+	for _, tag := range tags.Allergens {
+		rows = append(rows, MenuItemTag{MenuItemID: menuItemID, Kind: TagKindAllergen, Tag: tag})
+	}
+	for _, tag := range tags.DietaryTags {
+		rows = append(rows, MenuItemTag{MenuItemID: menuItemID, Kind: TagKindDietary, Tag: tag})
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	if err := tx.Create(&rows).Error; err != nil {
+		return fmt.Errorf("failed to create menu item tags: %w", err)
+	}
+	return nil
+}
+
+// GetMenuItemTags
+func (r *menuRepositoryDB) GetMenuItemTags(ctx context.Context, menuItemIDs []uuid.UUID) (map[uuid.UUID]MenuTags, error) {
+	result, err := getMenuItemTags(r.db.WithContext(ctx), menuItemIDs)
+	if err != nil {
+		logs.Error("Failed to get menu item tags", zap.Error(err))
+		return nil, fmt.Errorf("failed to get menu item tags: %w", err)
+	}
+	return result, nil
+}
+
+func getMenuItemTags(db *gorm.DB, menuItemIDs []uuid.UUID) (map[uuid.UUID]MenuTags, error) {
+	result := make(map[uuid.UUID]MenuTags)
+	if len(menuItemIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []MenuItemTag
+	if err := db.Where("menu_item_id IN ?", menuItemIDs).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	byItem := make(map[uuid.UUID]map[string]bool)
+	for _, row := range rows {
+		if byItem[row.MenuItemID] == nil {
+			byItem[row.MenuItemID] = make(map[string]bool)
+		}
+		byItem[row.MenuItemID][row.Kind+":"+row.Tag] = true
+	}
+	for id, has := range byItem {
+		result[id] = collectTags(func(kind, tag string) bool { return has[kind+":"+tag] })
+	}
+	return result, nil
+}
+
+// GetMenuSetTags
+func (r *menuRepositoryDB) GetMenuSetTags(ctx context.Context, menuSetIDs []uuid.UUID) (map[uuid.UUID]MenuTags, error) {
+	result := make(map[uuid.UUID]MenuTags)
+	if len(menuSetIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []struct {
+		MenuSetID  uuid.UUID `gorm:"column:menu_set_id"`
+		MenuItemID uuid.UUID `gorm:"column:menu_item_id"`
+		Kind       *string   `gorm:"column:kind"`
+		Tag        *string   `gorm:"column:tag"`
+	}
+	if err := r.db.WithContext(ctx).Raw(`
+		SELECT msi.menu_set_id, msi.menu_item_id, t.kind, t.tag
+		FROM (
+			SELECT menu_set_id, menu_item_id FROM menu_set_items
+			UNION
+			SELECT ss.menu_set_id, ssi.menu_item_id
+			FROM menu_set_slots ss
+			JOIN menu_set_slot_items ssi ON ssi.slot_id = ss.uuid
+		) msi
+		LEFT JOIN menu_item_tags t ON t.menu_item_id = msi.menu_item_id
+		WHERE msi.menu_set_id IN ?
+	`, menuSetIDs).Scan(&rows).Error; err != nil {
+		logs.Error("Failed to get menu set tags", zap.Error(err))
+		return nil, fmt.Errorf("failed to get menu set tags: %w", err)
+	}
+
+	// นับว่าแต่ละแท็กมีในกี่เมนูของเซ็ต
+	items := make(map[uuid.UUID]map[uuid.UUID]bool)
+	counts := make(map[uuid.UUID]map[string]int)
+	for _, row := range rows {
+		if items[row.MenuSetID] == nil {
+			items[row.MenuSetID] = make(map[uuid.UUID]bool)
+			counts[row.MenuSetID] = make(map[string]int)
+		}
+		items[row.MenuSetID][row.MenuItemID] = true
+		if row.Kind != nil && row.Tag != nil {
+			counts[row.MenuSetID][*row.Kind+":"+*row.Tag]++
+		}
+	}
+	for setID, count := range counts {
+		itemCount := len(items[setID])
+		result[setID] = collectTags(func(kind, tag string) bool {
+			if kind == TagKindAllergen {
+				return count[kind+":"+tag] > 0
+			}
+			return count[kind+":"+tag] == itemCount
+		})
+	}
+	return result, nil
+}
+
+// collectTags เรียงแท็กตามลำดับใน Allergens/DietaryTags
+func collectTags(has func(kind, tag string) bool) MenuTags {
+	var tags MenuTags
+	for _, tag := range Allergens {
+		if has(TagKindAllergen, tag) {
+			tags.Allergens = append(tags.Allergens, tag)
+		}
+	}
+	for _, tag := range DietaryTags {
+		if has(TagKindDietary, tag) {
+			tags.DietaryTags = append(tags.DietaryTags, tag)
+		}
+	}
+	return tags
+}
+
+// ---------------- Menu Schedules ------------------------
+
+// SetMenuItemSchedules แทนที่ schedule ทั้งหมดของเมนู (ส่งว่าง = สั่งได้ตลอด)
+func (r *menuRepositoryDB) SetMenuItemSchedules(ctx context.Context, menuItemID uuid.UUID, schedules []MenuSchedule) ([]MenuSchedule, error) {
+	var saved []MenuSchedule
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockMenuItemTx(tx, menuItemID); err != nil {
+			return err
+		}
+		for i := range schedules {
+			schedules[i].MenuItemID = &menuItemID
+			schedules[i].MenuSetID = nil
+		}
+		var err error
+		saved, err = replaceSchedulesTx(tx, "menu_item_id", menuItemID, schedules)
+		return err
+	})
+	if err != nil {
+		logs.Error("Failed to set menu item schedules", zap.Error(err))
+		return nil, err
+	}
+	return saved, nil
+}
+
+// SetMenuSetSchedules แทนที่ schedule ทั้งหมดของเมนูเซ็ต (ส่งว่าง = สั่งได้ตลอด)
+func (r *menuRepositoryDB) SetMenuSetSchedules(ctx context.Context, menuSetID uuid.UUID, schedules []MenuSchedule) ([]MenuSchedule, error) {
+	var saved []MenuSchedule
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var set MenuSet
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("uuid").First(&set, "uuid = ?", menuSetID).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrMenuSetNotFound
+		}
+		if err != nil {
+			return err
+		}
+		for i := range schedules {
+			schedules[i].MenuItemID = nil
+			schedules[i].MenuSetID = &menuSetID
+		}
+		saved, err = replaceSchedulesTx(tx, "menu_set_id", menuSetID, schedules)
+		return err
+	})
+	if err != nil {
+		logs.Error("Failed to set menu set schedules", zap.Error(err))
+		return nil, err
+	}
+	return saved, nil
+}
+
+func replaceSchedulesTx(tx *gorm.DB, column string, ownerID uuid.UUID, schedules []MenuSchedule) ([]MenuSchedule, error) {
+	if err := tx.Where(column+" = ?", ownerID).Delete(&MenuSchedule{}).Error; err != nil {
+		return nil, fmt.Errorf("failed to clear schedules: %w", err)
+	}
+	for i := range schedules {
+		schedules[i].UUID = uuid.Nil
+		if err := tx.Create(&schedules[i]).Error; err != nil {
+			return nil, fmt.Errorf("failed to create schedule: %w", err)
+		}
+	}
+	return schedules, nil
+}
+
+// GetMenuItemSchedules
+func (r *menuRepositoryDB) GetMenuItemSchedules(ctx context.Context, menuItemIDs []uuid.UUID) ([]MenuSchedule, error) {
+	return r.getSchedules(ctx, "menu_item_id", menuItemIDs)
+}
+
+// GetMenuSetSchedules
+func (r *menuRepositoryDB) GetMenuSetSchedules(ctx context.Context, menuSetIDs []uuid.UUID) ([]MenuSchedule, error) {
+	return r.getSchedules(ctx, "menu_set_id", menuSetIDs)
+}
+
+func (r *menuRepositoryDB) getSchedules(ctx context.Context, column string, ownerIDs []uuid.UUID) ([]MenuSchedule, error) {
+	var schedules []MenuSchedule
+	if len(ownerIDs) == 0 {
+		return schedules, nil
+	}
+	if err := r.db.WithContext(ctx).Where(column+" IN ?", ownerIDs).
+		Order("days_of_week, start_minute, uuid").Find(&schedules).Error; err != nil {
+		logs.Error("Failed to get menu schedules", zap.Error(err))
+		return nil, fmt.Errorf("failed to get menu schedules: %w", err)
+	}
+	return schedules, nil
+}
+
+// ---------------- Menu Modifiers ------------------------
+
+// SetMenuItemModifiers แทนที่กลุ่มตัวเลือกทั้งหมดของเมนู (ส่งว่าง = ไม่มีตัวเลือก)
+// กลุ่ม/ตัวเลือกที่ส่ง id เดิมมาจะใช้ id เดิม การจองที่อ้างถึงตัวเลือกเดิมจึงยังตรงกัน
+func (r *menuRepositoryDB) SetMenuItemModifiers(ctx context.Context, menuItemID uuid.UUID, groups []MenuModifierGroup) ([]MenuModifierGroup, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockMenuItemTx(tx, menuItemID); err != nil {
+			return err
+		}
+		// คง id เฉพาะกลุ่ม/ตัวเลือกที่เป็นของเมนูนี้อยู่แล้ว id อื่นสร้างใหม่
+		var existing []uuid.UUID
+		if err := tx.Raw(`
+			SELECT g.uuid FROM menu_modifier_groups g WHERE g.menu_item_id = ?
+			UNION ALL
+			SELECT o.uuid FROM menu_modifier_options o
+			JOIN menu_modifier_groups g ON g.uuid = o.group_id
+			WHERE g.menu_item_id = ?`, menuItemID, menuItemID).Scan(&existing).Error; err != nil {
+			return fmt.Errorf("failed to get menu modifiers: %w", err)
+		}
+		owned := make(map[uuid.UUID]bool, len(existing))
+		for _, id := range existing {
+			owned[id] = true
+		}
+		// ลบกลุ่มแล้วตัวเลือกจะถูกลบตาม (ON DELETE CASCADE)
+		if err := tx.Where("menu_item_id = ?", menuItemID).Delete(&MenuModifierGroup{}).Error; err != nil {
+			return fmt.Errorf("failed to clear menu modifiers: %w", err)
+		}
+		for i := range groups {
+			group := &groups[i]
+			group.MenuItemID = menuItemID
+			group.Position = int32(i)
+			if !owned[group.UUID] {
+				group.UUID = uuid.Nil
+			}
+			if err := tx.Create(group).Error; err != nil {
+				return fmt.Errorf("failed to create modifier group: %w", err)
+			}
+			for j := range group.Options {
+				option := &group.Options[j]
+				option.GroupID = group.UUID
+				option.Position = int32(j)
+				if !owned[option.UUID] {
+					option.UUID = uuid.Nil
+				}
+				if err := tx.Create(option).Error; err != nil {
+					return fmt.Errorf("failed to create modifier option: %w", err)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		logs.Error("Failed to set menu item modifiers", zap.Error(err))
+		return nil, err
+	}
+	return groups, nil
+}
+
+// GetMenuItemModifiers
+func (r *menuRepositoryDB) GetMenuItemModifiers(ctx context.Context, menuItemIDs []uuid.UUID) (map[uuid.UUID][]MenuModifierGroup, error) {
+	result := make(map[uuid.UUID][]MenuModifierGroup)
+	if len(menuItemIDs) == 0 {
+		return result, nil
+	}
+
+	var groups []MenuModifierGroup
+	if err := r.db.WithContext(ctx).Where("menu_item_id IN ?", menuItemIDs).
+		Order("menu_item_id, position").Find(&groups).Error; err != nil {
+		logs.Error("Failed to get menu modifier groups", zap.Error(err))
+		return nil, fmt.Errorf("failed to get menu modifier groups: %w", err)
+	}
+	if len(groups) == 0 {
+		return result, nil
+	}
+
+	groupIDs := make([]uuid.UUID, len(groups))
+	for i, group := range groups {
+		groupIDs[i] = group.UUID
+	}
+	var options []MenuModifierOption
+	if err := r.db.WithContext(ctx).Where("group_id IN ?", groupIDs).
+		Order("group_id, position").Find(&options).Error; err != nil {
+		logs.Error("Failed to get menu modifier options", zap.Error(err))
+		return nil, fmt.Errorf("failed to get menu modifier options: %w", err)
+	}
+	byGroup := make(map[uuid.UUID][]MenuModifierOption)
+	for _, option := range options {
+		byGroup[option.GroupID] = append(byGroup[option.GroupID], option)
+	}
+
+	for _, group := range groups {
+		group.Options = byGroup[group.UUID]
+		result[group.MenuItemID] = append(result[group.MenuItemID], group)
+	}
+	return result, nil
+}
+
+// ---------------- Menu Item Images ------------------------
+
+// lockMenuItemTx ล็อกแถวของเมนูไว้จนจบ transaction เพื่อไม่ให้การแก้แกลเลอรีพร้อมกันได้ position ซ้ำ
+func lockMenuItemTx(tx *gorm.DB, menuItemID uuid.UUID) error {
+	var item MenuItem
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("uuid").First(&item, "uuid = ?", menuItemID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrMenuItemNotFound
+	}
+	return err
+}
+
+// AddMenuItemImage เพิ่มรูปต่อท้ายแกลเลอรีของเมนู
+func (r *menuRepositoryDB) AddMenuItemImage(ctx context.Context, image MenuItemImage) (MenuItemImage, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockMenuItemTx(tx, image.MenuItemID); err != nil {
+			return err
+		}
+
+		var count int64
+		if err := tx.Model(&MenuItemImage{}).Where("menu_item_id = ?", image.MenuItemID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count >= MaxMenuItemImages {
+			return ErrTooManyMenuItemImages
+		}
+
+		id, err := uuid.NewV4()
+		if err != nil {
+			return err
+		}
+		image.UUID = id
+		image.Position = int32(count)
+		return tx.Create(&image).Error
+	})
+	if err != nil {
+		logs.Error("Failed to add menu item image", zap.String("MenuItemID", image.MenuItemID.String()), zap.Error(err))
+		return MenuItemImage{}, fmt.Errorf("failed to add menu item image: %w", err)
+	}
+	return image, nil
+}
+
+// GetMenuItemImages คืนค่ารูปในแกลเลอรีของหลายเมนู เรียงตามเมนูและ position
+func (r *menuRepositoryDB) GetMenuItemImages(ctx context.Context, menuItemIDs []uuid.UUID) ([]MenuItemImage, error) {
+	if len(menuItemIDs) == 0 {
+		return nil, nil
+	}
+
+	var images []MenuItemImage
+	if err := r.db.WithContext(ctx).
+		Where("menu_item_id IN ?", menuItemIDs).
+		Order("menu_item_id, position").
+		Find(&images).Error; err != nil {
+		logs.Error("Failed to get menu item images", zap.Error(err))
+		return nil, fmt.Errorf("failed to get menu item images: %v", err)
+	}
+	return images, nil
+}
+
+// ReorderMenuItemImages กำหนดลำดับรูปใหม่ตาม imageIDs ซึ่งต้องเป็นรูปทั้งหมดของเมนูนั้นพอดี
+func (r *menuRepositoryDB) ReorderMenuItemImages(ctx context.Context, menuItemID uuid.UUID, imageIDs []uuid.UUID) ([]MenuItemImage, error) {
+	var images []MenuItemImage
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockMenuItemTx(tx, menuItemID); err != nil {
+			return err
+		}
+
+		var current []MenuItemImage
+		if err := tx.Where("menu_item_id = ?", menuItemID).Find(&current).Error; err != nil {
+			return err
+		}
+		if len(imageIDs) != len(current) {
+			return ErrImageOrderMismatch
+		}
+		byID := make(map[uuid.UUID]MenuItemImage, len(current))
+		for _, image := range current {
+			byID[image.UUID] = image
+		}
+
+		// unique (menu_item_id, position) เป็น DEFERRABLE จึงสลับ position ระหว่างรูปได้
+		for position, imageID := range imageIDs {
+			image, ok := byID[imageID]
+			if !ok {
+				return ErrImageOrderMismatch
+			}
+			delete(byID, imageID)
+
+			if image.Position != int32(position) {
+				if err := tx.Model(&MenuItemImage{}).Where("uuid = ?", imageID).Update("position", position).Error; err != nil {
+					return err
+				}
+				image.Position = int32(position)
+			}
+			images = append(images, image)
+		}
+		return nil
+	})
+	if err != nil {
+		logs.Error("Failed to reorder menu item images", zap.String("MenuItemID", menuItemID.String()), zap.Error(err))
+		return nil, fmt.Errorf("failed to reorder menu item images: %w", err)
+	}
+	return images, nil
+}
+
+// RemoveMenuItemImage ลบรูปออกจากแกลเลอรีแล้วเลื่อน position ของรูปที่อยู่หลังขึ้นมา
+// คืนค่ารูปที่ลบเพื่อให้ผู้เรียกลบไฟล์ออกจากที่เก็บรูปภาพ
+func (r *menuRepositoryDB) RemoveMenuItemImage(ctx context.Context, menuItemID, imageID uuid.UUID) (MenuItemImage, error) {
+	var removed MenuItemImage
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockMenuItemTx(tx, menuItemID); err != nil {
+			return err
+		}
+
+		if err := tx.First(&removed, "uuid = ? AND menu_item_id = ?", imageID, menuItemID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrMenuItemImageNotFound
+			}
+			return err
+		}
+		if err := tx.Delete(&MenuItemImage{}, "uuid = ?", imageID).Error; err != nil {
+			return err
+		}
+		return tx.Model(&MenuItemImage{}).
+			Where("menu_item_id = ? AND position > ?", menuItemID, removed.Position).
+			Update("position", gorm.Expr("position - 1")).Error
+	})
+	if err != nil {
+		logs.Error("Failed to remove menu item image", zap.String("ImageID", imageID.String()), zap.Error(err))
+		return MenuItemImage{}, fmt.Errorf("failed to remove menu item image: %w", err)
+	}
+	return removed, nil
+}
+
 // ---------------- Menu Set ------------------------
 
 // CreateMenuSet สร้างเมนูเซตใหม่
@@ -198,8 +1109,14 @@ func (r *menuRepositoryDB) CreateMenuSet(ctx context.Context, menuSet MenuSet) (
 	// กำหนด ID ของ MenuSet
 	menuSet.UUID = newID
 
-	// บันทึกข้อมูล MenuSet ลงฐานข้อมูล
-	if err := r.db.WithContext(ctx).Create(&menuSet).Error; err != nil {
+	// บันทึกข้อมูล MenuSet ลงฐานข้อมูล พร้อมเริ่มประวัติของเซ็ต
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&menuSet).Error; err != nil {
+			return err
+		}
+		return startMenuSetHistoryTx(tx, menuSet, historyTime(time.Now()))
+	})
+	if err != nil {
 		logs.Error("Failed to create menu set", zap.Error(err), zap.Any("MenuSet", menuSet))
 		return uuid.Nil, err
 	}
@@ -210,24 +1127,80 @@ func (r *menuRepositoryDB) CreateMenuSet(ctx context.Context, menuSet MenuSet) (
 	return menuSet.UUID, nil
 }
 
-// UpdateMenuSet อัปเดต MenuSet
+// UpdateMenuSet อัปเดต MenuSet และบันทึกเวอร์ชันใหม่เมื่อชื่อหรือราคาเปลี่ยน ราคาใหม่มีผลทันที
 func (r *menuRepositoryDB) UpdateMenuSet(ctx context.Context, menuSet MenuSet) error {
-	if err := r.db.WithContext(ctx).Save(&menuSet).Error; err != nil {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var current MenuSet
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&current, "uuid = ?", menuSet.UUID).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrMenuSetNotFound
+		}
+		if err != nil {
+			return err
+		}
+		menuSet.ArchivedAt = current.ArchivedAt
+		return saveMenuSetTx(tx, menuSet, current, historyTime(time.Now()))
+	})
+	if err != nil {
 		logs.Error("Failed to update menu set", zap.Error(err), zap.Any("MenuSet", menuSet))
 		return err
 	}
 	return nil
 }
 
-// DeleteMenuSet ลบ MenuSet
-func (r *menuRepositoryDB) DeleteMenuSet(ctx context.Context, setID uuid.UUID) error {
-	if err := r.db.WithContext(ctx).Where("uuid = ?", setID).Delete(&MenuSet{}).Error; err != nil {
-		logs.Error("Failed to delete menu set", zap.Error(err))
+// saveMenuSetTx บันทึกเซ็ตที่แก้จาก current แล้ว ตั้งราคาใหม่และบันทึกเวอร์ชันเมื่อชื่อหรือราคาเปลี่ยน
+func saveMenuSetTx(tx *gorm.DB, menuSet, current MenuSet, now time.Time) error {
+	if err := tx.Save(&menuSet).Error; err != nil {
 		return err
 	}
+	if menuSet.Price != current.Price {
+		if err := setMenuPriceTx(tx, MenuPrice{MenuSetID: &menuSet.UUID, Price: menuSet.Price}, now); err != nil {
+			return err
+		}
+	}
+	if menuSet.Name != current.Name || menuSet.Price != current.Price {
+		return recordMenuSetVersionTx(tx, menuSet, now)
+	}
 	return nil
 }
 
+// DeleteMenuSet ลบ MenuSet ได้เฉพาะเซ็ตที่ไม่มีการจองอ้างถึงแล้ว
+func (r *menuRepositoryDB) DeleteMenuSet(ctx context.Context, setID uuid.UUID) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var menuSet MenuSet
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&menuSet, "uuid = ?", setID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrMenuSetNotFound
+			}
+			return err
+		}
+
+		var count int64
+		if err := tx.Raw(`SELECT COUNT(*) FROM booking_menu_sets WHERE menu_set_id = ?`, setID).Scan(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrMenuSetInUse
+		}
+		return tx.Delete(&menuSet).Error
+	})
+	if err != nil {
+		logs.Error("Failed to delete menu set", zap.String("ID", setID.String()), zap.Error(err))
+		return fmt.Errorf("failed to delete menu set: %w", err)
+	}
+	return nil
+}
+
+// ArchiveMenuSet เก็บเมนูเซ็ตเข้าคลัง เซ็ตที่เก็บแล้วอยู่แล้วไม่เปลี่ยนเวลาเดิม
+func (r *menuRepositoryDB) ArchiveMenuSet(ctx context.Context, setID uuid.UUID) error {
+	return setArchivedAt(ctx, r.db, &MenuSet{}, setID, gorm.Expr("COALESCE(archived_at, now())"), ErrMenuSetNotFound)
+}
+
+// RestoreMenuSet นำเมนูเซ็ตที่เก็บเข้าคลังกลับมาขาย
+func (r *menuRepositoryDB) RestoreMenuSet(ctx context.Context, setID uuid.UUID) error {
+	return setArchivedAt(ctx, r.db, &MenuSet{}, setID, nil, ErrMenuSetNotFound)
+}
+
 // GetMenuSets ดึงข้อมูลทั้งหมดของ MenuSets
 func (r *menuRepositoryDB) GetMenuSets(ctx context.Context) ([]MenuSet, error) {
 	var menuSet []MenuSet
@@ -250,6 +1223,438 @@ func (r *menuRepositoryDB) GetMenuSetByID(ctx context.Context, setID uuid.UUID)
 	return menuSet, nil
 }
 
+// ---------------- Menu History ------------------------
+
+// historyTime ตัดเวลาให้ละเอียดเท่า timestamptz ของ Postgres เพื่อให้เทียบกับค่าที่อ่านกลับมาได้ตรง
+func historyTime(t time.Time) time.Time {
+	return t.Truncate(time.Microsecond)
+}
+
+// startMenuItemHistoryTx สร้างเวอร์ชันแรกและช่วงราคาแรกของเมนูที่สร้างใหม่
+func startMenuItemHistoryTx(tx *gorm.DB, item MenuItem, at time.Time) error {
+	if err := recordMenuItemVersionTx(tx, item, at); err != nil {
+		return err
+	}
+	return setMenuPriceTx(tx, MenuPrice{MenuItemID: &item.UUID, Price: item.Price}, at)
+}
+
+func startMenuSetHistoryTx(tx *gorm.DB, menuSet MenuSet, at time.Time) error {
+	if err := recordMenuSetVersionTx(tx, menuSet, at); err != nil {
+		return err
+	}
+	return setMenuPriceTx(tx, MenuPrice{MenuSetID: &menuSet.UUID, Price: menuSet.Price}, at)
+}
+
+// recordMenuItemVersionTx ปิดเวอร์ชันที่ใช้อยู่ ณ เวลา at แล้วเพิ่มเวอร์ชันใหม่จากข้อมูลปัจจุบันของเมนู
+// ถ้าเวอร์ชันเดิมเริ่มตั้งแต่ at หรือหลังจากนั้น จะแก้เวอร์ชันนั้นแทนการเพิ่มช่วงที่ยาวเป็นศูนย์
+func recordMenuItemVersionTx(tx *gorm.DB, item MenuItem, at time.Time) error {
+	version := MenuItemVersion{
+		MenuItemID:    item.UUID,
+		NameTH:        item.NameTH,
+		NameEN:        item.NameEN,
+		Description:   item.Description,
+		Price:         item.Price,
+		CategoryID:    item.CategoryID,
+		EffectiveFrom: at,
+	}
+	var open MenuItemVersion
+	err := tx.Where("menu_item_id = ? AND effective_to IS NULL", item.UUID).Take(&open).Error
+	switch {
+	case err == nil && !at.After(open.EffectiveFrom):
+		version.UUID = open.UUID
+		version.EffectiveFrom = open.EffectiveFrom
+		return tx.Save(&version).Error
+	case err == nil:
+		if err := tx.Model(&open).Update("effective_to", at).Error; err != nil {
+			return fmt.Errorf("failed to close menu item version: %w", err)
+		}
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		return fmt.Errorf("failed to get menu item version: %w", err)
+	}
+	return tx.Create(&version).Error
+}
+
+func recordMenuSetVersionTx(tx *gorm.DB, menuSet MenuSet, at time.Time) error {
+	version := MenuSetVersion{
+		MenuSetID:     menuSet.UUID,
+		Name:          menuSet.Name,
+		Price:         menuSet.Price,
+		EffectiveFrom: at,
+	}
+	var open MenuSetVersion
+	err := tx.Where("menu_set_id = ? AND effective_to IS NULL", menuSet.UUID).Take(&open).Error
+	switch {
+	case err == nil && !at.After(open.EffectiveFrom):
+		version.UUID = open.UUID
+		version.EffectiveFrom = open.EffectiveFrom
+		return tx.Save(&version).Error
+	case err == nil:
+		if err := tx.Model(&open).Update("effective_to", at).Error; err != nil {
+			return fmt.Errorf("failed to close menu set version: %w", err)
+		}
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		return fmt.Errorf("failed to get menu set version: %w", err)
+	}
+	return tx.Create(&version).Error
+}
+
+// setMenuPriceTx ใส่ราคา entry.Price ในประวัติราคาของเจ้าของ (MenuItemID หรือ MenuSetID) ตั้งแต่เวลา from
+// ช่วงราคาที่ครอบ from อยู่จะถูกตัดที่ from และช่วงใหม่สิ้นสุดที่ช่วงถัดไป ราคาที่ตั้งไว้หลัง from จึงยังมีผลตามเดิม
+func setMenuPriceTx(tx *gorm.DB, entry MenuPrice, from time.Time) error {
+	column, ownerID := "menu_item_id", entry.MenuItemID
+	if ownerID == nil {
+		column, ownerID = "menu_set_id", entry.MenuSetID
+	}
+
+	// มีช่วงที่เริ่มเวลาเดียวกันอยู่แล้ว แทนที่ราคา
+	result := tx.Model(&MenuPrice{}).Where(column+" = ? AND effective_from = ?", *ownerID, from).Update("price", entry.Price)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update menu price: %w", result.Error)
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	var current MenuPrice
+	err := tx.Where(column+" = ? AND effective_from < ? AND (effective_to IS NULL OR effective_to > ?)", *ownerID, from, from).
+		Take(&current).Error
+	switch {
+	case err == nil:
+		entry.EffectiveTo = current.EffectiveTo
+		if err := tx.Model(&current).Update("effective_to", from).Error; err != nil {
+			return fmt.Errorf("failed to close menu price: %w", err)
+		}
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		// ไม่มีช่วงที่ครอบ from (ราคาแรกของเมนู) สิ้นสุดที่ช่วงถัดไปถ้ามี
+		var next MenuPrice
+		err := tx.Where(column+" = ? AND effective_from > ?", *ownerID, from).Order("effective_from").Take(&next).Error
+		if err == nil {
+			entry.EffectiveTo = &next.EffectiveFrom
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("failed to get next menu price: %w", err)
+		}
+	default:
+		return fmt.Errorf("failed to get current menu price: %w", err)
+	}
+
+	entry.UUID = uuid.Nil
+	entry.EffectiveFrom = from
+	return tx.Create(&entry).Error
+}
+
+// GetMenuItemHistory
+func (r *menuRepositoryDB) GetMenuItemHistory(ctx context.Context, menuItemID uuid.UUID) (MenuItemHistory, error) {
+	db := r.db.WithContext(ctx)
+	var item MenuItem
+	if err := db.Select("uuid").First(&item, "uuid = ?", menuItemID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return MenuItemHistory{}, ErrMenuItemNotFound
+		}
+		return MenuItemHistory{}, err
+	}
+
+	var history MenuItemHistory
+	if err := db.Where("menu_item_id = ?", menuItemID).Order("effective_from").Find(&history.Versions).Error; err != nil {
+		return MenuItemHistory{}, fmt.Errorf("failed to get menu item versions: %w", err)
+	}
+	if err := db.Where("menu_item_id = ?", menuItemID).Order("effective_from").Find(&history.Prices).Error; err != nil {
+		return MenuItemHistory{}, fmt.Errorf("failed to get menu item prices: %w", err)
+	}
+	return history, nil
+}
+
+// GetMenuSetHistory
+func (r *menuRepositoryDB) GetMenuSetHistory(ctx context.Context, menuSetID uuid.UUID) (MenuSetHistory, error) {
+	db := r.db.WithContext(ctx)
+	var menuSet MenuSet
+	if err := db.Select("uuid").First(&menuSet, "uuid = ?", menuSetID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return MenuSetHistory{}, ErrMenuSetNotFound
+		}
+		return MenuSetHistory{}, err
+	}
+
+	var history MenuSetHistory
+	if err := db.Where("menu_set_id = ?", menuSetID).Order("effective_from").Find(&history.Versions).Error; err != nil {
+		return MenuSetHistory{}, fmt.Errorf("failed to get menu set versions: %w", err)
+	}
+	if err := db.Where("menu_set_id = ?", menuSetID).Order("effective_from").Find(&history.Prices).Error; err != nil {
+		return MenuSetHistory{}, fmt.Errorf("failed to get menu set prices: %w", err)
+	}
+	return history, nil
+}
+
+// ScheduleMenuItemPrice
+func (r *menuRepositoryDB) ScheduleMenuItemPrice(ctx context.Context, menuItemID uuid.UUID, price float64, effectiveFrom time.Time) error {
+	if !effectiveFrom.After(time.Now()) {
+		return ErrPriceChangeNotInFuture
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockMenuItemTx(tx, menuItemID); err != nil {
+			return err
+		}
+		return setMenuPriceTx(tx, MenuPrice{MenuItemID: &menuItemID, Price: price}, historyTime(effectiveFrom))
+	})
+}
+
+// ScheduleMenuSetPrice
+func (r *menuRepositoryDB) ScheduleMenuSetPrice(ctx context.Context, menuSetID uuid.UUID, price float64, effectiveFrom time.Time) error {
+	if !effectiveFrom.After(time.Now()) {
+		return ErrPriceChangeNotInFuture
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var menuSet MenuSet
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("uuid").First(&menuSet, "uuid = ?", menuSetID).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrMenuSetNotFound
+		}
+		if err != nil {
+			return err
+		}
+		return setMenuPriceTx(tx, MenuPrice{MenuSetID: &menuSetID, Price: price}, historyTime(effectiveFrom))
+	})
+}
+
+// duePrice คือช่วงราคาที่มีผลอยู่แต่ราคาในตารางเมนูยังไม่ตรง
+type duePrice struct {
+	OwnerID       uuid.UUID `gorm:"column:owner_id"`
+	Price         float64   `gorm:"column:price"`
+	EffectiveFrom time.Time `gorm:"column:effective_from"`
+}
+
+// scheduledPricesLockKey คือ key ของ advisory lock ที่กันไม่ให้หลาย replica ปรับราคาพร้อมกัน
+const scheduledPricesLockKey int64 = 0x6d656e7570726963 // "menupric"
+
+// ApplyScheduledPrices คัดลอกราคาของช่วงที่มีผล ณ เวลา now ไปที่ menu_items / menu_sets
+// booking-service อ่านราคาจากตารางเมนูโดยตรง ราคาที่ตั้งล่วงหน้าจึงมีผลเมื่อฟังก์ชันนี้ทำงาน
+// ทุก replica เรียกฟังก์ชันนี้ แต่มีเพียง replica เดียวที่ได้ advisory lock ในแต่ละรอบ ที่เหลือคืน 0
+func (r *menuRepositoryDB) ApplyScheduledPrices(ctx context.Context, now time.Time) (int, error) {
+	changed := 0
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var locked bool
+		if err := tx.Raw(`SELECT pg_try_advisory_xact_lock(?)`, scheduledPricesLockKey).Scan(&locked).Error; err != nil {
+			return fmt.Errorf("failed to lock scheduled prices: %w", err)
+		}
+		if !locked {
+			return nil
+		}
+
+		var dueItems []duePrice
+		if err := tx.Raw(`
+			SELECT h.menu_item_id AS owner_id, h.price, h.effective_from
+			FROM menu_price_history h
+			JOIN menu_items mi ON mi.uuid = h.menu_item_id
+			WHERE h.effective_from <= ? AND (h.effective_to IS NULL OR h.effective_to > ?)
+				AND h.price <> mi.price`, now, now).Scan(&dueItems).Error; err != nil {
+			return fmt.Errorf("failed to get due menu item prices: %w", err)
+		}
+		for _, due := range dueItems {
+			var item MenuItem
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&item, "uuid = ?", due.OwnerID).Error; err != nil {
+				return fmt.Errorf("failed to apply price of menu item %s: %w", due.OwnerID, err)
+			}
+			item.Price = due.Price
+			if err := tx.Model(&item).Update("price", due.Price).Error; err != nil {
+				return fmt.Errorf("failed to apply price of menu item %s: %w", due.OwnerID, err)
+			}
+			if err := recordMenuItemVersionTx(tx, item, due.EffectiveFrom); err != nil {
+				return fmt.Errorf("failed to apply price of menu item %s: %w", due.OwnerID, err)
+			}
+			changed++
+		}
+
+		var dueSets []duePrice
+		if err := tx.Raw(`
+			SELECT h.menu_set_id AS owner_id, h.price, h.effective_from
+			FROM menu_price_history h
+			JOIN menu_sets ms ON ms.uuid = h.menu_set_id
+			WHERE h.effective_from <= ? AND (h.effective_to IS NULL OR h.effective_to > ?)
+				AND h.price <> ms.price`, now, now).Scan(&dueSets).Error; err != nil {
+			return fmt.Errorf("failed to get due menu set prices: %w", err)
+		}
+		for _, due := range dueSets {
+			var menuSet MenuSet
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&menuSet, "uuid = ?", due.OwnerID).Error; err != nil {
+				return fmt.Errorf("failed to apply price of menu set %s: %w", due.OwnerID, err)
+			}
+			menuSet.Price = due.Price
+			if err := tx.Model(&menuSet).Update("price", due.Price).Error; err != nil {
+				return fmt.Errorf("failed to apply price of menu set %s: %w", due.OwnerID, err)
+			}
+			if err := recordMenuSetVersionTx(tx, menuSet, due.EffectiveFrom); err != nil {
+				return fmt.Errorf("failed to apply price of menu set %s: %w", due.OwnerID, err)
+			}
+			changed++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return changed, nil
+}
+
+// ---------------- Menu Set Slot ------------------------
+
+// SetMenuSetSlots แทนที่ช่องเลือกทั้งหมดของเมนูเซ็ต (ส่งว่าง = เซ็ตมีแต่เมนูที่กำหนดไว้)
+// ช่องที่ส่ง id เดิมมาจะใช้ id เดิม การจองที่อ้างถึงช่องเดิมจึงยังตรงกัน
+func (r *menuRepositoryDB) SetMenuSetSlots(ctx context.Context, menuSetID uuid.UUID, slots []MenuSetSlot) ([]MenuSetSlot, error) {
+	var saved []MenuSetSlot
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var set MenuSet
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("uuid").First(&set, "uuid = ?", menuSetID).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrMenuSetNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if err := checkSlotItemsTx(tx, slots); err != nil {
+			return err
+		}
+
+		var existing []uuid.UUID
+		if err := tx.Model(&MenuSetSlot{}).Where("menu_set_id = ?", menuSetID).Pluck("uuid", &existing).Error; err != nil {
+			return fmt.Errorf("failed to get menu set slots: %w", err)
+		}
+		owned := make(map[uuid.UUID]bool, len(existing))
+		for _, id := range existing {
+			owned[id] = true
+		}
+		// ลบช่องแล้วเมนูในช่องจะถูกลบตาม (ON DELETE CASCADE)
+		if err := tx.Where("menu_set_id = ?", menuSetID).Delete(&MenuSetSlot{}).Error; err != nil {
+			return fmt.Errorf("failed to clear menu set slots: %w", err)
+		}
+		for i := range slots {
+			slot := &slots[i]
+			slot.MenuSetID = menuSetID
+			slot.Position = int32(i)
+			if !owned[slot.UUID] {
+				slot.UUID = uuid.Nil
+			}
+			if err := tx.Create(slot).Error; err != nil {
+				return fmt.Errorf("failed to create menu set slot: %w", err)
+			}
+			for j := range slot.Items {
+				item := &slot.Items[j]
+				item.SlotID = slot.UUID
+				item.Position = int32(j)
+				if err := tx.Create(item).Error; err != nil {
+					return fmt.Errorf("failed to create menu set slot item: %w", err)
+				}
+			}
+		}
+
+		bySet, err := getMenuSetSlots(tx, []uuid.UUID{menuSetID})
+		saved = bySet[menuSetID]
+		return err
+	})
+	if err != nil {
+		logs.Error("Failed to set menu set slots", zap.Error(err))
+		return nil, err
+	}
+	return saved, nil
+}
+
+// checkSlotItemsTx ตรวจว่าหมวดหมู่และเมนูในช่องมีอยู่จริง และเมนูอยู่ในหมวดหมู่ของช่อง
+func checkSlotItemsTx(tx *gorm.DB, slots []MenuSetSlot) error {
+	var categories []MenuCategory
+	if err := tx.Find(&categories).Error; err != nil {
+		return fmt.Errorf("failed to get menu categories: %w", err)
+	}
+	categoryIDs := make(map[uuid.UUID]bool, len(categories))
+	for _, category := range categories {
+		categoryIDs[category.UUID] = true
+	}
+
+	var itemIDs []uuid.UUID
+	for _, slot := range slots {
+		if slot.CategoryID != nil && !categoryIDs[*slot.CategoryID] {
+			return ErrMenuCategoryNotFound
+		}
+		for _, item := range slot.Items {
+			itemIDs = append(itemIDs, item.MenuItemID)
+		}
+	}
+	if len(itemIDs) == 0 {
+		return nil
+	}
+	var items []MenuItem
+	if err := tx.Select("uuid", "category_id").Where("uuid IN ?", itemIDs).Find(&items).Error; err != nil {
+		return fmt.Errorf("failed to get menu items: %w", err)
+	}
+	itemCategories := make(map[uuid.UUID]uuid.UUID, len(items))
+	for _, item := range items {
+		itemCategories[item.UUID] = item.CategoryID
+	}
+
+	for _, slot := range slots {
+		var inCategory map[uuid.UUID]bool
+		if slot.CategoryID != nil {
+			inCategory = CategoryDescendants(categories, *slot.CategoryID)
+		}
+		for _, item := range slot.Items {
+			categoryID, ok := itemCategories[item.MenuItemID]
+			if !ok {
+				return ErrMenuItemNotFound
+			}
+			if inCategory != nil && !inCategory[categoryID] {
+				return ErrSlotItemNotInCategory
+			}
+		}
+	}
+	return nil
+}
+
+// GetMenuSetSlots
+func (r *menuRepositoryDB) GetMenuSetSlots(ctx context.Context, menuSetIDs []uuid.UUID) (map[uuid.UUID][]MenuSetSlot, error) {
+	slots, err := getMenuSetSlots(r.db.WithContext(ctx), menuSetIDs)
+	if err != nil {
+		logs.Error("Failed to get menu set slots", zap.Error(err))
+		return nil, err
+	}
+	return slots, nil
+}
+
+func getMenuSetSlots(db *gorm.DB, menuSetIDs []uuid.UUID) (map[uuid.UUID][]MenuSetSlot, error) {
+	result := make(map[uuid.UUID][]MenuSetSlot)
+	if len(menuSetIDs) == 0 {
+		return result, nil
+	}
+
+	var slots []MenuSetSlot
+	if err := db.Where("menu_set_id IN ?", menuSetIDs).Order("menu_set_id, position").Find(&slots).Error; err != nil {
+		return nil, fmt.Errorf("failed to get menu set slots: %w", err)
+	}
+	if len(slots) == 0 {
+		return result, nil
+	}
+
+	slotIDs := make([]uuid.UUID, len(slots))
+	for i, slot := range slots {
+		slotIDs[i] = slot.UUID
+	}
+	var items []MenuSetSlotItem
+	if err := db.Raw(`
+		SELECT ssi.slot_id, ssi.menu_item_id, ssi.upcharge, ssi.position, mi.name_th, mi.name_en
+		FROM menu_set_slot_items ssi
+		JOIN menu_items mi ON mi.uuid = ssi.menu_item_id
+		WHERE ssi.slot_id IN ?
+		ORDER BY ssi.slot_id, ssi.position`, slotIDs).Scan(&items).Error; err != nil {
+		return nil, fmt.Errorf("failed to get menu set slot items: %w", err)
+	}
+	bySlot := make(map[uuid.UUID][]MenuSetSlotItem)
+	for _, item := range items {
+		bySlot[item.SlotID] = append(bySlot[item.SlotID], item)
+	}
+
+	for _, slot := range slots {
+		slot.Items = bySlot[slot.UUID]
+		result[slot.MenuSetID] = append(result[slot.MenuSetID], slot)
+	}
+	return result, nil
+}
+
 // ---------------- Menu Set Item ------------------------
 
 func (r *menuRepositoryDB) CreateMenuSetItems(ctx context.Context, menuSetID uuid.UUID, menuItemIDs []uuid.UUID) error {
@@ -365,10 +1770,11 @@ func (r *menuRepositoryDB) GetMenuSetItems(ctx context.Context) ([]MenuSetItemDe
 			"mi.name_th AS menu_name_th",
 			"mi.name_en AS menu_name_en",
 			"mi.price AS menu_price",
-			"mi.category AS menu_category",
+			"mc.name_en AS menu_category",
 			"mi.image_url AS image_url").
 		Joins("JOIN menu_set_items msi ON ms.uuid = msi.menu_set_id").
 		Joins("JOIN menu_items mi ON msi.menu_item_id = mi.uuid").
+		Joins("JOIN menu_categories mc ON mc.uuid = mi.category_id").
 		Scan(&result).Error; err != nil {
 		logs.Error("Failed to get menu set items", zap.Error(err))
 		return nil, fmt.Errorf("failed to get menu set items: %v", err)
@@ -389,10 +1795,11 @@ func (r *menuRepositoryDB) GetMenuSetItemByMenuSetID(ctx context.Context, menuSe
 			"mi.name_th AS menu_name_th",
 			"mi.name_en AS menu_name_en",
 			"mi.price AS menu_price",
-			"mi.category AS menu_category",
+			"mc.name_en AS menu_category",
 			"mi.image_url AS image_url").
 		Joins("JOIN menu_set_items msi ON ms.uuid = msi.menu_set_id").
 		Joins("JOIN menu_items mi ON msi.menu_item_id = mi.uuid").
+		Joins("JOIN menu_categories mc ON mc.uuid = mi.category_id").
 		Where("ms.uuid = ?", menuSetID).
 		Scan(&result).Error; err != nil {
 		logs.Error("Failed to get menu set items by menu set ID", zap.Error(err))
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// สถานะของใบสั่งซื้อ DRAFT -> SENT -> RECEIVED หรือยกเลิกก่อนรับของ
+const (
+	PurchaseOrderDraft     = "DRAFT"
+	PurchaseOrderSent      = "SENT"
+	PurchaseOrderReceived  = "RECEIVED"
+	PurchaseOrderCancelled = "CANCELLED"
+)
+
+// Supplier คือผู้ขายวัตถุดิบ
+type Supplier struct {
+	UUID         uuid.UUID `gorm:"column:uuid;type:uuid;default:gen_random_uuid();primaryKey" json:"supplier_id"`
+	Name         string    `gorm:"column:name;type:varchar(255);not null;unique" json:"name"`
+	ContactName  string    `gorm:"column:contact_name;type:varchar(255);not null" json:"contact_name"`
+	Phone        string    `gorm:"column:phone;type:varchar(32);not null" json:"phone"`
+	Email        string    `gorm:"column:email;type:varchar(255);not null" json:"email"`
+	LeadTimeDays int32     `gorm:"column:lead_time_days;not null" json:"lead_time_days"` // จำนวนวันตั้งแต่สั่งจนของมาถึง
+}
+
+// PurchaseOrder คือใบสั่งซื้อของผู้ขายหนึ่งราย Supplier และ Lines โหลดมาพร้อมกันเสมอ
+type PurchaseOrder struct {
+	UUID       uuid.UUID           `gorm:"column:uuid;type:uuid;default:gen_random_uuid();primaryKey" json:"purchase_order_id"`
+	SupplierID uuid.UUID           `gorm:"column:supplier_id;type:uuid;not null" json:"supplier_id"`
+	Status     string              `gorm:"column:status;type:varchar(16);not null" json:"status"`
+	FromDate   time.Time           `gorm:"column:from_date;type:date;not null" json:"from_date"` // ช่วงวันของการจองที่ใช้คำนวณ
+	ToDate     time.Time           `gorm:"column:to_date;type:date;not null" json:"to_date"`     // รวมวันสุดท้าย
+	CreatedAt  time.Time           `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+	UpdatedAt  time.Time           `gorm:"column:updated_at;autoUpdateTime" json:"updated_at"`
+	Supplier   Supplier            `gorm:"-" json:"supplier"`
+	Lines      []PurchaseOrderLine `gorm:"-" json:"lines"`
+}
+
+// PurchaseOrderLine คือวัตถุดิบหนึ่งรายการในใบสั่งซื้อ
+type PurchaseOrderLine struct {
+	PurchaseOrderID uuid.UUID `gorm:"column:purchase_order_id;type:uuid;primaryKey" json:"purchase_order_id"`
+	IngredientID    uuid.UUID `gorm:"column:ingredient_id;type:uuid;primaryKey" json:"ingredient_id"`
+	Required        float64   `gorm:"column:required;type:numeric(14,3);not null" json:"required"` // ที่การจองในช่วงต้องใช้
+	OnHand          float64   `gorm:"column:on_hand;type:numeric(14,3);not null" json:"on_hand"`   // คงเหลือตอนสร้าง
+	OnOrder         float64   `gorm:"column:on_order;type:numeric(14,3);not null" json:"on_order"` // สั่งไปแล้วยังไม่ได้รับ
+	Quantity        float64   `gorm:"column:quantity;type:numeric(14,3);not null" json:"quantity"` // จำนวนที่สั่ง
+	UnitCost        float64   `gorm:"column:unit_cost;type:numeric(10,2);not null" json:"unit_cost"`
+	NameTH          string    `gorm:"column:name_th;->" json:"name_th"` // ชื่อวัตถุดิบ อ่านอย่างเดียว
+	NameEN          string    `gorm:"column:name_en;->" json:"name_en"`
+	Unit            string    `gorm:"column:unit;->" json:"unit"`
+}
+
+var (
+	ErrSupplierNotFound  = errors.New("supplier not found")
+	ErrSupplierNameTaken = errors.New("supplier name is already in use")
+	// ErrSupplierInUse ลบผู้ขายที่มีใบสั่งซื้อแล้วไม่ได้
+	ErrSupplierInUse         = errors.New("supplier has purchase orders")
+	ErrPurchaseOrderNotFound = errors.New("purchase order not found")
+	// ErrPurchaseOrderStatus เปลี่ยนสถานะข้ามขั้นหรือย้อนกลับไม่ได้
+	ErrPurchaseOrderStatus = errors.New("purchase order cannot change to this status")
+)
+
+type PurchasingRepository interface {
+	// Supplier Methods
+	CreateSupplier(ctx context.Context, supplier Supplier) (Supplier, error)
+	UpdateSupplier(ctx context.Context, supplier Supplier) (Supplier, error)
+	// DeleteSupplier วัตถุดิบของผู้ขายนี้จะไม่มีผู้ขายหลัก
+	DeleteSupplier(ctx context.Context, id uuid.UUID) error
+	// GetSuppliers เรียงตามชื่อ
+	GetSuppliers(ctx context.Context) ([]Supplier, error)
+
+	// Purchase Order Methods
+	// GetOnOrderQuantities ปริมาณของแต่ละวัตถุดิบในใบสั่งซื้อที่ส่งแล้วแต่ยังไม่ได้รับ
+	GetOnOrderQuantities(ctx context.Context) (map[uuid.UUID]float64, error)
+	// ReplaceDraftPurchaseOrders ลบใบสั่งซื้อ DRAFT ทั้งหมดแล้วบันทึก orders แทน
+	ReplaceDraftPurchaseOrders(ctx context.Context, orders []PurchaseOrder) ([]PurchaseOrder, error)
+	// GetPurchaseOrders ใหม่สุดก่อน status ว่าง = ทุกสถานะ
+	GetPurchaseOrders(ctx context.Context, status string) ([]PurchaseOrder, error)
+	GetPurchaseOrderByID(ctx context.Context, id uuid.UUID) (PurchaseOrder, error)
+	// SetPurchaseOrderStatus เปลี่ยนเป็น RECEIVED แล้วบันทึกรับของเข้าสต็อกทุกรายการใน transaction เดียวกัน
+	SetPurchaseOrderStatus(ctx context.Context, id uuid.UUID, status string) (PurchaseOrder, error)
+}
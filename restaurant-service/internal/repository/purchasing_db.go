@@ -0,0 +1,303 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+	"gitlab.com/final_project1240930/booking_service/internal/logs"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type purchasingRepositoryDB struct {
+	db *gorm.DB
+}
+
+func NewPurchasingRepository(db *gorm.DB) PurchasingRepository {
+	return &purchasingRepositoryDB{db: db}
+}
+
+// purchaseOrderTransitions สถานะที่เปลี่ยนไปได้จากแต่ละสถานะ
+var purchaseOrderTransitions = map[string][]string{
+	PurchaseOrderDraft: {PurchaseOrderSent, PurchaseOrderCancelled},
+	PurchaseOrderSent:  {PurchaseOrderReceived, PurchaseOrderCancelled},
+}
+
+// ---------------- Supplier ------------------------
+
+// CreateSupplier
+func (r *purchasingRepositoryDB) CreateSupplier(ctx context.Context, supplier Supplier) (Supplier, error) {
+	newID, err := uuid.NewV4()
+	if err != nil {
+		logs.Error("Failed to generate UUID", zap.Error(err))
+		return Supplier{}, err
+	}
+	supplier.UUID = newID
+
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkSupplierNameTx(tx, supplier); err != nil {
+			return err
+		}
+		return tx.Create(&supplier).Error
+	})
+	if err != nil {
+		logs.Error("Failed to create supplier", zap.Error(err), zap.Any("Supplier", supplier))
+		return Supplier{}, fmt.Errorf("failed to create supplier: %w", err)
+	}
+	return supplier, nil
+}
+
+// UpdateSupplier
+func (r *purchasingRepositoryDB) UpdateSupplier(ctx context.Context, supplier Supplier) (Supplier, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&Supplier{}).Where("uuid = ?", supplier.UUID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count == 0 {
+			return ErrSupplierNotFound
+		}
+		if err := checkSupplierNameTx(tx, supplier); err != nil {
+			return err
+		}
+		return tx.Save(&supplier).Error
+	})
+	if err != nil {
+		logs.Error("Failed to update supplier", zap.Error(err), zap.Any("Supplier", supplier))
+		return Supplier{}, fmt.Errorf("failed to update supplier: %w", err)
+	}
+	return supplier, nil
+}
+
+func checkSupplierNameTx(tx *gorm.DB, supplier Supplier) error {
+	var count int64
+	if err := tx.Model(&Supplier{}).Where("name = ? AND uuid <> ?", supplier.Name, supplier.UUID).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrSupplierNameTaken
+	}
+	return nil
+}
+
+// DeleteSupplier ลบได้เฉพาะผู้ขายที่ยังไม่มีใบสั่งซื้อ
+func (r *purchasingRepositoryDB) DeleteSupplier(ctx context.Context, id uuid.UUID) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var supplier Supplier
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&supplier, "uuid = ?", id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrSupplierNotFound
+			}
+			return err
+		}
+
+		var count int64
+		if err := tx.Model(&PurchaseOrder{}).Where("supplier_id = ?", id).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrSupplierInUse
+		}
+		return tx.Delete(&supplier).Error
+	})
+	if err != nil {
+		logs.Error("Failed to delete supplier", zap.String("ID", id.String()), zap.Error(err))
+		return fmt.Errorf("failed to delete supplier: %w", err)
+	}
+	return nil
+}
+
+// GetSuppliers
+func (r *purchasingRepositoryDB) GetSuppliers(ctx context.Context) ([]Supplier, error) {
+	var suppliers []Supplier
+	if err := r.db.WithContext(ctx).Order("name").Find(&suppliers).Error; err != nil {
+		logs.Error("Failed to get suppliers", zap.Error(err))
+		return nil, fmt.Errorf("failed to get suppliers: %w", err)
+	}
+	return suppliers, nil
+}
+
+// ---------------- Purchase Order ------------------------
+
+// GetOnOrderQuantities
+func (r *purchasingRepositoryDB) GetOnOrderQuantities(ctx context.Context) (map[uuid.UUID]float64, error) {
+	var rows []struct {
+		IngredientID uuid.UUID `gorm:"column:ingredient_id"`
+		Quantity     float64   `gorm:"column:quantity"`
+	}
+	if err := r.db.WithContext(ctx).
+		Table("purchase_order_lines l").
+		Select("l.ingredient_id, SUM(l.quantity) AS quantity").
+		Joins("JOIN purchase_orders po ON po.uuid = l.purchase_order_id").
+		Where("po.status = ?", PurchaseOrderSent).
+		Group("l.ingredient_id").
+		Scan(&rows).Error; err != nil {
+		logs.Error("Failed to get on-order quantities", zap.Error(err))
+		return nil, fmt.Errorf("failed to get on-order quantities: %w", err)
+	}
+
+	quantities := make(map[uuid.UUID]float64, len(rows))
+	for _, row := range rows {
+		quantities[row.IngredientID] = row.Quantity
+	}
+	return quantities, nil
+}
+
+// ReplaceDraftPurchaseOrders สร้างใบสั่งซื้อใหม่ทับร่างเดิม เพื่อให้สร้างซ้ำได้โดยไม่มีร่างค้างซ้อนกัน
+func (r *purchasingRepositoryDB) ReplaceDraftPurchaseOrders(ctx context.Context, orders []PurchaseOrder) ([]PurchaseOrder, error) {
+	ids := make([]uuid.UUID, 0, len(orders))
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("status = ?", PurchaseOrderDraft).Delete(&PurchaseOrder{}).Error; err != nil {
+			return err
+		}
+		for _, order := range orders {
+			newID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			order.UUID = newID
+			order.Status = PurchaseOrderDraft
+			if err := tx.Create(&order).Error; err != nil {
+				return err
+			}
+			for _, line := range order.Lines {
+				line.PurchaseOrderID = order.UUID
+				if err := tx.Create(&line).Error; err != nil {
+					return err
+				}
+			}
+			ids = append(ids, order.UUID)
+		}
+		return nil
+	})
+	if err != nil {
+		logs.Error("Failed to replace draft purchase orders", zap.Error(err))
+		return nil, fmt.Errorf("failed to replace draft purchase orders: %w", err)
+	}
+
+	logs.Info("Draft purchase orders generated", zap.Int("Count", len(ids)))
+	if len(ids) == 0 {
+		return []PurchaseOrder{}, nil
+	}
+	return r.loadPurchaseOrders(r.db.WithContext(ctx).Where("uuid IN ?", ids))
+}
+
+// GetPurchaseOrders
+func (r *purchasingRepositoryDB) GetPurchaseOrders(ctx context.Context, status string) ([]PurchaseOrder, error) {
+	query := r.db.WithContext(ctx)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	orders, err := r.loadPurchaseOrders(query)
+	if err != nil {
+		logs.Error("Failed to get purchase orders", zap.Error(err))
+		return nil, fmt.Errorf("failed to get purchase orders: %w", err)
+	}
+	return orders, nil
+}
+
+// GetPurchaseOrderByID
+func (r *purchasingRepositoryDB) GetPurchaseOrderByID(ctx context.Context, id uuid.UUID) (PurchaseOrder, error) {
+	orders, err := r.loadPurchaseOrders(r.db.WithContext(ctx).Where("uuid = ?", id))
+	if err != nil {
+		return PurchaseOrder{}, fmt.Errorf("failed to get purchase order: %w", err)
+	}
+	if len(orders) == 0 {
+		return PurchaseOrder{}, ErrPurchaseOrderNotFound
+	}
+	return orders[0], nil
+}
+
+// loadPurchaseOrders โหลดใบสั่งซื้อตาม query พร้อมผู้ขายและรายการ เรียงใหม่สุดก่อน
+// รายการในใบเรียงตามชื่อวัตถุดิบ
+func (r *purchasingRepositoryDB) loadPurchaseOrders(query *gorm.DB) ([]PurchaseOrder, error) {
+	var orders []PurchaseOrder
+	if err := query.Order("created_at DESC").Find(&orders).Error; err != nil {
+		return nil, err
+	}
+	if len(orders) == 0 {
+		return orders, nil
+	}
+
+	db := query.Session(&gorm.Session{NewDB: true})
+	ids := make([]uuid.UUID, len(orders))
+	supplierIDs := make([]uuid.UUID, len(orders))
+	for i, order := range orders {
+		ids[i] = order.UUID
+		supplierIDs[i] = order.SupplierID
+	}
+
+	var suppliers []Supplier
+	if err := db.Where("uuid IN ?", supplierIDs).Find(&suppliers).Error; err != nil {
+		return nil, err
+	}
+	supplierByID := make(map[uuid.UUID]Supplier, len(suppliers))
+	for _, supplier := range suppliers {
+		supplierByID[supplier.UUID] = supplier
+	}
+
+	var lines []PurchaseOrderLine
+	if err := db.
+		Select("purchase_order_lines.*, i.name_th, i.name_en, i.unit").
+		Joins("JOIN ingredients i ON i.uuid = purchase_order_lines.ingredient_id").
+		Where("purchase_order_lines.purchase_order_id IN ?", ids).
+		Order("i.name_th").
+		Find(&lines).Error; err != nil {
+		return nil, err
+	}
+	linesByOrder := make(map[uuid.UUID][]PurchaseOrderLine, len(orders))
+	for _, line := range lines {
+		linesByOrder[line.PurchaseOrderID] = append(linesByOrder[line.PurchaseOrderID], line)
+	}
+
+	for i := range orders {
+		orders[i].Supplier = supplierByID[orders[i].SupplierID]
+		orders[i].Lines = linesByOrder[orders[i].UUID]
+	}
+	return orders, nil
+}
+
+// SetPurchaseOrderStatus
+func (r *purchasingRepositoryDB) SetPurchaseOrderStatus(ctx context.Context, id uuid.UUID, status string) (PurchaseOrder, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var order PurchaseOrder
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&order, "uuid = ?", id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrPurchaseOrderNotFound
+			}
+			return err
+		}
+
+		allowed := false
+		for _, next := range purchaseOrderTransitions[order.Status] {
+			allowed = allowed || next == status
+		}
+		if !allowed {
+			return fmt.Errorf("%w: %s to %s", ErrPurchaseOrderStatus, order.Status, status)
+		}
+
+		if status == PurchaseOrderReceived {
+			var lines []PurchaseOrderLine
+			if err := tx.Where("purchase_order_id = ?", id).Find(&lines).Error; err != nil {
+				return err
+			}
+			note := "purchase order " + id.String()
+			for _, line := range lines {
+				if _, err := adjustStockTx(tx, line.IngredientID, MovementReceive, line.Quantity, note); err != nil {
+					return err
+				}
+			}
+		}
+		return tx.Model(&order).Update("status", status).Error
+	})
+	if err != nil {
+		logs.Error("Failed to update purchase order status", zap.String("ID", id.String()), zap.String("Status", status), zap.Error(err))
+		return PurchaseOrder{}, fmt.Errorf("failed to update purchase order status: %w", err)
+	}
+
+	logs.Info("Purchase order status updated", zap.String("ID", id.String()), zap.String("Status", status))
+	return r.GetPurchaseOrderByID(ctx, id)
+}
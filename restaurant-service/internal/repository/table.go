@@ -2,33 +2,126 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/gofrs/uuid"
 )
 
-type Type string
+type Table struct {
+	UUID        uuid.UUID `gorm:"column:uuid;type:uuid;default:gen_random_uuid();primaryKey"`
+	NumTable    int32     `gorm:"type:int;not null;unique"`
+	TypeID      uuid.UUID `gorm:"column:type_id;type:uuid;not null"` // ประเภทโต๊ะใน table_types
+	TableLayout `gorm:"embedded"`
+}
+
+// Shape รูปทรงของโต๊ะบนผังร้าน
+type Shape string
 
 const (
-	STANDARD Type = "STANDARD"
-	LARGE    Type = "LARGE"
+	SQUARE    Shape = "SQUARE"
+	ROUND     Shape = "ROUND"
+	RECTANGLE Shape = "RECTANGLE"
 )
 
-type Table struct {
-	UUID     uuid.UUID `gorm:"column:uuid;type:uuid;default:gen_random_uuid();primaryKey"`
-	NumTable int32     `gorm:"type:int;not null;unique"`
-	Type     Type      `gorm:"type:table_type;not null"`
+// TableLayout คือตำแหน่งของโต๊ะบนผังของโซน PosX/PosY คือจุดกึ่งกลางโต๊ะ Rotation เป็นองศา (0-359)
+type TableLayout struct {
+	ZoneID   *uuid.UUID `gorm:"column:zone_id;type:uuid" json:"zone_id"` // nil = ยังไม่ได้จัดโซน
+	PosX     float64    `gorm:"column:pos_x;not null" json:"pos_x"`
+	PosY     float64    `gorm:"column:pos_y;not null" json:"pos_y"`
+	Rotation float64    `gorm:"column:rotation;not null" json:"rotation"`
+	Shape    Shape      `gorm:"column:shape;type:varchar(16);not null" json:"shape"`
+}
+
+// TableZone คือโซนของร้าน เช่น ในร้าน ระเบียง ห้องส่วนตัว Width/Height คือขนาดผังของโซน (0 = ไม่กำหนด)
+type TableZone struct {
+	UUID      uuid.UUID `gorm:"column:uuid;type:uuid;default:gen_random_uuid();primaryKey" json:"zone_id"`
+	NameTH    string    `gorm:"column:name_th;type:varchar(255);not null;unique" json:"name_th"`
+	NameEN    string    `gorm:"column:name_en;type:varchar(255);not null;unique" json:"name_en"`
+	SortOrder int32     `gorm:"column:sort_order;not null" json:"sort_order"` // เรียงน้อยไปมาก
+	Width     int32     `gorm:"column:width;not null" json:"width"`
+	Height    int32     `gorm:"column:height;not null" json:"height"`
+}
+
+func (TableZone) TableName() string {
+	return "table_zones"
+}
+
+// TableCombination คือชุดโต๊ะที่ต่อกันได้สำหรับกลุ่มใหญ่ จองชุดแล้วโต๊ะทุกตัวในชุดจะไม่ว่าง
+type TableCombination struct {
+	UUID     uuid.UUID   `gorm:"column:uuid;type:uuid;default:gen_random_uuid();primaryKey" json:"combination_id"`
+	Name     string      `gorm:"column:name;type:varchar(255);not null;unique" json:"name"`
+	Capacity int32       `gorm:"column:capacity;not null" json:"capacity"` // จำนวนที่นั่งเมื่อต่อโต๊ะแล้ว
+	TableIDs []uuid.UUID `gorm:"-" json:"table_ids"`                       // เก็บใน table_combination_members
+}
+
+func (TableCombination) TableName() string {
+	return "table_combinations"
+}
+
+type TableCombinationMember struct {
+	CombinationID uuid.UUID `gorm:"column:combination_id;type:uuid;primaryKey"`
+	TableID       uuid.UUID `gorm:"column:table_id;type:uuid;primaryKey"`
 }
 
+func (TableCombinationMember) TableName() string {
+	return "table_combination_members"
+}
+
+var (
+	ErrTableNotFound      = errors.New("table not found")
+	ErrTableZoneNotFound  = errors.New("table zone not found")
+	ErrTableZoneNameTaken = errors.New("table zone name is already in use")
+	ErrTableZoneInUse     = errors.New("table zone still has tables")
+	// ErrTableInCombination ลบโต๊ะที่ยังอยู่ในชุดโต๊ะต่อไม่ได้
+	ErrTableInCombination           = errors.New("table is still part of a table combination")
+	ErrTableCombinationNotFound     = errors.New("table combination not found")
+	ErrTableCombinationNameTaken    = errors.New("table combination name is already in use")
+	ErrTableCombinationTableMissing = errors.New("table in combination not found")
+	ErrTableTypeNotFound            = errors.New("table type not found")
+	ErrTableTypeNameTaken           = errors.New("table type name is already in use")
+	// ErrTableTypeInUse ลบประเภทโต๊ะที่ยังมีโต๊ะใช้อยู่ไม่ได้
+	ErrTableTypeInUse     = errors.New("table type is still used by tables")
+	ErrTableBlockNotFound = errors.New("table block not found")
+)
+
+// TableBlock คือช่วงเวลาที่โต๊ะใช้งานไม่ได้ เช่น ชำรุด หรือกันไว้ให้พนักงาน ช่วงเวลาเป็น [StartTime, EndTime)
+// โต๊ะที่ถูก block จะไม่แสดงใน GetAvailableTables และจองไม่ได้ (booking-service ตรวจจาก table_blocks)
+type TableBlock struct {
+	UUID      uuid.UUID `gorm:"column:uuid;type:uuid;default:gen_random_uuid();primaryKey" json:"block_id"`
+	TableID   uuid.UUID `gorm:"column:table_id;type:uuid;not null" json:"table_id"`
+	NumTable  int32     `gorm:"column:num_table;->" json:"num_table"` // เลขโต๊ะ อ่านอย่างเดียว
+	Reason    string    `gorm:"column:reason;type:text;not null" json:"reason"`
+	StartTime time.Time `gorm:"column:start_time;not null" json:"start_time"`
+	EndTime   time.Time `gorm:"column:end_time;not null" json:"end_time"`
+	CreatedBy string    `gorm:"column:created_by;type:varchar(255);not null" json:"created_by"` // username ของผู้สร้าง
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime" json:"created_at"`
+}
+
+func (TableBlock) TableName() string {
+	return "table_blocks"
+}
+
+// TableType คือประเภทโต๊ะที่ร้านกำหนดเอง เช่น โต๊ะ 2 ที่ โต๊ะ 4 ที่ บูธ ที่นั่งบาร์ ห้องส่วนตัว
 type TableType struct {
-	Type      Type `gorm:"column:type;primaryKey"`
-	SeatCount int  `gorm:"column:seat_count"`
+	UUID        uuid.UUID `gorm:"column:uuid;type:uuid;default:gen_random_uuid();primaryKey" json:"type_id"`
+	Name        string    `gorm:"column:name;type:varchar(255);not null;unique" json:"name"`
+	MinSeats    int32     `gorm:"column:min_seats;not null" json:"min_seats"` // จำนวนลูกค้าที่เหมาะกับโต๊ะ
+	MaxSeats    int32     `gorm:"column:max_seats;not null" json:"max_seats"`
+	Description string    `gorm:"column:description;type:text;not null" json:"description"`
+	Surcharge   float64   `gorm:"column:surcharge;type:numeric(10,2);not null" json:"surcharge"` // ค่าบริการเพิ่ม (บาท)
+}
+
+func (TableType) TableName() string {
+	return "table_types"
 }
 
 type TableAvailability struct {
-	TimeSlot string `json:"time_slot"`
-	TableID  string `json:"table_id"`
-	NumTable int    `json:"num_table"`
-	Type     Type   `json:"type"`
+	TimeSlot string    `json:"time_slot"`
+	TableID  string    `json:"table_id"`
+	NumTable int       `json:"num_table"`
+	TypeID   uuid.UUID `json:"type_id"`
+	TableLayout
 }
 
 type TableRepository interface {
@@ -40,9 +133,32 @@ type TableRepository interface {
 	GetTableByID(ctx context.Context, tableID uuid.UUID) (Table, error)
 	GetTableByNumTable(ctx context.Context, numTable int32) (Table, error)
 
-	GetAvailableTables(ctx context.Context, date string) ([]TableAvailability, error)
+	// zoneID nil = ทุกโซน
+	GetAvailableTables(ctx context.Context, date string, zoneID *uuid.UUID) ([]TableAvailability, error)
+
+	// Floor plan
+	CreateTableZone(ctx context.Context, zone TableZone) (TableZone, error)
+	UpdateTableZone(ctx context.Context, zone TableZone) (TableZone, error)
+	DeleteTableZone(ctx context.Context, zoneID uuid.UUID) error
+	ListTableZones(ctx context.Context) ([]TableZone, error)
+	// UpdateTableLayouts บันทึกตำแหน่งโต๊ะหลายตัวพร้อมกัน (จากหน้าจัดผังร้าน) ถ้าตัวใดผิดจะไม่บันทึกเลย
+	UpdateTableLayouts(ctx context.Context, layouts map[uuid.UUID]TableLayout) error
+
+	// Table combinations
+	CreateTableCombination(ctx context.Context, combination TableCombination) (TableCombination, error)
+	UpdateTableCombination(ctx context.Context, combination TableCombination) (TableCombination, error)
+	DeleteTableCombination(ctx context.Context, combinationID uuid.UUID) error
+	ListTableCombinations(ctx context.Context) ([]TableCombination, error)
 
 	// CRUD for Table Types
-	UpdateTableType(ctx context.Context, Type Type, seatCount int32) error
+	CreateTableType(ctx context.Context, tableType TableType) (TableType, error)
+	UpdateTableType(ctx context.Context, tableType TableType) (TableType, error)
+	DeleteTableType(ctx context.Context, typeID uuid.UUID) error
 	ListTableTypes(ctx context.Context) ([]TableType, error)
+
+	// Table blocks
+	CreateTableBlock(ctx context.Context, block TableBlock) (TableBlock, error)
+	DeleteTableBlock(ctx context.Context, blockID uuid.UUID) error
+	// ListTableBlocks คืน block ที่คาบเกี่ยวกับช่วง [from, to) เรียงตามเวลาเริ่มและเลขโต๊ะ
+	ListTableBlocks(ctx context.Context, from, to time.Time) ([]TableBlock, error)
 }
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"gitlab.com/final_project1240930/booking_service/internal/logs"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ----------------  Table blocks ------------------------
+
+// CreateTableBlock
+func (r *tableRepository) CreateTableBlock(ctx context.Context, block TableBlock) (TableBlock, error) {
+	newID, err := uuid.NewV4()
+	if err != nil {
+		logs.Error("Failed to generate UUID", zap.Error(err))
+		return TableBlock{}, err
+	}
+	block.UUID = newID
+
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var table Table
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&table, "uuid = ?", block.TableID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrTableNotFound
+			}
+			return err
+		}
+		if err := tx.Create(&block).Error; err != nil {
+			return err
+		}
+		block.NumTable = table.NumTable
+		return nil
+	})
+	if err != nil {
+		logs.Error("Failed to create table block", zap.Error(err), zap.Any("TableBlock", block))
+		return TableBlock{}, fmt.Errorf("failed to create table block: %w", err)
+	}
+
+	logs.Info("Table block created successfully",
+		zap.String("ID", block.UUID.String()),
+		zap.Int32("NumTable", block.NumTable),
+		zap.String("CreatedBy", block.CreatedBy))
+	return block, nil
+}
+
+// DeleteTableBlock ใช้ยกเลิก block ก่อนหมดเวลา (เช่น ซ่อมเสร็จเร็วกว่าที่คาด)
+func (r *tableRepository) DeleteTableBlock(ctx context.Context, blockID uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&TableBlock{}, "uuid = ?", blockID)
+	if result.Error != nil {
+		logs.Error("Failed to delete table block", zap.String("BlockID", blockID.String()), zap.Error(result.Error))
+		return fmt.Errorf("failed to delete table block: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("failed to delete table block: %w", ErrTableBlockNotFound)
+	}
+	logs.Info("Table block deleted successfully", zap.String("BlockID", blockID.String()))
+	return nil
+}
+
+// ListTableBlocks
+func (r *tableRepository) ListTableBlocks(ctx context.Context, from, to time.Time) ([]TableBlock, error) {
+	var blocks []TableBlock
+	err := r.db.WithContext(ctx).
+		Table("table_blocks tb").
+		Select("tb.*, t.num_table").
+		Joins("JOIN tables t ON t.uuid = tb.table_id").
+		Where("tb.start_time < ? AND tb.end_time > ?", to, from).
+		Order("tb.start_time, t.num_table").
+		Scan(&blocks).Error
+	if err != nil {
+		logs.Error("Failed to fetch table blocks", zap.Error(err))
+		return nil, fmt.Errorf("failed to fetch table blocks: %w", err)
+	}
+	return blocks, nil
+}
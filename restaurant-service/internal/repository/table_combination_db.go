@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+	"gitlab.com/final_project1240930/booking_service/internal/logs"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ----------------  Table combinations ------------------------
+
+// CreateTableCombination
+func (r *tableRepository) CreateTableCombination(ctx context.Context, combination TableCombination) (TableCombination, error) {
+	newID, err := uuid.NewV4()
+	if err != nil {
+		logs.Error("Failed to generate UUID", zap.Error(err))
+		return TableCombination{}, err
+	}
+	combination.UUID = newID
+
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkTableCombinationTx(tx, combination); err != nil {
+			return err
+		}
+		if err := tx.Create(&combination).Error; err != nil {
+			return err
+		}
+		return replaceTableCombinationMembersTx(tx, combination)
+	})
+	if err != nil {
+		logs.Error("Failed to create table combination", zap.Error(err), zap.Any("TableCombination", combination))
+		return TableCombination{}, fmt.Errorf("failed to create table combination: %w", err)
+	}
+
+	logs.Info("Table combination created successfully", zap.String("ID", combination.UUID.String()), zap.String("Name", combination.Name))
+	return combination, nil
+}
+
+// UpdateTableCombination แทนที่ชื่อ จำนวนที่นั่ง และโต๊ะในชุดทั้งหมด
+func (r *tableRepository) UpdateTableCombination(ctx context.Context, combination TableCombination) (TableCombination, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing TableCombination
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&existing, "uuid = ?", combination.UUID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrTableCombinationNotFound
+			}
+			return err
+		}
+		if err := checkTableCombinationTx(tx, combination); err != nil {
+			return err
+		}
+		if err := tx.Save(&combination).Error; err != nil {
+			return err
+		}
+		return replaceTableCombinationMembersTx(tx, combination)
+	})
+	if err != nil {
+		logs.Error("Failed to update table combination", zap.Error(err), zap.Any("TableCombination", combination))
+		return TableCombination{}, fmt.Errorf("failed to update table combination: %w", err)
+	}
+	return combination, nil
+}
+
+// checkTableCombinationTx ตรวจว่าชื่อไม่ซ้ำและโต๊ะในชุดมีอยู่จริงทุกตัว
+func checkTableCombinationTx(tx *gorm.DB, combination TableCombination) error {
+	var count int64
+	if err := tx.Model(&TableCombination{}).
+		Where("name = ? AND uuid <> ?", combination.Name, combination.UUID).
+		Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrTableCombinationNameTaken
+	}
+
+	if err := tx.Model(&Table{}).Where("uuid IN ?", combination.TableIDs).Count(&count).Error; err != nil {
+		return err
+	}
+	if int(count) != len(combination.TableIDs) {
+		return ErrTableCombinationTableMissing
+	}
+	return nil
+}
+
+func replaceTableCombinationMembersTx(tx *gorm.DB, combination TableCombination) error {
+	if err := tx.Where("combination_id = ?", combination.UUID).Delete(&TableCombinationMember{}).Error; err != nil {
+		return err
+	}
+	members := make([]TableCombinationMember, len(combination.TableIDs))
+	for i, tableID := range combination.TableIDs {
+		members[i] = TableCombinationMember{CombinationID: combination.UUID, TableID: tableID}
+	}
+	return tx.Create(&members).Error
+}
+
+// DeleteTableCombination ไม่กระทบการจองเดิม เพราะการจองเก็บโต๊ะทุกตัวในชุดไว้แล้ว
+func (r *tableRepository) DeleteTableCombination(ctx context.Context, combinationID uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&TableCombination{}, "uuid = ?", combinationID)
+	if result.Error != nil {
+		logs.Error("Failed to delete table combination", zap.String("CombinationID", combinationID.String()), zap.Error(result.Error))
+		return fmt.Errorf("failed to delete table combination: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("failed to delete table combination: %w", ErrTableCombinationNotFound)
+	}
+	logs.Info("Table combination deleted successfully", zap.String("CombinationID", combinationID.String()))
+	return nil
+}
+
+// ListTableCombinations เรียงตามชื่อ TableIDs เรียงตามเลขโต๊ะ
+func (r *tableRepository) ListTableCombinations(ctx context.Context) ([]TableCombination, error) {
+	db := r.db.WithContext(ctx)
+
+	var combinations []TableCombination
+	if err := db.Order("name").Find(&combinations).Error; err != nil {
+		logs.Error("Failed to fetch table combinations", zap.Error(err))
+		return nil, fmt.Errorf("failed to fetch table combinations: %w", err)
+	}
+
+	var members []TableCombinationMember
+	if err := db.Raw(`
+		SELECT m.combination_id, m.table_id
+		FROM table_combination_members m
+		JOIN tables t ON t.uuid = m.table_id
+		ORDER BY t.num_table`).Scan(&members).Error; err != nil {
+		logs.Error("Failed to fetch table combination members", zap.Error(err))
+		return nil, fmt.Errorf("failed to fetch table combination members: %w", err)
+	}
+
+	tableIDs := make(map[uuid.UUID][]uuid.UUID, len(combinations))
+	for _, member := range members {
+		tableIDs[member.CombinationID] = append(tableIDs[member.CombinationID], member.TableID)
+	}
+	for i := range combinations {
+		combinations[i].TableIDs = tableIDs[combinations[i].UUID]
+	}
+	return combinations, nil
+}
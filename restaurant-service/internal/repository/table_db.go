@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"go.uber.org/zap"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // Gorm implementation of TableRepository
@@ -56,6 +58,12 @@ func (r *tableRepository) CreateTable(ctx context.Context, table Table) (uuid.UU
 		logs.Error("Table with NumTable already exists", zap.Int32("NumTable", table.NumTable))
 		return uuid.Nil, fmt.Errorf("table with num_table %d already exists", table.NumTable)
 	}
+	if err := checkTableZoneExists(r.db.WithContext(ctx), table.ZoneID); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to create table: %w", err)
+	}
+	if err := checkTableTypeExists(r.db.WithContext(ctx), table.TypeID); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to create table: %w", err)
+	}
 
 	// หากไม่พบข้อมูลซ้ำ ก็ทำการสร้างโต๊ะใหม่
 	if err := r.db.Create(&table).Error; err != nil {
@@ -68,6 +76,12 @@ func (r *tableRepository) CreateTable(ctx context.Context, table Table) (uuid.UU
 
 // UpdateTable updates an existing table in the database
 func (r *tableRepository) UpdateTable(ctx context.Context, table Table) error {
+	if err := checkTableZoneExists(r.db.WithContext(ctx), table.ZoneID); err != nil {
+		return fmt.Errorf("failed to update table: %w", err)
+	}
+	if err := checkTableTypeExists(r.db.WithContext(ctx), table.TypeID); err != nil {
+		return fmt.Errorf("failed to update table: %w", err)
+	}
 	if err := r.db.Save(&table).Error; err != nil {
 		logs.Error("Failed to update table", zap.Error(err))
 		return fmt.Errorf("failed to update table: %w", err)
@@ -78,6 +92,14 @@ func (r *tableRepository) UpdateTable(ctx context.Context, table Table) error {
 
 // DeleteTable deletes a table by its ID
 func (r *tableRepository) DeleteTable(ctx context.Context, tableID uuid.UUID) error {
+	var count int64
+	if err := r.db.Model(&TableCombinationMember{}).Where("table_id = ?", tableID).Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to delete table: %w", err)
+	}
+	if count > 0 {
+		return fmt.Errorf("failed to delete table: %w", ErrTableInCombination)
+	}
+
 	if err := r.db.Delete(&Table{}, "uuid = ?", tableID).Error; err != nil {
 		logs.Error("Failed to delete table", zap.String("TableID", tableID.String()), zap.Error(err))
 		return fmt.Errorf("failed to delete table: %w", err)
@@ -123,7 +145,7 @@ func (r *tableRepository) GetTableByNumTable(ctx context.Context, numTable int32
 	return table, nil
 }
 
-func (r *tableRepository) GetAvailableTables(ctx context.Context, date string) ([]TableAvailability, error) {
+func (r *tableRepository) GetAvailableTables(ctx context.Context, date string, zoneID *uuid.UUID) ([]TableAvailability, error) {
 	startTime, err := time.Parse("2006-01-02", date)
 	if err != nil {
 		return nil, fmt.Errorf("invalid date format: %v", err)
@@ -152,13 +174,23 @@ func (r *tableRepository) GetAvailableTables(ctx context.Context, date string) (
 				AND a.hour_start + interval '1 hour' > b.booking_date_time
 			JOIN booking_tables bt ON bt.booking_id = b.uuid
 			WHERE b.status NOT IN ('CANCELLED', 'COMPLETED')
+			UNION
+			-- โต๊ะที่ถูก block คาบเกี่ยวกับชั่วโมงนั้น ถือว่าไม่ว่าง
+			SELECT
+				tb.table_id,
+				a.hour_start
+			FROM
+				all_hours a
+			JOIN table_blocks tb
+			ON tb.start_time < a.hour_start + interval '1 hour'
+				AND tb.end_time > a.hour_start
 		),
 		available_tables AS (
 			SELECT
 				a.hour_start,
 				t.uuid AS table_id,
 				t.num_table,
-				t."type"
+				t.type_id
 			FROM
 				all_hours a
 			CROSS JOIN tables t
@@ -173,7 +205,12 @@ func (r *tableRepository) GetAvailableTables(ctx context.Context, date string) (
 			to_char(a.hour_start, 'HH24:MI') AS time_slot,
 			t.uuid AS table_id,
 			t.num_table,
-			t."type"
+			t.type_id,
+			t.zone_id,
+			t.pos_x,
+			t.pos_y,
+			t.rotation,
+			t.shape
 		FROM
 			all_hours a
 		CROSS JOIN tables t
@@ -183,12 +220,21 @@ func (r *tableRepository) GetAvailableTables(ctx context.Context, date string) (
 			WHERE b.hour_start = a.hour_start
 			AND b.table_id = t.uuid
 		)
+		%s
 		ORDER BY
 			a.hour_start, t.num_table;
 	`
 
+	// กรองเฉพาะโต๊ะในโซนที่ลูกค้าต้องการ (เช่น ระเบียงเท่านั้น)
+	args := []interface{}{startTime, endTime}
+	zoneFilter := ""
+	if zoneID != nil {
+		zoneFilter = "AND t.zone_id = ?"
+		args = append(args, *zoneID)
+	}
+
 	// Execute query using GORM
-	err = r.db.Raw(query, startTime, endTime).Scan(&result).Error
+	err = r.db.Raw(fmt.Sprintf(query, zoneFilter), args...).Scan(&result).Error
 	if err != nil {
 		return nil, err
 	}
@@ -197,44 +243,114 @@ func (r *tableRepository) GetAvailableTables(ctx context.Context, date string) (
 }
 
 // ----------------  CRUD Methods for TableType ------------------------
-func (r *tableRepository) UpdateTableType(ctx context.Context, tableType Type, seatCount int32) error {
-	// ตรวจสอบว่า seatCount เป็นค่าที่ถูกต้องหรือไม่
-	if seatCount <= 0 {
-		logs.Error("Invalid seat count", zap.Int32("SeatCount", seatCount))
-		return fmt.Errorf("seat count must be greater than 0")
+
+// CreateTableType
+func (r *tableRepository) CreateTableType(ctx context.Context, tableType TableType) (TableType, error) {
+	newID, err := uuid.NewV4()
+	if err != nil {
+		logs.Error("Failed to generate UUID", zap.Error(err))
+		return TableType{}, err
 	}
+	tableType.UUID = newID
 
-	// ใช้ GORM อัพเดทข้อมูลในตาราง table_types
-	var tableTypeEnum string
-	switch tableType {
-	case STANDARD:
-		tableTypeEnum = "STANDARD"
-	case LARGE:
-		tableTypeEnum = "LARGE"
-	default:
-		return fmt.Errorf("invalid table type: %v", tableType)
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkTableTypeNameTx(tx, tableType); err != nil {
+			return err
+		}
+		return tx.Create(&tableType).Error
+	})
+	if err != nil {
+		logs.Error("Failed to create table type", zap.Error(err), zap.Any("TableType", tableType))
+		return TableType{}, fmt.Errorf("failed to create table type: %w", err)
 	}
 
-	// อัพเดทข้อมูลในตาราง table_types
-	err := r.db.Model(&TableType{}).
-		Where("type = ?", tableTypeEnum).
-		Update("seat_count", seatCount).Error
+	logs.Info("Table type created successfully", zap.String("ID", tableType.UUID.String()), zap.String("Name", tableType.Name))
+	return tableType, nil
+}
 
+// UpdateTableType
+func (r *tableRepository) UpdateTableType(ctx context.Context, tableType TableType) (TableType, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing TableType
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&existing, "uuid = ?", tableType.UUID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrTableTypeNotFound
+			}
+			return err
+		}
+		if err := checkTableTypeNameTx(tx, tableType); err != nil {
+			return err
+		}
+		return tx.Save(&tableType).Error
+	})
 	if err != nil {
-		logs.Error("Failed to update table type in database", zap.Error(err))
-		return fmt.Errorf("failed to update table type in database: %v", err)
+		logs.Error("Failed to update table type", zap.Error(err), zap.Any("TableType", tableType))
+		return TableType{}, fmt.Errorf("failed to update table type: %w", err)
 	}
 
-	logs.Info("Table type updated successfully", zap.String("TableType", tableTypeEnum), zap.Int32("SeatCount", seatCount))
+	logs.Info("Table type updated successfully", zap.String("ID", tableType.UUID.String()), zap.String("Name", tableType.Name))
+	return tableType, nil
+}
+
+// checkTableTypeNameTx ตรวจว่าชื่อประเภทโต๊ะไม่ซ้ำกับประเภทอื่น
+func checkTableTypeNameTx(tx *gorm.DB, tableType TableType) error {
+	var count int64
+	if err := tx.Model(&TableType{}).
+		Where("name = ? AND uuid <> ?", tableType.Name, tableType.UUID).
+		Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrTableTypeNameTaken
+	}
+	return nil
+}
+
+// checkTableTypeExists ตรวจว่าประเภทโต๊ะที่โต๊ะอ้างถึงมีอยู่จริง
+func checkTableTypeExists(db *gorm.DB, typeID uuid.UUID) error {
+	var count int64
+	if err := db.Model(&TableType{}).Where("uuid = ?", typeID).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return ErrTableTypeNotFound
+	}
+	return nil
+}
+
+// DeleteTableType ลบได้เฉพาะประเภทที่ไม่มีโต๊ะใช้อยู่แล้ว
+func (r *tableRepository) DeleteTableType(ctx context.Context, typeID uuid.UUID) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var tableType TableType
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&tableType, "uuid = ?", typeID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrTableTypeNotFound
+			}
+			return err
+		}
+
+		var count int64
+		if err := tx.Model(&Table{}).Where("type_id = ?", typeID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrTableTypeInUse
+		}
+		return tx.Delete(&tableType).Error
+	})
+	if err != nil {
+		logs.Error("Failed to delete table type", zap.String("TypeID", typeID.String()), zap.Error(err))
+		return fmt.Errorf("failed to delete table type: %w", err)
+	}
 	return nil
 }
 
-// ListTableTypes all available table types (Standard, Large)
+// ListTableTypes เรียงตามจำนวนที่นั่ง
 func (r *tableRepository) ListTableTypes(ctx context.Context) ([]TableType, error) {
 	var tableTypes []TableType
 
 	// ดึงข้อมูลประเภทโต๊ะทั้งหมดจากฐานข้อมูล
-	err := r.db.Model(&TableType{}).Find(&tableTypes).Error
+	err := r.db.WithContext(ctx).Order("min_seats, max_seats, name").Find(&tableTypes).Error
 	if err != nil {
 		logs.Error("Failed to fetch table types", zap.Error(err))
 		return nil, fmt.Errorf("failed to fetch table types: %v", err)
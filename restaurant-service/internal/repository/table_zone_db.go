@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gofrs/uuid"
+	"gitlab.com/final_project1240930/booking_service/internal/logs"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ----------------  Floor plan ------------------------
+
+// CreateTableZone
+func (r *tableRepository) CreateTableZone(ctx context.Context, zone TableZone) (TableZone, error) {
+	newID, err := uuid.NewV4()
+	if err != nil {
+		logs.Error("Failed to generate UUID", zap.Error(err))
+		return TableZone{}, err
+	}
+	zone.UUID = newID
+
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkTableZoneNameTx(tx, zone); err != nil {
+			return err
+		}
+		return tx.Create(&zone).Error
+	})
+	if err != nil {
+		logs.Error("Failed to create table zone", zap.Error(err), zap.Any("TableZone", zone))
+		return TableZone{}, fmt.Errorf("failed to create table zone: %w", err)
+	}
+
+	logs.Info("Table zone created successfully", zap.String("ID", zone.UUID.String()), zap.String("NameTH", zone.NameTH))
+	return zone, nil
+}
+
+// UpdateTableZone
+func (r *tableRepository) UpdateTableZone(ctx context.Context, zone TableZone) (TableZone, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing TableZone
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&existing, "uuid = ?", zone.UUID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrTableZoneNotFound
+			}
+			return err
+		}
+		if err := checkTableZoneNameTx(tx, zone); err != nil {
+			return err
+		}
+		return tx.Save(&zone).Error
+	})
+	if err != nil {
+		logs.Error("Failed to update table zone", zap.Error(err), zap.Any("TableZone", zone))
+		return TableZone{}, fmt.Errorf("failed to update table zone: %w", err)
+	}
+	return zone, nil
+}
+
+// checkTableZoneNameTx ตรวจว่าชื่อโซนไม่ซ้ำกับโซนอื่น
+func checkTableZoneNameTx(tx *gorm.DB, zone TableZone) error {
+	var count int64
+	if err := tx.Model(&TableZone{}).
+		Where("(name_th = ? OR name_en = ?) AND uuid <> ?", zone.NameTH, zone.NameEN, zone.UUID).
+		Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrTableZoneNameTaken
+	}
+	return nil
+}
+
+// checkTableZoneExists ตรวจว่าโซนที่โต๊ะอ้างถึงมีอยู่จริง (nil = ไม่ได้จัดโซน ผ่านเสมอ)
+func checkTableZoneExists(db *gorm.DB, zoneID *uuid.UUID) error {
+	if zoneID == nil {
+		return nil
+	}
+	var count int64
+	if err := db.Model(&TableZone{}).Where("uuid = ?", *zoneID).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return ErrTableZoneNotFound
+	}
+	return nil
+}
+
+// DeleteTableZone ลบได้เฉพาะโซนที่ไม่มีโต๊ะแล้ว
+func (r *tableRepository) DeleteTableZone(ctx context.Context, zoneID uuid.UUID) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var zone TableZone
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&zone, "uuid = ?", zoneID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrTableZoneNotFound
+			}
+			return err
+		}
+
+		var count int64
+		if err := tx.Model(&Table{}).Where("zone_id = ?", zoneID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrTableZoneInUse
+		}
+		return tx.Delete(&zone).Error
+	})
+	if err != nil {
+		logs.Error("Failed to delete table zone", zap.String("ZoneID", zoneID.String()), zap.Error(err))
+		return fmt.Errorf("failed to delete table zone: %w", err)
+	}
+	return nil
+}
+
+// ListTableZones เรียงตาม sort_order
+func (r *tableRepository) ListTableZones(ctx context.Context) ([]TableZone, error) {
+	var zones []TableZone
+	if err := r.db.WithContext(ctx).Order("sort_order, name_en").Find(&zones).Error; err != nil {
+		logs.Error("Failed to fetch table zones", zap.Error(err))
+		return nil, fmt.Errorf("failed to fetch table zones: %w", err)
+	}
+	return zones, nil
+}
+
+// UpdateTableLayouts อัปเดตเฉพาะคอลัมน์ของผังร้าน ไม่แตะเลขโต๊ะและประเภทโต๊ะ
+func (r *tableRepository) UpdateTableLayouts(ctx context.Context, layouts map[uuid.UUID]TableLayout) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for tableID, layout := range layouts {
+			if err := checkTableZoneExists(tx, layout.ZoneID); err != nil {
+				return err
+			}
+			result := tx.Model(&Table{}).Where("uuid = ?", tableID).Updates(map[string]interface{}{
+				"zone_id":  layout.ZoneID,
+				"pos_x":    layout.PosX,
+				"pos_y":    layout.PosY,
+				"rotation": layout.Rotation,
+				"shape":    layout.Shape,
+			})
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return fmt.Errorf("%w: %s", ErrTableNotFound, tableID)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		logs.Error("Failed to update table layouts", zap.Int("Tables", len(layouts)), zap.Error(err))
+		return fmt.Errorf("failed to update table layouts: %w", err)
+	}
+
+	logs.Info("Table layouts updated successfully", zap.Int("Tables", len(layouts)))
+	return nil
+}
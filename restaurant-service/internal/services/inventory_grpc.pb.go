@@ -0,0 +1,818 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.2
+// source: inventory.proto
+
+package services
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	InventoryService_CreateIngredient_FullMethodName          = "/services.InventoryService/CreateIngredient"
+	InventoryService_UpdateIngredient_FullMethodName          = "/services.InventoryService/UpdateIngredient"
+	InventoryService_DeleteIngredient_FullMethodName          = "/services.InventoryService/DeleteIngredient"
+	InventoryService_GetIngredients_FullMethodName            = "/services.InventoryService/GetIngredients"
+	InventoryService_GetIngredientById_FullMethodName         = "/services.InventoryService/GetIngredientById"
+	InventoryService_SetMenuItemRecipe_FullMethodName         = "/services.InventoryService/SetMenuItemRecipe"
+	InventoryService_GetMenuItemRecipe_FullMethodName         = "/services.InventoryService/GetMenuItemRecipe"
+	InventoryService_AdjustStock_FullMethodName               = "/services.InventoryService/AdjustStock"
+	InventoryService_GetStockMovements_FullMethodName         = "/services.InventoryService/GetStockMovements"
+	InventoryService_GetConsumptionProjection_FullMethodName  = "/services.InventoryService/GetConsumptionProjection"
+	InventoryService_GetLowStockAlerts_FullMethodName         = "/services.InventoryService/GetLowStockAlerts"
+	InventoryService_CreateSupplier_FullMethodName            = "/services.InventoryService/CreateSupplier"
+	InventoryService_UpdateSupplier_FullMethodName            = "/services.InventoryService/UpdateSupplier"
+	InventoryService_DeleteSupplier_FullMethodName            = "/services.InventoryService/DeleteSupplier"
+	InventoryService_GetSuppliers_FullMethodName              = "/services.InventoryService/GetSuppliers"
+	InventoryService_GeneratePurchaseOrders_FullMethodName    = "/services.InventoryService/GeneratePurchaseOrders"
+	InventoryService_GetPurchaseOrders_FullMethodName         = "/services.InventoryService/GetPurchaseOrders"
+	InventoryService_GetPurchaseOrderById_FullMethodName      = "/services.InventoryService/GetPurchaseOrderById"
+	InventoryService_UpdatePurchaseOrderStatus_FullMethodName = "/services.InventoryService/UpdatePurchaseOrderStatus"
+)
+
+// InventoryServiceClient is the client API for InventoryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type InventoryServiceClient interface {
+	// Handle Ingredient
+	CreateIngredient(ctx context.Context, in *CreateIngredientRequest, opts ...grpc.CallOption) (*Ingredient, error)
+	UpdateIngredient(ctx context.Context, in *UpdateIngredientRequest, opts ...grpc.CallOption) (*Ingredient, error)
+	DeleteIngredient(ctx context.Context, in *IngredientIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetIngredients(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*IngredientList, error)
+	GetIngredientById(ctx context.Context, in *IngredientIdRequest, opts ...grpc.CallOption) (*Ingredient, error)
+	// Handle Recipe (วัตถุดิบที่ใช้ต่อเมนูหนึ่งจาน)
+	SetMenuItemRecipe(ctx context.Context, in *SetMenuItemRecipeRequest, opts ...grpc.CallOption) (*Recipe, error)
+	GetMenuItemRecipe(ctx context.Context, in *GetMenuItemRecipeRequest, opts ...grpc.CallOption) (*Recipe, error)
+	// Handle Stock
+	AdjustStock(ctx context.Context, in *AdjustStockRequest, opts ...grpc.CallOption) (*StockMovement, error)
+	GetStockMovements(ctx context.Context, in *GetStockMovementsRequest, opts ...grpc.CallOption) (*StockMovementList, error)
+	// คาดการณ์การใช้วัตถุดิบจากการจองที่ยืนยันแล้ว และเตือนวัตถุดิบใกล้หมด
+	GetConsumptionProjection(ctx context.Context, in *ConsumptionProjectionRequest, opts ...grpc.CallOption) (*ConsumptionProjection, error)
+	GetLowStockAlerts(ctx context.Context, in *LowStockAlertsRequest, opts ...grpc.CallOption) (*LowStockAlertList, error)
+	// Handle Supplier
+	CreateSupplier(ctx context.Context, in *Supplier, opts ...grpc.CallOption) (*Supplier, error)
+	UpdateSupplier(ctx context.Context, in *Supplier, opts ...grpc.CallOption) (*Supplier, error)
+	DeleteSupplier(ctx context.Context, in *SupplierIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetSuppliers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SupplierList, error)
+	// Handle Purchase Order (ใบสั่งซื้อตามปริมาณที่การจองต้องใช้)
+	GeneratePurchaseOrders(ctx context.Context, in *GeneratePurchaseOrdersRequest, opts ...grpc.CallOption) (*GeneratePurchaseOrdersResponse, error)
+	GetPurchaseOrders(ctx context.Context, in *GetPurchaseOrdersRequest, opts ...grpc.CallOption) (*PurchaseOrderList, error)
+	GetPurchaseOrderById(ctx context.Context, in *PurchaseOrderIdRequest, opts ...grpc.CallOption) (*PurchaseOrder, error)
+	UpdatePurchaseOrderStatus(ctx context.Context, in *UpdatePurchaseOrderStatusRequest, opts ...grpc.CallOption) (*PurchaseOrder, error)
+}
+
+type inventoryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInventoryServiceClient(cc grpc.ClientConnInterface) InventoryServiceClient {
+	return &inventoryServiceClient{cc}
+}
+
+func (c *inventoryServiceClient) CreateIngredient(ctx context.Context, in *CreateIngredientRequest, opts ...grpc.CallOption) (*Ingredient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Ingredient)
+	err := c.cc.Invoke(ctx, InventoryService_CreateIngredient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) UpdateIngredient(ctx context.Context, in *UpdateIngredientRequest, opts ...grpc.CallOption) (*Ingredient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Ingredient)
+	err := c.cc.Invoke(ctx, InventoryService_UpdateIngredient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) DeleteIngredient(ctx context.Context, in *IngredientIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, InventoryService_DeleteIngredient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetIngredients(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*IngredientList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IngredientList)
+	err := c.cc.Invoke(ctx, InventoryService_GetIngredients_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetIngredientById(ctx context.Context, in *IngredientIdRequest, opts ...grpc.CallOption) (*Ingredient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Ingredient)
+	err := c.cc.Invoke(ctx, InventoryService_GetIngredientById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SetMenuItemRecipe(ctx context.Context, in *SetMenuItemRecipeRequest, opts ...grpc.CallOption) (*Recipe, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Recipe)
+	err := c.cc.Invoke(ctx, InventoryService_SetMenuItemRecipe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetMenuItemRecipe(ctx context.Context, in *GetMenuItemRecipeRequest, opts ...grpc.CallOption) (*Recipe, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Recipe)
+	err := c.cc.Invoke(ctx, InventoryService_GetMenuItemRecipe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) AdjustStock(ctx context.Context, in *AdjustStockRequest, opts ...grpc.CallOption) (*StockMovement, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockMovement)
+	err := c.cc.Invoke(ctx, InventoryService_AdjustStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetStockMovements(ctx context.Context, in *GetStockMovementsRequest, opts ...grpc.CallOption) (*StockMovementList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockMovementList)
+	err := c.cc.Invoke(ctx, InventoryService_GetStockMovements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetConsumptionProjection(ctx context.Context, in *ConsumptionProjectionRequest, opts ...grpc.CallOption) (*ConsumptionProjection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConsumptionProjection)
+	err := c.cc.Invoke(ctx, InventoryService_GetConsumptionProjection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetLowStockAlerts(ctx context.Context, in *LowStockAlertsRequest, opts ...grpc.CallOption) (*LowStockAlertList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LowStockAlertList)
+	err := c.cc.Invoke(ctx, InventoryService_GetLowStockAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CreateSupplier(ctx context.Context, in *Supplier, opts ...grpc.CallOption) (*Supplier, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Supplier)
+	err := c.cc.Invoke(ctx, InventoryService_CreateSupplier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) UpdateSupplier(ctx context.Context, in *Supplier, opts ...grpc.CallOption) (*Supplier, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Supplier)
+	err := c.cc.Invoke(ctx, InventoryService_UpdateSupplier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) DeleteSupplier(ctx context.Context, in *SupplierIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, InventoryService_DeleteSupplier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetSuppliers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SupplierList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SupplierList)
+	err := c.cc.Invoke(ctx, InventoryService_GetSuppliers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GeneratePurchaseOrders(ctx context.Context, in *GeneratePurchaseOrdersRequest, opts ...grpc.CallOption) (*GeneratePurchaseOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneratePurchaseOrdersResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GeneratePurchaseOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetPurchaseOrders(ctx context.Context, in *GetPurchaseOrdersRequest, opts ...grpc.CallOption) (*PurchaseOrderList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseOrderList)
+	err := c.cc.Invoke(ctx, InventoryService_GetPurchaseOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetPurchaseOrderById(ctx context.Context, in *PurchaseOrderIdRequest, opts ...grpc.CallOption) (*PurchaseOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseOrder)
+	err := c.cc.Invoke(ctx, InventoryService_GetPurchaseOrderById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) UpdatePurchaseOrderStatus(ctx context.Context, in *UpdatePurchaseOrderStatusRequest, opts ...grpc.CallOption) (*PurchaseOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseOrder)
+	err := c.cc.Invoke(ctx, InventoryService_UpdatePurchaseOrderStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InventoryServiceServer is the server API for InventoryService service.
+// All implementations must embed UnimplementedInventoryServiceServer
+// for forward compatibility.
+type InventoryServiceServer interface {
+	// Handle Ingredient
+	CreateIngredient(context.Context, *CreateIngredientRequest) (*Ingredient, error)
+	UpdateIngredient(context.Context, *UpdateIngredientRequest) (*Ingredient, error)
+	DeleteIngredient(context.Context, *IngredientIdRequest) (*emptypb.Empty, error)
+	GetIngredients(context.Context, *emptypb.Empty) (*IngredientList, error)
+	GetIngredientById(context.Context, *IngredientIdRequest) (*Ingredient, error)
+	// Handle Recipe (วัตถุดิบที่ใช้ต่อเมนูหนึ่งจาน)
+	SetMenuItemRecipe(context.Context, *SetMenuItemRecipeRequest) (*Recipe, error)
+	GetMenuItemRecipe(context.Context, *GetMenuItemRecipeRequest) (*Recipe, error)
+	// Handle Stock
+	AdjustStock(context.Context, *AdjustStockRequest) (*StockMovement, error)
+	GetStockMovements(context.Context, *GetStockMovementsRequest) (*StockMovementList, error)
+	// คาดการณ์การใช้วัตถุดิบจากการจองที่ยืนยันแล้ว และเตือนวัตถุดิบใกล้หมด
+	GetConsumptionProjection(context.Context, *ConsumptionProjectionRequest) (*ConsumptionProjection, error)
+	GetLowStockAlerts(context.Context, *LowStockAlertsRequest) (*LowStockAlertList, error)
+	// Handle Supplier
+	CreateSupplier(context.Context, *Supplier) (*Supplier, error)
+	UpdateSupplier(context.Context, *Supplier) (*Supplier, error)
+	DeleteSupplier(context.Context, *SupplierIdRequest) (*emptypb.Empty, error)
+	GetSuppliers(context.Context, *emptypb.Empty) (*SupplierList, error)
+	// Handle Purchase Order (ใบสั่งซื้อตามปริมาณที่การจองต้องใช้)
+	GeneratePurchaseOrders(context.Context, *GeneratePurchaseOrdersRequest) (*GeneratePurchaseOrdersResponse, error)
+	GetPurchaseOrders(context.Context, *GetPurchaseOrdersRequest) (*PurchaseOrderList, error)
+	GetPurchaseOrderById(context.Context, *PurchaseOrderIdRequest) (*PurchaseOrder, error)
+	UpdatePurchaseOrderStatus(context.Context, *UpdatePurchaseOrderStatusRequest) (*PurchaseOrder, error)
+	mustEmbedUnimplementedInventoryServiceServer()
+}
+
+// UnimplementedInventoryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedInventoryServiceServer struct{}
+
+func (UnimplementedInventoryServiceServer) CreateIngredient(context.Context, *CreateIngredientRequest) (*Ingredient, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateIngredient not implemented")
+}
+func (UnimplementedInventoryServiceServer) UpdateIngredient(context.Context, *UpdateIngredientRequest) (*Ingredient, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateIngredient not implemented")
+}
+func (UnimplementedInventoryServiceServer) DeleteIngredient(context.Context, *IngredientIdRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteIngredient not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetIngredients(context.Context, *emptypb.Empty) (*IngredientList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIngredients not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetIngredientById(context.Context, *IngredientIdRequest) (*Ingredient, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIngredientById not implemented")
+}
+func (UnimplementedInventoryServiceServer) SetMenuItemRecipe(context.Context, *SetMenuItemRecipeRequest) (*Recipe, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMenuItemRecipe not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetMenuItemRecipe(context.Context, *GetMenuItemRecipeRequest) (*Recipe, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMenuItemRecipe not implemented")
+}
+func (UnimplementedInventoryServiceServer) AdjustStock(context.Context, *AdjustStockRequest) (*StockMovement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustStock not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetStockMovements(context.Context, *GetStockMovementsRequest) (*StockMovementList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStockMovements not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetConsumptionProjection(context.Context, *ConsumptionProjectionRequest) (*ConsumptionProjection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsumptionProjection not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetLowStockAlerts(context.Context, *LowStockAlertsRequest) (*LowStockAlertList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLowStockAlerts not implemented")
+}
+func (UnimplementedInventoryServiceServer) CreateSupplier(context.Context, *Supplier) (*Supplier, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSupplier not implemented")
+}
+func (UnimplementedInventoryServiceServer) UpdateSupplier(context.Context, *Supplier) (*Supplier, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSupplier not implemented")
+}
+func (UnimplementedInventoryServiceServer) DeleteSupplier(context.Context, *SupplierIdRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSupplier not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetSuppliers(context.Context, *emptypb.Empty) (*SupplierList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSuppliers not implemented")
+}
+func (UnimplementedInventoryServiceServer) GeneratePurchaseOrders(context.Context, *GeneratePurchaseOrdersRequest) (*GeneratePurchaseOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeneratePurchaseOrders not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetPurchaseOrders(context.Context, *GetPurchaseOrdersRequest) (*PurchaseOrderList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPurchaseOrders not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetPurchaseOrderById(context.Context, *PurchaseOrderIdRequest) (*PurchaseOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPurchaseOrderById not implemented")
+}
+func (UnimplementedInventoryServiceServer) UpdatePurchaseOrderStatus(context.Context, *UpdatePurchaseOrderStatusRequest) (*PurchaseOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePurchaseOrderStatus not implemented")
+}
+func (UnimplementedInventoryServiceServer) mustEmbedUnimplementedInventoryServiceServer() {}
+func (UnimplementedInventoryServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeInventoryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InventoryServiceServer will
+// result in compilation errors.
+type UnsafeInventoryServiceServer interface {
+	mustEmbedUnimplementedInventoryServiceServer()
+}
+
+func RegisterInventoryServiceServer(s grpc.ServiceRegistrar, srv InventoryServiceServer) {
+	// If the following call pancis, it indicates UnimplementedInventoryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&InventoryService_ServiceDesc, srv)
+}
+
+func _InventoryService_CreateIngredient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIngredientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CreateIngredient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CreateIngredient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CreateIngredient(ctx, req.(*CreateIngredientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_UpdateIngredient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateIngredientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).UpdateIngredient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_UpdateIngredient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).UpdateIngredient(ctx, req.(*UpdateIngredientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_DeleteIngredient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IngredientIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).DeleteIngredient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_DeleteIngredient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).DeleteIngredient(ctx, req.(*IngredientIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetIngredients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetIngredients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetIngredients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetIngredients(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetIngredientById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IngredientIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetIngredientById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetIngredientById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetIngredientById(ctx, req.(*IngredientIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SetMenuItemRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMenuItemRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SetMenuItemRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SetMenuItemRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SetMenuItemRecipe(ctx, req.(*SetMenuItemRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetMenuItemRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMenuItemRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetMenuItemRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetMenuItemRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetMenuItemRecipe(ctx, req.(*GetMenuItemRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_AdjustStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).AdjustStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_AdjustStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).AdjustStock(ctx, req.(*AdjustStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetStockMovements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStockMovementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetStockMovements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetStockMovements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetStockMovements(ctx, req.(*GetStockMovementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetConsumptionProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumptionProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetConsumptionProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetConsumptionProjection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetConsumptionProjection(ctx, req.(*ConsumptionProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetLowStockAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LowStockAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetLowStockAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetLowStockAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetLowStockAlerts(ctx, req.(*LowStockAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CreateSupplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Supplier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CreateSupplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CreateSupplier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CreateSupplier(ctx, req.(*Supplier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_UpdateSupplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Supplier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).UpdateSupplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_UpdateSupplier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).UpdateSupplier(ctx, req.(*Supplier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_DeleteSupplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupplierIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).DeleteSupplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_DeleteSupplier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).DeleteSupplier(ctx, req.(*SupplierIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetSuppliers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetSuppliers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetSuppliers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetSuppliers(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GeneratePurchaseOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeneratePurchaseOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GeneratePurchaseOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GeneratePurchaseOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GeneratePurchaseOrders(ctx, req.(*GeneratePurchaseOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetPurchaseOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPurchaseOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetPurchaseOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetPurchaseOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetPurchaseOrders(ctx, req.(*GetPurchaseOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetPurchaseOrderById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseOrderIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetPurchaseOrderById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetPurchaseOrderById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetPurchaseOrderById(ctx, req.(*PurchaseOrderIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_UpdatePurchaseOrderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePurchaseOrderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).UpdatePurchaseOrderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_UpdatePurchaseOrderStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).UpdatePurchaseOrderStatus(ctx, req.(*UpdatePurchaseOrderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// InventoryService_ServiceDesc is the grpc.ServiceDesc for InventoryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var InventoryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "services.InventoryService",
+	HandlerType: (*InventoryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateIngredient",
+			Handler:    _InventoryService_CreateIngredient_Handler,
+		},
+		{
+			MethodName: "UpdateIngredient",
+			Handler:    _InventoryService_UpdateIngredient_Handler,
+		},
+		{
+			MethodName: "DeleteIngredient",
+			Handler:    _InventoryService_DeleteIngredient_Handler,
+		},
+		{
+			MethodName: "GetIngredients",
+			Handler:    _InventoryService_GetIngredients_Handler,
+		},
+		{
+			MethodName: "GetIngredientById",
+			Handler:    _InventoryService_GetIngredientById_Handler,
+		},
+		{
+			MethodName: "SetMenuItemRecipe",
+			Handler:    _InventoryService_SetMenuItemRecipe_Handler,
+		},
+		{
+			MethodName: "GetMenuItemRecipe",
+			Handler:    _InventoryService_GetMenuItemRecipe_Handler,
+		},
+		{
+			MethodName: "AdjustStock",
+			Handler:    _InventoryService_AdjustStock_Handler,
+		},
+		{
+			MethodName: "GetStockMovements",
+			Handler:    _InventoryService_GetStockMovements_Handler,
+		},
+		{
+			MethodName: "GetConsumptionProjection",
+			Handler:    _InventoryService_GetConsumptionProjection_Handler,
+		},
+		{
+			MethodName: "GetLowStockAlerts",
+			Handler:    _InventoryService_GetLowStockAlerts_Handler,
+		},
+		{
+			MethodName: "CreateSupplier",
+			Handler:    _InventoryService_CreateSupplier_Handler,
+		},
+		{
+			MethodName: "UpdateSupplier",
+			Handler:    _InventoryService_UpdateSupplier_Handler,
+		},
+		{
+			MethodName: "DeleteSupplier",
+			Handler:    _InventoryService_DeleteSupplier_Handler,
+		},
+		{
+			MethodName: "GetSuppliers",
+			Handler:    _InventoryService_GetSuppliers_Handler,
+		},
+		{
+			MethodName: "GeneratePurchaseOrders",
+			Handler:    _InventoryService_GeneratePurchaseOrders_Handler,
+		},
+		{
+			MethodName: "GetPurchaseOrders",
+			Handler:    _InventoryService_GetPurchaseOrders_Handler,
+		},
+		{
+			MethodName: "GetPurchaseOrderById",
+			Handler:    _InventoryService_GetPurchaseOrderById_Handler,
+		},
+		{
+			MethodName: "UpdatePurchaseOrderStatus",
+			Handler:    _InventoryService_UpdatePurchaseOrderStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "inventory.proto",
+}
@@ -0,0 +1,479 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"gitlab.com/final_project1240930/booking_service/internal/logs"
+	"gitlab.com/final_project1240930/booking_service/internal/repository"
+	"go.uber.org/zap"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type inventoryServer struct {
+	inventoryRepo  repository.InventoryRepository
+	purchasingRepo repository.PurchasingRepository
+}
+
+func NewInventoryServer(inventoryRepo repository.InventoryRepository, purchasingRepo repository.PurchasingRepository) InventoryServiceServer {
+	return &inventoryServer{inventoryRepo: inventoryRepo, purchasingRepo: purchasingRepo}
+}
+
+func (s *inventoryServer) mustEmbedUnimplementedInventoryServiceServer() {}
+
+const (
+	defaultProjectionDays = 7
+	maxProjectionDays     = 90
+	defaultMovementLimit  = 100
+	maxMovementLimit      = 1000
+)
+
+// เหตุผลของการเตือนวัตถุดิบ เรียงตามความเร่งด่วน
+const (
+	alertShortfall   = "SHORTFALL"
+	alertLowStock    = "LOW_STOCK"
+	alertReorderSoon = "REORDER_SOON"
+)
+
+var alertRank = map[string]int{alertShortfall: 0, alertLowStock: 1, alertReorderSoon: 2}
+
+// ---------------- Ingredient ------------------------
+
+func (s *inventoryServer) CreateIngredient(ctx context.Context, req *CreateIngredientRequest) (*Ingredient, error) {
+	ingredient, err := convertProtoToIngredient(req.NameTh, req.NameEn, req.Unit, req.ReorderLevel, req.SupplierId, req.UnitCost, req.PackSize)
+	if err != nil {
+		return nil, err
+	}
+	if req.Stock < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Stock must not be negative")
+	}
+	ingredient.Stock = req.Stock
+
+	created, err := s.inventoryRepo.CreateIngredient(ctx, ingredient)
+	if err != nil {
+		return nil, ingredientError("create", err)
+	}
+	return convertIngredientToProto(created), nil
+}
+
+func (s *inventoryServer) UpdateIngredient(ctx context.Context, req *UpdateIngredientRequest) (*Ingredient, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid ingredient ID format")
+	}
+	ingredient, err := convertProtoToIngredient(req.NameTh, req.NameEn, req.Unit, req.ReorderLevel, req.SupplierId, req.UnitCost, req.PackSize)
+	if err != nil {
+		return nil, err
+	}
+	ingredient.UUID = id
+
+	updated, err := s.inventoryRepo.UpdateIngredient(ctx, ingredient)
+	if err != nil {
+		return nil, ingredientError("update", err)
+	}
+	return convertIngredientToProto(updated), nil
+}
+
+func (s *inventoryServer) DeleteIngredient(ctx context.Context, req *IngredientIdRequest) (*emptypb.Empty, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid ingredient ID format")
+	}
+	if err := s.inventoryRepo.DeleteIngredient(ctx, id); err != nil {
+		return nil, ingredientError("delete", err)
+	}
+
+	logs.Info("Ingredient deleted successfully", zap.String("ID", req.Id))
+	return &emptypb.Empty{}, nil
+}
+
+func (s *inventoryServer) GetIngredients(ctx context.Context, _ *emptypb.Empty) (*IngredientList, error) {
+	ingredients, err := s.inventoryRepo.GetIngredients(ctx)
+	if err != nil {
+		return nil, ingredientError("get", err)
+	}
+
+	list := &IngredientList{Ingredients: make([]*Ingredient, 0, len(ingredients))}
+	for _, ingredient := range ingredients {
+		list.Ingredients = append(list.Ingredients, convertIngredientToProto(ingredient))
+	}
+	return list, nil
+}
+
+func (s *inventoryServer) GetIngredientById(ctx context.Context, req *IngredientIdRequest) (*Ingredient, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid ingredient ID format")
+	}
+	ingredient, err := s.inventoryRepo.GetIngredientByID(ctx, id)
+	if err != nil {
+		return nil, ingredientError("get", err)
+	}
+	return convertIngredientToProto(ingredient), nil
+}
+
+// ingredientError แปลง error จาก repository เป็น gRPC status
+func ingredientError(action string, err error) error {
+	switch {
+	case errors.Is(err, repository.ErrIngredientNotFound):
+		return status.Errorf(codes.NotFound, "Ingredient not found")
+	case errors.Is(err, repository.ErrMenuItemNotFound):
+		return status.Errorf(codes.NotFound, "Menu item not found")
+	case errors.Is(err, repository.ErrSupplierNotFound):
+		return status.Errorf(codes.InvalidArgument, "Supplier not found")
+	case errors.Is(err, repository.ErrIngredientNameTaken),
+		errors.Is(err, repository.ErrIngredientInUse),
+		errors.Is(err, repository.ErrIngredientOrdered),
+		errors.Is(err, repository.ErrInsufficientStock):
+		return status.Error(codes.FailedPrecondition, errors.Unwrap(err).Error())
+	}
+	logs.Error("Failed to "+action+" ingredient", zap.Error(err))
+	return status.Errorf(codes.Internal, "Failed to %s ingredient: %v", action, err)
+}
+
+func convertProtoToIngredient(nameTH, nameEN, unit string, reorderLevel float64, supplierID string, unitCost, packSize float64) (repository.Ingredient, error) {
+	ingredient := repository.Ingredient{
+		NameTH:       strings.TrimSpace(nameTH),
+		NameEN:       strings.TrimSpace(nameEN),
+		Unit:         strings.ToLower(strings.TrimSpace(unit)),
+		ReorderLevel: reorderLevel,
+		UnitCost:     unitCost,
+		PackSize:     packSize,
+	}
+	if ingredient.NameTH == "" || ingredient.NameEN == "" {
+		return repository.Ingredient{}, status.Errorf(codes.InvalidArgument, "NameTh and NameEn must be provided")
+	}
+	if !slices.Contains(repository.IngredientUnits, ingredient.Unit) {
+		return repository.Ingredient{}, status.Errorf(codes.InvalidArgument, "Unit must be one of %s", strings.Join(repository.IngredientUnits, ", "))
+	}
+	if ingredient.ReorderLevel < 0 || ingredient.UnitCost < 0 || ingredient.PackSize < 0 {
+		return repository.Ingredient{}, status.Errorf(codes.InvalidArgument, "ReorderLevel, UnitCost and PackSize must not be negative")
+	}
+	if supplierID != "" {
+		id, err := uuid.FromString(supplierID)
+		if err != nil {
+			return repository.Ingredient{}, status.Errorf(codes.InvalidArgument, "Invalid supplier ID format")
+		}
+		ingredient.SupplierID = &id
+	}
+	return ingredient, nil
+}
+
+func convertIngredientToProto(ingredient repository.Ingredient) *Ingredient {
+	response := &Ingredient{
+		Id:           ingredient.UUID.String(),
+		NameTh:       ingredient.NameTH,
+		NameEn:       ingredient.NameEN,
+		Unit:         ingredient.Unit,
+		Stock:        ingredient.Stock,
+		ReorderLevel: ingredient.ReorderLevel,
+		UnitCost:     ingredient.UnitCost,
+		PackSize:     ingredient.PackSize,
+	}
+	if ingredient.SupplierID != nil {
+		response.SupplierId = ingredient.SupplierID.String()
+	}
+	return response
+}
+
+// ---------------- Recipe ------------------------
+
+func (s *inventoryServer) SetMenuItemRecipe(ctx context.Context, req *SetMenuItemRecipeRequest) (*Recipe, error) {
+	menuItemID, err := uuid.FromString(req.MenuItemId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid menu item ID format")
+	}
+
+	lines := make([]repository.RecipeLine, 0, len(req.Lines))
+	seen := make(map[uuid.UUID]bool, len(req.Lines))
+	for _, line := range req.Lines {
+		ingredientID, err := uuid.FromString(line.IngredientId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid ingredient ID format: %s", line.IngredientId)
+		}
+		if seen[ingredientID] {
+			return nil, status.Errorf(codes.InvalidArgument, "Ingredient %s is listed more than once", line.IngredientId)
+		}
+		if line.Quantity <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Quantity must be greater than 0")
+		}
+		seen[ingredientID] = true
+		lines = append(lines, repository.RecipeLine{IngredientID: ingredientID, Quantity: line.Quantity})
+	}
+
+	saved, err := s.inventoryRepo.SetMenuItemRecipe(ctx, menuItemID, lines)
+	if err != nil {
+		return nil, ingredientError("set recipe for", err)
+	}
+
+	logs.Info("Menu item recipe updated", zap.String("MenuItemID", req.MenuItemId), zap.Int("Lines", len(saved)))
+	return convertRecipeToProto(menuItemID, saved), nil
+}
+
+func (s *inventoryServer) GetMenuItemRecipe(ctx context.Context, req *GetMenuItemRecipeRequest) (*Recipe, error) {
+	menuItemID, err := uuid.FromString(req.MenuItemId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid menu item ID format")
+	}
+
+	lines, err := s.inventoryRepo.GetMenuItemRecipe(ctx, menuItemID)
+	if err != nil {
+		return nil, ingredientError("get recipe for", err)
+	}
+	return convertRecipeToProto(menuItemID, lines), nil
+}
+
+func convertRecipeToProto(menuItemID uuid.UUID, lines []repository.RecipeLine) *Recipe {
+	recipe := &Recipe{MenuItemId: menuItemID.String(), Lines: make([]*RecipeLine, 0, len(lines))}
+	for _, line := range lines {
+		recipe.Lines = append(recipe.Lines, &RecipeLine{
+			IngredientId: line.IngredientID.String(),
+			Quantity:     line.Quantity,
+			NameTh:       line.NameTH,
+			NameEn:       line.NameEN,
+			Unit:         line.Unit,
+		})
+	}
+	return recipe
+}
+
+// ---------------- Stock ------------------------
+
+func (s *inventoryServer) AdjustStock(ctx context.Context, req *AdjustStockRequest) (*StockMovement, error) {
+	ingredientID, err := uuid.FromString(req.IngredientId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid ingredient ID format")
+	}
+	note := strings.TrimSpace(req.Note)
+
+	// แปลงเป็นค่าที่เปลี่ยน ยกเว้น COUNT ที่ส่งจำนวนที่นับได้ไปตรง ๆ
+	quantity := req.Quantity
+	switch strings.ToUpper(req.Kind) {
+	case repository.MovementReceive:
+		if quantity <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Quantity must be greater than 0")
+		}
+	case repository.MovementConsume, repository.MovementWaste:
+		if quantity <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Quantity must be greater than 0")
+		}
+		quantity = -quantity
+	case repository.MovementCount:
+		if quantity < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Counted quantity must not be negative")
+		}
+	case repository.MovementAdjust:
+		if quantity == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Quantity must not be 0")
+		}
+		if note == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "Note must be provided for ADJUST")
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Kind must be one of RECEIVE, CONSUME, WASTE, COUNT, ADJUST")
+	}
+
+	movement, err := s.inventoryRepo.AdjustStock(ctx, ingredientID, strings.ToUpper(req.Kind), quantity, note)
+	if err != nil {
+		return nil, ingredientError("adjust stock of", err)
+	}
+	return convertStockMovementToProto(movement), nil
+}
+
+func (s *inventoryServer) GetStockMovements(ctx context.Context, req *GetStockMovementsRequest) (*StockMovementList, error) {
+	var ingredientID *uuid.UUID
+	if req.IngredientId != "" {
+		id, err := uuid.FromString(req.IngredientId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid ingredient ID format")
+		}
+		ingredientID = &id
+	}
+	limit := int(req.Limit)
+	switch {
+	case limit < 0:
+		return nil, status.Errorf(codes.InvalidArgument, "Limit must not be negative")
+	case limit == 0:
+		limit = defaultMovementLimit
+	case limit > maxMovementLimit:
+		limit = maxMovementLimit
+	}
+
+	movements, err := s.inventoryRepo.GetStockMovements(ctx, ingredientID, limit)
+	if err != nil {
+		return nil, ingredientError("get stock movements of", err)
+	}
+
+	list := &StockMovementList{Movements: make([]*StockMovement, 0, len(movements))}
+	for _, movement := range movements {
+		list.Movements = append(list.Movements, convertStockMovementToProto(movement))
+	}
+	return list, nil
+}
+
+func convertStockMovementToProto(movement repository.StockMovement) *StockMovement {
+	return &StockMovement{
+		Id:               movement.UUID.String(),
+		IngredientId:     movement.IngredientID.String(),
+		IngredientNameTh: movement.NameTH,
+		Kind:             movement.Kind,
+		Quantity:         movement.Quantity,
+		StockAfter:       movement.StockAfter,
+		Note:             movement.Note,
+		CreatedAt:        formatHistoryTime(&movement.CreatedAt),
+	}
+}
+
+// ---------------- Projection & Alerts ------------------------
+
+func (s *inventoryServer) GetConsumptionProjection(ctx context.Context, req *ConsumptionProjectionRequest) (*ConsumptionProjection, error) {
+	fromDate, err := stockDate(req.FromDate)
+	if err != nil {
+		return nil, err
+	}
+	days, err := projectionDays(req.Days)
+	if err != nil {
+		return nil, err
+	}
+
+	projections, from, to, err := s.projectConsumption(ctx, fromDate, days)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ConsumptionProjection{
+		FromDate:    from.Format("2006-01-02"),
+		ToDate:      to.AddDate(0, 0, -1).Format("2006-01-02"),
+		Ingredients: make([]*IngredientProjection, 0, len(projections)),
+	}
+	for _, projection := range projections {
+		if projection.TotalRequired > 0 {
+			result.Ingredients = append(result.Ingredients, projection)
+		}
+	}
+	return result, nil
+}
+
+func (s *inventoryServer) GetLowStockAlerts(ctx context.Context, req *LowStockAlertsRequest) (*LowStockAlertList, error) {
+	today, err := stockDate("")
+	if err != nil {
+		return nil, err
+	}
+	days, err := projectionDays(req.Days)
+	if err != nil {
+		return nil, err
+	}
+
+	projections, _, _, err := s.projectConsumption(ctx, today, days)
+	if err != nil {
+		return nil, err
+	}
+
+	alerts := &LowStockAlertList{}
+	for _, projection := range projections {
+		ingredient := projection.Ingredient
+		var reason string
+		switch {
+		case projection.ProjectedStock < 0:
+			reason = alertShortfall
+		case ingredient.ReorderLevel > 0 && ingredient.Stock <= ingredient.ReorderLevel:
+			reason = alertLowStock
+		case ingredient.ReorderLevel > 0 && projection.ProjectedStock <= ingredient.ReorderLevel:
+			reason = alertReorderSoon
+		default:
+			continue
+		}
+		alerts.Alerts = append(alerts.Alerts, &LowStockAlert{
+			Ingredient:     ingredient,
+			Reason:         reason,
+			Required:       projection.TotalRequired,
+			ProjectedStock: projection.ProjectedStock,
+			RunOutDate:     projection.RunOutDate,
+		})
+	}
+	sort.SliceStable(alerts.Alerts, func(i, j int) bool {
+		return alertRank[alerts.Alerts[i].Reason] < alertRank[alerts.Alerts[j].Reason]
+	})
+
+	if len(alerts.Alerts) > 0 {
+		logs.Info("Low stock alerts", zap.Int("Count", len(alerts.Alerts)))
+	}
+	return alerts, nil
+}
+
+func projectionDays(days int32) (int, error) {
+	switch {
+	case days < 0:
+		return 0, status.Errorf(codes.InvalidArgument, "Days must not be negative")
+	case days == 0:
+		return defaultProjectionDays, nil
+	case days > maxProjectionDays:
+		return 0, status.Errorf(codes.InvalidArgument, "Days must not exceed %d", maxProjectionDays)
+	}
+	return int(days), nil
+}
+
+// projectConsumption หักปริมาณที่การจองแต่ละวันต้องใช้ออกจากคงเหลือปัจจุบันทีละวัน
+// คืนทุกวัตถุดิบเรียงตามชื่อ พร้อมช่วงเวลา [from, to) ตามเวลาไทย
+func (s *inventoryServer) projectConsumption(ctx context.Context, fromDate string, days int) ([]*IngredientProjection, time.Time, time.Time, error) {
+	bangkok, err := time.LoadLocation("Asia/Bangkok")
+	if err != nil {
+		return nil, time.Time{}, time.Time{}, status.Errorf(codes.Internal, "could not load Bangkok timezone: %v", err)
+	}
+	from, err := time.ParseInLocation("2006-01-02", fromDate, bangkok)
+	if err != nil {
+		return nil, time.Time{}, time.Time{}, status.Errorf(codes.InvalidArgument, "date must be in YYYY-MM-DD format")
+	}
+	to := from.AddDate(0, 0, days)
+
+	ingredients, err := s.inventoryRepo.GetIngredients(ctx)
+	if err != nil {
+		return nil, time.Time{}, time.Time{}, ingredientError("get", err)
+	}
+	requirements, err := s.inventoryRepo.GetIngredientRequirements(ctx, from, to)
+	if err != nil {
+		return nil, time.Time{}, time.Time{}, ingredientError("project consumption of", err)
+	}
+
+	// requirements เรียงตามวันที่อยู่แล้ว
+	byIngredient := make(map[uuid.UUID][]repository.IngredientRequirement)
+	for _, requirement := range requirements {
+		byIngredient[requirement.IngredientID] = append(byIngredient[requirement.IngredientID], requirement)
+	}
+
+	projections := make([]*IngredientProjection, 0, len(ingredients))
+	for _, ingredient := range ingredients {
+		projection := &IngredientProjection{
+			Ingredient:     convertIngredientToProto(ingredient),
+			ProjectedStock: ingredient.Stock,
+		}
+		for _, requirement := range byIngredient[ingredient.UUID] {
+			projection.TotalRequired += requirement.Quantity
+			projection.ProjectedStock = roundStock(projection.ProjectedStock - requirement.Quantity)
+			if projection.ProjectedStock < 0 && projection.RunOutDate == "" {
+				projection.RunOutDate = requirement.Date
+			}
+			projection.Days = append(projection.Days, &DailyRequirement{
+				Date:           requirement.Date,
+				Quantity:       requirement.Quantity,
+				ProjectedStock: projection.ProjectedStock,
+			})
+		}
+		projection.TotalRequired = roundStock(projection.TotalRequired)
+		projections = append(projections, projection)
+	}
+	return projections, from, to, nil
+}
+
+// roundStock ปัดทศนิยมสามตำแหน่งเท่ากับที่เก็บในฐานข้อมูล
+func roundStock(quantity float64) float64 {
+	return math.Round(quantity*1000) / 1000
+}
@@ -0,0 +1,194 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"gitlab.com/final_project1240930/booking_service/internal/logs"
+	"gitlab.com/final_project1240930/booking_service/internal/repository"
+	"go.uber.org/zap"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ---------------- Menu Import/Export ------------------------
+
+// ExportMenu คืนเมนูทั้งร้านในรูปแบบที่ส่งกลับเข้า ImportMenu ได้ทันที
+func (s *menuServer) ExportMenu(ctx context.Context, _ *emptypb.Empty) (*MenuCatalog, error) {
+	catalog, err := s.menuRepo.ExportMenuCatalog(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to export menu: %v", err)
+	}
+
+	logs.Info("Menu exported",
+		zap.Int("Categories", len(catalog.Categories)),
+		zap.Int("Items", len(catalog.Items)),
+		zap.Int("Sets", len(catalog.Sets)),
+	)
+	return convertMenuCatalogToProto(catalog), nil
+}
+
+// ImportMenu รูปใน catalog ต้องอัปโหลดไว้ก่อนแล้ว (gateway อัปโหลดรูปจากไฟล์ zip ผ่าน UploadImage)
+func (s *menuServer) ImportMenu(ctx context.Context, req *ImportMenuRequest) (*ImportMenuResponse, error) {
+	catalog, err := convertProtoToMenuCatalog(req.Catalog)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := s.menuRepo.ImportMenuCatalog(ctx, catalog, req.DryRun)
+	if err != nil {
+		if errors.Is(err, repository.ErrInvalidMenuCatalog) {
+			return nil, status.Error(codes.InvalidArgument, errors.Unwrap(err).Error())
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to import menu: %v", err)
+	}
+
+	resp := &ImportMenuResponse{DryRun: req.DryRun, Changes: make([]*MenuImportChange, len(changes))}
+	for i, change := range changes {
+		resp.Changes[i] = &MenuImportChange{Kind: change.Kind, Name: change.Name, Action: change.Action, Fields: change.Fields}
+		switch change.Action {
+		case repository.ImportActionCreate:
+			resp.Created++
+		case repository.ImportActionUpdate:
+			resp.Updated++
+		default:
+			resp.Unchanged++
+		}
+	}
+
+	logs.Info("Menu imported",
+		zap.Bool("DryRun", req.DryRun),
+		zap.Int32("Created", resp.Created),
+		zap.Int32("Updated", resp.Updated),
+		zap.Int32("Unchanged", resp.Unchanged),
+	)
+	return resp, nil
+}
+
+func convertMenuCatalogToProto(catalog repository.MenuCatalog) *MenuCatalog {
+	resp := &MenuCatalog{
+		Categories: make([]*CatalogCategory, len(catalog.Categories)),
+		Items:      make([]*CatalogItem, len(catalog.Items)),
+		Sets:       make([]*CatalogSet, len(catalog.Sets)),
+	}
+	for i, category := range catalog.Categories {
+		resp.Categories[i] = &CatalogCategory{
+			NameTh:       category.NameTH,
+			NameEn:       category.NameEN,
+			SortOrder:    category.SortOrder,
+			ParentNameEn: category.ParentNameEN,
+		}
+	}
+	for i, item := range catalog.Items {
+		resp.Items[i] = &CatalogItem{
+			NameTh:         item.NameTH,
+			NameEn:         item.NameEN,
+			Description:    item.Description,
+			Price:          item.Price,
+			CategoryNameEn: item.CategoryNameEN,
+			IsAvailable:    item.IsAvailable,
+			DailyLimit:     item.DailyLimit,
+			Allergens:      item.Tags.Allergens,
+			DietaryTags:    item.Tags.DietaryTags,
+			Archived:       item.Archived,
+		}
+		if item.Image.FullURL != "" {
+			resp.Items[i].Image = convertImageVariantsToProto(item.Image)
+		}
+	}
+	for i, menuSet := range catalog.Sets {
+		resp.Sets[i] = &CatalogSet{
+			Name:        menuSet.Name,
+			Price:       menuSet.Price,
+			ItemNamesEn: menuSet.ItemNamesEN,
+			Archived:    menuSet.Archived,
+		}
+	}
+	return resp
+}
+
+// convertProtoToMenuCatalog ตรวจค่าของแต่ละรายการ ส่วนการอ้างถึงกันตรวจใน repository
+func convertProtoToMenuCatalog(req *MenuCatalog) (repository.MenuCatalog, error) {
+	var catalog repository.MenuCatalog
+	if req == nil || len(req.Categories)+len(req.Items)+len(req.Sets) == 0 {
+		return catalog, status.Errorf(codes.InvalidArgument, "Catalog must contain at least one category, menu item or menu set")
+	}
+
+	for _, category := range req.Categories {
+		entry := repository.CatalogCategory{
+			NameTH:       strings.TrimSpace(category.NameTh),
+			NameEN:       strings.TrimSpace(category.NameEn),
+			SortOrder:    category.SortOrder,
+			ParentNameEN: strings.TrimSpace(category.ParentNameEn),
+		}
+		if entry.NameTH == "" || entry.NameEN == "" {
+			return catalog, status.Errorf(codes.InvalidArgument, "Category name_th and name_en must be provided")
+		}
+		catalog.Categories = append(catalog.Categories, entry)
+	}
+
+	for _, item := range req.Items {
+		entry := repository.CatalogItem{
+			NameTH:         strings.TrimSpace(item.NameTh),
+			NameEN:         strings.TrimSpace(item.NameEn),
+			Description:    item.Description,
+			Price:          item.Price,
+			CategoryNameEN: strings.TrimSpace(item.CategoryNameEn),
+			IsAvailable:    item.IsAvailable,
+			DailyLimit:     item.DailyLimit,
+			Archived:       item.Archived,
+			ImageFile:      item.ImageFile,
+		}
+		if entry.NameTH == "" || entry.NameEN == "" {
+			return catalog, status.Errorf(codes.InvalidArgument, "Menu item name_th and name_en must be provided")
+		}
+		if entry.Price <= 0 {
+			return catalog, status.Errorf(codes.InvalidArgument, "Menu item %q: price must be greater than 0", entry.NameEN)
+		}
+		if entry.CategoryNameEN == "" {
+			return catalog, status.Errorf(codes.InvalidArgument, "Menu item %q: category_name_en must be provided", entry.NameEN)
+		}
+		if entry.DailyLimit < 0 {
+			return catalog, status.Errorf(codes.InvalidArgument, "Menu item %q: daily_limit must not be negative", entry.NameEN)
+		}
+
+		var err error
+		if entry.Tags.Allergens, err = normalizeTags("allergens", item.Allergens, repository.Allergens); err != nil {
+			return catalog, err
+		}
+		if entry.Tags.DietaryTags, err = normalizeTags("dietary_tags", item.DietaryTags, repository.DietaryTags); err != nil {
+			return catalog, err
+		}
+		if image := item.Image; image != nil && image.FullUrl != "" {
+			entry.Image = repository.ImageVariants{
+				FullURL:      image.FullUrl,
+				ThumbnailURL: image.ThumbnailUrl,
+				CardURL:      image.CardUrl,
+			}
+		}
+		catalog.Items = append(catalog.Items, entry)
+	}
+
+	for _, menuSet := range req.Sets {
+		entry := repository.CatalogSet{
+			Name:     strings.TrimSpace(menuSet.Name),
+			Price:    menuSet.Price,
+			Archived: menuSet.Archived,
+		}
+		if entry.Name == "" {
+			return catalog, status.Errorf(codes.InvalidArgument, "Menu set name must be provided")
+		}
+		if entry.Price <= 0 {
+			return catalog, status.Errorf(codes.InvalidArgument, "Menu set %q: price must be greater than 0", entry.Name)
+		}
+		for _, name := range menuSet.ItemNamesEn {
+			if name = strings.TrimSpace(name); name != "" {
+				entry.ItemNamesEN = append(entry.ItemNamesEN, name)
+			}
+		}
+		catalog.Sets = append(catalog.Sets, entry)
+	}
+	return catalog, nil
+}
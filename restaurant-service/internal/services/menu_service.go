@@ -2,10 +2,18 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/gofrs/uuid"
+	"gitlab.com/final_project1240930/booking_service/internal/imaging"
 	"gitlab.com/final_project1240930/booking_service/internal/logs"
+	"gitlab.com/final_project1240930/booking_service/internal/menusearch"
 	"gitlab.com/final_project1240930/booking_service/internal/repository"
 	"go.uber.org/zap"
 	codes "google.golang.org/grpc/codes"
@@ -24,19 +32,6 @@ func NewMenuServer(menuRepo repository.MenuRepository) MenuServiceServer {
 func (s menuServer) mustEmbedUnimplementedMenuServiceServer() {
 }
 
-func mapProtoCategoryToRepoCategory(protoCategory MenuCategory) (repository.MenuCategory, error) {
-	switch protoCategory {
-	case MenuCategory_MAIN_COURSE:
-		return repository.MainCourse, nil
-	case MenuCategory_BEVERAGE:
-		return repository.Beverage, nil
-	case MenuCategory_DESSERT:
-		return repository.Dessert, nil
-	default:
-		return "", fmt.Errorf("invalid category: %v", protoCategory)
-	}
-}
-
 // ---------------- Image Upload & Delete ------------------------
 
 func (s *menuServer) UploadImage(ctx context.Context, req *UploadImageRequest) (*UploadImageResponse, error) {
@@ -48,35 +43,74 @@ func (s *menuServer) UploadImage(ctx context.Context, req *UploadImageRequest) (
 		return nil, status.Errorf(codes.InvalidArgument, "Image data must be provided")
 	}
 
-	// อัปโหลดไฟล์ไปยัง Cloudinary
-	imageUrl, err := s.UploadImageToCloudinary(req.ImageData, req.FileName)
+	// ตรวจสอบ ย่อรูป แล้วอัปโหลดไปยังที่เก็บรูปภาพ
+	variants, err := s.storeImage(ctx, req.ImageData)
 	if err != nil {
-		logs.Error("Failed to upload image", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "Failed to upload image: %v", err)
+		return nil, err
 	}
 
 	// ส่งคืน URL ของภาพที่อัปโหลด
 	return &UploadImageResponse{
-		ImageUrl: imageUrl,
-		Status:   Status_SUCCESS,
+		ImageUrl:      variants.FullURL,
+		Status:        Status_SUCCESS,
+		ImageVariants: convertImageVariantsToProto(variants),
 	}, nil
 }
 
-// ฟังก์ชันช่วยอัปโหลดรูปภาพไปยัง Cloudinary
-func (s *menuServer) UploadImageToCloudinary(imageData []byte, fileName string) (string, error) {
+// storeImage ตรวจสอบชนิดไฟล์ ตัด EXIF ย่อเป็นทุกขนาดเป็น WebP แล้วอัปโหลดไปยังที่เก็บรูปภาพ
+// (Cloudinary, local หรือ S3 ตาม IMAGE_STORE) คืนค่า gRPC status error
+func (s *menuServer) storeImage(ctx context.Context, imageData []byte) (repository.ImageVariants, error) {
 	if len(imageData) == 0 {
 		logs.Error("No image data received")
-		return "", fmt.Errorf("no image data received")
+		return repository.ImageVariants{}, status.Errorf(codes.InvalidArgument, "Image data must be provided")
 	}
 
-	resp, err := s.menuRepo.UploadImage(context.Background(), imageData, fileName)
+	images, err := imaging.Process(imageData)
 	if err != nil {
-		logs.Error("Failed to upload image to Cloudinary", zap.Error(err))
-		return "", fmt.Errorf("failed to upload image to cloudinary: %v", err)
+		logs.Error("Failed to process image", zap.Error(err))
+		if errors.Is(err, imaging.ErrUnsupportedFormat) || errors.Is(err, imaging.ErrInvalidImage) {
+			return repository.ImageVariants{}, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return repository.ImageVariants{}, status.Errorf(codes.Internal, "Failed to process image: %v", err)
 	}
 
-	logs.Info("Image uploaded to Cloudinary", zap.String("image_url", resp))
-	return resp, nil
+	variants, err := s.menuRepo.UploadImage(ctx, images)
+	if err != nil {
+		logs.Error("Failed to upload image", zap.Error(err))
+		return repository.ImageVariants{}, status.Errorf(codes.Internal, "Failed to upload image: %v", err)
+	}
+
+	logs.Info("Image uploaded", zap.String("image_url", variants.FullURL))
+	return variants, nil
+}
+
+// convertImageVariantsToProto แปลง URL รูปแต่ละขนาด
+func convertImageVariantsToProto(variants repository.ImageVariants) *ImageVariants {
+	return &ImageVariants{
+		ThumbnailUrl: variants.ThumbnailURL,
+		CardUrl:      variants.CardURL,
+		FullUrl:      variants.FullURL,
+	}
+}
+
+// menuItemImageVariants คืนค่า URL รูปแต่ละขนาดของเมนู
+// รูปที่อัปโหลดก่อนมีการย่อรูปใช้รูปเดิมแทนทุกขนาด
+func menuItemImageVariants(item repository.MenuItem) *ImageVariants {
+	if item.ImageURL == "" || item.ImageURL == "no image" {
+		return nil
+	}
+	variants := repository.ImageVariants{
+		ThumbnailURL: item.ImageThumbnailURL,
+		CardURL:      item.ImageCardURL,
+		FullURL:      item.ImageURL,
+	}
+	if variants.ThumbnailURL == "" {
+		variants.ThumbnailURL = item.ImageURL
+	}
+	if variants.CardURL == "" {
+		variants.CardURL = item.ImageURL
+	}
+	return convertImageVariantsToProto(variants)
 }
 
 // ฟังก์ชันการลบรูปภาพ
@@ -122,32 +156,23 @@ func (s *menuServer) CreateMenuItem(ctx context.Context, req *CreateMenuItemRequ
 	}
 	println("Images", req.ImageData)
 
-	// แปลงประเภท Category
-	category, err := mapProtoCategoryToRepoCategory(req.Category)
+	// ตรวจสอบหมวดหมู่
+	categoryID, err := s.menuItemCategoryID(ctx, req.CategoryId)
 	if err != nil {
-		logs.Error("Validation error: Invalid Category", zap.String("Category", req.Category.String()))
-		return nil, status.Errorf(codes.InvalidArgument, "Invalid category")
+		return nil, err
 	}
 
 	// อัปโหลดรูปภาพถ้ามี
-	imageUrl := "no image"      // ถ้าไม่มีรูปภาพจะตั้งค่าเป็น "no image"
+	imageUrl := "no image" // ถ้าไม่มีรูปภาพจะตั้งค่าเป็น "no image"
+	var variants repository.ImageVariants
 	if len(req.ImageData) > 0 { // ถ้ามี ImageData
 		logs.Info("Image data received", zap.Int("ImageDataLength", len(req.ImageData)))
 
-		// สร้าง UUID สำหรับชื่อไฟล์
-		newUUID, err := uuid.NewV4()
-		if err != nil {
-			logs.Error("Error generating UUID", zap.Error(err))
-			return nil, status.Errorf(codes.Internal, "Error generating UUID: %v", err)
-		}
-		fileName := fmt.Sprintf("menu_image_%s.jpg", newUUID.String())
-
-		// อัปโหลดไฟล์ไปยัง Cloudinary
-		imageUrl, err = s.UploadImageToCloudinary(req.ImageData, fileName)
+		variants, err = s.storeImage(ctx, req.ImageData)
 		if err != nil {
-			logs.Error("Failed to upload image", zap.Error(err))
-			return nil, status.Errorf(codes.Internal, "Failed to upload image: %v", err)
+			return nil, err
 		}
+		imageUrl = variants.FullURL
 	}
 
 	// สร้าง MenuItem
@@ -156,8 +181,14 @@ func (s *menuServer) CreateMenuItem(ctx context.Context, req *CreateMenuItemRequ
 		NameEN:      req.NameEn,
 		Description: req.Description,
 		Price:       req.Price,
-		Category:    category,
+		CategoryID:  categoryID,
 		ImageURL:    imageUrl, // ถ้ามีการอัปโหลดจะได้ URL กลับมา, ถ้าไม่มีจะเป็น "no image"
+		ImageKey:    variants.Key,
+
+		ImageThumbnailURL: variants.ThumbnailURL,
+		ImageCardURL:      variants.CardURL,
+
+		IsAvailable: true,
 	}
 
 	newID, err := s.menuRepo.CreateMenuItem(ctx, item)
@@ -193,11 +224,10 @@ func (s *menuServer) UpdateMenuItem(ctx context.Context, req *UpdateMenuItemRequ
 		return nil, status.Errorf(codes.InvalidArgument, "Description must be provided")
 	}
 
-	// แปลงประเภท Category
-	category, err := mapProtoCategoryToRepoCategory(req.Category)
+	// ตรวจสอบหมวดหมู่
+	categoryID, err := s.menuItemCategoryID(ctx, req.CategoryId)
 	if err != nil {
-		logs.Error("Validation error: Invalid Category", zap.String("Category", req.Category.String()))
-		return nil, status.Errorf(codes.InvalidArgument, "Invalid category")
+		return nil, err
 	}
 
 	id, err := uuid.FromString(req.Id)
@@ -225,34 +255,27 @@ func (s *menuServer) UpdateMenuItem(ctx context.Context, req *UpdateMenuItemRequ
 	if req.Price > 0 {
 		menuItem.Price = req.Price
 	}
-	menuItem.Category = category
+	menuItem.CategoryID = categoryID
 
 	/// อัปโหลดรูปภาพใหม่ถ้ามี
 	if len(req.ImageData) > 0 {
-		// ลบรูปภาพเก่าก่อนหากมี
-		if menuItem.ImageURL != "no image" {
-			err := s.menuRepo.DeleteImage(ctx, repository.Image{ImageURL: menuItem.ImageURL})
-			if err != nil {
-				logs.Error("Failed to delete old image from Cloudinary", zap.Error(err))
-				return nil, status.Errorf(codes.Internal, "Failed to delete old image: %v", err)
-			}
-		}
-
-		// สร้าง UUID สำหรับชื่อไฟล์
-		newUUID, err := uuid.NewV4()
+		// อัปโหลดรูปใหม่ก่อน ถ้ารูปใหม่ใช้ไม่ได้รูปเก่าจะยังอยู่
+		variants, err := s.storeImage(ctx, req.ImageData)
 		if err != nil {
-			logs.Error("Error generating UUID", zap.Error(err))
-			return nil, status.Errorf(codes.Internal, "Error generating UUID: %v", err)
+			return nil, err
 		}
-		fileName := fmt.Sprintf("menu_image_%s.jpg", newUUID.String())
 
-		// อัปโหลดไฟล์ไปยัง Cloudinary
-		imageUrl, err := s.UploadImageToCloudinary(req.ImageData, fileName)
-		if err != nil {
-			logs.Error("Failed to upload image", zap.Error(err))
-			return nil, status.Errorf(codes.Internal, "Failed to upload image: %v", err)
+		// ลบรูปภาพเก่าหากมี
+		if menuItem.ImageURL != "no image" {
+			if err := s.menuRepo.DeleteImage(ctx, repository.Image{ImageURL: menuItem.ImageURL, ImageKey: menuItem.ImageKey}); err != nil {
+				logs.Error("Failed to delete old image", zap.Error(err))
+			}
 		}
-		menuItem.ImageURL = imageUrl
+
+		menuItem.ImageURL = variants.FullURL
+		menuItem.ImageKey = variants.Key
+		menuItem.ImageThumbnailURL = variants.ThumbnailURL
+		menuItem.ImageCardURL = variants.CardURL
 	}
 
 	if err := s.menuRepo.UpdateMenuItem(ctx, menuItem); err != nil {
@@ -282,21 +305,30 @@ func (s *menuServer) DeleteMenuItem(ctx context.Context, req *DeleteMenuItemRequ
 		return nil, status.Errorf(codes.NotFound, "Menu item not found")
 	}
 
-	// ลบรูปภาพจาก Cloudinary หากมี
-	if menuItem.ImageURL != "no image" {
-		err := s.menuRepo.DeleteImage(ctx, repository.Image{ImageURL: menuItem.ImageURL})
-		if err != nil {
-			logs.Error("Failed to delete image from Cloudinary", zap.Error(err))
-			return nil, status.Errorf(codes.Internal, "Failed to delete image: %v", err)
-		}
+	gallery, err := s.menuRepo.GetMenuItemImages(ctx, []uuid.UUID{menuItem.UUID})
+	if err != nil {
+		logs.Error("Failed to get menu item images", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Failed to get menu item images: %v", err)
 	}
 
-	// ลบข้อมูลเมนูจากฐานข้อมูล
+	// ลบข้อมูลเมนูจากฐานข้อมูล (รูปในแกลเลอรีถูกลบตาม ON DELETE CASCADE)
+	// เมนูที่การจองหรือเมนูเซ็ตยังอ้างถึงลบไม่ได้ ต้องเก็บเข้าคลังแทน
 	if err := s.menuRepo.DeleteMenuItem(ctx, menuItem.UUID); err != nil {
 		logs.Error("Failed to delete menu item", zap.Error(err))
+		if errors.Is(err, repository.ErrMenuItemInUse) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", repository.ErrMenuItemInUse)
+		}
 		return nil, status.Errorf(codes.Internal, "Failed to delete menu item: %v", err)
 	}
 
+	// ลบไฟล์รูปหลังลบข้อมูลแล้ว ถ้าลบไฟล์ไม่สำเร็จจะเหลือแค่ไฟล์ค้าง
+	if menuItem.ImageURL != "no image" {
+		s.deleteStoredImage(ctx, repository.Image{ImageURL: menuItem.ImageURL, ImageKey: menuItem.ImageKey})
+	}
+	for _, image := range gallery {
+		s.deleteStoredImage(ctx, repository.Image{ImageURL: image.ImageURL, ImageKey: image.ImageKey})
+	}
+
 	logs.Info("Menu item deleted successfully", zap.String("ID", req.Id))
 
 	return &DeleteMenuItemResponse{
@@ -304,42 +336,267 @@ func (s *menuServer) DeleteMenuItem(ctx context.Context, req *DeleteMenuItemRequ
 	}, nil
 }
 
-func (s *menuServer) GetMenuItems(ctx context.Context, _ *emptypb.Empty) (*MenuItemList, error) {
+func (s *menuServer) GetMenuItems(ctx context.Context, req *GetMenuItemsRequest) (*MenuItemList, error) {
+	listing, err := s.listMenuItems(ctx, menuItemFilter{
+		availability:     req.Availability,
+		date:             req.Date,
+		at:               req.At,
+		excludeAllergens: req.ExcludeAllergens,
+		dietaryTags:      req.DietaryTags,
+		categoryID:       req.CategoryId,
+		minPrice:         req.MinPrice,
+		maxPrice:         req.MaxPrice,
+		archived:         req.Archived,
+	})
+	if err != nil {
+		return nil, err
+	}
+	start, end, err := pageRange(len(listing.items), req.Page, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	menuItemList, err := s.menuItemsToProto(ctx, listing, listing.items[start:end])
+	if err != nil {
+		return nil, err
+	}
+
+	return &MenuItemList{
+		MenuItems: menuItemList,
+		Total:     int32(len(listing.items)),
+	}, nil
+}
+
+// menuItemFilter คือเงื่อนไขกรองเมนูที่ใช้ร่วมกันระหว่าง GetMenuItems และ SearchMenu
+type menuItemFilter struct {
+	availability     AvailabilityFilter
+	date             string
+	at               string
+	excludeAllergens []string
+	dietaryTags      []string
+	categoryID       string
+	minPrice         float64
+	maxPrice         float64
+	archived         bool // true = เอาเฉพาะเมนูที่เก็บเข้าคลังแล้ว false = เอาเฉพาะที่ยังขาย
+}
 
-	menuItems, err := s.menuRepo.GetMenuItems(ctx)
+// menuItemListing คือเมนูที่ผ่านการกรองแล้ว พร้อมข้อมูลที่โหลดมาใช้ตอนแปลงเป็น proto
+type menuItemListing struct {
+	items      []repository.MenuItem
+	usage      map[uuid.UUID]int32
+	schedules  map[uuid.UUID][]repository.MenuSchedule
+	tags       map[uuid.UUID]repository.MenuTags
+	categories map[uuid.UUID]repository.MenuCategory
+}
+
+// listMenuItems โหลดเมนูทั้งหมดแล้วกรองตาม filter เรียงตามลำดับเดิมของ repository
+func (s *menuServer) listMenuItems(ctx context.Context, filter menuItemFilter) (menuItemListing, error) {
+	at, hasAt, err := orderTime(filter.at)
+	if err != nil {
+		return menuItemListing{}, err
+	}
+	excludeAllergens, err := normalizeTags("exclude_allergens", filter.excludeAllergens, repository.Allergens)
+	if err != nil {
+		return menuItemListing{}, err
+	}
+	dietaryTags, err := normalizeTags("dietary_tags", filter.dietaryTags, repository.DietaryTags)
+	if err != nil {
+		return menuItemListing{}, err
+	}
+	if err := priceBounds(filter.minPrice, filter.maxPrice); err != nil {
+		return menuItemListing{}, err
+	}
+	dateValue := filter.date
+	if dateValue == "" && hasAt {
+		dateValue = at.Format("2006-01-02")
+	}
+	date, err := stockDate(dateValue)
+	if err != nil {
+		return menuItemListing{}, err
+	}
+
+	allMenuItems, err := s.menuRepo.GetMenuItems(ctx)
 	if err != nil {
 		logs.Error("Failed to get menu items", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "Failed to get menu items: %v", err)
+		return menuItemListing{}, status.Errorf(codes.Internal, "Failed to get menu items: %v", err)
 	}
 
-	var menuItemList []*MenuItem
-	for _, item := range menuItems {
-		var protoCategory MenuCategory
-		switch item.Category {
-		case repository.MainCourse:
-			protoCategory = MenuCategory_MAIN_COURSE
-		case repository.Beverage:
-			protoCategory = MenuCategory_BEVERAGE
-		case repository.Dessert:
-			protoCategory = MenuCategory_DESSERT
-		default:
-			return nil, fmt.Errorf("invalid category: %v", item.Category)
+	allMenuItemIDs := make([]uuid.UUID, 0, len(allMenuItems))
+	for _, item := range allMenuItems {
+		allMenuItemIDs = append(allMenuItemIDs, item.UUID)
+	}
+	usage, err := s.menuRepo.GetMenuItemUsage(ctx, date, allMenuItemIDs)
+	if err != nil {
+		return menuItemListing{}, status.Errorf(codes.Internal, "Failed to get menu item stock: %v", err)
+	}
+	schedules, err := s.menuRepo.GetMenuItemSchedules(ctx, allMenuItemIDs)
+	if err != nil {
+		return menuItemListing{}, status.Errorf(codes.Internal, "Failed to get menu item schedules: %v", err)
+	}
+	schedulesByItem := make(map[uuid.UUID][]repository.MenuSchedule)
+	for _, schedule := range schedules {
+		schedulesByItem[*schedule.MenuItemID] = append(schedulesByItem[*schedule.MenuItemID], schedule)
+	}
+	tagsByItem, err := s.menuRepo.GetMenuItemTags(ctx, allMenuItemIDs)
+	if err != nil {
+		return menuItemListing{}, status.Errorf(codes.Internal, "Failed to get menu item tags: %v", err)
+	}
+	categories, err := s.menuRepo.GetMenuCategories(ctx)
+	if err != nil {
+		return menuItemListing{}, status.Errorf(codes.Internal, "Failed to get menu categories: %v", err)
+	}
+	categoriesByID := make(map[uuid.UUID]repository.MenuCategory, len(categories))
+	for _, category := range categories {
+		categoriesByID[category.UUID] = category
+	}
+
+	// กรองตามหมวดหมู่ รวมเมนูในหมวดหมู่ย่อยด้วย
+	inCategory, err := categoryScope(categories, filter.categoryID)
+	if err != nil {
+		return menuItemListing{}, err
+	}
+
+	// กรองตามสถานะการขายของวันที่ขอ ถ้าระบุ at จะคืนเฉพาะเมนูที่สั่งได้ในเวลานั้น
+	availability := filter.availability
+	if hasAt && availability == AvailabilityFilter_AVAILABILITY_ALL {
+		availability = AvailabilityFilter_AVAILABILITY_AVAILABLE
+	}
+	var menuItems []repository.MenuItem
+	for _, item := range allMenuItems {
+		if (item.ArchivedAt != nil) != filter.archived {
+			continue
+		}
+		if inCategory != nil && !inCategory[item.CategoryID] {
+			continue
+		}
+		if !inPriceRange(item.Price, filter.minPrice, filter.maxPrice) {
+			continue
+		}
+		if !matchesTags(tagsByItem[item.UUID], excludeAllergens, dietaryTags) {
+			continue
 		}
+		orderable := isOrderable(item, usage) && (!hasAt || repository.ScheduledAt(schedulesByItem[item.UUID], at))
+		switch availability {
+		case AvailabilityFilter_AVAILABILITY_AVAILABLE:
+			if !orderable {
+				continue
+			}
+		case AvailabilityFilter_AVAILABILITY_UNAVAILABLE:
+			if orderable {
+				continue
+			}
+		}
+		menuItems = append(menuItems, item)
+	}
 
+	return menuItemListing{
+		items:      menuItems,
+		usage:      usage,
+		schedules:  schedulesByItem,
+		tags:       tagsByItem,
+		categories: categoriesByID,
+	}, nil
+}
+
+// menuItemsToProto แปลงเมนูบางส่วนของ listing (เช่น หน้าเดียว) เป็น proto โหลดรูปและตัวเลือกเฉพาะเมนูเหล่านั้น
+func (s *menuServer) menuItemsToProto(ctx context.Context, listing menuItemListing, menuItems []repository.MenuItem) ([]*MenuItem, error) {
+	menuItemIDs := make([]uuid.UUID, 0, len(menuItems))
+	for _, item := range menuItems {
+		menuItemIDs = append(menuItemIDs, item.UUID)
+	}
+	gallery, err := s.menuRepo.GetMenuItemImages(ctx, menuItemIDs)
+	if err != nil {
+		logs.Error("Failed to get menu item images", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Failed to get menu item images: %v", err)
+	}
+	modifiersByItem, err := s.menuRepo.GetMenuItemModifiers(ctx, menuItemIDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get menu item modifiers: %v", err)
+	}
+	imagesByItem := make(map[uuid.UUID][]*MenuItemImage)
+	for _, image := range gallery {
+		imagesByItem[image.MenuItemID] = append(imagesByItem[image.MenuItemID], convertMenuItemImageToProto(image))
+	}
+
+	var menuItemList []*MenuItem
+	for _, item := range menuItems {
 		menuItemList = append(menuItemList, &MenuItem{
 			Id:          item.UUID.String(),
 			NameTh:      item.NameTH,
 			NameEn:      item.NameEN,
 			Description: item.Description,
 			Price:       item.Price,
-			Category:    protoCategory,
+			CategoryId:  item.CategoryID.String(),
+			Category:    convertMenuCategoryToProto(listing.categories[item.CategoryID]),
 			ImageUrl:    item.ImageURL,
+
+			ImageVariants: menuItemImageVariants(item),
+			Images:        imagesByItem[item.UUID],
+
+			IsAvailable:    item.IsAvailable,
+			DailyLimit:     item.DailyLimit,
+			RemainingStock: remainingStock(item, listing.usage),
+			Schedules:      convertSchedulesToProto(listing.schedules[item.UUID]),
+			Allergens:      listing.tags[item.UUID].Allergens,
+			DietaryTags:    listing.tags[item.UUID].DietaryTags,
+			ModifierGroups: convertModifierGroupsToProto(modifiersByItem[item.UUID]),
+			ArchivedAt:     formatHistoryTime(item.ArchivedAt),
 		})
 	}
+	return menuItemList, nil
+}
 
-	return &MenuItemList{
-		MenuItems: menuItemList,
-	}, nil
+// categoryScope คืนหมวดหมู่ categoryID และหมวดหมู่ย่อยทั้งหมด nil = ไม่กรองตามหมวดหมู่
+func categoryScope(categories []repository.MenuCategory, categoryID string) (map[uuid.UUID]bool, error) {
+	if categoryID == "" {
+		return nil, nil
+	}
+	id, err := uuid.FromString(categoryID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid category ID format")
+	}
+	for _, category := range categories {
+		if category.UUID == id {
+			return repository.CategoryDescendants(categories, id), nil
+		}
+	}
+	return nil, status.Errorf(codes.InvalidArgument, "Menu category not found")
+}
+
+// priceBounds ตรวจช่วงราคาที่ใช้กรอง 0 = ไม่จำกัดด้านนั้น
+func priceBounds(minPrice, maxPrice float64) error {
+	if minPrice < 0 || maxPrice < 0 {
+		return status.Errorf(codes.InvalidArgument, "min_price and max_price must not be negative")
+	}
+	if maxPrice > 0 && minPrice > maxPrice {
+		return status.Errorf(codes.InvalidArgument, "min_price must not be greater than max_price")
+	}
+	return nil
+}
+
+func inPriceRange(price, minPrice, maxPrice float64) bool {
+	return price >= minPrice && (maxPrice == 0 || price <= maxPrice)
+}
+
+// maxPageSize จำนวนต่อหน้าสูงสุดของรายการเมนูและผลการค้นหา
+const maxPageSize = 100
+
+// pageRange คืนช่วง [start, end) ของหน้าที่ขอ page เริ่มที่ 1 (0 = หน้าแรก) pageSize 0 = คืนทั้งหมด
+// หน้าที่เกินจำนวนที่มีคืนช่วงว่าง
+func pageRange(total int, page, pageSize int32) (int, int, error) {
+	if page < 0 || pageSize < 0 {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "page and page_size must not be negative")
+	}
+	if pageSize > maxPageSize {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "page_size must not exceed %d", maxPageSize)
+	}
+	if pageSize == 0 {
+		return 0, total, nil
+	}
+	if page == 0 {
+		page = 1
+	}
+	start := min(int(page-1)*int(pageSize), total)
+	return start, min(start+int(pageSize), total), nil
 }
 
 func (s *menuServer) GetMenuItemById(ctx context.Context, req *GetMenuItemByIdRequest) (*MenuItem, error) {
@@ -357,17 +614,10 @@ func (s *menuServer) GetMenuItemById(ctx context.Context, req *GetMenuItemByIdRe
 		return nil, status.Errorf(codes.NotFound, "Menu item not found")
 	}
 
-	var protoCategory MenuCategory
-	switch menuItem.Category {
-	case repository.MainCourse:
-		protoCategory = MenuCategory_MAIN_COURSE
-	case repository.Beverage:
-		protoCategory = MenuCategory_BEVERAGE
-	case repository.Dessert:
-		protoCategory = MenuCategory_DESSERT
-	default:
-		logs.Error("Invalid category in menu item", zap.String("Category", string(menuItem.Category)))
-		return nil, status.Errorf(codes.Internal, "Invalid category in menu item")
+	category, err := s.menuRepo.GetMenuCategoryByID(ctx, menuItem.CategoryID)
+	if err != nil {
+		logs.Error("Failed to get menu item category", zap.String("CategoryID", menuItem.CategoryID.String()), zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Failed to get menu item category: %v", err)
 	}
 
 	response := &MenuItem{
@@ -376,145 +626,1591 @@ func (s *menuServer) GetMenuItemById(ctx context.Context, req *GetMenuItemByIdRe
 		NameEn:      menuItem.NameEN,
 		Description: menuItem.Description,
 		Price:       menuItem.Price,
-		Category:    protoCategory,
+		CategoryId:  menuItem.CategoryID.String(),
+		Category:    convertMenuCategoryToProto(category),
 		ImageUrl:    menuItem.ImageURL,
-	}
-
-	logs.Info("Successfully retrieved menu item", zap.String("ItemID", menuItem.UUID.String()))
 
-	return response, nil
-}
+		ImageVariants: menuItemImageVariants(menuItem),
 
-// ---------------- Menu Set ------------------------
+		IsAvailable: menuItem.IsAvailable,
+		DailyLimit:  menuItem.DailyLimit,
+		ArchivedAt:  formatHistoryTime(menuItem.ArchivedAt),
+	}
 
-func (s *menuServer) CreateMenuSet(ctx context.Context, req *CreateMenuSetRequest) (*CreateMenuSetResponse, error) {
-	logs.Info("Received CreateMenuSetRequest", zap.String("Name", req.Name))
+	if menuItem.DailyLimit > 0 {
+		date, err := stockDate("")
+		if err != nil {
+			return nil, err
+		}
+		usage, err := s.menuRepo.GetMenuItemUsage(ctx, date, []uuid.UUID{menuItem.UUID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Failed to get menu item stock: %v", err)
+		}
+		response.RemainingStock = remainingStock(menuItem, usage)
+	}
 
-	if req.Name == "" {
-		logs.Error("Validation error: Name is empty")
-		return nil, status.Errorf(codes.InvalidArgument, "Name must be provided")
+	schedules, err := s.menuRepo.GetMenuItemSchedules(ctx, []uuid.UUID{menuItem.UUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get menu item schedules: %v", err)
 	}
+	response.Schedules = convertSchedulesToProto(schedules)
 
-	if req.Price <= 0 {
-		logs.Error("Validation error: Invalid Price", zap.Float64("Price", req.Price))
-		return nil, status.Errorf(codes.InvalidArgument, "Price must be greater than 0")
+	tags, err := s.menuRepo.GetMenuItemTags(ctx, []uuid.UUID{menuItem.UUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get menu item tags: %v", err)
 	}
+	response.Allergens = tags[menuItem.UUID].Allergens
+	response.DietaryTags = tags[menuItem.UUID].DietaryTags
 
-	menuSet := repository.MenuSet{
-		Name:  req.Name,
-		Price: req.Price,
+	modifiers, err := s.menuRepo.GetMenuItemModifiers(ctx, []uuid.UUID{menuItem.UUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get menu item modifiers: %v", err)
 	}
+	response.ModifierGroups = convertModifierGroupsToProto(modifiers[menuItem.UUID])
 
-	newID, err := s.menuRepo.CreateMenuSet(ctx, menuSet)
+	gallery, err := s.menuRepo.GetMenuItemImages(ctx, []uuid.UUID{menuItem.UUID})
 	if err != nil {
-		logs.Error("Failed to create menu set", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "Failed to create menu set: %v", err)
+		logs.Error("Failed to get menu item images", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Failed to get menu item images: %v", err)
+	}
+	for _, image := range gallery {
+		response.Images = append(response.Images, convertMenuItemImageToProto(image))
 	}
 
-	logs.Info("Successfully created menu set", zap.String("MenuSetID", newID.String()))
+	logs.Info("Successfully retrieved menu item", zap.String("ItemID", menuItem.UUID.String()))
 
-	return &CreateMenuSetResponse{
-		Id:     newID.String(),
-		Status: Status_SUCCESS,
-	}, nil
+	return response, nil
 }
 
-func (s *menuServer) UpdateMenuSet(ctx context.Context, req *UpdateMenuSetRequest) (*UpdateMenuSetResponse, error) {
-	logs.Info("Received UpdateMenuSetRequest", zap.String("SetID", req.Id))
+// ---------------- Menu Category ------------------------
 
-	id, err := uuid.FromString(req.Id)
+func (s *menuServer) CreateMenuCategory(ctx context.Context, req *CreateMenuCategoryRequest) (*MenuCategory, error) {
+	category, err := convertProtoToMenuCategory(req.NameTh, req.NameEn, req.SortOrder, req.ParentId)
 	if err != nil {
-		logs.Error("Invalid UUID format", zap.Error(err))
-		return nil, status.Errorf(codes.InvalidArgument, "Invalid menu set ID format")
+		return nil, err
 	}
 
-	menuSet, err := s.menuRepo.GetMenuSetByID(ctx, id)
+	created, err := s.menuRepo.CreateMenuCategory(ctx, category)
 	if err != nil {
-		logs.Error("Failed to find menu set", zap.Error(err))
-		return nil, status.Errorf(codes.NotFound, "Menu set not found")
+		return nil, menuCategoryError("create", err)
 	}
+	return convertMenuCategoryToProto(created), nil
+}
 
-	if req.Name != "" {
-		menuSet.Name = req.Name
-		menuSet.Price = req.Price
+func (s *menuServer) UpdateMenuCategory(ctx context.Context, req *UpdateMenuCategoryRequest) (*MenuCategory, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid menu category ID format")
 	}
-
-	if err := s.menuRepo.UpdateMenuSet(ctx, menuSet); err != nil {
-		logs.Error("Failed to update menu set", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "Failed to update menu set: %v", err)
+	category, err := convertProtoToMenuCategory(req.NameTh, req.NameEn, req.SortOrder, req.ParentId)
+	if err != nil {
+		return nil, err
 	}
+	category.UUID = id
 
-	logs.Info("Menu set updated successfully", zap.String("ID", menuSet.UUID.String()))
-
-	return &UpdateMenuSetResponse{
-		Status: Status_SUCCESS,
-	}, nil
+	updated, err := s.menuRepo.UpdateMenuCategory(ctx, category)
+	if err != nil {
+		return nil, menuCategoryError("update", err)
+	}
+	return convertMenuCategoryToProto(updated), nil
 }
 
-func (s *menuServer) DeleteMenuSet(ctx context.Context, req *DeleteMenuSetRequest) (*DeleteMenuSetResponse, error) {
-	logs.Info("Received DeleteMenuSetRequest", zap.String("SetID", req.Id))
-
+func (s *menuServer) DeleteMenuCategory(ctx context.Context, req *DeleteMenuCategoryRequest) (*DeleteMenuCategoryResponse, error) {
 	id, err := uuid.FromString(req.Id)
 	if err != nil {
-		logs.Error("Invalid UUID format", zap.Error(err))
-		return nil, status.Errorf(codes.InvalidArgument, "Invalid menu set ID format")
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid menu category ID format")
 	}
 
-	if err := s.menuRepo.DeleteMenuSet(ctx, id); err != nil {
-		logs.Error("Failed to delete menu set", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "Failed to delete menu set: %v", err)
+	if err := s.menuRepo.DeleteMenuCategory(ctx, id); err != nil {
+		return nil, menuCategoryError("delete", err)
 	}
 
-	logs.Info("Menu set deleted successfully", zap.String("ID", req.Id))
+	logs.Info("Menu category deleted successfully", zap.String("ID", req.Id))
+	return &DeleteMenuCategoryResponse{Status: Status_SUCCESS}, nil
+}
 
-	return &DeleteMenuSetResponse{
-		Status: Status_SUCCESS,
-	}, nil
+func (s *menuServer) GetMenuCategories(ctx context.Context, _ *emptypb.Empty) (*MenuCategoryList, error) {
+	categories, err := s.menuRepo.GetMenuCategories(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get menu categories: %v", err)
+	}
+
+	list := &MenuCategoryList{}
+	for _, category := range categories {
+		list.Categories = append(list.Categories, convertMenuCategoryToProto(category))
+	}
+	return list, nil
 }
 
-func (s *menuServer) GetMenuSets(ctx context.Context, _ *emptypb.Empty) (*MenuSetList, error) {
+func (s *menuServer) GetMenuCategoryById(ctx context.Context, req *GetMenuCategoryByIdRequest) (*MenuCategory, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid menu category ID format")
+	}
 
-	menuSets, err := s.menuRepo.GetMenuSets(ctx)
+	category, err := s.menuRepo.GetMenuCategoryByID(ctx, id)
 	if err != nil {
-		logs.Error("Failed to get menu sets", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "Failed to get menu sets: %v", err)
+		return nil, menuCategoryError("get", err)
 	}
+	return convertMenuCategoryToProto(category), nil
+}
 
-	var menuSetList []*MenuSet
-	for _, set := range menuSets {
-		menuSetList = append(menuSetList, &MenuSet{
-			Id:   set.UUID.String(),
-			Name: set.Name,
-		})
+// menuItemCategoryID ตรวจว่าหมวดหมู่ที่เมนูอ้างถึงมีอยู่จริง
+func (s *menuServer) menuItemCategoryID(ctx context.Context, categoryID string) (uuid.UUID, error) {
+	if categoryID == "" {
+		logs.Error("Validation error: CategoryID is empty")
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "Category ID must be provided")
+	}
+	id, err := uuid.FromString(categoryID)
+	if err != nil {
+		logs.Error("Validation error: Invalid CategoryID", zap.String("CategoryID", categoryID))
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "Invalid category ID format")
 	}
+	if _, err := s.menuRepo.GetMenuCategoryByID(ctx, id); err != nil {
+		if errors.Is(err, repository.ErrMenuCategoryNotFound) {
+			return uuid.Nil, status.Errorf(codes.InvalidArgument, "Menu category not found")
+		}
+		return uuid.Nil, status.Errorf(codes.Internal, "Failed to get menu category: %v", err)
+	}
+	return id, nil
+}
 
-	return &MenuSetList{
-		MenuSets: menuSetList,
-	}, nil
+// menuCategoryError แปลง error ของหมวดหมู่เป็น gRPC status
+func menuCategoryError(action string, err error) error {
+	switch {
+	case errors.Is(err, repository.ErrMenuCategoryNotFound):
+		return status.Errorf(codes.NotFound, "Menu category not found")
+	case errors.Is(err, repository.ErrParentCategoryNotFound), errors.Is(err, repository.ErrMenuCategoryCycle):
+		return status.Error(codes.InvalidArgument, errors.Unwrap(err).Error())
+	case errors.Is(err, repository.ErrMenuCategoryInUse), errors.Is(err, repository.ErrMenuCategoryNameTaken):
+		return status.Error(codes.FailedPrecondition, errors.Unwrap(err).Error())
+	}
+	logs.Error("Failed to "+action+" menu category", zap.Error(err))
+	return status.Errorf(codes.Internal, "Failed to %s menu category: %v", action, err)
 }
 
-func (s *menuServer) GetMenuSetById(ctx context.Context, req *GetMenuSetByIdRequest) (*MenuSet, error) {
-	logs.Info("Received GetMenuSetByIdRequest", zap.String("SetID", req.Id))
+func convertProtoToMenuCategory(nameTH, nameEN string, sortOrder int32, parentID string) (repository.MenuCategory, error) {
+	category := repository.MenuCategory{
+		NameTH:    strings.TrimSpace(nameTH),
+		NameEN:    strings.TrimSpace(nameEN),
+		SortOrder: sortOrder,
+	}
+	if category.NameTH == "" || category.NameEN == "" {
+		return repository.MenuCategory{}, status.Errorf(codes.InvalidArgument, "NameTh and NameEn must be provided")
+	}
+	if parentID != "" {
+		id, err := uuid.FromString(parentID)
+		if err != nil {
+			return repository.MenuCategory{}, status.Errorf(codes.InvalidArgument, "Invalid parent category ID format")
+		}
+		category.ParentID = &id
+	}
+	return category, nil
+}
+
+func convertMenuCategoryToProto(category repository.MenuCategory) *MenuCategory {
+	protoCategory := &MenuCategory{
+		Id:        category.UUID.String(),
+		NameTh:    category.NameTH,
+		NameEn:    category.NameEN,
+		SortOrder: category.SortOrder,
+	}
+	if category.ParentID != nil {
+		protoCategory.ParentId = category.ParentID.String()
+	}
+	return protoCategory
+}
 
+// ---------------- Menu Item Availability ------------------------
+
+func (s *menuServer) SetMenuItemAvailability(ctx context.Context, req *SetMenuItemAvailabilityRequest) (*MenuItem, error) {
 	id, err := uuid.FromString(req.Id)
 	if err != nil {
 		logs.Error("Invalid UUID format", zap.Error(err))
-		return nil, status.Errorf(codes.InvalidArgument, "Invalid menu set ID format")
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid menu item ID format")
 	}
-
-	menuSet, err := s.menuRepo.GetMenuSetByID(ctx, id)
-	if err != nil {
-		logs.Error("Failed to find menu set", zap.Error(err))
-		return nil, status.Errorf(codes.NotFound, "Menu set not found")
+	if req.DailyLimit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "daily_limit must not be negative")
 	}
 
-	response := &MenuSet{
-		Id:   menuSet.UUID.String(),
-		Name: menuSet.Name,
+	if err := s.menuRepo.SetMenuItemAvailability(ctx, id, req.IsAvailable, req.DailyLimit); err != nil {
+		if errors.Is(err, repository.ErrMenuItemNotFound) {
+			return nil, status.Errorf(codes.NotFound, "Menu item not found")
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to update menu item availability: %v", err)
 	}
 
-	logs.Info("Successfully retrieved menu set", zap.String("SetID", menuSet.UUID.String()))
+	logs.Info("Menu item availability updated",
+		zap.String("ID", req.Id),
+		zap.Bool("IsAvailable", req.IsAvailable),
+		zap.Int32("DailyLimit", req.DailyLimit),
+	)
+
+	return s.GetMenuItemById(ctx, &GetMenuItemByIdRequest{Id: req.Id})
+}
 
+// stockDate ตรวจรูปแบบวันที่ที่ใช้คิดจำนวนคงเหลือ ว่าง = วันนี้ตามเวลาไทย
+func stockDate(date string) (string, error) {
+	bangkok, err := time.LoadLocation("Asia/Bangkok")
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "could not load Bangkok timezone: %v", err)
+	}
+	if date == "" {
+		return time.Now().In(bangkok).Format("2006-01-02"), nil
+	}
+	if _, err := time.ParseInLocation("2006-01-02", date, bangkok); err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "date must be in YYYY-MM-DD format")
+	}
+	return date, nil
+}
+
+// remainingStock จำนวนที่ยังสั่งได้ของวันนั้น มีความหมายเฉพาะเมนูที่จำกัดจำนวนต่อวัน
+func remainingStock(item repository.MenuItem, usage map[uuid.UUID]int32) int32 {
+	if item.DailyLimit <= 0 {
+		return 0
+	}
+	return max(0, item.DailyLimit-usage[item.UUID])
+}
+
+// isOrderable เมนูเปิดขายอยู่และยังไม่หมดของวันนั้น
+func isOrderable(item repository.MenuItem, usage map[uuid.UUID]int32) bool {
+	return item.IsAvailable && (item.DailyLimit <= 0 || remainingStock(item, usage) > 0)
+}
+
+// ---------------- Menu Archive ------------------------
+
+// ArchiveMenuItem เก็บเมนูเข้าคลังแทนการลบ เมนูยังอยู่ในเมนูเซ็ตและการจองเก่า แต่ไม่แสดงในรายการและสั่งใหม่ไม่ได้
+func (s *menuServer) ArchiveMenuItem(ctx context.Context, req *ArchiveMenuRequest) (*MenuItem, error) {
+	return s.setMenuItemArchived(ctx, req.Id, true)
+}
+
+// RestoreMenuItem นำเมนูที่เก็บเข้าคลังกลับมาขาย
+func (s *menuServer) RestoreMenuItem(ctx context.Context, req *ArchiveMenuRequest) (*MenuItem, error) {
+	return s.setMenuItemArchived(ctx, req.Id, false)
+}
+
+func (s *menuServer) setMenuItemArchived(ctx context.Context, menuItemID string, archived bool) (*MenuItem, error) {
+	id, err := uuid.FromString(menuItemID)
+	if err != nil {
+		logs.Error("Invalid UUID format", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid menu item ID format")
+	}
+
+	if archived {
+		err = s.menuRepo.ArchiveMenuItem(ctx, id)
+	} else {
+		err = s.menuRepo.RestoreMenuItem(ctx, id)
+	}
+	if err != nil {
+		if errors.Is(err, repository.ErrMenuItemNotFound) {
+			return nil, status.Errorf(codes.NotFound, "Menu item not found")
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to update menu item archive state: %v", err)
+	}
+
+	logs.Info("Menu item archive state updated", zap.String("ID", menuItemID), zap.Bool("Archived", archived))
+
+	return s.GetMenuItemById(ctx, &GetMenuItemByIdRequest{Id: menuItemID})
+}
+
+// ArchiveMenuSet เก็บเมนูเซ็ตเข้าคลังแทนการลบ
+func (s *menuServer) ArchiveMenuSet(ctx context.Context, req *ArchiveMenuRequest) (*MenuSet, error) {
+	return s.setMenuSetArchived(ctx, req.Id, true)
+}
+
+// RestoreMenuSet นำเมนูเซ็ตที่เก็บเข้าคลังกลับมาขาย
+func (s *menuServer) RestoreMenuSet(ctx context.Context, req *ArchiveMenuRequest) (*MenuSet, error) {
+	return s.setMenuSetArchived(ctx, req.Id, false)
+}
+
+func (s *menuServer) setMenuSetArchived(ctx context.Context, menuSetID string, archived bool) (*MenuSet, error) {
+	id, err := uuid.FromString(menuSetID)
+	if err != nil {
+		logs.Error("Invalid UUID format", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid menu set ID format")
+	}
+
+	if archived {
+		err = s.menuRepo.ArchiveMenuSet(ctx, id)
+	} else {
+		err = s.menuRepo.RestoreMenuSet(ctx, id)
+	}
+	if err != nil {
+		if errors.Is(err, repository.ErrMenuSetNotFound) {
+			return nil, status.Errorf(codes.NotFound, "Menu set not found")
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to update menu set archive state: %v", err)
+	}
+
+	logs.Info("Menu set archive state updated", zap.String("ID", menuSetID), zap.Bool("Archived", archived))
+
+	return s.GetMenuSetById(ctx, &GetMenuSetByIdRequest{Id: menuSetID})
+}
+
+// ---------------- Menu Tags ------------------------
+
+func (s *menuServer) SetMenuItemTags(ctx context.Context, req *SetMenuItemTagsRequest) (*MenuItem, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid menu item ID format")
+	}
+	allergens, err := normalizeTags("allergens", req.Allergens, repository.Allergens)
+	if err != nil {
+		return nil, err
+	}
+	dietaryTags, err := normalizeTags("dietary_tags", req.DietaryTags, repository.DietaryTags)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.menuRepo.SetMenuItemTags(ctx, id, repository.MenuTags{Allergens: allergens, DietaryTags: dietaryTags}); err != nil {
+		if errors.Is(err, repository.ErrMenuItemNotFound) {
+			return nil, status.Errorf(codes.NotFound, "Menu item not found")
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to update menu item tags: %v", err)
+	}
+
+	logs.Info("Menu item tags updated",
+		zap.String("ID", req.Id),
+		zap.Strings("Allergens", allergens),
+		zap.Strings("DietaryTags", dietaryTags),
+	)
+
+	return s.GetMenuItemById(ctx, &GetMenuItemByIdRequest{Id: req.Id})
+}
+
+// normalizeTags แปลงแท็กเป็นตัวพิมพ์ใหญ่ ตัดตัวซ้ำ และตรวจว่าอยู่ใน allowed
+func normalizeTags(field string, values []string, allowed []string) ([]string, error) {
+	var tags []string
+	seen := make(map[string]bool)
+	for _, value := range values {
+		tag := strings.ToUpper(strings.TrimSpace(value))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		valid := false
+		for _, a := range allowed {
+			if a == tag {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return nil, status.Errorf(codes.InvalidArgument, "%s: unknown tag %q (allowed: %s)", field, value, strings.Join(allowed, ", "))
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
+
+// matchesTags เมนูไม่มีสารก่อภูมิแพ้ใน excludeAllergens และมีแท็กใน dietaryTags ครบ
+func matchesTags(tags repository.MenuTags, excludeAllergens, dietaryTags []string) bool {
+	for _, excluded := range excludeAllergens {
+		for _, allergen := range tags.Allergens {
+			if allergen == excluded {
+				return false
+			}
+		}
+	}
+	for _, required := range dietaryTags {
+		found := false
+		for _, tag := range tags.DietaryTags {
+			if tag == required {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// ---------------- Menu Schedule ------------------------
+
+func (s *menuServer) SetMenuItemSchedules(ctx context.Context, req *SetMenuSchedulesRequest) (*MenuScheduleList, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid menu item ID format")
+	}
+	schedules, err := convertProtoToSchedules(req.Schedules)
+	if err != nil {
+		return nil, err
+	}
+
+	saved, err := s.menuRepo.SetMenuItemSchedules(ctx, id, schedules)
+	if err != nil {
+		return nil, scheduleError(err)
+	}
+
+	logs.Info("Menu item schedules updated", zap.String("ID", req.Id), zap.Int("Schedules", len(saved)))
+	return &MenuScheduleList{Schedules: convertSchedulesToProto(saved)}, nil
+}
+
+func (s *menuServer) SetMenuSetSchedules(ctx context.Context, req *SetMenuSchedulesRequest) (*MenuScheduleList, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid menu set ID format")
+	}
+	schedules, err := convertProtoToSchedules(req.Schedules)
+	if err != nil {
+		return nil, err
+	}
+
+	saved, err := s.menuRepo.SetMenuSetSchedules(ctx, id, schedules)
+	if err != nil {
+		return nil, scheduleError(err)
+	}
+
+	logs.Info("Menu set schedules updated", zap.String("ID", req.Id), zap.Int("Schedules", len(saved)))
+	return &MenuScheduleList{Schedules: convertSchedulesToProto(saved)}, nil
+}
+
+func scheduleError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrMenuItemNotFound):
+		return status.Errorf(codes.NotFound, "Menu item not found")
+	case errors.Is(err, repository.ErrMenuSetNotFound):
+		return status.Errorf(codes.NotFound, "Menu set not found")
+	}
+	return status.Errorf(codes.Internal, "Failed to update schedules: %v", err)
+}
+
+// orderTime แปลงเวลาที่จะสั่ง (RFC3339) เป็นเวลาไทย ว่าง = ไม่กรองตามเวลา
+func orderTime(at string) (time.Time, bool, error) {
+	if at == "" {
+		return time.Time{}, false, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, at)
+	if err != nil {
+		return time.Time{}, false, status.Errorf(codes.InvalidArgument, "at must be an RFC3339 timestamp")
+	}
+	bangkok, err := time.LoadLocation("Asia/Bangkok")
+	if err != nil {
+		return time.Time{}, false, status.Errorf(codes.Internal, "could not load Bangkok timezone: %v", err)
+	}
+	return parsed.In(bangkok), true, nil
+}
+
+func convertProtoToSchedules(protos []*MenuSchedule) ([]repository.MenuSchedule, error) {
+	schedules := make([]repository.MenuSchedule, 0, len(protos))
+	for i, p := range protos {
+		schedule := repository.MenuSchedule{}
+		for _, day := range p.DaysOfWeek {
+			if day < 0 || day > 6 {
+				return nil, status.Errorf(codes.InvalidArgument, "schedule %d: days_of_week must be between 0 (Sunday) and 6 (Saturday)", i)
+			}
+			schedule.DaysOfWeek |= 1 << day
+		}
+
+		var err error
+		if schedule.StartMinute, err = parseClock(p.StartTime, 0); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "schedule %d: start_time %v", i, err)
+		}
+		if schedule.EndMinute, err = parseClock(p.EndTime, 24*60); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "schedule %d: end_time %v", i, err)
+		}
+		// end_time ก่อน start_time คือช่วงที่ข้ามเที่ยงคืน เช่น 22:00-02:00
+		if schedule.StartMinute == schedule.EndMinute {
+			return nil, status.Errorf(codes.InvalidArgument, "schedule %d: end_time must differ from start_time", i)
+		}
+
+		if schedule.ValidFrom, err = parseScheduleDate(p.ValidFrom); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "schedule %d: valid_from must be in YYYY-MM-DD format", i)
+		}
+		if schedule.ValidUntil, err = parseScheduleDate(p.ValidUntil); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "schedule %d: valid_until must be in YYYY-MM-DD format", i)
+		}
+		if schedule.ValidFrom != nil && schedule.ValidUntil != nil && schedule.ValidUntil.Before(*schedule.ValidFrom) {
+			return nil, status.Errorf(codes.InvalidArgument, "schedule %d: valid_until must not be before valid_from", i)
+		}
+		schedules = append(schedules, schedule)
+	}
+	return schedules, nil
+}
+
+func convertSchedulesToProto(schedules []repository.MenuSchedule) []*MenuSchedule {
+	result := make([]*MenuSchedule, 0, len(schedules))
+	for _, schedule := range schedules {
+		p := &MenuSchedule{
+			Id:        schedule.UUID.String(),
+			StartTime: formatClock(schedule.StartMinute),
+			EndTime:   formatClock(schedule.EndMinute),
+		}
+		for day := int32(0); day < 7; day++ {
+			if schedule.DaysOfWeek&(1<<day) != 0 {
+				p.DaysOfWeek = append(p.DaysOfWeek, day)
+			}
+		}
+		if schedule.ValidFrom != nil {
+			p.ValidFrom = schedule.ValidFrom.Format("2006-01-02")
+		}
+		if schedule.ValidUntil != nil {
+			p.ValidUntil = schedule.ValidUntil.Format("2006-01-02")
+		}
+		result = append(result, p)
+	}
+	return result
+}
+
+// parseClock แปลง "HH:MM" เป็นนาทีนับจากเที่ยงคืน รับ "24:00" เป็นสิ้นวัน
+func parseClock(value string, empty int16) (int16, error) {
+	switch value {
+	case "":
+		return empty, nil
+	case "24:00":
+		return 24 * 60, nil
+	}
+	clock, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("must be in HH:MM format")
+	}
+	return int16(clock.Hour()*60 + clock.Minute()), nil
+}
+
+func formatClock(minute int16) string {
+	return fmt.Sprintf("%02d:%02d", minute/60, minute%60)
+}
+
+func parseScheduleDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return nil, err
+	}
+	return &date, nil
+}
+
+// ---------------- Menu Modifier ------------------------
+
+func (s *menuServer) SetMenuItemModifiers(ctx context.Context, req *SetMenuItemModifiersRequest) (*MenuModifierGroupList, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid menu item ID format")
+	}
+	groups, err := convertProtoToModifierGroups(req.Groups)
+	if err != nil {
+		return nil, err
+	}
+
+	saved, err := s.menuRepo.SetMenuItemModifiers(ctx, id, groups)
+	if err != nil {
+		if errors.Is(err, repository.ErrMenuItemNotFound) {
+			return nil, status.Errorf(codes.NotFound, "Menu item not found")
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to update menu item modifiers: %v", err)
+	}
+
+	logs.Info("Menu item modifiers updated", zap.String("ID", req.Id), zap.Int("Groups", len(saved)))
+	return &MenuModifierGroupList{Groups: convertModifierGroupsToProto(saved)}, nil
+}
+
+// convertProtoToModifierGroups ตรวจกลุ่มตัวเลือก SINGLE เลือกได้อย่างเดียวเสมอ
+// ส่วน MULTI ต้องได้ 0 <= min_select <= max_select <= จำนวนตัวเลือก (max_select 0 = เท่าจำนวนตัวเลือก)
+func convertProtoToModifierGroups(protos []*MenuModifierGroup) ([]repository.MenuModifierGroup, error) {
+	groups := make([]repository.MenuModifierGroup, 0, len(protos))
+	seen := make(map[uuid.UUID]bool)
+	parseID := func(value string) (uuid.UUID, error) {
+		if value == "" {
+			return uuid.Nil, nil
+		}
+		id, err := uuid.FromString(value)
+		if err != nil {
+			return uuid.Nil, status.Errorf(codes.InvalidArgument, "Invalid modifier ID format: %s", value)
+		}
+		if seen[id] {
+			return uuid.Nil, status.Errorf(codes.InvalidArgument, "Modifier ID %s is used more than once", value)
+		}
+		seen[id] = true
+		return id, nil
+	}
+
+	for i, p := range protos {
+		if strings.TrimSpace(p.NameTh) == "" || strings.TrimSpace(p.NameEn) == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "modifier group %d: name_th and name_en are required", i+1)
+		}
+		if len(p.Options) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "modifier group %q must have at least one option", p.NameTh)
+		}
+		groupID, err := parseID(p.Id)
+		if err != nil {
+			return nil, err
+		}
+		group := repository.MenuModifierGroup{
+			UUID:   groupID,
+			NameTH: strings.TrimSpace(p.NameTh),
+			NameEN: strings.TrimSpace(p.NameEn),
+		}
+
+		optionCount := int32(len(p.Options))
+		switch p.Selection {
+		case ModifierSelection_MODIFIER_SINGLE:
+			if p.MinSelect < 0 || p.MinSelect > 1 {
+				return nil, status.Errorf(codes.InvalidArgument, "modifier group %q: min_select must be 0 or 1 for single selection", p.NameTh)
+			}
+			group.SelectionType = repository.ModifierSelectionSingle
+			group.MinSelect, group.MaxSelect = p.MinSelect, 1
+		case ModifierSelection_MODIFIER_MULTI:
+			maxSelect := p.MaxSelect
+			if maxSelect == 0 {
+				maxSelect = optionCount
+			}
+			if p.MinSelect < 0 || p.MinSelect > maxSelect || maxSelect > optionCount {
+				return nil, status.Errorf(codes.InvalidArgument, "modifier group %q: need 0 <= min_select <= max_select <= %d", p.NameTh, optionCount)
+			}
+			group.SelectionType = repository.ModifierSelectionMulti
+			group.MinSelect, group.MaxSelect = p.MinSelect, maxSelect
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "modifier group %q: unknown selection type", p.NameTh)
+		}
+
+		for _, o := range p.Options {
+			if strings.TrimSpace(o.NameTh) == "" || strings.TrimSpace(o.NameEn) == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "modifier group %q: option name_th and name_en are required", p.NameTh)
+			}
+			if math.IsNaN(o.PriceDelta) || math.IsInf(o.PriceDelta, 0) || math.Abs(o.PriceDelta) >= 1e8 {
+				return nil, status.Errorf(codes.InvalidArgument, "modifier option %q: invalid price_delta", o.NameTh)
+			}
+			optionID, err := parseID(o.Id)
+			if err != nil {
+				return nil, err
+			}
+			group.Options = append(group.Options, repository.MenuModifierOption{
+				UUID:       optionID,
+				NameTH:     strings.TrimSpace(o.NameTh),
+				NameEN:     strings.TrimSpace(o.NameEn),
+				PriceDelta: math.Round(o.PriceDelta*100) / 100,
+			})
+		}
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
+func convertModifierGroupsToProto(groups []repository.MenuModifierGroup) []*MenuModifierGroup {
+	protos := make([]*MenuModifierGroup, 0, len(groups))
+	for _, group := range groups {
+		selection := ModifierSelection_MODIFIER_SINGLE
+		if group.SelectionType == repository.ModifierSelectionMulti {
+			selection = ModifierSelection_MODIFIER_MULTI
+		}
+		p := &MenuModifierGroup{
+			Id:        group.UUID.String(),
+			NameTh:    group.NameTH,
+			NameEn:    group.NameEN,
+			Selection: selection,
+			MinSelect: group.MinSelect,
+			MaxSelect: group.MaxSelect,
+		}
+		for _, option := range group.Options {
+			p.Options = append(p.Options, &MenuModifierOption{
+				Id:         option.UUID.String(),
+				NameTh:     option.NameTH,
+				NameEn:     option.NameEN,
+				PriceDelta: option.PriceDelta,
+			})
+		}
+		protos = append(protos, p)
+	}
+	return protos
+}
+
+// ---------------- Menu Item Gallery ------------------------
+
+func (s *menuServer) AddMenuItemImage(ctx context.Context, req *AddMenuItemImageRequest) (*MenuItemImage, error) {
+	logs.Info("Received AddMenuItemImageRequest", zap.String("MenuItemID", req.MenuItemId))
+
+	menuItemID, err := uuid.FromString(req.MenuItemId)
+	if err != nil {
+		logs.Error("Invalid UUID format", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid menu item ID format")
+	}
+	altTH, altEN := strings.TrimSpace(req.AltTh), strings.TrimSpace(req.AltEn)
+	if utf8.RuneCountInString(altTH) > 255 || utf8.RuneCountInString(altEN) > 255 {
+		return nil, status.Errorf(codes.InvalidArgument, "Alt text must not exceed 255 characters")
+	}
+
+	// ตรวจสอบก่อนอัปโหลด จะได้ไม่มีไฟล์ค้างถ้าไม่มีเมนูนี้
+	if _, err := s.menuRepo.GetMenuItemByID(ctx, menuItemID); err != nil {
+		logs.Error("Menu item not found", zap.String("ID", req.MenuItemId))
+		return nil, status.Errorf(codes.NotFound, "Menu item not found")
+	}
+
+	variants, err := s.storeImage(ctx, req.ImageData)
+	if err != nil {
+		return nil, err
+	}
+
+	image, err := s.menuRepo.AddMenuItemImage(ctx, repository.MenuItemImage{
+		MenuItemID:        menuItemID,
+		AltTH:             altTH,
+		AltEN:             altEN,
+		ImageURL:          variants.FullURL,
+		ImageKey:          variants.Key,
+		ImageThumbnailURL: variants.ThumbnailURL,
+		ImageCardURL:      variants.CardURL,
+	})
+	if err != nil {
+		// บันทึกไม่สำเร็จ ลบไฟล์ที่เพิ่งอัปโหลดทิ้ง
+		s.deleteStoredImage(ctx, repository.Image{ImageURL: variants.FullURL, ImageKey: variants.Key})
+		return nil, galleryError("add", err)
+	}
+
+	logs.Info("Menu item image added", zap.String("ImageID", image.UUID.String()), zap.Int32("Position", image.Position))
+	return convertMenuItemImageToProto(image), nil
+}
+
+func (s *menuServer) ReorderMenuItemImages(ctx context.Context, req *ReorderMenuItemImagesRequest) (*MenuItemImageList, error) {
+	logs.Info("Received ReorderMenuItemImagesRequest", zap.String("MenuItemID", req.MenuItemId))
+
+	menuItemID, err := uuid.FromString(req.MenuItemId)
+	if err != nil {
+		logs.Error("Invalid UUID format", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid menu item ID format")
+	}
+	imageIDs := make([]uuid.UUID, 0, len(req.ImageIds))
+	for _, id := range req.ImageIds {
+		imageID, err := uuid.FromString(id)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid image ID format: %s", id)
+		}
+		imageIDs = append(imageIDs, imageID)
+	}
+
+	images, err := s.menuRepo.ReorderMenuItemImages(ctx, menuItemID, imageIDs)
+	if err != nil {
+		return nil, galleryError("reorder", err)
+	}
+
+	response := &MenuItemImageList{Images: make([]*MenuItemImage, 0, len(images))}
+	for _, image := range images {
+		response.Images = append(response.Images, convertMenuItemImageToProto(image))
+	}
+	return response, nil
+}
+
+func (s *menuServer) RemoveMenuItemImage(ctx context.Context, req *RemoveMenuItemImageRequest) (*RemoveMenuItemImageResponse, error) {
+	logs.Info("Received RemoveMenuItemImageRequest", zap.String("MenuItemID", req.MenuItemId), zap.String("ImageID", req.ImageId))
+
+	menuItemID, err := uuid.FromString(req.MenuItemId)
+	if err != nil {
+		logs.Error("Invalid UUID format", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid menu item ID format")
+	}
+	imageID, err := uuid.FromString(req.ImageId)
+	if err != nil {
+		logs.Error("Invalid UUID format", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid image ID format")
+	}
+
+	removed, err := s.menuRepo.RemoveMenuItemImage(ctx, menuItemID, imageID)
+	if err != nil {
+		return nil, galleryError("remove", err)
+	}
+	s.deleteStoredImage(ctx, repository.Image{ImageURL: removed.ImageURL, ImageKey: removed.ImageKey})
+
+	return &RemoveMenuItemImageResponse{
+		Status: Status_SUCCESS,
+	}, nil
+}
+
+// galleryError แปลง error ของแกลเลอรีเป็น gRPC status
+func galleryError(action string, err error) error {
+	switch {
+	case errors.Is(err, repository.ErrMenuItemNotFound):
+		return status.Errorf(codes.NotFound, "Menu item not found")
+	case errors.Is(err, repository.ErrMenuItemImageNotFound):
+		return status.Errorf(codes.NotFound, "Menu item image not found")
+	case errors.Is(err, repository.ErrTooManyMenuItemImages):
+		return status.Errorf(codes.FailedPrecondition, "Menu item already has %d images", repository.MaxMenuItemImages)
+	case errors.Is(err, repository.ErrImageOrderMismatch):
+		return status.Error(codes.InvalidArgument, repository.ErrImageOrderMismatch.Error())
+	}
+	logs.Error("Failed to "+action+" menu item image", zap.Error(err))
+	return status.Errorf(codes.Internal, "Failed to %s menu item image: %v", action, err)
+}
+
+// deleteStoredImage ลบไฟล์รูปทุกขนาดหลังลบข้อมูลออกจากฐานข้อมูลแล้ว ถ้าลบไม่สำเร็จแค่บันทึก log
+func (s *menuServer) deleteStoredImage(ctx context.Context, image repository.Image) {
+	if err := s.menuRepo.DeleteImage(ctx, image); err != nil {
+		logs.Error("Failed to delete stored image", zap.String("image_url", image.ImageURL), zap.Error(err))
+	}
+}
+
+func convertMenuItemImageToProto(image repository.MenuItemImage) *MenuItemImage {
+	return &MenuItemImage{
+		Id:         image.UUID.String(),
+		MenuItemId: image.MenuItemID.String(),
+		Position:   image.Position,
+		AltTh:      image.AltTH,
+		AltEn:      image.AltEN,
+		ImageUrl:   image.ImageURL,
+		ImageVariants: menuItemImageVariants(repository.MenuItem{
+			ImageURL:          image.ImageURL,
+			ImageThumbnailURL: image.ImageThumbnailURL,
+			ImageCardURL:      image.ImageCardURL,
+		}),
+	}
+}
+
+// ---------------- Menu Set ------------------------
+
+func (s *menuServer) CreateMenuSet(ctx context.Context, req *CreateMenuSetRequest) (*CreateMenuSetResponse, error) {
+	logs.Info("Received CreateMenuSetRequest", zap.String("Name", req.Name))
+
+	if req.Name == "" {
+		logs.Error("Validation error: Name is empty")
+		return nil, status.Errorf(codes.InvalidArgument, "Name must be provided")
+	}
+
+	if req.Price <= 0 {
+		logs.Error("Validation error: Invalid Price", zap.Float64("Price", req.Price))
+		return nil, status.Errorf(codes.InvalidArgument, "Price must be greater than 0")
+	}
+
+	menuSet := repository.MenuSet{
+		Name:  req.Name,
+		Price: req.Price,
+	}
+
+	newID, err := s.menuRepo.CreateMenuSet(ctx, menuSet)
+	if err != nil {
+		logs.Error("Failed to create menu set", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Failed to create menu set: %v", err)
+	}
+
+	logs.Info("Successfully created menu set", zap.String("MenuSetID", newID.String()))
+
+	return &CreateMenuSetResponse{
+		Id:     newID.String(),
+		Status: Status_SUCCESS,
+	}, nil
+}
+
+func (s *menuServer) UpdateMenuSet(ctx context.Context, req *UpdateMenuSetRequest) (*UpdateMenuSetResponse, error) {
+	logs.Info("Received UpdateMenuSetRequest", zap.String("SetID", req.Id))
+
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		logs.Error("Invalid UUID format", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid menu set ID format")
+	}
+
+	menuSet, err := s.menuRepo.GetMenuSetByID(ctx, id)
+	if err != nil {
+		logs.Error("Failed to find menu set", zap.Error(err))
+		return nil, status.Errorf(codes.NotFound, "Menu set not found")
+	}
+
+	if req.Name != "" {
+		menuSet.Name = req.Name
+		menuSet.Price = req.Price
+	}
+
+	if err := s.menuRepo.UpdateMenuSet(ctx, menuSet); err != nil {
+		logs.Error("Failed to update menu set", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Failed to update menu set: %v", err)
+	}
+
+	logs.Info("Menu set updated successfully", zap.String("ID", menuSet.UUID.String()))
+
+	return &UpdateMenuSetResponse{
+		Status: Status_SUCCESS,
+	}, nil
+}
+
+func (s *menuServer) DeleteMenuSet(ctx context.Context, req *DeleteMenuSetRequest) (*DeleteMenuSetResponse, error) {
+	logs.Info("Received DeleteMenuSetRequest", zap.String("SetID", req.Id))
+
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		logs.Error("Invalid UUID format", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid menu set ID format")
+	}
+
+	if err := s.menuRepo.DeleteMenuSet(ctx, id); err != nil {
+		logs.Error("Failed to delete menu set", zap.Error(err))
+		switch {
+		case errors.Is(err, repository.ErrMenuSetNotFound):
+			return nil, status.Errorf(codes.NotFound, "Menu set not found")
+		case errors.Is(err, repository.ErrMenuSetInUse):
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", repository.ErrMenuSetInUse)
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to delete menu set: %v", err)
+	}
+
+	logs.Info("Menu set deleted successfully", zap.String("ID", req.Id))
+
+	return &DeleteMenuSetResponse{
+		Status: Status_SUCCESS,
+	}, nil
+}
+
+func (s *menuServer) GetMenuSets(ctx context.Context, req *GetMenuSetsRequest) (*MenuSetList, error) {
+	listing, err := s.listMenuSets(ctx, menuSetFilter{
+		at:               req.At,
+		excludeAllergens: req.ExcludeAllergens,
+		dietaryTags:      req.DietaryTags,
+		categoryID:       req.CategoryId,
+		minPrice:         req.MinPrice,
+		maxPrice:         req.MaxPrice,
+		archived:         req.Archived,
+	})
+	if err != nil {
+		return nil, err
+	}
+	start, end, err := pageRange(len(listing.sets), req.Page, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	menuSetList, err := s.menuSetsToProto(ctx, listing, listing.sets[start:end])
+	if err != nil {
+		return nil, err
+	}
+
+	return &MenuSetList{
+		MenuSets: menuSetList,
+		Total:    int32(len(listing.sets)),
+	}, nil
+}
+
+// menuSetFilter คือเงื่อนไขกรองเซตเมนูที่ใช้ร่วมกันระหว่าง GetMenuSets และ SearchMenu
+type menuSetFilter struct {
+	at               string
+	excludeAllergens []string
+	dietaryTags      []string
+	categoryID       string
+	minPrice         float64
+	maxPrice         float64
+	archived         bool // true = เอาเฉพาะเซตที่เก็บเข้าคลังแล้ว false = เอาเฉพาะที่ยังขาย
+}
+
+// menuSetListing คือเซตเมนูที่ผ่านการกรองแล้ว พร้อมข้อมูลที่โหลดมาใช้ตอนแปลงเป็น proto
+type menuSetListing struct {
+	sets      []repository.MenuSet
+	schedules map[uuid.UUID][]repository.MenuSchedule
+	tags      map[uuid.UUID]repository.MenuTags
+}
+
+// listMenuSets โหลดเซตเมนูทั้งหมดแล้วกรองตาม filter
+func (s *menuServer) listMenuSets(ctx context.Context, filter menuSetFilter) (menuSetListing, error) {
+	at, hasAt, err := orderTime(filter.at)
+	if err != nil {
+		return menuSetListing{}, err
+	}
+	excludeAllergens, err := normalizeTags("exclude_allergens", filter.excludeAllergens, repository.Allergens)
+	if err != nil {
+		return menuSetListing{}, err
+	}
+	dietaryTags, err := normalizeTags("dietary_tags", filter.dietaryTags, repository.DietaryTags)
+	if err != nil {
+		return menuSetListing{}, err
+	}
+	if err := priceBounds(filter.minPrice, filter.maxPrice); err != nil {
+		return menuSetListing{}, err
+	}
+
+	allMenuSets, err := s.menuRepo.GetMenuSets(ctx)
+	if err != nil {
+		logs.Error("Failed to get menu sets", zap.Error(err))
+		return menuSetListing{}, status.Errorf(codes.Internal, "Failed to get menu sets: %v", err)
+	}
+
+	menuSetIDs := make([]uuid.UUID, 0, len(allMenuSets))
+	for _, set := range allMenuSets {
+		menuSetIDs = append(menuSetIDs, set.UUID)
+	}
+	schedules, err := s.menuRepo.GetMenuSetSchedules(ctx, menuSetIDs)
+	if err != nil {
+		return menuSetListing{}, status.Errorf(codes.Internal, "Failed to get menu set schedules: %v", err)
+	}
+	schedulesBySet := make(map[uuid.UUID][]repository.MenuSchedule)
+	for _, schedule := range schedules {
+		schedulesBySet[*schedule.MenuSetID] = append(schedulesBySet[*schedule.MenuSetID], schedule)
+	}
+	tagsBySet, err := s.menuRepo.GetMenuSetTags(ctx, menuSetIDs)
+	if err != nil {
+		return menuSetListing{}, status.Errorf(codes.Internal, "Failed to get menu set tags: %v", err)
+	}
+	var inCategory map[uuid.UUID]bool
+	if filter.categoryID != "" {
+		if inCategory, err = s.menuSetsInCategory(ctx, filter.categoryID, menuSetIDs); err != nil {
+			return menuSetListing{}, err
+		}
+	}
+
+	var menuSets []repository.MenuSet
+	for _, set := range allMenuSets {
+		if (set.ArchivedAt != nil) != filter.archived {
+			continue
+		}
+		// ถ้าระบุ at คืนเฉพาะเซตที่สั่งได้ในเวลานั้น
+		if hasAt && !repository.ScheduledAt(schedulesBySet[set.UUID], at) {
+			continue
+		}
+		if inCategory != nil && !inCategory[set.UUID] {
+			continue
+		}
+		if !inPriceRange(set.Price, filter.minPrice, filter.maxPrice) {
+			continue
+		}
+		if !matchesTags(tagsBySet[set.UUID], excludeAllergens, dietaryTags) {
+			continue
+		}
+		menuSets = append(menuSets, set)
+	}
+
+	return menuSetListing{
+		sets:      menuSets,
+		schedules: schedulesBySet,
+		tags:      tagsBySet,
+	}, nil
+}
+
+// menuSetsInCategory คืนเซตที่มีเมนูในหมวดหมู่ categoryID (รวมหมวดหมู่ย่อย)
+// นับทั้งเมนูที่กำหนดไว้ในเซต เมนูที่เลือกได้ในช่อง และช่องที่เลือกจากหมวดหมู่นั้น
+func (s *menuServer) menuSetsInCategory(ctx context.Context, categoryID string, menuSetIDs []uuid.UUID) (map[uuid.UUID]bool, error) {
+	categories, err := s.menuRepo.GetMenuCategories(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get menu categories: %v", err)
+	}
+	inCategory, err := categoryScope(categories, categoryID)
+	if err != nil {
+		return nil, err
+	}
+	menuItems, err := s.menuRepo.GetMenuItems(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get menu items: %v", err)
+	}
+	itemInCategory := make(map[uuid.UUID]bool)
+	for _, item := range menuItems {
+		if inCategory[item.CategoryID] {
+			itemInCategory[item.UUID] = true
+		}
+	}
+
+	sets := make(map[uuid.UUID]bool)
+	setItems, err := s.menuRepo.GetMenuSetItems(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get menu set items: %v", err)
+	}
+	for _, setItem := range setItems {
+		itemID, _ := uuid.FromString(setItem.MenuItemId)
+		setID, _ := uuid.FromString(setItem.MenuSetId)
+		if itemInCategory[itemID] {
+			sets[setID] = true
+		}
+	}
+	slotsBySet, err := s.menuRepo.GetMenuSetSlots(ctx, menuSetIDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get menu set slots: %v", err)
+	}
+	for setID, slots := range slotsBySet {
+		for _, slot := range slots {
+			if slot.CategoryID != nil && inCategory[*slot.CategoryID] {
+				sets[setID] = true
+			}
+			for _, option := range slot.Items {
+				if itemInCategory[option.MenuItemID] {
+					sets[setID] = true
+				}
+			}
+		}
+	}
+	return sets, nil
+}
+
+// menuSetsToProto แปลงเซตบางส่วนของ listing (เช่น หน้าเดียว) เป็น proto โหลดช่องเลือกเฉพาะเซตเหล่านั้น
+func (s *menuServer) menuSetsToProto(ctx context.Context, listing menuSetListing, menuSets []repository.MenuSet) ([]*MenuSet, error) {
+	menuSetIDs := make([]uuid.UUID, 0, len(menuSets))
+	for _, set := range menuSets {
+		menuSetIDs = append(menuSetIDs, set.UUID)
+	}
+	slotsBySet, err := s.menuRepo.GetMenuSetSlots(ctx, menuSetIDs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get menu set slots: %v", err)
+	}
+
+	var menuSetList []*MenuSet
+	for _, set := range menuSets {
+		menuSetList = append(menuSetList, &MenuSet{
+			Id:          set.UUID.String(),
+			Name:        set.Name,
+			Price:       set.Price,
+			Schedules:   convertSchedulesToProto(listing.schedules[set.UUID]),
+			Allergens:   listing.tags[set.UUID].Allergens,
+			DietaryTags: listing.tags[set.UUID].DietaryTags,
+			Slots:       convertSetSlotsToProto(slotsBySet[set.UUID]),
+			ArchivedAt:  formatHistoryTime(set.ArchivedAt),
+		})
+	}
+	return menuSetList, nil
+}
+
+func (s *menuServer) GetMenuSetById(ctx context.Context, req *GetMenuSetByIdRequest) (*MenuSet, error) {
+	logs.Info("Received GetMenuSetByIdRequest", zap.String("SetID", req.Id))
+
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		logs.Error("Invalid UUID format", zap.Error(err))
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid menu set ID format")
+	}
+
+	menuSet, err := s.menuRepo.GetMenuSetByID(ctx, id)
+	if err != nil {
+		logs.Error("Failed to find menu set", zap.Error(err))
+		return nil, status.Errorf(codes.NotFound, "Menu set not found")
+	}
+
+	schedules, err := s.menuRepo.GetMenuSetSchedules(ctx, []uuid.UUID{menuSet.UUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get menu set schedules: %v", err)
+	}
+
+	tags, err := s.menuRepo.GetMenuSetTags(ctx, []uuid.UUID{menuSet.UUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get menu set tags: %v", err)
+	}
+
+	slots, err := s.menuRepo.GetMenuSetSlots(ctx, []uuid.UUID{menuSet.UUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get menu set slots: %v", err)
+	}
+
+	response := &MenuSet{
+		Id:          menuSet.UUID.String(),
+		Name:        menuSet.Name,
+		Schedules:   convertSchedulesToProto(schedules),
+		Allergens:   tags[menuSet.UUID].Allergens,
+		DietaryTags: tags[menuSet.UUID].DietaryTags,
+		Slots:       convertSetSlotsToProto(slots[menuSet.UUID]),
+		ArchivedAt:  formatHistoryTime(menuSet.ArchivedAt),
+	}
+
+	logs.Info("Successfully retrieved menu set", zap.String("SetID", menuSet.UUID.String()))
+
+	return response, nil
+}
+
+// ---------------- Menu Set Slot ------------------------
+
+func (s *menuServer) SetMenuSetSlots(ctx context.Context, req *SetMenuSetSlotsRequest) (*MenuSetSlotList, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid menu set ID format")
+	}
+	slots, err := convertProtoToSetSlots(req.Slots)
+	if err != nil {
+		return nil, err
+	}
+
+	saved, err := s.menuRepo.SetMenuSetSlots(ctx, id, slots)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrMenuSetNotFound):
+			return nil, status.Errorf(codes.NotFound, "Menu set not found")
+		case errors.Is(err, repository.ErrMenuItemNotFound):
+			return nil, status.Errorf(codes.InvalidArgument, "Menu item not found")
+		case errors.Is(err, repository.ErrMenuCategoryNotFound):
+			return nil, status.Errorf(codes.InvalidArgument, "Menu category not found")
+		case errors.Is(err, repository.ErrSlotItemNotInCategory):
+			return nil, status.Error(codes.InvalidArgument, repository.ErrSlotItemNotInCategory.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to update menu set slots: %v", err)
+	}
+
+	logs.Info("Menu set slots updated", zap.String("ID", req.Id), zap.Int("Slots", len(saved)))
+	return &MenuSetSlotList{Slots: convertSetSlotsToProto(saved)}, nil
+}
+
+// convertProtoToSetSlots ช่องต้องมีชื่อ เลือกอย่างน้อยหนึ่งจาน และมีหมวดหมู่หรือรายการเมนูอย่างใดอย่างหนึ่ง
+func convertProtoToSetSlots(protos []*MenuSetSlot) ([]repository.MenuSetSlot, error) {
+	slots := make([]repository.MenuSetSlot, 0, len(protos))
+	seenSlots := make(map[uuid.UUID]bool)
+	for i, p := range protos {
+		if strings.TrimSpace(p.NameTh) == "" || strings.TrimSpace(p.NameEn) == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "slot %d: name_th and name_en are required", i+1)
+		}
+		if p.Picks < 1 {
+			return nil, status.Errorf(codes.InvalidArgument, "slot %q: picks must be at least 1", p.NameTh)
+		}
+		if p.CategoryId == "" && len(p.Items) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "slot %q needs a category_id or at least one item", p.NameTh)
+		}
+		slot := repository.MenuSetSlot{
+			NameTH: strings.TrimSpace(p.NameTh),
+			NameEN: strings.TrimSpace(p.NameEn),
+			Picks:  p.Picks,
+		}
+		if p.Id != "" {
+			slotID, err := uuid.FromString(p.Id)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "Invalid slot ID format: %s", p.Id)
+			}
+			if seenSlots[slotID] {
+				return nil, status.Errorf(codes.InvalidArgument, "Slot ID %s is used more than once", p.Id)
+			}
+			seenSlots[slotID] = true
+			slot.UUID = slotID
+		}
+		if p.CategoryId != "" {
+			categoryID, err := uuid.FromString(p.CategoryId)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "Invalid category ID format")
+			}
+			slot.CategoryID = &categoryID
+		}
+
+		seenItems := make(map[uuid.UUID]bool)
+		for _, item := range p.Items {
+			menuItemID, err := uuid.FromString(item.MenuItemId)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "Invalid menu item ID format: %s", item.MenuItemId)
+			}
+			if seenItems[menuItemID] {
+				return nil, status.Errorf(codes.InvalidArgument, "slot %q lists menu item %s more than once", p.NameTh, item.MenuItemId)
+			}
+			seenItems[menuItemID] = true
+			if math.IsNaN(item.Upcharge) || item.Upcharge < 0 || item.Upcharge >= 1e8 {
+				return nil, status.Errorf(codes.InvalidArgument, "slot %q: upcharge must be between 0 and 99,999,999.99", p.NameTh)
+			}
+			slot.Items = append(slot.Items, repository.MenuSetSlotItem{
+				MenuItemID: menuItemID,
+				Upcharge:   math.Round(item.Upcharge*100) / 100,
+			})
+		}
+		slots = append(slots, slot)
+	}
+	return slots, nil
+}
+
+func convertSetSlotsToProto(slots []repository.MenuSetSlot) []*MenuSetSlot {
+	protos := make([]*MenuSetSlot, 0, len(slots))
+	for _, slot := range slots {
+		p := &MenuSetSlot{
+			Id:     slot.UUID.String(),
+			NameTh: slot.NameTH,
+			NameEn: slot.NameEN,
+			Picks:  slot.Picks,
+		}
+		if slot.CategoryID != nil {
+			p.CategoryId = slot.CategoryID.String()
+		}
+		for _, item := range slot.Items {
+			p.Items = append(p.Items, &MenuSetSlotItem{
+				MenuItemId: item.MenuItemID.String(),
+				NameTh:     item.NameTH,
+				NameEn:     item.NameEN,
+				Upcharge:   item.Upcharge,
+			})
+		}
+		protos = append(protos, p)
+	}
+	return protos
+}
+
+// ---------------- Menu History ------------------------
+
+func (s *menuServer) GetMenuItemHistory(ctx context.Context, req *GetMenuHistoryRequest) (*MenuHistory, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid menu item ID format")
+	}
+	at, hasAt, err := historyAt(req.At)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := s.menuRepo.GetMenuItemHistory(ctx, id)
+	if err != nil {
+		return nil, menuHistoryError(err)
+	}
+
+	resp := &MenuHistory{Id: req.Id, Prices: convertPricesToProto(history.Prices)}
+	for _, version := range history.Versions {
+		resp.Versions = append(resp.Versions, &MenuVersion{
+			Id:            version.UUID.String(),
+			NameTh:        version.NameTH,
+			NameEn:        version.NameEN,
+			Description:   version.Description,
+			Price:         version.Price,
+			CategoryId:    version.CategoryID.String(),
+			EffectiveFrom: formatHistoryTime(&version.EffectiveFrom),
+			EffectiveTo:   formatHistoryTime(version.EffectiveTo),
+		})
+	}
+	if hasAt {
+		resp.PriceAt = priceAt(history.Prices, at)
+	}
+	return resp, nil
+}
+
+func (s *menuServer) GetMenuSetHistory(ctx context.Context, req *GetMenuHistoryRequest) (*MenuHistory, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid menu set ID format")
+	}
+	at, hasAt, err := historyAt(req.At)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := s.menuRepo.GetMenuSetHistory(ctx, id)
+	if err != nil {
+		return nil, menuHistoryError(err)
+	}
+
+	resp := &MenuHistory{Id: req.Id, Prices: convertPricesToProto(history.Prices)}
+	for _, version := range history.Versions {
+		resp.Versions = append(resp.Versions, &MenuVersion{
+			Id:            version.UUID.String(),
+			NameTh:        version.Name,
+			Price:         version.Price,
+			EffectiveFrom: formatHistoryTime(&version.EffectiveFrom),
+			EffectiveTo:   formatHistoryTime(version.EffectiveTo),
+		})
+	}
+	if hasAt {
+		resp.PriceAt = priceAt(history.Prices, at)
+	}
+	return resp, nil
+}
+
+func (s *menuServer) ScheduleMenuItemPrice(ctx context.Context, req *ScheduleMenuPriceRequest) (*MenuHistory, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid menu item ID format")
+	}
+	effectiveFrom, err := scheduledPriceDate(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.menuRepo.ScheduleMenuItemPrice(ctx, id, req.Price, effectiveFrom); err != nil {
+		return nil, menuHistoryError(err)
+	}
+
+	logs.Info("Menu item price scheduled", zap.String("ID", req.Id), zap.Float64("Price", req.Price), zap.String("EffectiveDate", req.EffectiveDate))
+	return s.GetMenuItemHistory(ctx, &GetMenuHistoryRequest{Id: req.Id})
+}
+
+func (s *menuServer) ScheduleMenuSetPrice(ctx context.Context, req *ScheduleMenuPriceRequest) (*MenuHistory, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid menu set ID format")
+	}
+	effectiveFrom, err := scheduledPriceDate(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.menuRepo.ScheduleMenuSetPrice(ctx, id, req.Price, effectiveFrom); err != nil {
+		return nil, menuHistoryError(err)
+	}
+
+	logs.Info("Menu set price scheduled", zap.String("ID", req.Id), zap.Float64("Price", req.Price), zap.String("EffectiveDate", req.EffectiveDate))
+	return s.GetMenuSetHistory(ctx, &GetMenuHistoryRequest{Id: req.Id})
+}
+
+func menuHistoryError(err error) error {
+	switch {
+	case errors.Is(err, repository.ErrMenuItemNotFound):
+		return status.Errorf(codes.NotFound, "Menu item not found")
+	case errors.Is(err, repository.ErrMenuSetNotFound):
+		return status.Errorf(codes.NotFound, "Menu set not found")
+	case errors.Is(err, repository.ErrPriceChangeNotInFuture):
+		return status.Errorf(codes.InvalidArgument, "effective_date must be in the future")
+	}
+	return status.Errorf(codes.Internal, "Failed to handle menu history: %v", err)
+}
+
+// scheduledPriceDate ตรวจราคาและแปลงวันที่มีผลเป็น 00:00 เวลาไทยของวันนั้น
+func scheduledPriceDate(req *ScheduleMenuPriceRequest) (time.Time, error) {
+	if math.IsNaN(req.Price) || req.Price <= 0 || req.Price >= 1e8 {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "price must be between 0.01 and 99,999,999.99")
+	}
+	bangkok, err := time.LoadLocation("Asia/Bangkok")
+	if err != nil {
+		return time.Time{}, status.Errorf(codes.Internal, "could not load Bangkok timezone: %v", err)
+	}
+	date, err := time.ParseInLocation("2006-01-02", req.EffectiveDate, bangkok)
+	if err != nil {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "effective_date must be in YYYY-MM-DD format")
+	}
+	return date, nil
+}
+
+// historyAt แปลงเวลาที่ต้องการดูราคา (RFC3339 หรือ YYYY-MM-DD เวลาไทย) ว่าง = ไม่ต้องหา
+func historyAt(at string) (time.Time, bool, error) {
+	if at == "" {
+		return time.Time{}, false, nil
+	}
+	if parsed, err := time.Parse(time.RFC3339, at); err == nil {
+		return parsed, true, nil
+	}
+	bangkok, err := time.LoadLocation("Asia/Bangkok")
+	if err != nil {
+		return time.Time{}, false, status.Errorf(codes.Internal, "could not load Bangkok timezone: %v", err)
+	}
+	parsed, err := time.ParseInLocation("2006-01-02", at, bangkok)
+	if err != nil {
+		return time.Time{}, false, status.Errorf(codes.InvalidArgument, "at must be an RFC3339 timestamp or YYYY-MM-DD")
+	}
+	return parsed, true, nil
+}
+
+// formatHistoryTime แสดงเวลาเป็น RFC3339 ตามเวลาไทย nil = ว่าง
+func formatHistoryTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	if bangkok, err := time.LoadLocation("Asia/Bangkok"); err == nil {
+		return t.In(bangkok).Format(time.RFC3339)
+	}
+	return t.Format(time.RFC3339)
+}
+
+func convertPricesToProto(prices []repository.MenuPrice) []*MenuPricePeriod {
+	now := time.Now()
+	protos := make([]*MenuPricePeriod, 0, len(prices))
+	for _, price := range prices {
+		protos = append(protos, &MenuPricePeriod{
+			Id:            price.UUID.String(),
+			Price:         price.Price,
+			EffectiveFrom: formatHistoryTime(&price.EffectiveFrom),
+			EffectiveTo:   formatHistoryTime(price.EffectiveTo),
+			Scheduled:     price.EffectiveFrom.After(now),
+		})
+	}
+	return protos
+}
+
+// priceAt คืนช่วงราคาที่ครอบเวลา at หรือ nil ถ้าเวลานั้นยังไม่มีประวัติราคา
+func priceAt(prices []repository.MenuPrice, at time.Time) *MenuPricePeriod {
+	for _, price := range prices {
+		if price.EffectiveFrom.After(at) || (price.EffectiveTo != nil && !price.EffectiveTo.After(at)) {
+			continue
+		}
+		return convertPricesToProto([]repository.MenuPrice{price})[0]
+	}
+	return nil
+}
+
+// ---------------- Menu Search ------------------------
+
+// defaultSearchPageSize จำนวนผลการค้นหาต่อหน้าเมื่อไม่ระบุ page_size
+const defaultSearchPageSize = 20
+
+// น้ำหนักของแต่ละช่องตอนให้คะแนน ชื่อสำคัญกว่ารายละเอียด
+const (
+	searchNameWeight        = 1.0
+	searchDescriptionWeight = 0.5
+)
+
+func (s *menuServer) SearchMenu(ctx context.Context, req *SearchMenuRequest) (*SearchMenuResponse, error) {
+	query := menusearch.NewQuery(req.Q)
+	if query.Empty() {
+		return nil, status.Errorf(codes.InvalidArgument, "q is required")
+	}
+	pageSize := req.PageSize
+	if pageSize == 0 {
+		pageSize = defaultSearchPageSize
+	}
+
+	items, err := s.listMenuItems(ctx, menuItemFilter{
+		at:               req.At,
+		excludeAllergens: req.ExcludeAllergens,
+		dietaryTags:      req.DietaryTags,
+		categoryID:       req.CategoryId,
+		minPrice:         req.MinPrice,
+		maxPrice:         req.MaxPrice,
+	})
+	if err != nil {
+		return nil, err
+	}
+	sets, err := s.listMenuSets(ctx, menuSetFilter{
+		at:               req.At,
+		excludeAllergens: req.ExcludeAllergens,
+		dietaryTags:      req.DietaryTags,
+		categoryID:       req.CategoryId,
+		minPrice:         req.MinPrice,
+		maxPrice:         req.MaxPrice,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	type searchHit struct {
+		score float64
+		name  string
+		item  *repository.MenuItem
+		set   *repository.MenuSet
+	}
+	var hits []searchHit
+	for i, item := range items.items {
+		score := query.Score(
+			menusearch.Field{Text: item.NameTH, Weight: searchNameWeight},
+			menusearch.Field{Text: item.NameEN, Weight: searchNameWeight},
+			menusearch.Field{Text: item.Description, Weight: searchDescriptionWeight},
+		)
+		if score >= menusearch.MinScore {
+			hits = append(hits, searchHit{score: score, name: item.NameTH, item: &items.items[i]})
+		}
+	}
+	for i, set := range sets.sets {
+		score := query.Score(menusearch.Field{Text: set.Name, Weight: searchNameWeight})
+		if score >= menusearch.MinScore {
+			hits = append(hits, searchHit{score: score, name: set.Name, set: &sets.sets[i]})
+		}
+	}
+	// คะแนนเท่ากันเรียงตามชื่อ เพื่อให้แบ่งหน้าได้ผลเหมือนเดิมทุกครั้ง
+	sort.SliceStable(hits, func(i, j int) bool {
+		if hits[i].score != hits[j].score {
+			return hits[i].score > hits[j].score
+		}
+		return hits[i].name < hits[j].name
+	})
+
+	total := len(hits)
+	start, end, err := pageRange(total, req.Page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	hits = hits[start:end]
+
+	var pageItems []repository.MenuItem
+	var pageSets []repository.MenuSet
+	for _, hit := range hits {
+		if hit.item != nil {
+			pageItems = append(pageItems, *hit.item)
+		} else {
+			pageSets = append(pageSets, *hit.set)
+		}
+	}
+	itemProtos, err := s.menuItemsToProto(ctx, items, pageItems)
+	if err != nil {
+		return nil, err
+	}
+	setProtos, err := s.menuSetsToProto(ctx, sets, pageSets)
+	if err != nil {
+		return nil, err
+	}
+
+	// itemProtos และ setProtos เรียงตามลำดับเดียวกับ hits
+	response := &SearchMenuResponse{Total: int32(total)}
+	for _, hit := range hits {
+		result := &MenuSearchHit{Score: math.Round(hit.score*1000) / 1000}
+		if hit.item != nil {
+			result.MenuItem, itemProtos = itemProtos[0], itemProtos[1:]
+		} else {
+			result.MenuSet, setProtos = setProtos[0], setProtos[1:]
+		}
+		response.Hits = append(response.Hits, result)
+	}
 	return response, nil
 }
 
@@ -0,0 +1,317 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"gitlab.com/final_project1240930/booking_service/internal/logs"
+	"gitlab.com/final_project1240930/booking_service/internal/repository"
+	"go.uber.org/zap"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ---------------- Supplier ------------------------
+
+func (s *inventoryServer) CreateSupplier(ctx context.Context, req *Supplier) (*Supplier, error) {
+	supplier, err := convertProtoToSupplier(req)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.purchasingRepo.CreateSupplier(ctx, supplier)
+	if err != nil {
+		return nil, purchasingError("create supplier", err)
+	}
+	return convertSupplierToProto(created), nil
+}
+
+func (s *inventoryServer) UpdateSupplier(ctx context.Context, req *Supplier) (*Supplier, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid supplier ID format")
+	}
+	supplier, err := convertProtoToSupplier(req)
+	if err != nil {
+		return nil, err
+	}
+	supplier.UUID = id
+
+	updated, err := s.purchasingRepo.UpdateSupplier(ctx, supplier)
+	if err != nil {
+		return nil, purchasingError("update supplier", err)
+	}
+	return convertSupplierToProto(updated), nil
+}
+
+func (s *inventoryServer) DeleteSupplier(ctx context.Context, req *SupplierIdRequest) (*emptypb.Empty, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid supplier ID format")
+	}
+	if err := s.purchasingRepo.DeleteSupplier(ctx, id); err != nil {
+		return nil, purchasingError("delete supplier", err)
+	}
+
+	logs.Info("Supplier deleted successfully", zap.String("ID", req.Id))
+	return &emptypb.Empty{}, nil
+}
+
+func (s *inventoryServer) GetSuppliers(ctx context.Context, _ *emptypb.Empty) (*SupplierList, error) {
+	suppliers, err := s.purchasingRepo.GetSuppliers(ctx)
+	if err != nil {
+		return nil, purchasingError("get suppliers", err)
+	}
+
+	list := &SupplierList{Suppliers: make([]*Supplier, 0, len(suppliers))}
+	for _, supplier := range suppliers {
+		list.Suppliers = append(list.Suppliers, convertSupplierToProto(supplier))
+	}
+	return list, nil
+}
+
+// purchasingError แปลง error จาก repository เป็น gRPC status
+func purchasingError(action string, err error) error {
+	switch {
+	case errors.Is(err, repository.ErrSupplierNotFound):
+		return status.Errorf(codes.NotFound, "Supplier not found")
+	case errors.Is(err, repository.ErrPurchaseOrderNotFound):
+		return status.Errorf(codes.NotFound, "Purchase order not found")
+	case errors.Is(err, repository.ErrSupplierNameTaken),
+		errors.Is(err, repository.ErrSupplierInUse),
+		errors.Is(err, repository.ErrPurchaseOrderStatus):
+		return status.Error(codes.FailedPrecondition, errors.Unwrap(err).Error())
+	}
+	logs.Error("Failed to "+action, zap.Error(err))
+	return status.Errorf(codes.Internal, "Failed to %s: %v", action, err)
+}
+
+func convertProtoToSupplier(req *Supplier) (repository.Supplier, error) {
+	supplier := repository.Supplier{
+		Name:         strings.TrimSpace(req.Name),
+		ContactName:  strings.TrimSpace(req.ContactName),
+		Phone:        strings.TrimSpace(req.Phone),
+		Email:        strings.TrimSpace(req.Email),
+		LeadTimeDays: req.LeadTimeDays,
+	}
+	if supplier.Name == "" {
+		return repository.Supplier{}, status.Errorf(codes.InvalidArgument, "Name must be provided")
+	}
+	if supplier.LeadTimeDays < 0 {
+		return repository.Supplier{}, status.Errorf(codes.InvalidArgument, "LeadTimeDays must not be negative")
+	}
+	return supplier, nil
+}
+
+func convertSupplierToProto(supplier repository.Supplier) *Supplier {
+	return &Supplier{
+		Id:           supplier.UUID.String(),
+		Name:         supplier.Name,
+		ContactName:  supplier.ContactName,
+		Phone:        supplier.Phone,
+		Email:        supplier.Email,
+		LeadTimeDays: supplier.LeadTimeDays,
+	}
+}
+
+// ---------------- Purchase Order ------------------------
+
+// GeneratePurchaseOrders สร้างร่างใบสั่งซื้อแยกตามผู้ขายจากปริมาณที่การจองในช่วงต้องใช้
+func (s *inventoryServer) GeneratePurchaseOrders(ctx context.Context, req *GeneratePurchaseOrdersRequest) (*GeneratePurchaseOrdersResponse, error) {
+	fromDate, err := time.Parse("2006-01-02", req.FromDate)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "from_date must be in YYYY-MM-DD format")
+	}
+	toDate, err := time.Parse("2006-01-02", req.ToDate)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "to_date must be in YYYY-MM-DD format")
+	}
+	days := int(toDate.Sub(fromDate).Hours()/24) + 1
+	if days < 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "to_date must not be before from_date")
+	}
+	if days > maxProjectionDays {
+		return nil, status.Errorf(codes.InvalidArgument, "Date range must not exceed %d days", maxProjectionDays)
+	}
+
+	// ใช้คงเหลือปัจจุบันหักตามการจองของแต่ละวัตถุดิบ
+	projections, _, _, err := s.projectConsumption(ctx, req.FromDate, days)
+	if err != nil {
+		return nil, err
+	}
+	ingredients, err := s.inventoryRepo.GetIngredients(ctx)
+	if err != nil {
+		return nil, ingredientError("get", err)
+	}
+	onOrder, err := s.purchasingRepo.GetOnOrderQuantities(ctx)
+	if err != nil {
+		return nil, purchasingError("get on-order quantities", err)
+	}
+	ingredientByID := make(map[string]repository.Ingredient, len(ingredients))
+	for _, ingredient := range ingredients {
+		ingredientByID[ingredient.UUID.String()] = ingredient
+	}
+
+	response := &GeneratePurchaseOrdersResponse{}
+	linesBySupplier := make(map[uuid.UUID][]repository.PurchaseOrderLine)
+	for _, projection := range projections {
+		ingredient, ok := ingredientByID[projection.Ingredient.Id]
+		if !ok {
+			continue
+		}
+		line := repository.PurchaseOrderLine{
+			IngredientID: ingredient.UUID,
+			Required:     projection.TotalRequired,
+			OnHand:       ingredient.Stock,
+			OnOrder:      roundStock(onOrder[ingredient.UUID]),
+			UnitCost:     ingredient.UnitCost,
+			NameTH:       ingredient.NameTH,
+			NameEN:       ingredient.NameEN,
+			Unit:         ingredient.Unit,
+		}
+		line.Quantity = orderQuantity(line.Required+ingredient.ReorderLevel-line.OnHand-line.OnOrder, ingredient.PackSize)
+		if line.Quantity <= 0 {
+			continue
+		}
+		if ingredient.SupplierID == nil {
+			response.Unassigned = append(response.Unassigned, convertPurchaseOrderLineToProto(line))
+			continue
+		}
+		linesBySupplier[*ingredient.SupplierID] = append(linesBySupplier[*ingredient.SupplierID], line)
+	}
+
+	orders := make([]repository.PurchaseOrder, 0, len(linesBySupplier))
+	for supplierID, lines := range linesBySupplier {
+		orders = append(orders, repository.PurchaseOrder{
+			SupplierID: supplierID,
+			FromDate:   fromDate,
+			ToDate:     toDate,
+			Lines:      lines,
+		})
+	}
+	saved, err := s.purchasingRepo.ReplaceDraftPurchaseOrders(ctx, orders)
+	if err != nil {
+		return nil, purchasingError("generate purchase orders", err)
+	}
+	sort.Slice(saved, func(i, j int) bool {
+		return saved[i].Supplier.Name < saved[j].Supplier.Name
+	})
+
+	for _, order := range saved {
+		response.Orders = append(response.Orders, convertPurchaseOrderToProto(order))
+	}
+
+	logs.Info("Generated purchase orders",
+		zap.String("FromDate", req.FromDate),
+		zap.String("ToDate", req.ToDate),
+		zap.Int("Orders", len(response.Orders)),
+		zap.Int("Unassigned", len(response.Unassigned)),
+	)
+	return response, nil
+}
+
+// orderQuantity ปัดจำนวนที่ขาดขึ้นเป็นจำนวนเต็มของขนาดบรรจุ ไม่ขาด = 0
+func orderQuantity(shortage, packSize float64) float64 {
+	shortage = roundStock(shortage)
+	if shortage <= 0 {
+		return 0
+	}
+	if packSize <= 0 {
+		return shortage
+	}
+	// ปัดผลหารก่อนกันเศษของ float ทำให้ปัดขึ้นเกินไปหนึ่งแพ็ก
+	packs := math.Ceil(math.Round(shortage/packSize*1e6) / 1e6)
+	return roundStock(packs * packSize)
+}
+
+func (s *inventoryServer) GetPurchaseOrders(ctx context.Context, req *GetPurchaseOrdersRequest) (*PurchaseOrderList, error) {
+	orderStatus := strings.ToUpper(req.Status)
+	switch orderStatus {
+	case "", repository.PurchaseOrderDraft, repository.PurchaseOrderSent, repository.PurchaseOrderReceived, repository.PurchaseOrderCancelled:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Status must be one of DRAFT, SENT, RECEIVED, CANCELLED")
+	}
+
+	orders, err := s.purchasingRepo.GetPurchaseOrders(ctx, orderStatus)
+	if err != nil {
+		return nil, purchasingError("get purchase orders", err)
+	}
+
+	list := &PurchaseOrderList{Orders: make([]*PurchaseOrder, 0, len(orders))}
+	for _, order := range orders {
+		list.Orders = append(list.Orders, convertPurchaseOrderToProto(order))
+	}
+	return list, nil
+}
+
+func (s *inventoryServer) GetPurchaseOrderById(ctx context.Context, req *PurchaseOrderIdRequest) (*PurchaseOrder, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid purchase order ID format")
+	}
+	order, err := s.purchasingRepo.GetPurchaseOrderByID(ctx, id)
+	if err != nil {
+		return nil, purchasingError("get purchase order", err)
+	}
+	return convertPurchaseOrderToProto(order), nil
+}
+
+func (s *inventoryServer) UpdatePurchaseOrderStatus(ctx context.Context, req *UpdatePurchaseOrderStatusRequest) (*PurchaseOrder, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid purchase order ID format")
+	}
+	orderStatus := strings.ToUpper(req.Status)
+	switch orderStatus {
+	case repository.PurchaseOrderSent, repository.PurchaseOrderReceived, repository.PurchaseOrderCancelled:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Status must be one of SENT, RECEIVED, CANCELLED")
+	}
+
+	order, err := s.purchasingRepo.SetPurchaseOrderStatus(ctx, id, orderStatus)
+	if err != nil {
+		return nil, purchasingError("update purchase order status", err)
+	}
+	return convertPurchaseOrderToProto(order), nil
+}
+
+func convertPurchaseOrderToProto(order repository.PurchaseOrder) *PurchaseOrder {
+	response := &PurchaseOrder{
+		Id:          order.UUID.String(),
+		Supplier:    convertSupplierToProto(order.Supplier),
+		Status:      order.Status,
+		FromDate:    order.FromDate.Format("2006-01-02"),
+		ToDate:      order.ToDate.Format("2006-01-02"),
+		OrderByDate: order.FromDate.AddDate(0, 0, -int(order.Supplier.LeadTimeDays)).Format("2006-01-02"),
+		Lines:       make([]*PurchaseOrderLine, 0, len(order.Lines)),
+		CreatedAt:   formatHistoryTime(&order.CreatedAt),
+	}
+	for _, line := range order.Lines {
+		protoLine := convertPurchaseOrderLineToProto(line)
+		response.Lines = append(response.Lines, protoLine)
+		response.Total += protoLine.Amount
+	}
+	response.Total = math.Round(response.Total*100) / 100
+	return response
+}
+
+func convertPurchaseOrderLineToProto(line repository.PurchaseOrderLine) *PurchaseOrderLine {
+	return &PurchaseOrderLine{
+		IngredientId: line.IngredientID.String(),
+		NameTh:       line.NameTH,
+		NameEn:       line.NameEN,
+		Unit:         line.Unit,
+		Required:     line.Required,
+		OnHand:       line.OnHand,
+		OnOrder:      line.OnOrder,
+		Quantity:     line.Quantity,
+		UnitCost:     line.UnitCost,
+		Amount:       math.Round(line.Quantity*line.UnitCost*100) / 100,
+	}
+}
@@ -0,0 +1,114 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"gitlab.com/final_project1240930/booking_service/internal/logs"
+	"gitlab.com/final_project1240930/booking_service/internal/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ---------------- Table Block ------------------------
+
+func (s *tableServer) CreateTableBlock(ctx context.Context, req *CreateTableBlockRequest) (*TableBlock, error) {
+	logs.Info("Received CreateTableBlockRequest", zap.String("TableID", req.GetTableId()), zap.String("CreatedBy", req.GetCreatedBy()))
+
+	tableID, err := uuid.FromString(req.GetTableId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid table ID format")
+	}
+	reason := strings.TrimSpace(req.GetReason())
+	if reason == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Reason must be provided")
+	}
+	startTime, err := time.Parse(time.RFC3339, req.GetStartTime())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "start_time must be an RFC3339 timestamp")
+	}
+	endTime, err := time.Parse(time.RFC3339, req.GetEndTime())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "end_time must be an RFC3339 timestamp")
+	}
+	if !endTime.After(startTime) {
+		return nil, status.Errorf(codes.InvalidArgument, "end_time must be after start_time")
+	}
+
+	block, err := s.tableRepo.CreateTableBlock(ctx, repository.TableBlock{
+		TableID:   tableID,
+		Reason:    reason,
+		StartTime: startTime,
+		EndTime:   endTime,
+		CreatedBy: strings.TrimSpace(req.GetCreatedBy()),
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrTableNotFound) {
+			return nil, status.Errorf(codes.NotFound, "Table not found")
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to create table block: %v", err)
+	}
+	return convertTableBlockToProto(block), nil
+}
+
+func (s *tableServer) DeleteTableBlock(ctx context.Context, req *DeleteTableBlockRequest) (*DeleteTableBlockResponse, error) {
+	id, err := uuid.FromString(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid table block ID format")
+	}
+
+	if err := s.tableRepo.DeleteTableBlock(ctx, id); err != nil {
+		if errors.Is(err, repository.ErrTableBlockNotFound) {
+			return nil, status.Errorf(codes.NotFound, "Table block not found")
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to delete table block: %v", err)
+	}
+	return &DeleteTableBlockResponse{Status: "success"}, nil
+}
+
+// ListTableBlocks คืน block ที่คาบเกี่ยวกับวันที่ระบุ (00:00-24:00 เวลาไทย)
+func (s *tableServer) ListTableBlocks(ctx context.Context, req *ListTableBlocksRequest) (*TableBlockList, error) {
+	bangkok, err := time.LoadLocation("Asia/Bangkok")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not load Bangkok timezone: %v", err)
+	}
+	date, err := time.ParseInLocation("2006-01-02", req.GetDate(), bangkok)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "date must be in YYYY-MM-DD format")
+	}
+
+	blocks, err := s.tableRepo.ListTableBlocks(ctx, date, date.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to list table blocks: %v", err)
+	}
+
+	list := &TableBlockList{}
+	for _, block := range blocks {
+		list.Blocks = append(list.Blocks, convertTableBlockToProto(block))
+	}
+	return list, nil
+}
+
+// convertTableBlockToProto แสดงเวลาเป็น RFC3339 ตามเวลาไทย
+func convertTableBlockToProto(block repository.TableBlock) *TableBlock {
+	format := func(t time.Time) string {
+		if bangkok, err := time.LoadLocation("Asia/Bangkok"); err == nil {
+			t = t.In(bangkok)
+		}
+		return t.Format(time.RFC3339)
+	}
+	return &TableBlock{
+		Id:        block.UUID.String(),
+		TableId:   block.TableID.String(),
+		NumTable:  block.NumTable,
+		Reason:    block.Reason,
+		StartTime: format(block.StartTime),
+		EndTime:   format(block.EndTime),
+		CreatedBy: block.CreatedBy,
+		CreatedAt: format(block.CreatedAt),
+	}
+}
@@ -0,0 +1,181 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"gitlab.com/final_project1240930/booking_service/internal/logs"
+	"gitlab.com/final_project1240930/booking_service/internal/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ---------------- Table Combination ------------------------
+
+func (s *tableServer) CreateTableCombination(ctx context.Context, req *CreateTableCombinationRequest) (*TableCombination, error) {
+	combination, err := convertProtoToTableCombination(req.Name, req.Capacity, req.TableIds)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.tableRepo.CreateTableCombination(ctx, combination)
+	if err != nil {
+		return nil, tableCombinationError("create", err)
+	}
+	return s.tableCombinationToProto(ctx, created)
+}
+
+func (s *tableServer) UpdateTableCombination(ctx context.Context, req *UpdateTableCombinationRequest) (*TableCombination, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid table combination ID format")
+	}
+	combination, err := convertProtoToTableCombination(req.Name, req.Capacity, req.TableIds)
+	if err != nil {
+		return nil, err
+	}
+	combination.UUID = id
+
+	updated, err := s.tableRepo.UpdateTableCombination(ctx, combination)
+	if err != nil {
+		return nil, tableCombinationError("update", err)
+	}
+	return s.tableCombinationToProto(ctx, updated)
+}
+
+func (s *tableServer) DeleteTableCombination(ctx context.Context, req *DeleteTableCombinationRequest) (*DeleteTableCombinationResponse, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid table combination ID format")
+	}
+
+	if err := s.tableRepo.DeleteTableCombination(ctx, id); err != nil {
+		return nil, tableCombinationError("delete", err)
+	}
+	return &DeleteTableCombinationResponse{Status: "success"}, nil
+}
+
+func (s *tableServer) ListTableCombinations(ctx context.Context, _ *emptypb.Empty) (*TableCombinationList, error) {
+	combinations, err := s.tableRepo.ListTableCombinations(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to list table combinations: %v", err)
+	}
+	numbers, err := s.tableNumbers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &TableCombinationList{}
+	for _, combination := range combinations {
+		list.Combinations = append(list.Combinations, convertTableCombinationToProto(combination, numbers))
+	}
+	return list, nil
+}
+
+// availableCombinations คืนชุดโต๊ะที่โต๊ะทุกตัวอยู่ใน tables (โต๊ะที่ว่างในช่วงเวลานั้น)
+func availableCombinations(combinations []repository.TableCombination, tables []*Table, numbers map[uuid.UUID]int32) []*TableCombination {
+	free := make(map[string]bool, len(tables))
+	for _, table := range tables {
+		free[table.Id] = true
+	}
+
+	var result []*TableCombination
+	for _, combination := range combinations {
+		available := len(combination.TableIDs) > 0
+		for _, tableID := range combination.TableIDs {
+			if !free[tableID.String()] {
+				available = false
+				break
+			}
+		}
+		if available {
+			result = append(result, convertTableCombinationToProto(combination, numbers))
+		}
+	}
+	return result
+}
+
+func (s *tableServer) tableCombinationToProto(ctx context.Context, combination repository.TableCombination) (*TableCombination, error) {
+	numbers, err := s.tableNumbers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return convertTableCombinationToProto(combination, numbers), nil
+}
+
+// tableNumbers คืนเลขโต๊ะของโต๊ะทุกตัว ใช้แสดงเลขโต๊ะในชุดโต๊ะต่อ
+func (s *tableServer) tableNumbers(ctx context.Context) (map[uuid.UUID]int32, error) {
+	tables, err := s.tableRepo.GetTables(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to fetch tables: %v", err)
+	}
+	numbers := make(map[uuid.UUID]int32, len(tables))
+	for _, table := range tables {
+		numbers[table.UUID] = table.NumTable
+	}
+	return numbers, nil
+}
+
+func tableCombinationError(action string, err error) error {
+	switch {
+	case errors.Is(err, repository.ErrTableCombinationNotFound):
+		return status.Errorf(codes.NotFound, "Table combination not found")
+	case errors.Is(err, repository.ErrTableCombinationTableMissing):
+		return status.Error(codes.InvalidArgument, errors.Unwrap(err).Error())
+	case errors.Is(err, repository.ErrTableCombinationNameTaken):
+		return status.Error(codes.FailedPrecondition, errors.Unwrap(err).Error())
+	}
+	logs.Error("Failed to "+action+" table combination", zap.Error(err))
+	return status.Errorf(codes.Internal, "Failed to %s table combination: %v", action, err)
+}
+
+func convertProtoToTableCombination(name string, capacity int32, tableIDs []string) (repository.TableCombination, error) {
+	combination := repository.TableCombination{
+		Name:     strings.TrimSpace(name),
+		Capacity: capacity,
+	}
+	if combination.Name == "" {
+		return repository.TableCombination{}, status.Errorf(codes.InvalidArgument, "Name must be provided")
+	}
+	if combination.Capacity <= 0 {
+		return repository.TableCombination{}, status.Errorf(codes.InvalidArgument, "Capacity must be greater than 0")
+	}
+
+	seen := make(map[uuid.UUID]bool, len(tableIDs))
+	for _, raw := range tableIDs {
+		id, err := uuid.FromString(raw)
+		if err != nil {
+			return repository.TableCombination{}, status.Errorf(codes.InvalidArgument, "Invalid table ID format: %q", raw)
+		}
+		if seen[id] {
+			return repository.TableCombination{}, status.Errorf(codes.InvalidArgument, "Table %s is listed more than once", id)
+		}
+		seen[id] = true
+		combination.TableIDs = append(combination.TableIDs, id)
+	}
+	if len(combination.TableIDs) < 2 {
+		return repository.TableCombination{}, status.Errorf(codes.InvalidArgument, "A table combination needs at least 2 tables")
+	}
+	return combination, nil
+}
+
+func convertTableCombinationToProto(combination repository.TableCombination, numbers map[uuid.UUID]int32) *TableCombination {
+	result := &TableCombination{
+		Id:       combination.UUID.String(),
+		Name:     combination.Name,
+		Capacity: combination.Capacity,
+	}
+	tableIDs := slices.Clone(combination.TableIDs)
+	sort.SliceStable(tableIDs, func(i, j int) bool { return numbers[tableIDs[i]] < numbers[tableIDs[j]] })
+	for _, tableID := range tableIDs {
+		result.TableIds = append(result.TableIds, tableID.String())
+		result.NumTables = append(result.NumTables, numbers[tableID])
+	}
+	return result
+}
@@ -20,14 +20,28 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TableService_CreateTable_FullMethodName        = "/services.TableService/CreateTable"
-	TableService_UpdateTable_FullMethodName        = "/services.TableService/UpdateTable"
-	TableService_DeleteTable_FullMethodName        = "/services.TableService/DeleteTable"
-	TableService_GetTables_FullMethodName          = "/services.TableService/GetTables"
-	TableService_GetTableByNumTable_FullMethodName = "/services.TableService/GetTableByNumTable"
-	TableService_GetAvailableTables_FullMethodName = "/services.TableService/GetAvailableTables"
-	TableService_UpdateTableType_FullMethodName    = "/services.TableService/UpdateTableType"
-	TableService_ListTableTypes_FullMethodName     = "/services.TableService/ListTableTypes"
+	TableService_CreateTable_FullMethodName            = "/services.TableService/CreateTable"
+	TableService_UpdateTable_FullMethodName            = "/services.TableService/UpdateTable"
+	TableService_DeleteTable_FullMethodName            = "/services.TableService/DeleteTable"
+	TableService_GetTables_FullMethodName              = "/services.TableService/GetTables"
+	TableService_GetTableByNumTable_FullMethodName     = "/services.TableService/GetTableByNumTable"
+	TableService_GetAvailableTables_FullMethodName     = "/services.TableService/GetAvailableTables"
+	TableService_CreateTableZone_FullMethodName        = "/services.TableService/CreateTableZone"
+	TableService_UpdateTableZone_FullMethodName        = "/services.TableService/UpdateTableZone"
+	TableService_DeleteTableZone_FullMethodName        = "/services.TableService/DeleteTableZone"
+	TableService_ListTableZones_FullMethodName         = "/services.TableService/ListTableZones"
+	TableService_UpdateTableLayouts_FullMethodName     = "/services.TableService/UpdateTableLayouts"
+	TableService_CreateTableCombination_FullMethodName = "/services.TableService/CreateTableCombination"
+	TableService_UpdateTableCombination_FullMethodName = "/services.TableService/UpdateTableCombination"
+	TableService_DeleteTableCombination_FullMethodName = "/services.TableService/DeleteTableCombination"
+	TableService_ListTableCombinations_FullMethodName  = "/services.TableService/ListTableCombinations"
+	TableService_CreateTableType_FullMethodName        = "/services.TableService/CreateTableType"
+	TableService_UpdateTableType_FullMethodName        = "/services.TableService/UpdateTableType"
+	TableService_DeleteTableType_FullMethodName        = "/services.TableService/DeleteTableType"
+	TableService_ListTableTypes_FullMethodName         = "/services.TableService/ListTableTypes"
+	TableService_CreateTableBlock_FullMethodName       = "/services.TableService/CreateTableBlock"
+	TableService_DeleteTableBlock_FullMethodName       = "/services.TableService/DeleteTableBlock"
+	TableService_ListTableBlocks_FullMethodName        = "/services.TableService/ListTableBlocks"
 )
 
 // TableServiceClient is the client API for TableService service.
@@ -41,9 +55,26 @@ type TableServiceClient interface {
 	GetTables(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TableList, error)
 	GetTableByNumTable(ctx context.Context, in *GetTableByNumTableRequest, opts ...grpc.CallOption) (*GetTableByNumTableResponse, error)
 	GetAvailableTables(ctx context.Context, in *GetAvailableTablesRequest, opts ...grpc.CallOption) (*GetAvailableTablesResponse, error)
-	// Handle Table Type Count
-	UpdateTableType(ctx context.Context, in *UpdateTableTypeRequest, opts ...grpc.CallOption) (*UpdateTableTypeResponse, error)
+	// Handle Floor Plan
+	CreateTableZone(ctx context.Context, in *CreateTableZoneRequest, opts ...grpc.CallOption) (*TableZone, error)
+	UpdateTableZone(ctx context.Context, in *UpdateTableZoneRequest, opts ...grpc.CallOption) (*TableZone, error)
+	DeleteTableZone(ctx context.Context, in *DeleteTableZoneRequest, opts ...grpc.CallOption) (*DeleteTableZoneResponse, error)
+	ListTableZones(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TableZoneList, error)
+	UpdateTableLayouts(ctx context.Context, in *UpdateTableLayoutsRequest, opts ...grpc.CallOption) (*UpdateTableLayoutsResponse, error)
+	// Handle Table Combination
+	CreateTableCombination(ctx context.Context, in *CreateTableCombinationRequest, opts ...grpc.CallOption) (*TableCombination, error)
+	UpdateTableCombination(ctx context.Context, in *UpdateTableCombinationRequest, opts ...grpc.CallOption) (*TableCombination, error)
+	DeleteTableCombination(ctx context.Context, in *DeleteTableCombinationRequest, opts ...grpc.CallOption) (*DeleteTableCombinationResponse, error)
+	ListTableCombinations(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TableCombinationList, error)
+	// Handle Table Type
+	CreateTableType(ctx context.Context, in *CreateTableTypeRequest, opts ...grpc.CallOption) (*TableType, error)
+	UpdateTableType(ctx context.Context, in *UpdateTableTypeRequest, opts ...grpc.CallOption) (*TableType, error)
+	DeleteTableType(ctx context.Context, in *DeleteTableTypeRequest, opts ...grpc.CallOption) (*DeleteTableTypeResponse, error)
 	ListTableTypes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TableTypeList, error)
+	// Handle Table Block
+	CreateTableBlock(ctx context.Context, in *CreateTableBlockRequest, opts ...grpc.CallOption) (*TableBlock, error)
+	DeleteTableBlock(ctx context.Context, in *DeleteTableBlockRequest, opts ...grpc.CallOption) (*DeleteTableBlockResponse, error)
+	ListTableBlocks(ctx context.Context, in *ListTableBlocksRequest, opts ...grpc.CallOption) (*TableBlockList, error)
 }
 
 type tableServiceClient struct {
@@ -114,9 +145,109 @@ func (c *tableServiceClient) GetAvailableTables(ctx context.Context, in *GetAvai
 	return out, nil
 }
 
-func (c *tableServiceClient) UpdateTableType(ctx context.Context, in *UpdateTableTypeRequest, opts ...grpc.CallOption) (*UpdateTableTypeResponse, error) {
+func (c *tableServiceClient) CreateTableZone(ctx context.Context, in *CreateTableZoneRequest, opts ...grpc.CallOption) (*TableZone, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UpdateTableTypeResponse)
+	out := new(TableZone)
+	err := c.cc.Invoke(ctx, TableService_CreateTableZone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tableServiceClient) UpdateTableZone(ctx context.Context, in *UpdateTableZoneRequest, opts ...grpc.CallOption) (*TableZone, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TableZone)
+	err := c.cc.Invoke(ctx, TableService_UpdateTableZone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tableServiceClient) DeleteTableZone(ctx context.Context, in *DeleteTableZoneRequest, opts ...grpc.CallOption) (*DeleteTableZoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTableZoneResponse)
+	err := c.cc.Invoke(ctx, TableService_DeleteTableZone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tableServiceClient) ListTableZones(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TableZoneList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TableZoneList)
+	err := c.cc.Invoke(ctx, TableService_ListTableZones_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tableServiceClient) UpdateTableLayouts(ctx context.Context, in *UpdateTableLayoutsRequest, opts ...grpc.CallOption) (*UpdateTableLayoutsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTableLayoutsResponse)
+	err := c.cc.Invoke(ctx, TableService_UpdateTableLayouts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tableServiceClient) CreateTableCombination(ctx context.Context, in *CreateTableCombinationRequest, opts ...grpc.CallOption) (*TableCombination, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TableCombination)
+	err := c.cc.Invoke(ctx, TableService_CreateTableCombination_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tableServiceClient) UpdateTableCombination(ctx context.Context, in *UpdateTableCombinationRequest, opts ...grpc.CallOption) (*TableCombination, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TableCombination)
+	err := c.cc.Invoke(ctx, TableService_UpdateTableCombination_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tableServiceClient) DeleteTableCombination(ctx context.Context, in *DeleteTableCombinationRequest, opts ...grpc.CallOption) (*DeleteTableCombinationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTableCombinationResponse)
+	err := c.cc.Invoke(ctx, TableService_DeleteTableCombination_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tableServiceClient) ListTableCombinations(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TableCombinationList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TableCombinationList)
+	err := c.cc.Invoke(ctx, TableService_ListTableCombinations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tableServiceClient) CreateTableType(ctx context.Context, in *CreateTableTypeRequest, opts ...grpc.CallOption) (*TableType, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TableType)
+	err := c.cc.Invoke(ctx, TableService_CreateTableType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tableServiceClient) UpdateTableType(ctx context.Context, in *UpdateTableTypeRequest, opts ...grpc.CallOption) (*TableType, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TableType)
 	err := c.cc.Invoke(ctx, TableService_UpdateTableType_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -124,6 +255,16 @@ func (c *tableServiceClient) UpdateTableType(ctx context.Context, in *UpdateTabl
 	return out, nil
 }
 
+func (c *tableServiceClient) DeleteTableType(ctx context.Context, in *DeleteTableTypeRequest, opts ...grpc.CallOption) (*DeleteTableTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTableTypeResponse)
+	err := c.cc.Invoke(ctx, TableService_DeleteTableType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tableServiceClient) ListTableTypes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TableTypeList, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TableTypeList)
@@ -134,6 +275,36 @@ func (c *tableServiceClient) ListTableTypes(ctx context.Context, in *emptypb.Emp
 	return out, nil
 }
 
+func (c *tableServiceClient) CreateTableBlock(ctx context.Context, in *CreateTableBlockRequest, opts ...grpc.CallOption) (*TableBlock, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TableBlock)
+	err := c.cc.Invoke(ctx, TableService_CreateTableBlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tableServiceClient) DeleteTableBlock(ctx context.Context, in *DeleteTableBlockRequest, opts ...grpc.CallOption) (*DeleteTableBlockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTableBlockResponse)
+	err := c.cc.Invoke(ctx, TableService_DeleteTableBlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tableServiceClient) ListTableBlocks(ctx context.Context, in *ListTableBlocksRequest, opts ...grpc.CallOption) (*TableBlockList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TableBlockList)
+	err := c.cc.Invoke(ctx, TableService_ListTableBlocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TableServiceServer is the server API for TableService service.
 // All implementations must embed UnimplementedTableServiceServer
 // for forward compatibility.
@@ -145,9 +316,26 @@ type TableServiceServer interface {
 	GetTables(context.Context, *emptypb.Empty) (*TableList, error)
 	GetTableByNumTable(context.Context, *GetTableByNumTableRequest) (*GetTableByNumTableResponse, error)
 	GetAvailableTables(context.Context, *GetAvailableTablesRequest) (*GetAvailableTablesResponse, error)
-	// Handle Table Type Count
-	UpdateTableType(context.Context, *UpdateTableTypeRequest) (*UpdateTableTypeResponse, error)
+	// Handle Floor Plan
+	CreateTableZone(context.Context, *CreateTableZoneRequest) (*TableZone, error)
+	UpdateTableZone(context.Context, *UpdateTableZoneRequest) (*TableZone, error)
+	DeleteTableZone(context.Context, *DeleteTableZoneRequest) (*DeleteTableZoneResponse, error)
+	ListTableZones(context.Context, *emptypb.Empty) (*TableZoneList, error)
+	UpdateTableLayouts(context.Context, *UpdateTableLayoutsRequest) (*UpdateTableLayoutsResponse, error)
+	// Handle Table Combination
+	CreateTableCombination(context.Context, *CreateTableCombinationRequest) (*TableCombination, error)
+	UpdateTableCombination(context.Context, *UpdateTableCombinationRequest) (*TableCombination, error)
+	DeleteTableCombination(context.Context, *DeleteTableCombinationRequest) (*DeleteTableCombinationResponse, error)
+	ListTableCombinations(context.Context, *emptypb.Empty) (*TableCombinationList, error)
+	// Handle Table Type
+	CreateTableType(context.Context, *CreateTableTypeRequest) (*TableType, error)
+	UpdateTableType(context.Context, *UpdateTableTypeRequest) (*TableType, error)
+	DeleteTableType(context.Context, *DeleteTableTypeRequest) (*DeleteTableTypeResponse, error)
 	ListTableTypes(context.Context, *emptypb.Empty) (*TableTypeList, error)
+	// Handle Table Block
+	CreateTableBlock(context.Context, *CreateTableBlockRequest) (*TableBlock, error)
+	DeleteTableBlock(context.Context, *DeleteTableBlockRequest) (*DeleteTableBlockResponse, error)
+	ListTableBlocks(context.Context, *ListTableBlocksRequest) (*TableBlockList, error)
 	mustEmbedUnimplementedTableServiceServer()
 }
 
@@ -176,12 +364,54 @@ func (UnimplementedTableServiceServer) GetTableByNumTable(context.Context, *GetT
 func (UnimplementedTableServiceServer) GetAvailableTables(context.Context, *GetAvailableTablesRequest) (*GetAvailableTablesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAvailableTables not implemented")
 }
-func (UnimplementedTableServiceServer) UpdateTableType(context.Context, *UpdateTableTypeRequest) (*UpdateTableTypeResponse, error) {
+func (UnimplementedTableServiceServer) CreateTableZone(context.Context, *CreateTableZoneRequest) (*TableZone, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTableZone not implemented")
+}
+func (UnimplementedTableServiceServer) UpdateTableZone(context.Context, *UpdateTableZoneRequest) (*TableZone, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTableZone not implemented")
+}
+func (UnimplementedTableServiceServer) DeleteTableZone(context.Context, *DeleteTableZoneRequest) (*DeleteTableZoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTableZone not implemented")
+}
+func (UnimplementedTableServiceServer) ListTableZones(context.Context, *emptypb.Empty) (*TableZoneList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTableZones not implemented")
+}
+func (UnimplementedTableServiceServer) UpdateTableLayouts(context.Context, *UpdateTableLayoutsRequest) (*UpdateTableLayoutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTableLayouts not implemented")
+}
+func (UnimplementedTableServiceServer) CreateTableCombination(context.Context, *CreateTableCombinationRequest) (*TableCombination, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTableCombination not implemented")
+}
+func (UnimplementedTableServiceServer) UpdateTableCombination(context.Context, *UpdateTableCombinationRequest) (*TableCombination, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTableCombination not implemented")
+}
+func (UnimplementedTableServiceServer) DeleteTableCombination(context.Context, *DeleteTableCombinationRequest) (*DeleteTableCombinationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTableCombination not implemented")
+}
+func (UnimplementedTableServiceServer) ListTableCombinations(context.Context, *emptypb.Empty) (*TableCombinationList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTableCombinations not implemented")
+}
+func (UnimplementedTableServiceServer) CreateTableType(context.Context, *CreateTableTypeRequest) (*TableType, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTableType not implemented")
+}
+func (UnimplementedTableServiceServer) UpdateTableType(context.Context, *UpdateTableTypeRequest) (*TableType, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateTableType not implemented")
 }
+func (UnimplementedTableServiceServer) DeleteTableType(context.Context, *DeleteTableTypeRequest) (*DeleteTableTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTableType not implemented")
+}
 func (UnimplementedTableServiceServer) ListTableTypes(context.Context, *emptypb.Empty) (*TableTypeList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTableTypes not implemented")
 }
+func (UnimplementedTableServiceServer) CreateTableBlock(context.Context, *CreateTableBlockRequest) (*TableBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTableBlock not implemented")
+}
+func (UnimplementedTableServiceServer) DeleteTableBlock(context.Context, *DeleteTableBlockRequest) (*DeleteTableBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTableBlock not implemented")
+}
+func (UnimplementedTableServiceServer) ListTableBlocks(context.Context, *ListTableBlocksRequest) (*TableBlockList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTableBlocks not implemented")
+}
 func (UnimplementedTableServiceServer) mustEmbedUnimplementedTableServiceServer() {}
 func (UnimplementedTableServiceServer) testEmbeddedByValue()                      {}
 
@@ -311,6 +541,186 @@ func _TableService_GetAvailableTables_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TableService_CreateTableZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTableZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TableServiceServer).CreateTableZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TableService_CreateTableZone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TableServiceServer).CreateTableZone(ctx, req.(*CreateTableZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TableService_UpdateTableZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTableZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TableServiceServer).UpdateTableZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TableService_UpdateTableZone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TableServiceServer).UpdateTableZone(ctx, req.(*UpdateTableZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TableService_DeleteTableZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTableZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TableServiceServer).DeleteTableZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TableService_DeleteTableZone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TableServiceServer).DeleteTableZone(ctx, req.(*DeleteTableZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TableService_ListTableZones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TableServiceServer).ListTableZones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TableService_ListTableZones_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TableServiceServer).ListTableZones(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TableService_UpdateTableLayouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTableLayoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TableServiceServer).UpdateTableLayouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TableService_UpdateTableLayouts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TableServiceServer).UpdateTableLayouts(ctx, req.(*UpdateTableLayoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TableService_CreateTableCombination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTableCombinationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TableServiceServer).CreateTableCombination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TableService_CreateTableCombination_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TableServiceServer).CreateTableCombination(ctx, req.(*CreateTableCombinationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TableService_UpdateTableCombination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTableCombinationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TableServiceServer).UpdateTableCombination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TableService_UpdateTableCombination_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TableServiceServer).UpdateTableCombination(ctx, req.(*UpdateTableCombinationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TableService_DeleteTableCombination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTableCombinationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TableServiceServer).DeleteTableCombination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TableService_DeleteTableCombination_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TableServiceServer).DeleteTableCombination(ctx, req.(*DeleteTableCombinationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TableService_ListTableCombinations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TableServiceServer).ListTableCombinations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TableService_ListTableCombinations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TableServiceServer).ListTableCombinations(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TableService_CreateTableType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTableTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TableServiceServer).CreateTableType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TableService_CreateTableType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TableServiceServer).CreateTableType(ctx, req.(*CreateTableTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TableService_UpdateTableType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateTableTypeRequest)
 	if err := dec(in); err != nil {
@@ -329,6 +739,24 @@ func _TableService_UpdateTableType_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TableService_DeleteTableType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTableTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TableServiceServer).DeleteTableType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TableService_DeleteTableType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TableServiceServer).DeleteTableType(ctx, req.(*DeleteTableTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TableService_ListTableTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -347,6 +775,60 @@ func _TableService_ListTableTypes_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TableService_CreateTableBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTableBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TableServiceServer).CreateTableBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TableService_CreateTableBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TableServiceServer).CreateTableBlock(ctx, req.(*CreateTableBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TableService_DeleteTableBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTableBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TableServiceServer).DeleteTableBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TableService_DeleteTableBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TableServiceServer).DeleteTableBlock(ctx, req.(*DeleteTableBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TableService_ListTableBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTableBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TableServiceServer).ListTableBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TableService_ListTableBlocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TableServiceServer).ListTableBlocks(ctx, req.(*ListTableBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TableService_ServiceDesc is the grpc.ServiceDesc for TableService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -378,14 +860,70 @@ var TableService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAvailableTables",
 			Handler:    _TableService_GetAvailableTables_Handler,
 		},
+		{
+			MethodName: "CreateTableZone",
+			Handler:    _TableService_CreateTableZone_Handler,
+		},
+		{
+			MethodName: "UpdateTableZone",
+			Handler:    _TableService_UpdateTableZone_Handler,
+		},
+		{
+			MethodName: "DeleteTableZone",
+			Handler:    _TableService_DeleteTableZone_Handler,
+		},
+		{
+			MethodName: "ListTableZones",
+			Handler:    _TableService_ListTableZones_Handler,
+		},
+		{
+			MethodName: "UpdateTableLayouts",
+			Handler:    _TableService_UpdateTableLayouts_Handler,
+		},
+		{
+			MethodName: "CreateTableCombination",
+			Handler:    _TableService_CreateTableCombination_Handler,
+		},
+		{
+			MethodName: "UpdateTableCombination",
+			Handler:    _TableService_UpdateTableCombination_Handler,
+		},
+		{
+			MethodName: "DeleteTableCombination",
+			Handler:    _TableService_DeleteTableCombination_Handler,
+		},
+		{
+			MethodName: "ListTableCombinations",
+			Handler:    _TableService_ListTableCombinations_Handler,
+		},
+		{
+			MethodName: "CreateTableType",
+			Handler:    _TableService_CreateTableType_Handler,
+		},
 		{
 			MethodName: "UpdateTableType",
 			Handler:    _TableService_UpdateTableType_Handler,
 		},
+		{
+			MethodName: "DeleteTableType",
+			Handler:    _TableService_DeleteTableType_Handler,
+		},
 		{
 			MethodName: "ListTableTypes",
 			Handler:    _TableService_ListTableTypes_Handler,
 		},
+		{
+			MethodName: "CreateTableBlock",
+			Handler:    _TableService_CreateTableBlock_Handler,
+		},
+		{
+			MethodName: "DeleteTableBlock",
+			Handler:    _TableService_DeleteTableBlock_Handler,
+		},
+		{
+			MethodName: "ListTableBlocks",
+			Handler:    _TableService_ListTableBlocks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "table.proto",
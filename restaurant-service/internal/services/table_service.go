@@ -2,8 +2,10 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/gofrs/uuid"
@@ -37,11 +39,10 @@ func (s *tableServer) CreateTable(ctx context.Context, req *CreateTableRequest)
 		return nil, status.Errorf(codes.InvalidArgument, "NumTable must be greater than 0")
 	}
 
-	// แปลงค่า TableType จาก Protobuf ไปเป็น Repository
-	dbTableType, err := mapProtoTableTypeToRepository(req.GetType())
+	typeID, err := uuid.FromString(req.GetTypeId())
 	if err != nil {
-		logs.Error("Invalid table type", zap.String("Type", req.GetType().String()))
-		return nil, status.Errorf(codes.InvalidArgument, "Invalid table type: %v", req.GetType().String())
+		logs.Error("Invalid table type", zap.String("TypeID", req.GetTypeId()))
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid table type ID format")
 	}
 
 	// ตรวจสอบว่าเลขโต๊ะซ้ำหรือไม่
@@ -49,13 +50,22 @@ func (s *tableServer) CreateTable(ctx context.Context, req *CreateTableRequest)
 		return nil, err
 	}
 
+	layout, err := convertProtoToTableLayout(req.GetLayout())
+	if err != nil {
+		return nil, err
+	}
+
 	table := repository.Table{
-		NumTable: req.GetNumTable(),
-		Type:     dbTableType,
+		NumTable:    req.GetNumTable(),
+		TypeID:      typeID,
+		TableLayout: layout,
 	}
 
 	tableID, err := s.tableRepo.CreateTable(ctx, table)
 	if err != nil {
+		if errors.Is(err, repository.ErrTableZoneNotFound) || errors.Is(err, repository.ErrTableTypeNotFound) {
+			return nil, status.Error(codes.InvalidArgument, errors.Unwrap(err).Error())
+		}
 		logs.Error("Failed to create table", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "Failed to create table: %v", err)
 	}
@@ -105,15 +115,23 @@ func (s *tableServer) UpdateTable(ctx context.Context, req *UpdateTableRequest)
 		}
 	}
 
-	tableType, err := mapProtoTableTypeToRepository(req.GetType())
+	typeID, err := uuid.FromString(req.GetTypeId())
 	if err != nil {
-		return nil, fmt.Errorf("invalid type: %v", req.GetType())
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid table type ID format")
 	}
 
 	table.NumTable = req.GetNumTable()
-	table.Type = tableType
+	table.TypeID = typeID
+	if req.GetLayout() != nil {
+		if table.TableLayout, err = convertProtoToTableLayout(req.GetLayout()); err != nil {
+			return nil, err
+		}
+	}
 
 	if err := s.tableRepo.UpdateTable(ctx, table); err != nil {
+		if errors.Is(err, repository.ErrTableZoneNotFound) || errors.Is(err, repository.ErrTableTypeNotFound) {
+			return nil, status.Error(codes.InvalidArgument, errors.Unwrap(err).Error())
+		}
 		logs.Error("Failed to update table", zap.Error(err))
 		return nil, err
 	}
@@ -135,6 +153,9 @@ func (s *tableServer) DeleteTable(ctx context.Context, req *DeleteTableRequest)
 	}
 
 	if err := s.tableRepo.DeleteTable(ctx, tableID); err != nil {
+		if errors.Is(err, repository.ErrTableInCombination) {
+			return nil, status.Error(codes.FailedPrecondition, errors.Unwrap(err).Error())
+		}
 		logs.Error("Failed to delete table", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "Failed to delete table: %v", err)
 	}
@@ -155,19 +176,14 @@ func (s *tableServer) GetTables(ctx context.Context, _ *emptypb.Empty) (*TableLi
 		return nil, status.Errorf(codes.Internal, "Failed to fetch tables: %v", err)
 	}
 
+	tableTypes, err := s.tableTypesByID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var tableList []*Table
 	for _, table := range tables {
-		pbType, err := mapRepositoryTableTypeToProto(table.Type)
-		if err != nil {
-			logs.Error("Unknown table type", zap.String("Type", string(table.Type)))
-			continue
-		}
-
-		tableList = append(tableList, &Table{
-			Id:       table.UUID.String(),
-			NumTable: table.NumTable,
-			Type:     pbType,
-		})
+		tableList = append(tableList, convertTableToProto(table, tableTypes))
 	}
 
 	logs.Info("Successfully fetched tables", zap.Int("TotalTables", len(tableList)))
@@ -182,49 +198,67 @@ func (s *tableServer) GetTableByNumTable(ctx context.Context, req *GetTableByNum
 		return nil, status.Errorf(codes.Internal, "failed to get table by num_table: %v", err)
 	}
 
-	pbType, err := mapRepositoryTableTypeToProto(table.Type)
+	tableTypes, err := s.tableTypesByID(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to map table type: %v", err)
+		return nil, err
 	}
 
 	return &GetTableByNumTableResponse{
-		Table: &Table{
-			Id:       table.UUID.String(),
-			NumTable: table.NumTable,
-			Type:     pbType,
-		},
+		Table: convertTableToProto(table, tableTypes),
 	}, nil
 }
 
 func (s *tableServer) GetAvailableTables(ctx context.Context, req *GetAvailableTablesRequest) (*GetAvailableTablesResponse, error) {
 
-	tableAvailability, err := s.tableRepo.GetAvailableTables(ctx, req.Date)
+	var zoneID *uuid.UUID
+	if req.GetZoneId() != "" {
+		id, err := uuid.FromString(req.GetZoneId())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid table zone ID format")
+		}
+		zoneID = &id
+	}
+
+	tableAvailability, err := s.tableRepo.GetAvailableTables(ctx, req.Date, zoneID)
 	if err != nil {
 		return nil, fmt.Errorf("could not get available tables: %v", err)
 	}
 
+	tableTypes, err := s.tableTypesByID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	timeSlotMap := make(map[string][]*Table)
 
 	for _, availability := range tableAvailability {
-
-		pbType, err := mapRepositoryTableTypeToProto(availability.Type)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to map table type: %v", err)
-		}
 		table := &Table{
 			Id:       availability.TableID,
 			NumTable: int32(availability.NumTable),
-			Type:     TableType(pbType),
+			TypeId:   availability.TypeID.String(),
+			Type:     tableTypes[availability.TypeID],
+			Layout:   convertTableLayoutToProto(availability.TableLayout),
 		}
 
 		timeSlotMap[availability.TimeSlot] = append(timeSlotMap[availability.TimeSlot], table)
 	}
 
+	// ชุดโต๊ะต่อจองได้เมื่อโต๊ะทุกตัวในชุดว่าง (และอยู่ในโซนที่กรอง) ในช่วงเวลานั้น
+	combinations, err := s.tableRepo.ListTableCombinations(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list table combinations: %v", err)
+	}
+	numbers, err := s.tableNumbers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var availabilityList []*TableAvailability
 	for timeSlot, tables := range timeSlotMap {
 		availabilityList = append(availabilityList, &TableAvailability{
-			TimeSlot: timeSlot,
-			Tables:   tables,
+			TimeSlot:     timeSlot,
+			Tables:       tables,
+			Combinations: availableCombinations(combinations, tables, numbers),
 		})
 	}
 
@@ -241,82 +275,134 @@ func (s *tableServer) GetAvailableTables(ctx context.Context, req *GetAvailableT
 
 // ---------------- Table Type ------------------------
 
-func (s *tableServer) UpdateTableType(ctx context.Context, req *UpdateTableTypeRequest) (*UpdateTableTypeResponse, error) {
-	logs.Info("Received UpdateTableTypeRequest", zap.String("TableType", req.GetType().String()))
+func (s *tableServer) CreateTableType(ctx context.Context, req *CreateTableTypeRequest) (*TableType, error) {
+	tableType, err := convertProtoToTableType(req.Name, req.MinSeats, req.MaxSeats, req.Description, req.Surcharge)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.tableRepo.CreateTableType(ctx, tableType)
+	if err != nil {
+		return nil, tableTypeError("create", err)
+	}
+	return convertTableTypeToProto(created), nil
+}
+
+func (s *tableServer) UpdateTableType(ctx context.Context, req *UpdateTableTypeRequest) (*TableType, error) {
+	logs.Info("Received UpdateTableTypeRequest", zap.String("TypeID", req.GetId()))
+
+	id, err := uuid.FromString(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid table type ID format")
+	}
+	tableType, err := convertProtoToTableType(req.Name, req.MinSeats, req.MaxSeats, req.Description, req.Surcharge)
+	if err != nil {
+		return nil, err
+	}
+	tableType.UUID = id
 
-	var tableType repository.Type
-	switch req.GetType() {
-	case TableType_STANDARD:
-		tableType = repository.STANDARD
-	case TableType_LARGE:
-		tableType = repository.LARGE
-	default:
-		logs.Error("Invalid table type received")
-		return nil, status.Errorf(codes.InvalidArgument, "Invalid table type: %v", req.GetType().String())
+	updated, err := s.tableRepo.UpdateTableType(ctx, tableType)
+	if err != nil {
+		return nil, tableTypeError("update", err)
 	}
+	return convertTableTypeToProto(updated), nil
+}
 
-	err := s.tableRepo.UpdateTableType(ctx, tableType, req.GetSeatCount())
+func (s *tableServer) DeleteTableType(ctx context.Context, req *DeleteTableTypeRequest) (*DeleteTableTypeResponse, error) {
+	id, err := uuid.FromString(req.GetId())
 	if err != nil {
-		logs.Error("Failed to update table type", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, "Failed to update table type: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid table type ID format")
 	}
 
-	logs.Info("Successfully updated table type")
+	if err := s.tableRepo.DeleteTableType(ctx, id); err != nil {
+		return nil, tableTypeError("delete", err)
+	}
 
-	return &UpdateTableTypeResponse{
-		Status: "success",
-	}, nil
+	logs.Info("Table type deleted successfully", zap.String("ID", req.GetId()))
+	return &DeleteTableTypeResponse{Status: "success"}, nil
 }
 
 // ListTableTypes
 func (s *tableServer) ListTableTypes(ctx context.Context, _ *emptypb.Empty) (*TableTypeList, error) {
 	logs.Info("Received ListTableTypesRequest")
 
-	tableTypeCounts, err := s.tableRepo.ListTableTypes(ctx)
+	tableTypes, err := s.tableRepo.ListTableTypes(ctx)
 	if err != nil {
 		logs.Error("Failed to list table types", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "Failed to list table types: %v", err)
 	}
 
-	var pbTableTypeCounts []*TableTypeCount
-	for _, tableTypeCount := range tableTypeCounts {
-		logs.Info("Processing table type", zap.String("Type", string(tableTypeCount.Type)), zap.Int("SeatCount", tableTypeCount.SeatCount))
+	list := &TableTypeList{}
+	for _, tableType := range tableTypes {
+		list.TableTypes = append(list.TableTypes, convertTableTypeToProto(tableType))
+	}
+	return list, nil
+}
 
-		protoType, err := mapRepositoryTableTypeToProto(tableTypeCount.Type) // ใช้ฟังก์ชันที่ถูกต้อง
-		if err != nil {
-			logs.Error("Skipping unknown table type", zap.String("Type", string(tableTypeCount.Type)))
-			continue
-		}
+// tableTypesByID ใช้แนบข้อมูลประเภทโต๊ะไปกับโต๊ะแต่ละตัว
+func (s *tableServer) tableTypesByID(ctx context.Context) (map[uuid.UUID]*TableType, error) {
+	tableTypes, err := s.tableRepo.ListTableTypes(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to list table types: %v", err)
+	}
+	byID := make(map[uuid.UUID]*TableType, len(tableTypes))
+	for _, tableType := range tableTypes {
+		byID[tableType.UUID] = convertTableTypeToProto(tableType)
+	}
+	return byID, nil
+}
 
-		pbTableTypeCounts = append(pbTableTypeCounts, &TableTypeCount{
-			Type:  protoType,
-			Count: int32(tableTypeCount.SeatCount),
-		})
+func tableTypeError(action string, err error) error {
+	switch {
+	case errors.Is(err, repository.ErrTableTypeNotFound):
+		return status.Errorf(codes.NotFound, "Table type not found")
+	case errors.Is(err, repository.ErrTableTypeInUse), errors.Is(err, repository.ErrTableTypeNameTaken):
+		return status.Error(codes.FailedPrecondition, errors.Unwrap(err).Error())
 	}
+	logs.Error("Failed to "+action+" table type", zap.Error(err))
+	return status.Errorf(codes.Internal, "Failed to %s table type: %v", action, err)
+}
 
-	return &TableTypeList{
-		TableTypes: pbTableTypeCounts,
-	}, nil
+func convertProtoToTableType(name string, minSeats, maxSeats int32, description string, surcharge float64) (repository.TableType, error) {
+	tableType := repository.TableType{
+		Name:        strings.TrimSpace(name),
+		MinSeats:    minSeats,
+		MaxSeats:    maxSeats,
+		Description: strings.TrimSpace(description),
+		Surcharge:   surcharge,
+	}
+	if tableType.Name == "" {
+		return repository.TableType{}, status.Errorf(codes.InvalidArgument, "Name must be provided")
+	}
+	if tableType.MinSeats <= 0 {
+		tableType.MinSeats = 1
+	}
+	if tableType.MaxSeats < tableType.MinSeats {
+		return repository.TableType{}, status.Errorf(codes.InvalidArgument, "MaxSeats must be at least MinSeats")
+	}
+	if tableType.Surcharge < 0 {
+		return repository.TableType{}, status.Errorf(codes.InvalidArgument, "Surcharge must not be negative")
+	}
+	return tableType, nil
 }
 
-func mapRepositoryTableTypeToProto(tableType repository.Type) (TableType, error) {
-	switch tableType {
-	case repository.STANDARD:
-		return TableType_STANDARD, nil
-	case repository.LARGE:
-		return TableType_LARGE, nil
-	default:
-		return TableType_TABLE_TYPE_UNKNOWN, fmt.Errorf("unknown table type: %v", tableType)
+func convertTableTypeToProto(tableType repository.TableType) *TableType {
+	return &TableType{
+		Id:          tableType.UUID.String(),
+		Name:        tableType.Name,
+		MinSeats:    tableType.MinSeats,
+		MaxSeats:    tableType.MaxSeats,
+		Description: tableType.Description,
+		Surcharge:   tableType.Surcharge,
 	}
 }
 
-func mapProtoTableTypeToRepository(tableType TableType) (repository.Type, error) {
-	switch tableType {
-	case TableType_STANDARD:
-		return repository.STANDARD, nil
-	case TableType_LARGE:
-		return repository.LARGE, nil
-	default:
-		return "", fmt.Errorf("unknown table type: %v", tableType)
+func convertTableToProto(table repository.Table, tableTypes map[uuid.UUID]*TableType) *Table {
+	return &Table{
+		Id:       table.UUID.String(),
+		NumTable: table.NumTable,
+		TypeId:   table.TypeID.String(),
+		Type:     tableTypes[table.TypeID],
+		Layout:   convertTableLayoutToProto(table.TableLayout),
 	}
 }
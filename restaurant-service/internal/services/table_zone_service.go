@@ -0,0 +1,206 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"gitlab.com/final_project1240930/booking_service/internal/logs"
+	"gitlab.com/final_project1240930/booking_service/internal/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ---------------- Floor Plan ------------------------
+
+func (s *tableServer) CreateTableZone(ctx context.Context, req *CreateTableZoneRequest) (*TableZone, error) {
+	zone, err := convertProtoToTableZone(req.NameTh, req.NameEn, req.SortOrder, req.Width, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.tableRepo.CreateTableZone(ctx, zone)
+	if err != nil {
+		return nil, tableZoneError("create", err)
+	}
+	return convertTableZoneToProto(created), nil
+}
+
+func (s *tableServer) UpdateTableZone(ctx context.Context, req *UpdateTableZoneRequest) (*TableZone, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid table zone ID format")
+	}
+	zone, err := convertProtoToTableZone(req.NameTh, req.NameEn, req.SortOrder, req.Width, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	zone.UUID = id
+
+	updated, err := s.tableRepo.UpdateTableZone(ctx, zone)
+	if err != nil {
+		return nil, tableZoneError("update", err)
+	}
+	return convertTableZoneToProto(updated), nil
+}
+
+func (s *tableServer) DeleteTableZone(ctx context.Context, req *DeleteTableZoneRequest) (*DeleteTableZoneResponse, error) {
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid table zone ID format")
+	}
+
+	if err := s.tableRepo.DeleteTableZone(ctx, id); err != nil {
+		return nil, tableZoneError("delete", err)
+	}
+
+	logs.Info("Table zone deleted successfully", zap.String("ID", req.Id))
+	return &DeleteTableZoneResponse{Status: "success"}, nil
+}
+
+func (s *tableServer) ListTableZones(ctx context.Context, _ *emptypb.Empty) (*TableZoneList, error) {
+	zones, err := s.tableRepo.ListTableZones(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to list table zones: %v", err)
+	}
+
+	list := &TableZoneList{}
+	for _, zone := range zones {
+		list.Zones = append(list.Zones, convertTableZoneToProto(zone))
+	}
+	return list, nil
+}
+
+// UpdateTableLayouts ใช้จากหน้าจัดผังร้าน ส่งเฉพาะโต๊ะที่ย้ายตำแหน่ง
+func (s *tableServer) UpdateTableLayouts(ctx context.Context, req *UpdateTableLayoutsRequest) (*UpdateTableLayoutsResponse, error) {
+	if len(req.Tables) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "At least one table must be provided")
+	}
+
+	layouts := make(map[uuid.UUID]repository.TableLayout, len(req.Tables))
+	for _, position := range req.Tables {
+		tableID, err := uuid.FromString(position.TableId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid table ID format: %q", position.TableId)
+		}
+		if _, ok := layouts[tableID]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "Table %s is listed more than once", tableID)
+		}
+		if position.Layout == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Layout of table %s must be provided", tableID)
+		}
+		if layouts[tableID], err = convertProtoToTableLayout(position.Layout); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.tableRepo.UpdateTableLayouts(ctx, layouts); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrTableZoneNotFound):
+			return nil, status.Errorf(codes.InvalidArgument, "Table zone not found")
+		case errors.Is(err, repository.ErrTableNotFound):
+			return nil, status.Error(codes.NotFound, errors.Unwrap(err).Error())
+		}
+		return nil, status.Errorf(codes.Internal, "Failed to update table layouts: %v", err)
+	}
+	return &UpdateTableLayoutsResponse{Status: "success"}, nil
+}
+
+func tableZoneError(action string, err error) error {
+	switch {
+	case errors.Is(err, repository.ErrTableZoneNotFound):
+		return status.Errorf(codes.NotFound, "Table zone not found")
+	case errors.Is(err, repository.ErrTableZoneInUse), errors.Is(err, repository.ErrTableZoneNameTaken):
+		return status.Error(codes.FailedPrecondition, errors.Unwrap(err).Error())
+	}
+	logs.Error("Failed to "+action+" table zone", zap.Error(err))
+	return status.Errorf(codes.Internal, "Failed to %s table zone: %v", action, err)
+}
+
+func convertProtoToTableZone(nameTH, nameEN string, sortOrder, width, height int32) (repository.TableZone, error) {
+	zone := repository.TableZone{
+		NameTH:    strings.TrimSpace(nameTH),
+		NameEN:    strings.TrimSpace(nameEN),
+		SortOrder: sortOrder,
+		Width:     width,
+		Height:    height,
+	}
+	if zone.NameTH == "" || zone.NameEN == "" {
+		return repository.TableZone{}, status.Errorf(codes.InvalidArgument, "NameTh and NameEn must be provided")
+	}
+	if zone.Width < 0 || zone.Height < 0 {
+		return repository.TableZone{}, status.Errorf(codes.InvalidArgument, "Width and Height must not be negative")
+	}
+	return zone, nil
+}
+
+func convertTableZoneToProto(zone repository.TableZone) *TableZone {
+	return &TableZone{
+		Id:        zone.UUID.String(),
+		NameTh:    zone.NameTH,
+		NameEn:    zone.NameEN,
+		SortOrder: zone.SortOrder,
+		Width:     zone.Width,
+		Height:    zone.Height,
+	}
+}
+
+// convertProtoToTableLayout layout ที่เป็น nil คือโต๊ะที่ยังไม่ได้จัดโซน
+func convertProtoToTableLayout(layout *TableLayout) (repository.TableLayout, error) {
+	result := repository.TableLayout{Shape: repository.SQUARE}
+	if layout == nil {
+		return result, nil
+	}
+
+	if layout.ZoneId != "" {
+		zoneID, err := uuid.FromString(layout.ZoneId)
+		if err != nil {
+			return repository.TableLayout{}, status.Errorf(codes.InvalidArgument, "Invalid table zone ID format")
+		}
+		result.ZoneID = &zoneID
+	}
+	if layout.PosX < 0 || layout.PosY < 0 {
+		return repository.TableLayout{}, status.Errorf(codes.InvalidArgument, "PosX and PosY must not be negative")
+	}
+	if layout.Rotation < 0 || layout.Rotation >= 360 {
+		return repository.TableLayout{}, status.Errorf(codes.InvalidArgument, "Rotation must be between 0 and 359 degrees")
+	}
+	result.PosX = layout.PosX
+	result.PosY = layout.PosY
+	result.Rotation = layout.Rotation
+
+	switch layout.Shape {
+	case TableShape_TABLE_SHAPE_UNKNOWN, TableShape_SQUARE:
+		result.Shape = repository.SQUARE
+	case TableShape_ROUND:
+		result.Shape = repository.ROUND
+	case TableShape_RECTANGLE:
+		result.Shape = repository.RECTANGLE
+	default:
+		return repository.TableLayout{}, status.Errorf(codes.InvalidArgument, "Invalid table shape: %v", layout.Shape)
+	}
+	return result, nil
+}
+
+func convertTableLayoutToProto(layout repository.TableLayout) *TableLayout {
+	result := &TableLayout{
+		PosX:     layout.PosX,
+		PosY:     layout.PosY,
+		Rotation: layout.Rotation,
+	}
+	if layout.ZoneID != nil {
+		result.ZoneId = layout.ZoneID.String()
+	}
+	switch layout.Shape {
+	case repository.ROUND:
+		result.Shape = TableShape_ROUND
+	case repository.RECTANGLE:
+		result.Shape = TableShape_RECTANGLE
+	default:
+		result.Shape = TableShape_SQUARE
+	}
+	return result
+}
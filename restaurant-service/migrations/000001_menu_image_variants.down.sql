@@ -0,0 +1,3 @@
+ALTER TABLE menu_items
+    DROP COLUMN image_card_url,
+    DROP COLUMN image_thumbnail_url;
@@ -0,0 +1,4 @@
+-- URL ของรูปเมนูขนาดย่อ (image_url เดิมเป็นขนาด full)
+ALTER TABLE menu_items
+    ADD COLUMN image_thumbnail_url VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN image_card_url VARCHAR(255) NOT NULL DEFAULT '';
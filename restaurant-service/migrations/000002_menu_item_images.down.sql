@@ -0,0 +1 @@
+DROP TABLE menu_item_images;
@@ -0,0 +1,14 @@
+-- แกลเลอรีรูปภาพของเมนู (image_url ของ menu_items ยังเป็นรูปหลักเหมือนเดิม)
+CREATE TABLE menu_item_images (
+    uuid UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    menu_item_id UUID NOT NULL REFERENCES menu_items (uuid) ON DELETE CASCADE,
+    position INTEGER NOT NULL CHECK (position >= 0),
+    alt_th VARCHAR(255) NOT NULL DEFAULT '',
+    alt_en VARCHAR(255) NOT NULL DEFAULT '',
+    image_url VARCHAR(255) NOT NULL,
+    image_thumbnail_url VARCHAR(255) NOT NULL DEFAULT '',
+    image_card_url VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    -- DEFERRABLE เพื่อให้สลับลำดับรูปภายใน transaction เดียวได้
+    CONSTRAINT menu_item_images_position_key UNIQUE (menu_item_id, position) DEFERRABLE INITIALLY DEFERRED
+);
@@ -0,0 +1,5 @@
+ALTER TABLE menu_item_images
+    DROP COLUMN image_key;
+
+ALTER TABLE menu_items
+    DROP COLUMN image_key;
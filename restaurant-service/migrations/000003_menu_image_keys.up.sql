@@ -0,0 +1,15 @@
+-- เก็บ key ของรูปในที่เก็บรูปภาพ (Cloudinary public ID) ไว้ลบรูปภายหลัง แทนการเดาจาก URL
+ALTER TABLE menu_items
+    ADD COLUMN image_key VARCHAR(255) NOT NULL DEFAULT '';
+
+ALTER TABLE menu_item_images
+    ADD COLUMN image_key VARCHAR(255) NOT NULL DEFAULT '';
+
+-- รูปเดิมบน Cloudinary: public ID คือส่วนหลังเลข version และไม่มีนามสกุล
+-- เช่น .../image/upload/v1700000000/menu_images/menu_image_xxx.jpg -> menu_images/menu_image_xxx
+-- รูปอื่นที่ยังไม่มี key จะหา key จาก URL ตอนลบ
+UPDATE menu_items
+SET image_key = regexp_replace(
+        regexp_replace(image_url, '^https?://res\.cloudinary\.com/[^/]+/image/upload/(.*/)?v[0-9]+/', ''),
+        '\.[A-Za-z0-9]+$', '')
+WHERE image_url ~ '^https?://res\.cloudinary\.com/[^/]+/image/upload/(.*/)?v[0-9]+/';
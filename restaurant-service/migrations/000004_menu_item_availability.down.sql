@@ -0,0 +1,3 @@
+ALTER TABLE menu_items
+    DROP COLUMN daily_limit,
+    DROP COLUMN is_available;
@@ -0,0 +1,4 @@
+-- ปิดขายเมนูชั่วคราวโดยไม่ต้องลบ และจำกัดจำนวนที่ขายได้ต่อวัน (0 = ไม่จำกัด)
+ALTER TABLE menu_items
+    ADD COLUMN is_available BOOLEAN NOT NULL DEFAULT TRUE,
+    ADD COLUMN daily_limit INTEGER NOT NULL DEFAULT 0 CHECK (daily_limit >= 0);
@@ -0,0 +1,20 @@
+-- ช่วงเวลาที่สั่งเมนูหรือเมนูเซ็ตได้ (เช่น เซ็ตกลางวัน, เมนูพิเศษเสาร์-อาทิตย์)
+-- ไม่มีแถว = สั่งได้ตลอด, หลายแถว = ตรงแถวใดแถวหนึ่งก็สั่งได้ เวลาทั้งหมดเป็นเวลาไทย
+CREATE TABLE menu_schedules (
+    uuid UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    menu_item_id UUID REFERENCES menu_items (uuid) ON DELETE CASCADE,
+    menu_set_id UUID REFERENCES menu_sets (uuid) ON DELETE CASCADE,
+    -- bitmask ของวันในสัปดาห์ (bit 0 = อาทิตย์ ... bit 6 = เสาร์), 0 = ทุกวัน
+    days_of_week SMALLINT NOT NULL DEFAULT 0 CHECK (days_of_week BETWEEN 0 AND 127),
+    -- นาทีนับจากเที่ยงคืน [start_minute, end_minute)
+    start_minute SMALLINT NOT NULL DEFAULT 0,
+    end_minute SMALLINT NOT NULL DEFAULT 1440,
+    valid_from DATE,
+    valid_until DATE,
+    CHECK ((menu_item_id IS NULL) <> (menu_set_id IS NULL)),
+    CHECK (start_minute >= 0 AND start_minute < end_minute AND end_minute <= 1440),
+    CHECK (valid_from IS NULL OR valid_until IS NULL OR valid_from <= valid_until)
+);
+
+CREATE INDEX menu_schedules_menu_item_id_idx ON menu_schedules (menu_item_id);
+CREATE INDEX menu_schedules_menu_set_id_idx ON menu_schedules (menu_set_id);
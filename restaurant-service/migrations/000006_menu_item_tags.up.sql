@@ -0,0 +1,10 @@
+-- แท็กสารก่อภูมิแพ้ (ALLERGEN) และข้อมูลด้านอาหาร (DIETARY) ของเมนู
+-- ค่าที่ใช้ได้อยู่ใน repository.Allergens และ repository.DietaryTags
+CREATE TABLE menu_item_tags (
+    menu_item_id UUID NOT NULL REFERENCES menu_items (uuid) ON DELETE CASCADE,
+    kind VARCHAR(10) NOT NULL CHECK (kind IN ('ALLERGEN', 'DIETARY')),
+    tag VARCHAR(30) NOT NULL,
+    PRIMARY KEY (menu_item_id, kind, tag)
+);
+
+CREATE INDEX menu_item_tags_tag_idx ON menu_item_tags (kind, tag);
@@ -0,0 +1,26 @@
+CREATE TYPE menu_category AS ENUM ('MAIN_COURSE', 'BEVERAGE', 'DESSERT');
+
+ALTER TABLE menu_items ADD COLUMN category menu_category;
+
+-- หมวดหมู่ที่เพิ่มภายหลังย้อนกลับเป็นหมวดหมู่ระดับบนสุดของมัน ถ้าไม่ตรงกับค่า enum เดิมจะเป็น MAIN_COURSE
+UPDATE menu_items mi
+SET category = CASE root.name_en
+    WHEN 'Beverage' THEN 'BEVERAGE'::menu_category
+    WHEN 'Dessert' THEN 'DESSERT'::menu_category
+    ELSE 'MAIN_COURSE'::menu_category
+END
+FROM (
+    WITH RECURSIVE tree AS (
+        SELECT uuid, uuid AS root_id FROM menu_categories WHERE parent_id IS NULL
+        UNION ALL
+        SELECT c.uuid, tree.root_id FROM menu_categories c JOIN tree ON c.parent_id = tree.uuid
+    )
+    SELECT tree.uuid, mc.name_en FROM tree JOIN menu_categories mc ON mc.uuid = tree.root_id
+) root
+WHERE root.uuid = mi.category_id;
+
+ALTER TABLE menu_items
+    ALTER COLUMN category SET NOT NULL,
+    DROP COLUMN category_id;
+
+DROP TABLE menu_categories;
@@ -0,0 +1,37 @@
+-- หมวดหมู่เมนูที่เพิ่ม/แก้ไขได้เอง แทน enum menu_category ที่มีแค่ 3 ค่า
+-- หมวดหมู่ย่อยชี้ไปที่หมวดหมู่แม่ด้วย parent_id (NULL = หมวดหมู่ระดับบนสุด)
+CREATE TABLE menu_categories (
+    uuid UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name_th VARCHAR(255) NOT NULL UNIQUE,
+    name_en VARCHAR(255) NOT NULL UNIQUE,
+    sort_order INTEGER NOT NULL DEFAULT 0,
+    parent_id UUID REFERENCES menu_categories (uuid) ON DELETE RESTRICT,
+    CHECK (parent_id <> uuid)
+);
+
+CREATE INDEX menu_categories_parent_id_idx ON menu_categories (parent_id);
+
+INSERT INTO menu_categories (name_th, name_en, sort_order) VALUES
+    ('อาหารจานหลัก', 'Main Course', 1),
+    ('เครื่องดื่ม', 'Beverage', 2),
+    ('ของหวาน', 'Dessert', 3);
+
+-- ย้ายเมนูเดิมไปหมวดหมู่ที่ตรงกับค่า enum เดิม
+ALTER TABLE menu_items ADD COLUMN category_id UUID REFERENCES menu_categories (uuid) ON DELETE RESTRICT;
+
+UPDATE menu_items mi
+SET category_id = mc.uuid
+FROM menu_categories mc
+WHERE mc.name_en = CASE mi.category
+    WHEN 'MAIN_COURSE' THEN 'Main Course'
+    WHEN 'BEVERAGE' THEN 'Beverage'
+    WHEN 'DESSERT' THEN 'Dessert'
+END;
+
+ALTER TABLE menu_items
+    ALTER COLUMN category_id SET NOT NULL,
+    DROP COLUMN category;
+
+DROP TYPE menu_category;
+
+CREATE INDEX menu_items_category_id_idx ON menu_items (category_id);
@@ -0,0 +1,2 @@
+DROP TABLE menu_modifier_options;
+DROP TABLE menu_modifier_groups;
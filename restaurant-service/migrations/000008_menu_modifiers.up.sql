@@ -0,0 +1,28 @@
+-- กลุ่มตัวเลือกของเมนู (เช่น ระดับความเผ็ด, เลือกเนื้อสัตว์, ท็อปปิ้งเพิ่ม)
+-- SINGLE = เลือกได้หนึ่งอย่าง, MULTI = เลือกได้ระหว่าง min_select ถึง max_select อย่าง
+CREATE TABLE menu_modifier_groups (
+    uuid UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    menu_item_id UUID NOT NULL REFERENCES menu_items (uuid) ON DELETE CASCADE,
+    name_th VARCHAR(255) NOT NULL,
+    name_en VARCHAR(255) NOT NULL,
+    selection_type VARCHAR(10) NOT NULL CHECK (selection_type IN ('SINGLE', 'MULTI')),
+    min_select INTEGER NOT NULL DEFAULT 0,
+    max_select INTEGER NOT NULL DEFAULT 1,
+    position INTEGER NOT NULL,
+    CHECK (min_select >= 0 AND min_select <= max_select AND max_select >= 1),
+    CHECK (selection_type = 'MULTI' OR max_select = 1)
+);
+
+CREATE INDEX menu_modifier_groups_menu_item_id_idx ON menu_modifier_groups (menu_item_id, position);
+
+-- ตัวเลือกในกลุ่ม price_delta บวกเพิ่ม (หรือลด) จากราคาเมนูต่อหนึ่งจาน
+CREATE TABLE menu_modifier_options (
+    uuid UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    group_id UUID NOT NULL REFERENCES menu_modifier_groups (uuid) ON DELETE CASCADE,
+    name_th VARCHAR(255) NOT NULL,
+    name_en VARCHAR(255) NOT NULL,
+    price_delta DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    position INTEGER NOT NULL
+);
+
+CREATE INDEX menu_modifier_options_group_id_idx ON menu_modifier_options (group_id, position);
@@ -0,0 +1,2 @@
+DROP TABLE menu_set_slot_items;
+DROP TABLE menu_set_slots;
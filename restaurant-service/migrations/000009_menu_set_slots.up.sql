@@ -0,0 +1,23 @@
+-- ช่องเลือกเมนูของเมนูเซ็ต (เช่น เลือกของว่าง 1 อย่าง, อาหารจานหลัก 2 อย่าง, ของหวาน 1 อย่าง)
+-- เมนูใน menu_set_items ยังเป็นเมนูที่ได้ทุกเซ็ต ส่วนเมนูที่เลือกได้อยู่ในช่องเลือกเหล่านี้
+CREATE TABLE menu_set_slots (
+    uuid UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    menu_set_id UUID NOT NULL REFERENCES menu_sets (uuid) ON DELETE CASCADE,
+    name_th VARCHAR(255) NOT NULL,
+    name_en VARCHAR(255) NOT NULL,
+    -- NULL = ไม่จำกัดหมวดหมู่ ถ้าระบุ เมนูที่เลือกต้องอยู่ในหมวดหมู่นี้หรือหมวดหมู่ย่อย
+    category_id UUID REFERENCES menu_categories (uuid) ON DELETE RESTRICT,
+    picks INTEGER NOT NULL CHECK (picks >= 1),
+    position INTEGER NOT NULL
+);
+
+CREATE INDEX menu_set_slots_menu_set_id_idx ON menu_set_slots (menu_set_id, position);
+
+-- เมนูที่เลือกได้ในช่อง ถ้าช่องไม่มีรายการเลย เลือกเมนูใดก็ได้ในหมวดหมู่ของช่อง (ไม่มีราคาเพิ่ม)
+CREATE TABLE menu_set_slot_items (
+    slot_id UUID NOT NULL REFERENCES menu_set_slots (uuid) ON DELETE CASCADE,
+    menu_item_id UUID NOT NULL REFERENCES menu_items (uuid) ON DELETE CASCADE,
+    upcharge DECIMAL(10, 2) NOT NULL DEFAULT 0 CHECK (upcharge >= 0),
+    position INTEGER NOT NULL,
+    PRIMARY KEY (slot_id, menu_item_id)
+);
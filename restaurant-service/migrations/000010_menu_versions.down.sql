@@ -0,0 +1,3 @@
+DROP TABLE menu_price_history;
+DROP TABLE menu_set_versions;
+DROP TABLE menu_item_versions;
@@ -0,0 +1,57 @@
+-- ข้อมูลเมนูแต่ละช่วงเวลา ทุกครั้งที่แก้ไขชื่อ/คำอธิบาย/ราคา/หมวดหมู่ จะปิดแถวเดิมแล้วเพิ่มแถวใหม่
+-- แถวที่ยังใช้อยู่มี effective_to เป็น NULL ช่วงเวลาคือ [effective_from, effective_to)
+CREATE TABLE menu_item_versions (
+    uuid UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    menu_item_id UUID NOT NULL REFERENCES menu_items (uuid) ON DELETE CASCADE,
+    name_th VARCHAR(255) NOT NULL,
+    name_en VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    price DECIMAL(10, 2) NOT NULL,
+    category_id UUID,
+    effective_from TIMESTAMPTZ NOT NULL,
+    effective_to TIMESTAMPTZ,
+    CHECK (effective_to IS NULL OR effective_to > effective_from)
+);
+
+CREATE INDEX menu_item_versions_menu_item_id_idx ON menu_item_versions (menu_item_id, effective_from);
+
+CREATE TABLE menu_set_versions (
+    uuid UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    menu_set_id UUID NOT NULL REFERENCES menu_sets (uuid) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    price DECIMAL(10, 2) NOT NULL,
+    effective_from TIMESTAMPTZ NOT NULL,
+    effective_to TIMESTAMPTZ,
+    CHECK (effective_to IS NULL OR effective_to > effective_from)
+);
+
+CREATE INDEX menu_set_versions_menu_set_id_idx ON menu_set_versions (menu_set_id, effective_from);
+
+-- ราคาของเมนูหรือเมนูเซ็ตแต่ละช่วงเวลา รวมราคาที่ตั้งล่วงหน้า (effective_from ในอนาคต)
+-- ช่วงของเจ้าของเดียวกันต่อกันไม่ทับกัน menu_items.price / menu_sets.price คือราคาของช่วงปัจจุบัน
+CREATE TABLE menu_price_history (
+    uuid UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    menu_item_id UUID REFERENCES menu_items (uuid) ON DELETE CASCADE,
+    menu_set_id UUID REFERENCES menu_sets (uuid) ON DELETE CASCADE,
+    price DECIMAL(10, 2) NOT NULL CHECK (price >= 0),
+    effective_from TIMESTAMPTZ NOT NULL,
+    effective_to TIMESTAMPTZ,
+    CHECK ((menu_item_id IS NULL) <> (menu_set_id IS NULL)),
+    CHECK (effective_to IS NULL OR effective_to > effective_from)
+);
+
+CREATE INDEX menu_price_history_menu_item_id_idx ON menu_price_history (menu_item_id, effective_from);
+CREATE INDEX menu_price_history_menu_set_id_idx ON menu_price_history (menu_set_id, effective_from);
+
+-- เมนูที่มีอยู่แล้วเริ่มประวัติจากเวลาที่ migrate (ไม่มีข้อมูลก่อนหน้านี้)
+INSERT INTO menu_item_versions (menu_item_id, name_th, name_en, description, price, category_id, effective_from)
+SELECT uuid, name_th, name_en, COALESCE(description, ''), price, category_id, now() FROM menu_items;
+
+INSERT INTO menu_set_versions (menu_set_id, name, price, effective_from)
+SELECT uuid, name, price, now() FROM menu_sets;
+
+INSERT INTO menu_price_history (menu_item_id, price, effective_from)
+SELECT uuid, price, now() FROM menu_items;
+
+INSERT INTO menu_price_history (menu_set_id, price, effective_from)
+SELECT uuid, price, now() FROM menu_sets;
@@ -0,0 +1,2 @@
+ALTER TABLE menu_sets DROP COLUMN archived_at;
+ALTER TABLE menu_items DROP COLUMN archived_at;
@@ -0,0 +1,4 @@
+-- เมนูและเซตเมนูที่เลิกขายเก็บเข้าคลังแทนการลบ การจองเก่าจึงยังอ้างถึงได้
+-- archived_at เป็น NULL = ยังขายอยู่
+ALTER TABLE menu_items ADD COLUMN archived_at TIMESTAMPTZ;
+ALTER TABLE menu_sets ADD COLUMN archived_at TIMESTAMPTZ;
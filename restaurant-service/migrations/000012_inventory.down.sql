@@ -0,0 +1,3 @@
+DROP TABLE ingredient_movements;
+DROP TABLE menu_item_ingredients;
+DROP TABLE ingredients;
@@ -0,0 +1,35 @@
+-- วัตถุดิบ stock คือจำนวนคงเหลือปัจจุบันในหน่วย unit
+-- เปลี่ยน stock ได้ผ่าน ingredient_movements เท่านั้น เพื่อให้ตรวจย้อนหลังได้
+CREATE TABLE ingredients (
+    uuid UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name_th VARCHAR(255) NOT NULL UNIQUE,
+    name_en VARCHAR(255) NOT NULL UNIQUE,
+    unit VARCHAR(16) NOT NULL,
+    stock NUMERIC(14, 3) NOT NULL DEFAULT 0 CHECK (stock >= 0),
+    reorder_level NUMERIC(14, 3) NOT NULL DEFAULT 0 CHECK (reorder_level >= 0) -- เตือนเมื่อคงเหลือไม่เกินค่านี้ 0 = ไม่เตือน
+);
+
+-- สูตรของเมนู: ปริมาณวัตถุดิบที่ใช้ต่อหนึ่งจาน
+-- ลบวัตถุดิบที่ยังอยู่ในสูตรไม่ได้ (RESTRICT) ลบเมนูแล้วสูตรหายตาม
+CREATE TABLE menu_item_ingredients (
+    menu_item_id UUID NOT NULL REFERENCES menu_items (uuid) ON DELETE CASCADE,
+    ingredient_id UUID NOT NULL REFERENCES ingredients (uuid) ON DELETE RESTRICT,
+    quantity NUMERIC(14, 3) NOT NULL CHECK (quantity > 0),
+    PRIMARY KEY (menu_item_id, ingredient_id)
+);
+
+CREATE INDEX menu_item_ingredients_ingredient_id_idx ON menu_item_ingredients (ingredient_id);
+
+-- สมุดบันทึกการเคลื่อนไหวของสต็อก quantity เป็นค่าที่เปลี่ยน (บวก = รับเข้า ลบ = ใช้/ทิ้ง)
+-- stock_after คือคงเหลือหลังรายการนี้
+CREATE TABLE ingredient_movements (
+    uuid UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    ingredient_id UUID NOT NULL REFERENCES ingredients (uuid) ON DELETE CASCADE,
+    kind VARCHAR(16) NOT NULL,
+    quantity NUMERIC(14, 3) NOT NULL,
+    stock_after NUMERIC(14, 3) NOT NULL,
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX ingredient_movements_ingredient_id_idx ON ingredient_movements (ingredient_id, created_at);
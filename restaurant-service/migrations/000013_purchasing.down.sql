@@ -0,0 +1,7 @@
+DROP TABLE purchase_order_lines;
+DROP TABLE purchase_orders;
+ALTER TABLE ingredients
+    DROP COLUMN pack_size,
+    DROP COLUMN unit_cost,
+    DROP COLUMN supplier_id;
+DROP TABLE suppliers;
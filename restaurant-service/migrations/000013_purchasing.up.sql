@@ -0,0 +1,44 @@
+-- ผู้ขายวัตถุดิบ lead_time_days คือจำนวนวันตั้งแต่สั่งจนของมาถึง
+CREATE TABLE suppliers (
+    uuid UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(255) NOT NULL UNIQUE,
+    contact_name VARCHAR(255) NOT NULL DEFAULT '',
+    phone VARCHAR(32) NOT NULL DEFAULT '',
+    email VARCHAR(255) NOT NULL DEFAULT '',
+    lead_time_days INT NOT NULL DEFAULT 0 CHECK (lead_time_days >= 0)
+);
+
+-- ผู้ขายหลักของวัตถุดิบ ราคาต่อหน่วย และขนาดบรรจุที่ต้องสั่งเป็นจำนวนเต็ม (0 = สั่งเศษได้)
+ALTER TABLE ingredients
+    ADD COLUMN supplier_id UUID REFERENCES suppliers (uuid) ON DELETE SET NULL,
+    ADD COLUMN unit_cost NUMERIC(10, 2) NOT NULL DEFAULT 0 CHECK (unit_cost >= 0),
+    ADD COLUMN pack_size NUMERIC(14, 3) NOT NULL DEFAULT 0 CHECK (pack_size >= 0);
+
+-- ใบสั่งซื้อ DRAFT -> SENT -> RECEIVED หรือ CANCELLED
+-- from_date ถึง to_date คือช่วงวันของการจองที่ใช้คำนวณ
+CREATE TABLE purchase_orders (
+    uuid UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    supplier_id UUID NOT NULL REFERENCES suppliers (uuid) ON DELETE RESTRICT,
+    status VARCHAR(16) NOT NULL DEFAULT 'DRAFT',
+    from_date DATE NOT NULL,
+    to_date DATE NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX purchase_orders_status_idx ON purchase_orders (status);
+
+-- required = ที่การจองต้องใช้, on_hand = คงเหลือตอนสร้าง, on_order = ที่สั่งไปแล้วยังไม่ได้รับ
+-- quantity = จำนวนที่สั่ง unit_cost เก็บราคา ณ ตอนสร้าง
+CREATE TABLE purchase_order_lines (
+    purchase_order_id UUID NOT NULL REFERENCES purchase_orders (uuid) ON DELETE CASCADE,
+    ingredient_id UUID NOT NULL REFERENCES ingredients (uuid) ON DELETE RESTRICT,
+    required NUMERIC(14, 3) NOT NULL,
+    on_hand NUMERIC(14, 3) NOT NULL,
+    on_order NUMERIC(14, 3) NOT NULL,
+    quantity NUMERIC(14, 3) NOT NULL CHECK (quantity > 0),
+    unit_cost NUMERIC(10, 2) NOT NULL,
+    PRIMARY KEY (purchase_order_id, ingredient_id)
+);
+
+CREATE INDEX purchase_order_lines_ingredient_id_idx ON purchase_order_lines (ingredient_id);
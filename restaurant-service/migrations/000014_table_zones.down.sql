@@ -0,0 +1,7 @@
+ALTER TABLE tables
+    DROP COLUMN shape,
+    DROP COLUMN rotation,
+    DROP COLUMN pos_y,
+    DROP COLUMN pos_x,
+    DROP COLUMN zone_id;
+DROP TABLE table_zones;
@@ -0,0 +1,20 @@
+-- โซนของร้าน เช่น ในร้าน ระเบียง ห้องส่วนตัว width/height คือขนาดผังของโซน (หน่วยเดียวกับตำแหน่งโต๊ะ 0 = ไม่กำหนด)
+CREATE TABLE table_zones (
+    uuid UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name_th VARCHAR(255) NOT NULL UNIQUE,
+    name_en VARCHAR(255) NOT NULL UNIQUE,
+    sort_order INTEGER NOT NULL DEFAULT 0,
+    width INTEGER NOT NULL DEFAULT 0 CHECK (width >= 0),
+    height INTEGER NOT NULL DEFAULT 0 CHECK (height >= 0)
+);
+
+-- ตำแหน่งโต๊ะบนผังของโซน pos_x/pos_y คือจุดกึ่งกลางโต๊ะ rotation เป็นองศาตามเข็มนาฬิกา
+-- zone_id NULL = ยังไม่ได้จัดโซน
+ALTER TABLE tables
+    ADD COLUMN zone_id UUID REFERENCES table_zones (uuid) ON DELETE RESTRICT,
+    ADD COLUMN pos_x DOUBLE PRECISION NOT NULL DEFAULT 0,
+    ADD COLUMN pos_y DOUBLE PRECISION NOT NULL DEFAULT 0,
+    ADD COLUMN rotation DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (rotation >= 0 AND rotation < 360),
+    ADD COLUMN shape VARCHAR(16) NOT NULL DEFAULT 'SQUARE' CHECK (shape IN ('SQUARE', 'ROUND', 'RECTANGLE'));
+
+CREATE INDEX tables_zone_id_idx ON tables (zone_id);
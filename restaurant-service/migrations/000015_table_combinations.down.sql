@@ -0,0 +1,2 @@
+DROP TABLE table_combination_members;
+DROP TABLE table_combinations;
@@ -0,0 +1,15 @@
+-- ชุดโต๊ะที่ต่อกันได้สำหรับกลุ่มใหญ่ (เฉพาะโต๊ะที่ติดกันตามที่ร้านกำหนด) capacity คือจำนวนที่นั่งเมื่อต่อโต๊ะแล้ว
+CREATE TABLE table_combinations (
+    uuid UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(255) NOT NULL UNIQUE,
+    capacity INTEGER NOT NULL CHECK (capacity > 0)
+);
+
+-- โต๊ะในชุด ลบโต๊ะที่ยังอยู่ในชุดไม่ได้ ต้องแก้หรือลบชุดก่อน
+CREATE TABLE table_combination_members (
+    combination_id UUID NOT NULL REFERENCES table_combinations (uuid) ON DELETE CASCADE,
+    table_id UUID NOT NULL REFERENCES tables (uuid) ON DELETE RESTRICT,
+    PRIMARY KEY (combination_id, table_id)
+);
+
+CREATE INDEX table_combination_members_table_id_idx ON table_combination_members (table_id);
@@ -0,0 +1,31 @@
+CREATE TYPE table_type AS ENUM ('STANDARD', 'LARGE');
+
+ALTER TABLE tables ADD COLUMN type table_type;
+
+-- ประเภทที่เพิ่มภายหลังย้อนกลับเป็น STANDARD ถ้ารับลูกค้าได้ไม่เกินจำนวนที่นั่งของ Standard นอกนั้นเป็น LARGE
+UPDATE tables t
+SET type = CASE
+    WHEN tt.name = 'Large' THEN 'LARGE'::table_type
+    WHEN tt.name = 'Standard' THEN 'STANDARD'::table_type
+    WHEN tt.max_seats <= COALESCE((SELECT max_seats FROM table_types WHERE name = 'Standard'), 4) THEN 'STANDARD'::table_type
+    ELSE 'LARGE'::table_type
+END
+FROM table_types tt
+WHERE tt.uuid = t.type_id;
+
+ALTER TABLE tables
+    ALTER COLUMN type SET NOT NULL,
+    DROP COLUMN type_id;
+
+CREATE TABLE legacy_table_types (
+    type table_type PRIMARY KEY,
+    seat_count INTEGER
+);
+
+INSERT INTO legacy_table_types (type, seat_count)
+SELECT CASE name WHEN 'Standard' THEN 'STANDARD'::table_type ELSE 'LARGE'::table_type END, max_seats
+FROM table_types
+WHERE name IN ('Standard', 'Large');
+
+DROP TABLE table_types;
+ALTER TABLE legacy_table_types RENAME TO table_types;
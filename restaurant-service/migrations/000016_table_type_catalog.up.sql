@@ -0,0 +1,37 @@
+-- ประเภทโต๊ะที่เพิ่ม/แก้ไขได้เอง แทน enum table_type ที่มีแค่ STANDARD/LARGE
+-- min_seats/max_seats คือจำนวนลูกค้าที่เหมาะกับโต๊ะ surcharge คือค่าบริการเพิ่มของประเภทนี้ (บาท)
+ALTER TABLE table_types RENAME TO legacy_table_types;
+
+CREATE TABLE table_types (
+    uuid UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(255) NOT NULL UNIQUE,
+    min_seats INTEGER NOT NULL DEFAULT 1 CHECK (min_seats > 0),
+    max_seats INTEGER NOT NULL CHECK (max_seats > 0),
+    description TEXT NOT NULL DEFAULT '',
+    surcharge NUMERIC(10, 2) NOT NULL DEFAULT 0 CHECK (surcharge >= 0),
+    CHECK (max_seats >= min_seats)
+);
+
+-- ย้ายค่า enum เดิมพร้อมจำนวนที่นั่ง
+INSERT INTO table_types (name, max_seats)
+SELECT CASE l.type WHEN 'STANDARD' THEN 'Standard' WHEN 'LARGE' THEN 'Large' END, GREATEST(l.seat_count, 1)
+FROM legacy_table_types l;
+
+INSERT INTO table_types (name, max_seats) VALUES ('Standard', 4), ('Large', 8)
+ON CONFLICT (name) DO NOTHING;
+
+ALTER TABLE tables ADD COLUMN type_id UUID REFERENCES table_types (uuid) ON DELETE RESTRICT;
+
+UPDATE tables t
+SET type_id = tt.uuid
+FROM table_types tt
+WHERE tt.name = CASE t.type WHEN 'STANDARD' THEN 'Standard' WHEN 'LARGE' THEN 'Large' END;
+
+ALTER TABLE tables
+    ALTER COLUMN type_id SET NOT NULL,
+    DROP COLUMN type;
+
+DROP TABLE legacy_table_types;
+DROP TYPE table_type;
+
+CREATE INDEX tables_type_id_idx ON tables (type_id);
@@ -0,0 +1,14 @@
+-- ช่วงเวลาที่โต๊ะใช้งานไม่ได้ (ชำรุด ซ่อมบำรุง กันไว้ให้พนักงาน) แทนการลบโต๊ะ
+-- ช่วงเวลาเป็น [start_time, end_time)
+CREATE TABLE table_blocks (
+    uuid UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    table_id UUID NOT NULL REFERENCES tables (uuid) ON DELETE CASCADE,
+    reason TEXT NOT NULL,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    created_by VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CHECK (end_time > start_time)
+);
+
+CREATE INDEX table_blocks_table_id_idx ON table_blocks (table_id, start_time, end_time);
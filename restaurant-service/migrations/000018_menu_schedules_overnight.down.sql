@@ -0,0 +1,6 @@
+-- ต้องลบหรือแก้ช่วงที่ข้ามเที่ยงคืนก่อน ไม่อย่างนั้น check เดิมจะเพิ่มไม่ได้
+ALTER TABLE menu_schedules DROP CONSTRAINT menu_schedules_minutes_check;
+
+ALTER TABLE menu_schedules
+    ADD CONSTRAINT menu_schedules_minutes_check
+    CHECK (start_minute >= 0 AND start_minute < end_minute AND end_minute <= 1440);
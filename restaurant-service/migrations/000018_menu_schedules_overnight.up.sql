@@ -0,0 +1,20 @@
+-- ช่วงเวลาที่ข้ามเที่ยงคืน (เช่น 22:00-02:00 เก็บเป็น start_minute 1320, end_minute 120)
+-- ส่วนหลังเที่ยงคืนนับเป็นของวันก่อนหน้า ดู MenuSchedule.ActiveAt
+-- check เดิมไม่ได้ตั้งชื่อไว้ จึงหาจากนิยามของ constraint
+DO $$
+DECLARE
+    constraint_name TEXT;
+BEGIN
+    SELECT conname INTO constraint_name
+    FROM pg_constraint
+    WHERE conrelid = 'menu_schedules'::regclass
+        AND contype = 'c'
+        AND pg_get_constraintdef(oid) LIKE '%start_minute < end_minute%';
+    IF constraint_name IS NOT NULL THEN
+        EXECUTE format('ALTER TABLE menu_schedules DROP CONSTRAINT %I', constraint_name);
+    END IF;
+END $$;
+
+ALTER TABLE menu_schedules
+    ADD CONSTRAINT menu_schedules_minutes_check
+    CHECK (start_minute BETWEEN 0 AND 1440 AND end_minute BETWEEN 0 AND 1440 AND start_minute <> end_minute);